@@ -2,9 +2,19 @@ package client
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -19,6 +29,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/configs/configschema"
@@ -33,17 +47,31 @@ import (
 //go:generate mockgen -source ../vendor/github.com/aws/aws-sdk-go/service/iam/iamiface/interface.go -destination aws_iam_mock.go -package client
 //go:generate mockgen -source ../vendor/github.com/aws/aws-sdk-go/service/rds/rdsiface/interface.go -destination aws_rds_mock.go -package client
 //go:generate mockgen -source ../vendor/github.com/aws/aws-sdk-go/service/ecs/ecsiface/interface.go -destination aws_ecs_mock.go -package client
+//go:generate mockgen -source ../vendor/github.com/aws/aws-sdk-go/service/s3/s3iface/interface.go -destination aws_s3_mock.go -package client
+//go:generate mockgen -source ../vendor/github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface/interface.go -destination aws_cloudwatchlogs_mock.go -package client
+//go:generate mockgen -source ../vendor/github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface/interface.go -destination aws_servicequotas_mock.go -package client
 
 // AwsClient is a wrapper of the AWS SDK client
 // It has interfaces for each services to make testing easier
 type AwsClient struct {
-	IAM         iamiface.IAMAPI
-	EC2         ec2iface.EC2API
-	RDS         rdsiface.RDSAPI
-	ElastiCache elasticacheiface.ElastiCacheAPI
-	ELB         elbiface.ELBAPI
-	ELBV2       elbv2iface.ELBV2API
-	ECS         ecsiface.ECSAPI
+	IAM            iamiface.IAMAPI
+	EC2            ec2iface.EC2API
+	RDS            rdsiface.RDSAPI
+	ElastiCache    elasticacheiface.ElastiCacheAPI
+	ELB            elbiface.ELBAPI
+	ELBV2          elbv2iface.ELBV2API
+	ECS            ecsiface.ECSAPI
+	S3             s3iface.S3API
+	CloudWatchLogs cloudwatchlogsiface.CloudWatchLogsAPI
+	ServiceQuotas  servicequotasiface.ServiceQuotasAPI
+
+	// Region is the region of the session
+	Region string
+	// Partition is the partition of the region, such as "aws", "aws-cn" and "aws-us-gov"
+	Partition string
+	// AccountID is the account of the assumed role. It is empty when no role is assumed
+	// because looking up the caller identity requires an extra API call.
+	AccountID string
 }
 
 // AwsCredentials is credentials for AWS used in deep check mode
@@ -57,6 +85,12 @@ type AwsCredentials struct {
 	AssumeRolePolicy      string
 	AssumeRoleSessionName string
 	Region                string
+	// CacheCredentials caches credentials of the assumed role across runs
+	CacheCredentials bool
+	// CABundle is a path of PEM encoded certificates trusted in addition to the system ones, e.g. for TLS-intercepting proxies
+	CABundle string
+	// Partition is the AWS partition, such as "aws-us-gov". By default, it is detected from the region
+	Partition string
 }
 
 // AwsProviderBlockSchema is a schema of `aws` provider block
@@ -86,23 +120,106 @@ func NewAwsClient(creds AwsCredentials) (*AwsClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := validatePartition(creds); err != nil {
+		return nil, err
+	}
 
-	s, err := awsbase.GetSession(config)
+	var bundle string
+	if creds.CABundle != "" {
+		bundle, err = homedir.Expand(creds.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(bundle); err != nil {
+			return nil, fmt.Errorf("Failed to load the CA bundle `%s`: %s", creds.CABundle, err)
+		}
+	}
+
+	cacheable := creds.CacheCredentials && creds.AssumeRoleARN != ""
+	cached := false
+	if cacheable {
+		if cache, ok := loadCachedCredentials(creds, time.Now()); ok {
+			log.Printf("[INFO] Use cached credentials of %s", creds.AssumeRoleARN)
+			config = &awsbase.Config{
+				AccessKey: cache.AccessKeyID,
+				SecretKey: cache.SecretAccessKey,
+				Token:     cache.SessionToken,
+				Region:    config.Region,
+			}
+			cached = true
+		}
+	}
+
+	var s *session.Session
+	if bundle != "" {
+		s, err = getSessionWithCABundle(config, bundle)
+	} else {
+		s, err = awsbase.GetSession(config)
+	}
 	if err != nil {
 		return nil, formatBaseConfigError(err)
 	}
 
+	if cacheable && !cached {
+		if err := saveCachedCredentials(creds, s.Config.Credentials); err != nil {
+			log.Printf("[WARN] Failed to cache credentials of %s: %s", creds.AssumeRoleARN, err)
+		}
+	}
+
 	return &AwsClient{
-		IAM:         iam.New(s),
-		EC2:         ec2.New(s),
-		RDS:         rds.New(s),
-		ElastiCache: elasticache.New(s),
-		ELB:         elb.New(s),
-		ELBV2:       elbv2.New(s),
-		ECS:         ecs.New(s),
+		IAM:            iam.New(s),
+		EC2:            ec2.New(s),
+		RDS:            rds.New(s),
+		ElastiCache:    elasticache.New(s),
+		ELB:            elb.New(s),
+		ELBV2:          elbv2.New(s),
+		ECS:            ecs.New(s),
+		S3:             s3.New(s),
+		CloudWatchLogs: cloudwatchlogs.New(s),
+		ServiceQuotas:  servicequotas.New(s),
+		Region:         aws.StringValue(s.Config.Region),
+		Partition:      PartitionForRegion(aws.StringValue(s.Config.Region)),
+		AccountID:      accountIDFromARN(creds.AssumeRoleARN),
 	}, nil
 }
 
+// PartitionForRegion returns the partition of the region in the endpoints data bundled with the AWS SDK
+// Unknown regions are treated as regions of the standard partition.
+func PartitionForRegion(region string) string {
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return partition.ID()
+	}
+	return endpoints.AwsPartitionID
+}
+
+// validatePartition checks whether the explicit partition is known and contains the region
+// Credentials of a partition are not valid in other partitions, so a mismatch is reported before calling APIs.
+func validatePartition(creds AwsCredentials) error {
+	if creds.Partition == "" {
+		return nil
+	}
+
+	for _, partition := range endpoints.DefaultPartitions() {
+		if partition.ID() != creds.Partition {
+			continue
+		}
+		if creds.Region != "" && PartitionForRegion(creds.Region) != creds.Partition {
+			return fmt.Errorf("`%s` region is not in `%s` partition", creds.Region, creds.Partition)
+		}
+		return nil
+	}
+	return fmt.Errorf("`%s` is invalid partition", creds.Partition)
+}
+
+// accountIDFromARN returns the account ID of the passed ARN, or an empty string if it is not a valid ARN
+func accountIDFromARN(s string) string {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return parsed.AccountID
+}
+
 // ConvertToCredentials converts to credentials from the given provider config
 func ConvertToCredentials(providerConfig providerResource) (AwsCredentials, error) {
 	ret := AwsCredentials{}
@@ -197,6 +314,15 @@ func (c AwsCredentials) Merge(other AwsCredentials) AwsCredentials {
 	if other.AssumeRolePolicy != "" {
 		c.AssumeRolePolicy = other.AssumeRolePolicy
 	}
+	if other.CacheCredentials {
+		c.CacheCredentials = true
+	}
+	if other.CABundle != "" {
+		c.CABundle = other.CABundle
+	}
+	if other.Partition != "" {
+		c.Partition = other.Partition
+	}
 	return c
 }
 
@@ -219,6 +345,51 @@ func getBaseConfig(creds AwsCredentials) (*awsbase.Config, error) {
 	}, nil
 }
 
+// getSessionWithCABundle returns a session trusting the CA bundle in addition to the system certificates
+// Sessions created inside awsbase do not accept custom CA bundles, so only source credentials are resolved by awsbase,
+// and the role is assumed with the session trusting the bundle. Instance metadata is retrieved over plain HTTP,
+// so it does not need the bundle.
+func getSessionWithCABundle(config *awsbase.Config, bundle string) (*session.Session, error) {
+	f, err := os.Open(bundle)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	base := *config
+	base.AssumeRoleARN = ""
+	options, err := awsbase.GetSessionOptions(&base)
+	if err != nil {
+		return nil, err
+	}
+	options.CustomCABundle = f
+
+	s, err := session.NewSessionWithOptions(*options)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating AWS session: %s", err)
+	}
+	if config.AssumeRoleARN == "" {
+		return s, nil
+	}
+
+	log.Printf("[INFO] Assume role %s with the session trusting the CA bundle", config.AssumeRoleARN)
+	creds := stscreds.NewCredentials(s, config.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if config.AssumeRoleSessionName != "" {
+			p.RoleSessionName = config.AssumeRoleSessionName
+		}
+		if config.AssumeRoleExternalID != "" {
+			p.ExternalID = aws.String(config.AssumeRoleExternalID)
+		}
+		if config.AssumeRolePolicy != "" {
+			p.Policy = aws.String(config.AssumeRolePolicy)
+		}
+	})
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("Error assuming role %s: %s", config.AssumeRoleARN, err)
+	}
+	return s.Copy(&aws.Config{Credentials: creds}), nil
+}
+
 // @see https://github.com/hashicorp/aws-sdk-go-base/blob/v0.3.0/session.go#L87
 func formatBaseConfigError(err error) error {
 	if strings.Contains(err.Error(), "No valid credential sources found for AWS Provider") {
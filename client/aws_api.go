@@ -1,10 +1,13 @@
 package client
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
 )
 
 // DescribeSecurityGroups is a wrapper of DescribeSecurityGroups
@@ -216,3 +219,138 @@ func (c *AwsClient) DescribeVpcPeeringConnections() (map[string]bool, error) {
 	}
 	return ret, err
 }
+
+// DescribeAddresses is a wrapper of DescribeAddresses
+func (c *AwsClient) DescribeAddresses() (map[string]bool, error) {
+	ret := map[string]bool{}
+	resp, err := c.EC2.DescribeAddresses(&ec2.DescribeAddressesInput{})
+	if err != nil {
+		return ret, err
+	}
+	for _, address := range resp.Addresses {
+		if address.AllocationId != nil {
+			ret[*address.AllocationId] = true
+		}
+	}
+	return ret, err
+}
+
+// DescribeVpcs is a wrapper of DescribeVpcs
+func (c *AwsClient) DescribeVpcs() (map[string]bool, error) {
+	ret := map[string]bool{}
+	resp, err := c.EC2.DescribeVpcs(&ec2.DescribeVpcsInput{})
+	if err != nil {
+		return ret, err
+	}
+	for _, vpc := range resp.Vpcs {
+		ret[*vpc.VpcId] = true
+	}
+	return ret, err
+}
+
+// GetServiceQuota is a wrapper of GetServiceQuota
+// If the quota has never been increased in the account, it falls back to GetAWSDefaultServiceQuota
+func (c *AwsClient) GetServiceQuota(serviceCode string, quotaCode string) (int, error) {
+	resp, err := c.ServiceQuotas.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil {
+		return int(aws.Float64Value(resp.Quota.Value)), nil
+	}
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, err
+	}
+
+	defaultResp, err := c.ServiceQuotas.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(aws.Float64Value(defaultResp.Quota.Value)), nil
+}
+
+// DescribeInstanceTypeOfferings is a wrapper of DescribeInstanceTypeOfferings
+// It returns instance types offered in the region of the client
+func (c *AwsClient) DescribeInstanceTypeOfferings() (map[string]bool, error) {
+	ret := map[string]bool{}
+	err := c.EC2.DescribeInstanceTypeOfferingsPages(&ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeRegion),
+	}, func(page *ec2.DescribeInstanceTypeOfferingsOutput, lastPage bool) bool {
+		for _, offering := range page.InstanceTypeOfferings {
+			ret[*offering.InstanceType] = true
+		}
+		return true
+	})
+	return ret, err
+}
+
+// DescribeInstanceTypes is a wrapper of DescribeInstanceTypes
+// It returns whether each instance type available in the region of the client is current generation
+func (c *AwsClient) DescribeInstanceTypes() (map[string]bool, error) {
+	ret := map[string]bool{}
+	err := c.EC2.DescribeInstanceTypesPages(&ec2.DescribeInstanceTypesInput{}, func(page *ec2.DescribeInstanceTypesOutput, lastPage bool) bool {
+		for _, instanceType := range page.InstanceTypes {
+			ret[aws.StringValue(instanceType.InstanceType)] = aws.BoolValue(instanceType.CurrentGeneration)
+		}
+		return true
+	})
+	return ret, err
+}
+
+// DescribeInstanceTypeVCPUs is a wrapper of DescribeInstanceTypes
+// It returns the default number of vCPUs of each instance type available in the region of the client
+func (c *AwsClient) DescribeInstanceTypeVCPUs() (map[string]int, error) {
+	ret := map[string]int{}
+	err := c.EC2.DescribeInstanceTypesPages(&ec2.DescribeInstanceTypesInput{}, func(page *ec2.DescribeInstanceTypesOutput, lastPage bool) bool {
+		for _, instanceType := range page.InstanceTypes {
+			if instanceType.VCpuInfo != nil {
+				ret[aws.StringValue(instanceType.InstanceType)] = int(aws.Int64Value(instanceType.VCpuInfo.DefaultVCpus))
+			}
+		}
+		return true
+	})
+	return ret, err
+}
+
+// DescribeRunningInstanceVCPUs is a wrapper of DescribeInstances
+// It returns the number of vCPUs of running instances, indexed by instance types
+func (c *AwsClient) DescribeRunningInstanceVCPUs() (map[string]int, error) {
+	ret := map[string]int{}
+	err := c.EC2.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: []*string{aws.String(ec2.InstanceStateNameRunning)},
+			},
+		},
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				vcpus := 0
+				if instance.CpuOptions != nil {
+					vcpus = int(aws.Int64Value(instance.CpuOptions.CoreCount) * aws.Int64Value(instance.CpuOptions.ThreadsPerCore))
+				}
+				ret[aws.StringValue(instance.InstanceType)] += vcpus
+			}
+		}
+		return true
+	})
+	return ret, err
+}
+
+// DescribeAvailabilityZones is a wrapper of DescribeAvailabilityZones
+// It returns names of availability zones enabled in the account
+func (c *AwsClient) DescribeAvailabilityZones() (map[string]bool, error) {
+	ret := map[string]bool{}
+	resp, err := c.EC2.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+	if err != nil {
+		return ret, err
+	}
+	for _, zone := range resp.AvailabilityZones {
+		ret[*zone.ZoneName] = true
+	}
+	return ret, err
+}
@@ -1,6 +1,11 @@
 package client
 
 import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -29,6 +34,7 @@ func Test_Merge(t *testing.T) {
 				AssumeRoleExternalID:  "EXTERNAL_ID",
 				AssumeRolePolicy:      "POLICY_NAME",
 				Region:                "us-east-1",
+				CABundle:              "~/.aws/ca.pem",
 			},
 			Expected: AwsCredentials{
 				AccessKey:             "AWS_ACCESS_KEY",
@@ -40,6 +46,7 @@ func Test_Merge(t *testing.T) {
 				AssumeRoleExternalID:  "EXTERNAL_ID",
 				AssumeRolePolicy:      "POLICY_NAME",
 				Region:                "us-east-1",
+				CABundle:              "~/.aws/ca.pem",
 			},
 		},
 		{
@@ -114,6 +121,97 @@ func Test_Merge(t *testing.T) {
 	}
 }
 
+func Test_NewAwsClient_caBundleNotFound(t *testing.T) {
+	_, err := NewAwsClient(AwsCredentials{CABundle: "not_found.pem"})
+	if err == nil {
+		t.Fatal("Expected error does not occurred")
+	}
+	expected := "Failed to load the CA bundle `not_found.pem`: stat not_found.pem: no such file or directory"
+	if err.Error() != expected {
+		t.Fatalf("Expected error is `%s`, but get `%s`", expected, err.Error())
+	}
+}
+
+func Test_getSessionWithCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ca-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bundle := filepath.Join(dir, "bundle.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(bundle, cert, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	original := os.Getenv("AWS_CA_BUNDLE")
+	s, err := getSessionWithCABundle(&awsbase.Config{
+		AccessKey:            "AWS_ACCESS_KEY",
+		SecretKey:            "AWS_SECRET_KEY",
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+	}, bundle)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	resp, err := s.Config.HTTPClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected the server certificate is trusted, but got error: %s", err)
+	}
+	resp.Body.Close()
+	if env := os.Getenv("AWS_CA_BUNDLE"); env != original {
+		t.Fatalf("Expected AWS_CA_BUNDLE is not changed, but got `%s`", env)
+	}
+}
+
+func Test_NewAwsClient_partition(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Creds    AwsCredentials
+		Expected string
+	}{
+		{
+			Name:     "invalid partition",
+			Creds:    AwsCredentials{Partition: "aws-gov", Region: "us-gov-west-1"},
+			Expected: "`aws-gov` is invalid partition",
+		},
+		{
+			Name:     "region of another partition",
+			Creds:    AwsCredentials{Partition: "aws-us-gov", Region: "us-east-1"},
+			Expected: "`us-east-1` region is not in `aws-us-gov` partition",
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := NewAwsClient(tc.Creds)
+		if err == nil {
+			t.Fatalf("Failed `%s` test: Expected error does not occurred", tc.Name)
+		}
+		if err.Error() != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected error is `%s`, but get `%s`", tc.Name, tc.Expected, err.Error())
+		}
+	}
+}
+
+func Test_PartitionForRegion(t *testing.T) {
+	cases := map[string]string{
+		"us-east-1":     "aws",
+		"us-gov-west-1": "aws-us-gov",
+		"cn-north-1":    "aws-cn",
+		"unknown":       "aws",
+	}
+
+	for region, expected := range cases {
+		if partition := PartitionForRegion(region); partition != expected {
+			t.Fatalf("Failed `%s` test: expected partition is `%s`, but get `%s`", region, expected, partition)
+		}
+	}
+}
+
 func Test_getBaseConfig(t *testing.T) {
 	home, err := homedir.Expand("~/")
 	if err != nil {
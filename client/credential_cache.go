@@ -0,0 +1,206 @@
+package client
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	homedir "github.com/mitchellh/go-homedir"
+	keyring "github.com/zalando/go-keyring"
+)
+
+// CredentialCacheDir is the directory where credentials of assumed roles are cached
+var CredentialCacheDir = "~/.tflint.d/sts-cache"
+
+// The key encrypting cache files is held in the OS keychain, such as Keychain on macOS,
+// Secret Service on Linux and Credential Manager on Windows.
+const (
+	credentialCacheKeyService = "tflint"
+	credentialCacheKeyUser    = "sts-cache-key"
+)
+
+// keychainGet and keychainSet access the OS keychain. They are replaced in tests.
+var (
+	keychainGet = keyring.Get
+	keychainSet = keyring.Set
+)
+
+// credentialExpiryWindow is the margin before the expiration. Cached credentials expiring within it are not used
+// so that they do not expire in the middle of the inspection.
+var credentialExpiryWindow = 5 * time.Minute
+
+// cachedCredentials is temporary credentials of an assumed role stored in the cache directory
+type cachedCredentials struct {
+	AccessKeyID     string    `json:"access_key_id"`
+	SecretAccessKey string    `json:"secret_access_key"`
+	SessionToken    string    `json:"session_token"`
+	Expiration      time.Time `json:"expiration"`
+}
+
+// credentialCachePath returns the path of the cache file for the passed credentials
+// The key includes the source credentials as well as the role so that different identities never share the cache.
+func credentialCachePath(creds AwsCredentials) (string, error) {
+	dir, err := homedir.Expand(CredentialCacheDir)
+	if err != nil {
+		return "", err
+	}
+
+	key := strings.Join([]string{
+		creds.AssumeRoleARN,
+		creds.AssumeRoleExternalID,
+		creds.AssumeRoleSessionName,
+		creds.AssumeRolePolicy,
+		creds.AccessKey,
+		creds.Profile,
+		creds.CredsFile,
+	}, "\x00")
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".enc"), nil
+}
+
+// credentialCacheKey returns the key encrypting cache files from the OS keychain
+// If create is true, a new random key is stored when the keychain does not have it yet.
+// It returns an error if the keychain is not available, and then credentials are not cached.
+func credentialCacheKey(create bool) ([]byte, error) {
+	encoded, err := keychainGet(credentialCacheKeyService, credentialCacheKeyUser)
+	if err == keyring.ErrNotFound && create {
+		key := make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+		if err := keychainSet(credentialCacheKeyService, credentialCacheKeyUser, hex.EncodeToString(key)); err != nil {
+			return nil, fmt.Errorf("OS keychain is not available: %s", err)
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("OS keychain is not available: %s", err)
+	}
+	return hex.DecodeString(encoded)
+}
+
+func newCredentialCacheCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptCredentials returns the nonce followed by the encrypted credentials
+func encryptCredentials(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newCredentialCacheCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decryptCredentials(key []byte, ciphertext []byte) ([]byte, error) {
+	gcm, err := newCredentialCacheCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("the cache is truncated")
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+}
+
+// loadCachedCredentials returns the cached credentials of the role if they are still valid
+// Nothing is returned if the key is not found in the OS keychain or the cache cannot be decrypted with it.
+func loadCachedCredentials(creds AwsCredentials, now time.Time) (*cachedCredentials, bool) {
+	key, err := credentialCacheKey(false)
+	if err != nil {
+		log.Printf("[INFO] Cached credentials are not used: %s", err)
+		return nil, false
+	}
+	path, err := credentialCachePath(creds)
+	if err != nil {
+		log.Printf("[WARN] Failed to resolve the credential cache: %s", err)
+		return nil, false
+	}
+
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[WARN] Failed to read the credential cache: %s", err)
+		}
+		return nil, false
+	}
+	src, err = decryptCredentials(key, src)
+	if err != nil {
+		log.Printf("[WARN] Failed to decrypt the credential cache: %s", err)
+		return nil, false
+	}
+
+	var cached cachedCredentials
+	if err := json.Unmarshal(src, &cached); err != nil {
+		log.Printf("[WARN] Failed to parse the credential cache: %s", err)
+		return nil, false
+	}
+	if !now.Add(credentialExpiryWindow).Before(cached.Expiration) {
+		log.Printf("[INFO] Cached credentials of %s are expired", creds.AssumeRoleARN)
+		return nil, false
+	}
+	return &cached, true
+}
+
+// saveCachedCredentials stores credentials of the assumed role with their expiration
+// The file is encrypted with the key in the OS keychain, and is only readable by the owner.
+// If the keychain is not available, it returns an error without writing anything.
+func saveCachedCredentials(creds AwsCredentials, provider *credentials.Credentials) error {
+	key, err := credentialCacheKey(true)
+	if err != nil {
+		return err
+	}
+
+	value, err := provider.Get()
+	if err != nil {
+		return err
+	}
+	expiration, err := provider.ExpiresAt()
+	if err != nil {
+		return err
+	}
+
+	path, err := credentialCachePath(creds)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	src, err := json.Marshal(&cachedCredentials{
+		AccessKeyID:     value.AccessKeyID,
+		SecretAccessKey: value.SecretAccessKey,
+		SessionToken:    value.SessionToken,
+		Expiration:      expiration,
+	})
+	if err != nil {
+		return err
+	}
+	src, err = encryptCredentials(key, src)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, src, 0600)
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/google/go-cmp/cmp"
+	keyring "github.com/zalando/go-keyring"
+)
+
+type expiringProvider struct {
+	value      credentials.Value
+	expiration time.Time
+}
+
+func (p *expiringProvider) Retrieve() (credentials.Value, error) { return p.value, nil }
+func (p *expiringProvider) IsExpired() bool                      { return false }
+func (p *expiringProvider) ExpiresAt() time.Time                 { return p.expiration }
+
+func Test_credentialCache(t *testing.T) {
+	keyring.MockInit()
+
+	dir, err := ioutil.TempDir("", "sts-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	original := CredentialCacheDir
+	CredentialCacheDir = filepath.Join(dir, "cache")
+	defer func() { CredentialCacheDir = original }()
+
+	creds := AwsCredentials{
+		Profile:       "default",
+		AssumeRoleARN: "arn:aws:iam::123456789012:role/tflint",
+	}
+	expiration := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	if _, ok := loadCachedCredentials(creds, expiration.Add(-time.Hour)); ok {
+		t.Fatal("Expected no cached credentials before saving")
+	}
+
+	err = saveCachedCredentials(creds, credentials.NewCredentials(&expiringProvider{
+		value: credentials.Value{
+			AccessKeyID:     "ACCESS_KEY",
+			SecretAccessKey: "SECRET_KEY",
+			SessionToken:    "TOKEN",
+		},
+		expiration: expiration,
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	path, err := credentialCachePath(creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected the cache to be only readable by the owner, but the mode is %s", info.Mode())
+	}
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(src, []byte("SECRET_KEY")) {
+		t.Fatalf("Expected the cache to be encrypted, but got `%s`", src)
+	}
+
+	cases := []struct {
+		Name     string
+		Creds    AwsCredentials
+		Now      time.Time
+		Expected *cachedCredentials
+	}{
+		{
+			Name:  "valid",
+			Creds: creds,
+			Now:   expiration.Add(-time.Hour),
+			Expected: &cachedCredentials{
+				AccessKeyID:     "ACCESS_KEY",
+				SecretAccessKey: "SECRET_KEY",
+				SessionToken:    "TOKEN",
+				Expiration:      expiration,
+			},
+		},
+		{
+			Name:     "expiring soon",
+			Creds:    creds,
+			Now:      expiration.Add(-time.Minute),
+			Expected: nil,
+		},
+		{
+			Name: "another source identity",
+			Creds: AwsCredentials{
+				Profile:       "other",
+				AssumeRoleARN: "arn:aws:iam::123456789012:role/tflint",
+			},
+			Now:      expiration.Add(-time.Hour),
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		ret, _ := loadCachedCredentials(tc.Creds, tc.Now)
+		if !cmp.Equal(tc.Expected, ret) {
+			t.Fatalf("Failed `%s` test: Diff=%s", tc.Name, cmp.Diff(tc.Expected, ret))
+		}
+	}
+}
+
+func Test_credentialCache_keychainUnavailable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sts-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	originalDir, originalGet, originalSet := CredentialCacheDir, keychainGet, keychainSet
+	CredentialCacheDir = filepath.Join(dir, "cache")
+	keychainGet = func(service, user string) (string, error) { return "", errors.New("no keychain") }
+	keychainSet = func(service, user, password string) error { return errors.New("no keychain") }
+	defer func() { CredentialCacheDir, keychainGet, keychainSet = originalDir, originalGet, originalSet }()
+
+	creds := AwsCredentials{AssumeRoleARN: "arn:aws:iam::123456789012:role/tflint"}
+	expiration := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	err = saveCachedCredentials(creds, credentials.NewCredentials(&expiringProvider{
+		value:      credentials.Value{AccessKeyID: "ACCESS_KEY", SecretAccessKey: "SECRET_KEY"},
+		expiration: expiration,
+	}))
+	expected := "OS keychain is not available: no keychain"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error is `%s`, but got `%v`", expected, err)
+	}
+	if _, err := os.Stat(CredentialCacheDir); !os.IsNotExist(err) {
+		t.Fatalf("Expected nothing is cached, but got `%v`", err)
+	}
+	if _, ok := loadCachedCredentials(creds, expiration.Add(-time.Hour)); ok {
+		t.Fatal("Expected no cached credentials without the keychain")
+	}
+}
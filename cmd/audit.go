@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/terraform-linters/tflint/rules"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// audit runs only deep check rules against the recorded state and the live account
+// Rules inspecting templates are skipped, so it can be scheduled to report drifts and compliance issues continuously.
+func (cli *CLI) audit(opts Options, args []string) int {
+	if len(args) > 1 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Usage: tflint audit [DIR]")), map[string][]byte{})
+		return ExitCodeError
+	}
+	dir := "."
+	if len(args) == 1 {
+		dir = args[0]
+	}
+
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	cfg = cfg.Merge(opts.toConfig())
+	cfg.DeepCheck = true
+	exceptions, err := tflint.LoadExceptions(opts.Exceptions)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load exceptions", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	exceptions = exceptions.Active(time.Now())
+
+	if !cli.testMode {
+		cli.loader, err = tflint.NewLoader(cfg)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to prepare loading", err), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+
+	ctx, stop := interruptContext()
+	defer stop()
+
+	runners, appErr := setupRunners(ctx, cli.loader, cfg, dir)
+	if appErr != nil {
+		cli.formatter.Print(tflint.Issues{}, appErr, cli.loader.Sources())
+		return ExitCodeError
+	}
+	// Without the state, resources managed by the configuration are reported as duplicates of themselves
+	if !runners[len(runners)-1].HasState() {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to audit", errors.New("State file is not found. The audit requires the local state of the configuration")), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	var deadline time.Time
+	if cfg.Timeout > 0 {
+		deadline = time.Now().Add(cfg.Timeout)
+	}
+
+	auditRules := rules.NewAuditRules(cfg)
+	for i, rule := range auditRules {
+		for _, runner := range runners {
+			err := checkWithTimeout(ctx, cfg.RuleTimeout(rule.Name()), deadline, func() error {
+				return rule.Check(runner)
+			})
+			if err == errInterrupted {
+				return cli.printInterrupted(runners, []string{}, exceptions, fmt.Sprintf("after checking %d of %d rules", i, len(auditRules)), cli.loader.Sources())
+			}
+			if err != nil {
+				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError(fmt.Sprintf("Failed to check `%s` rule", rule.Name()), err), cli.loader.Sources())
+				return ExitCodeError
+			}
+		}
+	}
+
+	issues := tflint.Issues{}
+	for _, runner := range runners {
+		issues = append(issues, runner.LookupIssues()...)
+	}
+	issues = exceptions.Apply(issues)
+	cli.formatter.Exceptions = exceptions
+
+	cli.formatter.Print(issues, nil, cli.loader.Sources())
+
+	if len(issues) > 0 && !cfg.Force {
+		return ExitCodeIssuesFound
+	}
+	return ExitCodeOK
+}
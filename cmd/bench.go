@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// benchTolerance is the ratio of slowdowns and allocation increases from the baseline treated as regressions
+var benchTolerance = 0.2
+
+// benchMinDurationMs is the minimum duration of phases and rules in the baseline to compare
+var benchMinDurationMs = 10.0
+
+// benchMinAllocs is the minimum number of allocations of phases and rules in the baseline to compare
+var benchMinAllocs uint64 = 10000
+
+// benchResult is the output of the benchmark in JSON
+type benchResult struct {
+	Dir       string        `json:"dir"`
+	GoVersion string        `json:"go_version"`
+	Files     int           `json:"files"`
+	Resources int           `json:"resources"`
+	Modules   int           `json:"modules"`
+	Phases    []*benchPhase `json:"phases"`
+	Rules     []*benchPhase `json:"rules"`
+}
+
+// benchPhase is the cost of a phase of the inspection
+type benchPhase struct {
+	Name       string  `json:"name"`
+	DurationMs float64 `json:"duration_ms"`
+	Allocs     uint64  `json:"allocs"`
+	Bytes      uint64  `json:"bytes"`
+}
+
+// profiler records the cost of phases and rules of the inspection
+// It is set by `tflint bench`, and the inspection calls it at the boundaries of phases and rules.
+// All methods are no-op on nil, so the inspection does not have to check whether it is profiled.
+type profiler struct {
+	result *benchResult
+}
+
+func newProfiler(dir string) *profiler {
+	return &profiler{
+		result: &benchResult{Dir: dir, GoVersion: runtime.Version(), Phases: []*benchPhase{}, Rules: []*benchPhase{}},
+	}
+}
+
+// startPhase starts measuring the phase. Call the returned function at the end of the phase
+func (p *profiler) startPhase(name string) func() {
+	if p == nil {
+		return func() {}
+	}
+	return measure(&p.result.Phases, name)
+}
+
+// startRule starts measuring the rule. The cost is added up if the rule is checked multiple times, e.g. for examples
+func (p *profiler) startRule(name string) func() {
+	if p == nil {
+		return func() {}
+	}
+	return measure(&p.result.Rules, name)
+}
+
+// observe records the size of configurations to be inspected
+func (p *profiler) observe(runners []*tflint.Runner, sources map[string][]byte) {
+	if p == nil {
+		return
+	}
+	p.result.Files = len(sources)
+	p.result.Modules = len(runners)
+	p.result.Resources = 0
+	for _, runner := range runners {
+		p.result.Resources += len(runner.TFConfig.Module.ManagedResources)
+	}
+}
+
+// measure returns a function which adds the elapsed time and allocations since the call to the named entry
+// Allocations are counted for the whole process, so phases should not run concurrently.
+func measure(entries *[]*benchPhase, name string) func() {
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	return func() {
+		elapsed := time.Since(start)
+		var after runtime.MemStats
+		runtime.ReadMemStats(&after)
+
+		var entry *benchPhase
+		for _, e := range *entries {
+			if e.Name == name {
+				entry = e
+			}
+		}
+		if entry == nil {
+			entry = &benchPhase{Name: name}
+			*entries = append(*entries, entry)
+		}
+		entry.DurationMs += float64(elapsed.Microseconds()) / 1000
+		entry.Allocs += after.Mallocs - before.Mallocs
+		entry.Bytes += after.TotalAlloc - before.TotalAlloc
+	}
+}
+
+// bench inspects the directory in the same way as the normal inspection, and prints timing and allocations
+// of each phase and rule in JSON. Deep checking is disabled so that the result does not depend on the network.
+// Issues are not printed, and options which change files or call external services cannot be used.
+func (cli *CLI) bench(opts Options, args []string) int {
+	if len(args) < 1 || len(args) > 2 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Usage: tflint bench DIR [BASELINE]")), map[string][]byte{})
+		return ExitCodeError
+	}
+	conflicted := ""
+	switch {
+	case opts.Fix:
+		conflicted = "fix"
+	case opts.GitHubPR != "":
+		conflicted = "github-pr"
+	case opts.Stdin:
+		conflicted = "stdin"
+	}
+	if conflicted != "" {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", fmt.Errorf("`%s` option cannot be used with `bench`", conflicted)), map[string][]byte{})
+		return ExitCodeError
+	}
+	dir := args[0]
+	var baseline *benchResult
+	if len(args) == 2 {
+		src, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load the baseline", err), map[string][]byte{})
+			return ExitCodeError
+		}
+		if err := json.Unmarshal(src, &baseline); err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load the baseline", err), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+
+	cli.profiler = newProfiler(dir)
+	defer func() { cli.profiler = nil }()
+	stdout := cli.formatter.Stdout
+	cli.formatter.Stdout = ioutil.Discard
+	// Collect garbage of the argument parsing so that it is not counted in the first phase
+	runtime.GC()
+
+	status := cli.inspect(opts, dir, []string{})
+	cli.formatter.Stdout = stdout
+	if status == ExitCodeError {
+		return ExitCodeError
+	}
+
+	result := cli.profiler.result
+	// The slowest rules come first
+	sort.SliceStable(result.Rules, func(i, j int) bool {
+		return result.Rules[i].DurationMs > result.Rules[j].DurationMs
+	})
+
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to print the result", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	fmt.Fprintln(cli.outStream, string(out))
+
+	if baseline != nil {
+		if regressions := result.regressions(baseline); len(regressions) > 0 {
+			for _, regression := range regressions {
+				fmt.Fprintln(cli.errStream, regression)
+			}
+			return ExitCodeIssuesFound
+		}
+	}
+	return ExitCodeOK
+}
+
+// regressions returns phases and rules which are slower or allocate more than the baseline beyond the tolerance
+// Costs vary from run to run, so small phases and rules are not compared.
+func (r *benchResult) regressions(baseline *benchResult) []string {
+	ret := []string{}
+	ret = append(ret, compareBenchPhases("phase", r.Phases, baseline.Phases)...)
+	return append(ret, compareBenchPhases("rule", r.Rules, baseline.Rules)...)
+}
+
+func compareBenchPhases(kind string, phases []*benchPhase, baseline []*benchPhase) []string {
+	ret := []string{}
+	for _, phase := range phases {
+		for _, base := range baseline {
+			if phase.Name != base.Name {
+				continue
+			}
+			if base.DurationMs >= benchMinDurationMs && phase.DurationMs > base.DurationMs*(1+benchTolerance) {
+				ret = append(ret, fmt.Sprintf("`%s` %s took %.1fms, which is %.0f%% slower than the baseline (%.1fms)", phase.Name, kind, phase.DurationMs, (phase.DurationMs/base.DurationMs-1)*100, base.DurationMs))
+			}
+			if base.Allocs >= benchMinAllocs && float64(phase.Allocs) > float64(base.Allocs)*(1+benchTolerance) {
+				ret = append(ret, fmt.Sprintf("`%s` %s allocated %d objects, which is %.0f%% more than the baseline (%d)", phase.Name, kind, phase.Allocs, (float64(phase.Allocs)/float64(base.Allocs)-1)*100, base.Allocs))
+			}
+		}
+	}
+	return ret
+}
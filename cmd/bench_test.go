@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_bench(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(currentDir)
+	// Modules are looked up from the manifest in the current directory
+	if err := os.Chdir(filepath.Join("test-fixtures", "bench")); err != nil {
+		t.Fatal(err)
+	}
+
+	outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
+	cli := NewCLI(outStream, errStream)
+	status := cli.Run([]string{"./tflint", "bench", "--module", "."})
+	if status != ExitCodeOK {
+		t.Fatalf("Expected status is `%d`, but get `%d`: %s", ExitCodeOK, status, errStream.String())
+	}
+
+	var result benchResult
+	if err := json.Unmarshal(outStream.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse the result: %s", err)
+	}
+	if result.Files != 3 || result.Resources != 5 || result.Modules != 2 {
+		t.Fatalf("Unexpected counts: files=%d, resources=%d, modules=%d", result.Files, result.Resources, result.Modules)
+	}
+	phases := []string{}
+	for _, phase := range result.Phases {
+		phases = append(phases, phase.Name)
+	}
+	if expected := []string{"load", "check", "plugins", "report"}; !cmp.Equal(expected, phases) {
+		t.Fatalf("Unexpected phases: diff=%s", cmp.Diff(expected, phases))
+	}
+	found := false
+	for _, rule := range result.Rules {
+		if rule.Name == "aws_instance_invalid_type" {
+			found = true
+			if rule.Allocs == 0 {
+				t.Fatal("Allocations of `aws_instance_invalid_type` rule are not measured")
+			}
+		}
+	}
+	if !found {
+		t.Fatal("`aws_instance_invalid_type` rule is not measured")
+	}
+	// Issues are found in the fixture, but they are not printed
+	if strings.Contains(outStream.String(), "t1.2xlarge") {
+		t.Fatalf("Issues are printed: %s", outStream.String())
+	}
+}
+
+func Test_bench_regressions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bench")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A baseline in which the rule allocated much less than any run
+	baseline := `{"rules": [{"name": "aws_instance_invalid_type", "duration_ms": 0, "allocs": 1}]}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "baseline.json"), []byte(baseline), 0644); err != nil {
+		t.Fatal(err)
+	}
+	original := benchMinAllocs
+	benchMinAllocs = 1
+	defer func() { benchMinAllocs = original }()
+
+	outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
+	cli := NewCLI(outStream, errStream)
+	status := cli.Run([]string{"./tflint", "bench", filepath.Join("test-fixtures", "bench"), filepath.Join(dir, "baseline.json")})
+	if status != ExitCodeIssuesFound {
+		t.Fatalf("Expected status is `%d`, but get `%d`: %s", ExitCodeIssuesFound, status, errStream.String())
+	}
+	if !strings.Contains(errStream.String(), "`aws_instance_invalid_type` rule allocated") {
+		t.Fatalf("Expected a regression of `aws_instance_invalid_type` rule, but got `%s`", errStream.String())
+	}
+}
+
+func Test_bench_conflicts(t *testing.T) {
+	outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
+	cli := NewCLI(outStream, errStream)
+	status := cli.Run([]string{"./tflint", "bench", "--fix", filepath.Join("test-fixtures", "bench")})
+	if status != ExitCodeError {
+		t.Fatalf("Expected status is `%d`, but get `%d`", ExitCodeError, status)
+	}
+	if !strings.Contains(errStream.String(), "`fix` option cannot be used with `bench`") {
+		t.Fatalf("Unexpected error: %s", errStream.String())
+	}
+}
+
+func Test_benchResult_regressions(t *testing.T) {
+	baseline := &benchResult{
+		Phases: []*benchPhase{
+			{Name: "load", DurationMs: 100, Allocs: 100000},
+			{Name: "check", DurationMs: 5, Allocs: 100},
+		},
+		Rules: []*benchPhase{
+			{Name: "aws_instance_invalid_type", DurationMs: 20, Allocs: 50000},
+			{Name: "terraform_deprecated_interpolation", DurationMs: 1, Allocs: 10},
+		},
+	}
+	result := &benchResult{
+		Phases: []*benchPhase{
+			{Name: "load", DurationMs: 150, Allocs: 110000},
+			{Name: "check", DurationMs: 50, Allocs: 1000},
+		},
+		Rules: []*benchPhase{
+			{Name: "aws_instance_invalid_type", DurationMs: 22, Allocs: 75000},
+			{Name: "terraform_deprecated_interpolation", DurationMs: 10, Allocs: 1000},
+		},
+	}
+
+	expected := []string{
+		"`load` phase took 150.0ms, which is 50% slower than the baseline (100.0ms)",
+		"`aws_instance_invalid_type` rule allocated 75000 objects, which is 50% more than the baseline (50000)",
+	}
+	if got := result.regressions(baseline); !cmp.Equal(expected, got) {
+		t.Fatalf("diff=%s", cmp.Diff(expected, got))
+	}
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// checkRename prints every place that must be changed to rename an identifier in the root module,
+// and whether `terraform state mv` is needed to avoid destroying and recreating the object
+func (cli *CLI) checkRename(opts Options, args []string) int {
+	if len(args) != 2 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Usage: tflint check-rename OLD_ADDRESS NEW_ADDRESS")), map[string][]byte{})
+		return ExitCodeError
+	}
+	from, to := args[0], args[1]
+
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	cfg = cfg.Merge(opts.toConfig())
+
+	if !cli.testMode {
+		cli.loader, err = tflint.NewLoader(cfg)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to prepare loading", err), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	configs, err := cli.loader.LoadConfig(".")
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load configurations", err), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	impact, err := tflint.NewReferenceIndex(configs.Module).AnalyzeRename(from, to, cli.loader.FS(), cfg.Workspace)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to analyze rename", err), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	fmt.Fprintf(cli.outStream, "Renaming %s to %s requires the following changes:\n", from, to)
+	fmt.Fprintf(cli.outStream, "  %s (definition)\n", impact.Definition.String())
+	for _, ref := range impact.References {
+		fmt.Fprintf(cli.outStream, "  %s\n", ref.String())
+	}
+	if impact.StateMove {
+		fmt.Fprintf(cli.outStream, "\n%s is recorded in the state. Run `terraform state mv %s %s` to avoid destroying and recreating it\n", from, from, to)
+	} else {
+		fmt.Fprintln(cli.outStream, "\nNo state changes are required")
+	}
+
+	return ExitCodeOK
+}
@@ -20,6 +20,7 @@ const (
 	ExitCodeOK    int = 0
 	ExitCodeError int = 1 + iota
 	ExitCodeIssuesFound
+	ExitCodeBudgetExceeded
 )
 
 // CLI is the command line object
@@ -30,6 +31,12 @@ type CLI struct {
 	loader               tflint.AbstractLoader
 	formatter            *formatter.Formatter
 	testMode             bool
+
+	// inStream is the stdin to read answers to prompts.
+	inStream io.Reader
+
+	// profiler records the cost of the inspection in benchmarks.
+	profiler *profiler
 }
 
 // NewCLI returns new CLI initialized by input streams
@@ -37,6 +44,7 @@ func NewCLI(outStream io.Writer, errStream io.Writer) *CLI {
 	return &CLI{
 		outStream: outStream,
 		errStream: errStream,
+		inStream:  os.Stdin,
 	}
 }
 
@@ -47,17 +55,27 @@ func (cli *CLI) Run(args []string) int {
 	parser.Usage = "[OPTIONS] [FILE or DIR...]"
 	parser.UnknownOptionHandler = unknownOptionHandler
 	// Parse commandline flag
+	opts.recordValuesOrder(args)
 	args, err := parser.ParseArgs(args)
 	// Set up output formatter
 	cli.formatter = &formatter.Formatter{
 		Stdout: cli.outStream,
 		Stderr: cli.errStream,
-		Format: opts.Format,
+		Format: "default",
+	}
+	sinks, sinkErr := formatter.ParseSinks(opts.Format)
+	if sinkErr == nil {
+		if len(sinks) == 1 && sinks[0].Path == "" {
+			cli.formatter.Format = sinks[0].Format
+		} else {
+			cli.formatter.Sinks = sinks
+		}
 	}
 	if opts.NoColor {
 		color.NoColor = true
 		cli.formatter.NoColor = true
 	}
+	cli.formatter.AggregateAfter = opts.AggregateAfter
 
 	if err != nil {
 		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
@@ -67,6 +85,36 @@ func (cli *CLI) Run(args []string) int {
 		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", err), map[string][]byte{})
 		return ExitCodeError
 	}
+	if sinkErr != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", sinkErr), map[string][]byte{})
+		return ExitCodeError
+	}
+	switch opts.PathStyle {
+	case formatter.PathStyleRelative, formatter.PathStyleAbsolute, formatter.PathStyleRepoRoot:
+		cli.formatter.PathStyle = opts.PathStyle
+	default:
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", fmt.Errorf("`%s` is invalid path style. Please specify \"relative\", \"absolute\" or \"repo-root\"", opts.PathStyle)), map[string][]byte{})
+		return ExitCodeError
+	}
+	if len(args) > 1 {
+		switch args[1] {
+		case "plugin":
+			return cli.plugin(opts, args[2:])
+		case "refs":
+			return cli.refs(opts, args[2:])
+		case "audit":
+			return cli.audit(opts, args[2:])
+		case "check-rename":
+			return cli.checkRename(opts, args[2:])
+		case "scaffold":
+			return cli.scaffold(opts, args[2:])
+		case "bench":
+			return cli.bench(opts, args[2:])
+		case "explain":
+			return cli.explain(opts, args[2:])
+		}
+	}
+
 	dir, filterFiles, err := processArgs(args[1:])
 	if err != nil {
 		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", err), map[string][]byte{})
@@ -76,8 +124,14 @@ func (cli *CLI) Run(args []string) int {
 	switch {
 	case opts.Version:
 		return cli.printVersion(opts)
+	case opts.Init:
+		return cli.init(opts)
+	case opts.UpdateData:
+		return cli.updateData(opts)
 	case opts.Langserver:
 		return cli.startLanguageServer(opts.Config, opts.toConfig())
+	case opts.GenerateConfig != "":
+		return cli.generateConfig(opts, dir)
 	default:
 		return cli.inspect(opts, dir, filterFiles)
 	}
@@ -100,10 +154,10 @@ func processArgs(args []string) (string, []string, error) {
 			return dir, filterFiles, fmt.Errorf("Failed to load `%s`: %s", file, err)
 		}
 
-		if fileInfo.IsDir() {
+		if fileInfo.IsDir() || tflint.IsArchive(file) {
 			dir = file
 			if len(args) != 1 {
-				return dir, filterFiles, fmt.Errorf("Failed to load `%s`: Multiple arguments are not allowed when passing a directory", file)
+				return dir, filterFiles, fmt.Errorf("Failed to load `%s`: Multiple arguments are not allowed when passing a directory or an archive", file)
 			}
 			return dir, filterFiles, nil
 		}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/spf13/afero"
 	"github.com/terraform-linters/tflint/rules"
 	"github.com/terraform-linters/tflint/tflint"
 )
@@ -104,7 +106,7 @@ func TestCLIRun__noIssuesFound(t *testing.T) {
 			Name:    "invalid format",
 			Command: "./tflint --format awesome",
 			Status:  ExitCodeError,
-			Stderr:  "Invalid value `awesome' for option",
+			Stderr:  "`awesome` is invalid format",
 		},
 		{
 			Name:    "invalid rule name",
@@ -112,6 +114,12 @@ func TestCLIRun__noIssuesFound(t *testing.T) {
 			Status:  ExitCodeError,
 			Stderr:  "Rule not found: nosuchrule",
 		},
+		{
+			Name:    "`--interactive` without `--fix`",
+			Command: "./tflint --interactive",
+			Status:  ExitCodeError,
+			Stderr:  "`interactive` option must be used with `fix` option",
+		},
 	}
 
 	ctrl := gomock.NewController(t)
@@ -130,6 +138,8 @@ func TestCLIRun__noIssuesFound(t *testing.T) {
 		loader.EXPECT().LoadAnnotations(".").Return(map[string]tflint.Annotations{}, tc.LoadErr).AnyTimes()
 		loader.EXPECT().LoadValuesFiles().Return([]terraform.InputValues{}, tc.LoadErr).AnyTimes()
 		loader.EXPECT().Sources().Return(map[string][]byte{}).AnyTimes()
+		loader.EXPECT().FS().Return(afero.Afero{Fs: afero.NewOsFs()}).AnyTimes()
+		loader.EXPECT().SyntaxErrors().Return(hcl.Diagnostics{}).AnyTimes()
 		cli.loader = loader
 
 		status := cli.Run(strings.Split(tc.Command, " "))
@@ -157,6 +167,15 @@ type testRule struct {
 }
 type errorRule struct{}
 
+// anotherTestRule emits the same issue as testRule with another name
+type anotherTestRule struct {
+	testRule
+}
+
+func (r *anotherTestRule) Name() string {
+	return "another_test_rule"
+}
+
 func (r *testRule) Name() string {
 	return "test_rule"
 }
@@ -253,7 +272,7 @@ func TestCLIRun__issuesFound(t *testing.T) {
 
 	for _, tc := range cases {
 		// Mock rules
-		rules.DefaultRules = []rules.Rule{tc.Rule}
+		rules.DefaultRules = func() []rules.Rule { return []rules.Rule{tc.Rule} }
 
 		outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
 		cli := &CLI{
@@ -267,6 +286,8 @@ func TestCLIRun__issuesFound(t *testing.T) {
 		loader.EXPECT().LoadAnnotations(".").Return(map[string]tflint.Annotations{}, nil).AnyTimes()
 		loader.EXPECT().LoadValuesFiles().Return([]terraform.InputValues{}, nil).AnyTimes()
 		loader.EXPECT().Sources().Return(map[string][]byte{}).AnyTimes()
+		loader.EXPECT().FS().Return(afero.Afero{Fs: afero.NewOsFs()}).AnyTimes()
+		loader.EXPECT().SyntaxErrors().Return(hcl.Diagnostics{}).AnyTimes()
 		cli.loader = loader
 
 		status := cli.Run(strings.Split(tc.Command, " "))
@@ -390,7 +411,7 @@ func TestCLIRun__withArguments(t *testing.T) {
 
 	for _, tc := range cases {
 		// Mock rules
-		rules.DefaultRules = []rules.Rule{&testRule{dir: tc.Dir}}
+		rules.DefaultRules = func() []rules.Rule { return []rules.Rule{&testRule{dir: tc.Dir}} }
 
 		outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
 		cli := &CLI{
@@ -404,6 +425,8 @@ func TestCLIRun__withArguments(t *testing.T) {
 		loader.EXPECT().LoadAnnotations(tc.Dir).Return(map[string]tflint.Annotations{}, nil).AnyTimes()
 		loader.EXPECT().LoadValuesFiles().Return([]terraform.InputValues{}, nil).AnyTimes()
 		loader.EXPECT().Sources().Return(map[string][]byte{}).AnyTimes()
+		loader.EXPECT().FS().Return(afero.Afero{Fs: afero.NewOsFs()}).AnyTimes()
+		loader.EXPECT().SyntaxErrors().Return(hcl.Diagnostics{}).AnyTimes()
 		cli.loader = loader
 
 		status := cli.Run(strings.Split(tc.Command, " "))
@@ -425,3 +448,84 @@ func TestCLIRun__withArguments(t *testing.T) {
 		}
 	}
 }
+
+func TestCLIRun__budgetExceeded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "budget")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		Name    string
+		Config  string
+		Command string
+		Status  int
+		Stderr  string
+	}{
+		{
+			Name:    "within budgets",
+			Config:  "config {\n  max_issues_per_file = 2\n}",
+			Command: "./tflint",
+			Status:  ExitCodeOK,
+		},
+		{
+			Name:    "budgets exceeded",
+			Config:  "config {\n  max_issues_per_file = 1\n}",
+			Command: "./tflint",
+			Status:  ExitCodeBudgetExceeded,
+			Stderr:  "`test.tf` has 2 issues, exceeding the budget of 1 issues per file",
+		},
+		{
+			Name:    "`--force` option",
+			Config:  "config {\n  max_issues_per_file = 1\n}",
+			Command: "./tflint --force",
+			Status:  ExitCodeOK,
+			Stderr:  "`test.tf` has 2 issues, exceeding the budget of 1 issues per file",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	originalRules := rules.DefaultRules
+	defer func() {
+		rules.DefaultRules = originalRules
+		ctrl.Finish()
+	}()
+
+	for _, tc := range cases {
+		rules.DefaultRules = func() []rules.Rule { return []rules.Rule{&testRule{}, &anotherTestRule{}} }
+
+		config := filepath.Join(dir, ".tflint.hcl")
+		if err := ioutil.WriteFile(config, []byte(tc.Config), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
+		cli := &CLI{
+			outStream: outStream,
+			errStream: errStream,
+			testMode:  true,
+		}
+
+		loader := tflint.NewMockAbstractLoader(ctrl)
+		loader.EXPECT().LoadConfig(".").Return(configs.NewEmptyConfig(), nil).AnyTimes()
+		loader.EXPECT().LoadAnnotations(".").Return(map[string]tflint.Annotations{}, nil).AnyTimes()
+		loader.EXPECT().LoadValuesFiles().Return([]terraform.InputValues{}, nil).AnyTimes()
+		loader.EXPECT().Sources().Return(map[string][]byte{}).AnyTimes()
+		loader.EXPECT().FS().Return(afero.Afero{Fs: afero.NewOsFs()}).AnyTimes()
+		loader.EXPECT().SyntaxErrors().Return(hcl.Diagnostics{}).AnyTimes()
+		cli.loader = loader
+
+		status := cli.Run(strings.Split(tc.Command+" --config "+config, " "))
+
+		if status != tc.Status {
+			t.Fatalf("Failed `%s`: Expected status is `%d`, but get `%d`: %s", tc.Name, tc.Status, status, errStream.String())
+		}
+		if !strings.Contains(errStream.String(), tc.Stderr) {
+			t.Fatalf("Failed `%s`: Expected to contain `%s` in stderr, but get `%s`", tc.Name, tc.Stderr, errStream.String())
+		}
+		if tc.Stderr == "" && errStream.String() != "" {
+			t.Fatalf("Failed `%s`: Expected empty in stderr, but get `%s`", tc.Name, errStream.String())
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// exampleConfig returns the config for inspecting examples of the module in module mode
+// Examples are root configurations which call the module, so module mode is disabled and
+// the module is loaded as a child to check inputs passed to it.
+func exampleConfig(cfg *tflint.Config) *tflint.Config {
+	ret := cfg.Merge(&tflint.Config{
+		Module: true,
+		Rules: map[string]*tflint.RuleConfig{
+			"terraform_module_inputs": {Name: "terraform_module_inputs", Enabled: true},
+		},
+	})
+	ret.ModuleMode = false
+	return ret
+}
+
+// setupExampleRunners returns runners of the examples under the `examples` directory of the module
+// Each example is inspected as a root module. Modules with local paths are loaded without `terraform init`,
+// so a drift between the interface of the module and the examples is reported. It also returns sources of the examples.
+func setupExampleRunners(fs afero.Afero, cfg *tflint.Config, dir string) ([]*tflint.Runner, map[string][]byte, *tflint.Error) {
+	runners := []*tflint.Runner{}
+	sources := map[string][]byte{}
+
+	dirs, err := exampleDirs(fs, filepath.Join(dir, "examples"))
+	if err != nil {
+		return runners, sources, tflint.NewContextError("Failed to find examples", err)
+	}
+
+	for _, exampleDir := range dirs {
+		loader, err := tflint.NewLoader(cfg, tflint.WithFS(fs.Fs), tflint.WithModuleResolver(tflint.LocalModuleResolver(fs)))
+		if err != nil {
+			return runners, sources, tflint.NewContextError("Failed to prepare loading", err)
+		}
+		configs, err := loader.LoadConfig(exampleDir)
+		if err != nil {
+			return runners, sources, tflint.NewContextError("Failed to load configurations of the example", err)
+		}
+		annotations, err := loader.LoadAnnotations(exampleDir)
+		if err != nil {
+			return runners, sources, tflint.NewContextError("Failed to load configuration tokens of the example", err)
+		}
+
+		runner, err := tflint.NewRunnerWithFS(fs, cfg, annotations, configs)
+		if err != nil {
+			return runners, sources, tflint.NewContextError("Failed to initialize a runner of the example", err)
+		}
+		runner.Sources = loader.Sources()
+		for filename, src := range runner.Sources {
+			sources[filename] = src
+		}
+		runners = append(runners, runner)
+	}
+
+	return runners, sources, nil
+}
+
+// exampleDirs returns the directory and its immediate subdirectories containing Terraform files
+func exampleDirs(fs afero.Afero, dir string) ([]string, error) {
+	if exists, err := fs.DirExists(dir); !exists || err != nil {
+		return []string{}, err
+	}
+
+	candidates := []string{dir}
+	infos, err := fs.ReadDir(dir)
+	if err != nil {
+		return []string{}, err
+	}
+	for _, info := range infos {
+		path := filepath.Join(dir, info.Name())
+		// Examples are often symlinked from other places so that they are shared with tests
+		if info = tflint.ResolveSymlink(fs, path, info); info == nil {
+			continue
+		}
+		if info.IsDir() && !strings.HasPrefix(info.Name(), ".") {
+			candidates = append(candidates, path)
+		}
+	}
+
+	ret := []string{}
+	for _, candidate := range candidates {
+		infos, err := fs.ReadDir(candidate)
+		if err != nil {
+			return []string{}, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() && (strings.HasSuffix(info.Name(), ".tf") || strings.HasSuffix(info.Name(), ".tf.json")) {
+				ret = append(ret, candidate)
+				break
+			}
+		}
+	}
+	return ret, nil
+}
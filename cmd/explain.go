@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint/rules"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// explain prints the metadata and the documentation of the rule, and how to configure it
+func (cli *CLI) explain(opts Options, args []string) int {
+	if len(args) != 1 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Usage: tflint explain RULE")), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	cfg = cfg.Merge(opts.toConfig())
+
+	explanation, err := rules.Explain(cfg, args[0])
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to explain the rule", err), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	fmt.Fprintf(cli.outStream, "%s\n\n", explanation.Name)
+	fmt.Fprintf(cli.outStream, "Severity:           %s\n", explanation.Severity)
+	fmt.Fprintf(cli.outStream, "Enabled by default: %t\n", explanation.EnabledByDefault)
+	fmt.Fprintf(cli.outStream, "Enabled:            %t\n", explanation.Enabled)
+	fmt.Fprintf(cli.outStream, "Deep check only:    %t\n", explanation.DeepCheck)
+	if explanation.ModuleMode {
+		fmt.Fprintln(cli.outStream, "Module mode:        enabled by default")
+	}
+	if explanation.Link != "" {
+		fmt.Fprintf(cli.outStream, "Reference:          %s\n", explanation.Link)
+	}
+
+	doc := explanation.Doc
+	if doc != nil {
+		cli.printExplainSection("Description", doc.Description)
+		cli.printExplainSection("Rationale", doc.Rationale)
+	}
+
+	fmt.Fprintln(cli.outStream, "\nOptions:")
+	if len(explanation.Options) == 0 {
+		fmt.Fprintln(cli.outStream, "  No options other than common ones, such as `only` and `timeout`")
+	}
+	for _, option := range explanation.Options {
+		attrs := []string{option.Type}
+		if option.Required {
+			attrs = append(attrs, "required")
+		} else {
+			attrs = append(attrs, "optional")
+		}
+		if option.Default != "" {
+			attrs = append(attrs, "default: "+option.Default)
+		}
+		fmt.Fprintf(cli.outStream, "  %s (%s)\n", option.Name, strings.Join(attrs, ", "))
+	}
+
+	// Print the config enabling the rule with required options and defaults, aligned like `terraform fmt`
+	attrs := [][2]string{{"enabled", "true"}}
+	for _, option := range explanation.Options {
+		if option.Required {
+			attrs = append(attrs, [2]string{option.Name, "..."})
+		} else if option.Default != "" {
+			attrs = append(attrs, [2]string{option.Name, option.Default})
+		}
+	}
+	width := 0
+	for _, attr := range attrs {
+		if len(attr[0]) > width {
+			width = len(attr[0])
+		}
+	}
+	fmt.Fprintln(cli.outStream, "\nConfig:")
+	fmt.Fprintf(cli.outStream, "  rule \"%s\" {\n", explanation.Name)
+	for _, attr := range attrs {
+		fmt.Fprintf(cli.outStream, "    %-*s = %s\n", width, attr[0], attr[1])
+	}
+	fmt.Fprintln(cli.outStream, "  }")
+
+	if doc != nil {
+		if doc.Config != "" {
+			cli.printExplainSection("Config assumed by the examples", doc.Config)
+		}
+		cli.printExplainSection("Violating example", doc.Violating)
+		cli.printExplainSection("Compliant example", doc.Compliant)
+		cli.printExplainSection("How to fix", doc.Fix)
+	}
+
+	return ExitCodeOK
+}
+
+// printExplainSection prints the text under the title, indenting each line
+func (cli *CLI) printExplainSection(title string, text string) {
+	fmt.Fprintf(cli.outStream, "\n%s:\n", title)
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if line == "" {
+			fmt.Fprintln(cli.outStream)
+			continue
+		}
+		fmt.Fprintf(cli.outStream, "  %s\n", line)
+	}
+}
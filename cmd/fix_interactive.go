@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+const fixPromptHelp = `y - apply this fix
+n - do not apply this fix
+a - apply this fix and all later fixes of the rule
+d - do not apply this fix or any of the later fixes of the rule
+q - quit; do not apply this fix or any of the remaining ones`
+
+// selectFixes shows each fix as a diff and prompts whether to apply it, like `git add -p`
+// It returns the issues whose fixes are accepted. Prompts are written to stderr so as not to mix with the output of issues.
+func (cli *CLI) selectFixes(issues tflint.Issues, sources map[string][]byte) tflint.Issues {
+	candidates := tflint.Issues{}
+	for _, issue := range issues {
+		if issue.Fix != nil {
+			candidates = append(candidates, issue)
+		}
+	}
+	candidates = candidates.Sort()
+
+	reader := bufio.NewReader(cli.inStream)
+	decided := map[string]bool{}
+	ret := tflint.Issues{}
+
+	for i, issue := range candidates {
+		rule := issue.Rule.Name()
+		if apply, ok := decided[rule]; ok {
+			if apply {
+				ret = append(ret, issue)
+			}
+			continue
+		}
+
+		fmt.Fprintf(cli.errStream, "%s (%s)\n", issue.Message, rule)
+		fmt.Fprint(cli.errStream, issue.Fix.Diff(sources[issue.Fix.Range.Filename]))
+
+	prompt:
+		for {
+			fmt.Fprintf(cli.errStream, "(%d/%d) Apply this fix [y,n,a,d,q,?]? ", i+1, len(candidates))
+			answer, err := reader.ReadString('\n')
+			if err != nil && (err != io.EOF || answer == "") {
+				fmt.Fprintln(cli.errStream)
+				return ret
+			}
+
+			switch strings.TrimSpace(answer) {
+			case "y":
+				ret = append(ret, issue)
+			case "n":
+			case "a":
+				ret = append(ret, issue)
+				decided[rule] = true
+			case "d":
+				decided[rule] = false
+			case "q":
+				return ret
+			default:
+				fmt.Fprintln(cli.errStream, fixPromptHelp)
+				continue prompt
+			}
+			break
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+type otherRule struct{ testRule }
+
+func (r *otherRule) Name() string {
+	return "other_rule"
+}
+
+func Test_selectFixes(t *testing.T) {
+	sources := map[string][]byte{
+		"main.tf": []byte("a = 1\nb = 2\nc = 3\n"),
+	}
+	fixAt := func(rule tflint.Rule, line int, text string) *tflint.Issue {
+		offset := (line - 1) * 6
+		return &tflint.Issue{
+			Rule:    rule,
+			Message: "test",
+			Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: line}},
+			Fix: &tflint.Fix{
+				Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: offset + 4}, End: hcl.Pos{Byte: offset + 5}},
+				Text:  text,
+			},
+		}
+	}
+	first := fixAt(&testRule{}, 1, "10")
+	second := fixAt(&testRule{}, 2, "20")
+	third := fixAt(&otherRule{}, 3, "30")
+	noFix := &tflint.Issue{Rule: &testRule{}, Message: "test", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}}}
+	issues := tflint.Issues{third, noFix, second, first}
+
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected tflint.Issues
+	}{
+		{
+			Name:     "apply one by one",
+			Input:    "y\nn\ny\n",
+			Expected: tflint.Issues{first, third},
+		},
+		{
+			Name:     "apply all of the rule",
+			Input:    "a\nn\n",
+			Expected: tflint.Issues{first, second},
+		},
+		{
+			Name:     "skip all of the rule",
+			Input:    "d\ny\n",
+			Expected: tflint.Issues{third},
+		},
+		{
+			Name:     "quit",
+			Input:    "y\nq\n",
+			Expected: tflint.Issues{first},
+		},
+		{
+			Name:     "help",
+			Input:    "?\ny\ny\ny\n",
+			Expected: tflint.Issues{first, second, third},
+		},
+		{
+			Name:     "EOF",
+			Input:    "y",
+			Expected: tflint.Issues{first},
+		},
+	}
+
+	for _, tc := range cases {
+		errStream := new(bytes.Buffer)
+		cli := &CLI{errStream: errStream, inStream: strings.NewReader(tc.Input)}
+
+		got := cli.selectFixes(issues, sources)
+
+		if len(got) != len(tc.Expected) {
+			t.Fatalf("Failed `%s` test: expected %d fixes, but got %d", tc.Name, len(tc.Expected), len(got))
+		}
+		for i := range got {
+			if got[i] != tc.Expected[i] {
+				t.Fatalf("Failed `%s` test: expected fix is %#v, but got %#v", tc.Name, tc.Expected[i].Fix, got[i].Fix)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// generateConfig prints an HCL skeleton of the resource recorded in the state but missing from the configuration
+func (cli *CLI) generateConfig(opts Options, dir string) int {
+	address := opts.GenerateConfig
+
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	cfg = cfg.Merge(opts.toConfig())
+
+	if !cli.testMode {
+		cli.loader, err = tflint.NewLoader(cfg)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to prepare loading", err), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	configs, err := cli.loader.LoadConfig(dir)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load configurations", err), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	addr, diags := addrs.ParseAbsResourceInstanceStr(address)
+	if diags.HasErrors() || !addr.Module.IsRoot() {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to generate config", fmt.Errorf("`%s` is not a resource address of the root module", address)), map[string][]byte{})
+		return ExitCodeError
+	}
+	if configs.Module.ResourceByAddr(addr.Resource.Resource) != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to generate config", fmt.Errorf("`%s` is already declared in the configuration", addr.Resource.Resource)), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	ctx, stop := interruptContext()
+	defer stop()
+	state, err := tflint.LoadState(ctx, cli.loader.FS(), configs.Module, cfg)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load the state", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	if state == nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to generate config", fmt.Errorf("State file is not found")), map[string][]byte{})
+		return ExitCodeError
+	}
+	object := state.Lookup(address)
+	if object == nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to generate config", fmt.Errorf("`%s` is not recorded in the state", address)), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	var schema *configschema.Block
+	if cfg.ProviderSchemas != "" {
+		schemas, err := tflint.LoadProviderSchemas(cfg.ProviderSchemas)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load provider schemas", err), map[string][]byte{})
+			return ExitCodeError
+		}
+		resource := addr.Resource.Resource
+		if resource.Mode == addrs.DataResourceMode {
+			schema = schemas.DataSources[resource.Type]
+		} else {
+			schema = schemas.Resources[resource.Type]
+		}
+		if schema == nil {
+			log.Printf("[WARN] The schema of `%s` is not found. Attributes are selected from the recorded values", resource.Type)
+		}
+	}
+
+	src, err := object.GenerateConfig(schema)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to generate config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	fmt.Fprint(cli.outStream, string(src))
+
+	return ExitCodeOK
+}
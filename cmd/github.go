@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	tfplugin "github.com/terraform-linters/tflint/plugin"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// githubAPIURL is the endpoint of the GitHub REST API
+// This variable is exposed for testing.
+var githubAPIURL = "https://api.github.com"
+
+// githubCommentMarker is a hidden mark of the comment posted by TFLint
+// The comment is found by the mark and updated in later runs, so pull requests have only one comment.
+var githubCommentMarker = "<!-- tflint-pr-comment -->"
+
+var githubPRPattern = regexp.MustCompile(`^([^/#\s]+)/([^/#\s]+)#(\d+)$`)
+
+// githubPR is a pull request specified by `--github-pr`
+type githubPR struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+type githubComment struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+}
+
+type githubPullRequest struct {
+	Base struct {
+		SHA string `json:"sha"`
+	} `json:"base"`
+}
+
+// githubHTTPClient is the HTTP client of the GitHub API
+// Requests time out so that a stalled connection does not hang CI jobs.
+var githubHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// githubClient is a minimal client of the GitHub REST API for pull request comments
+type githubClient struct {
+	token string
+}
+
+func parseGitHubPR(arg string) (*githubPR, error) {
+	matches := githubPRPattern.FindStringSubmatch(arg)
+	if matches == nil {
+		return nil, fmt.Errorf("`%s` is invalid pull request. Please specify OWNER/REPO#NUMBER", arg)
+	}
+	number, err := strconv.Atoi(matches[3])
+	if err != nil {
+		return nil, err
+	}
+	return &githubPR{Owner: matches[1], Repo: matches[2], Number: number}, nil
+}
+
+func (c *githubClient) do(method string, path string, in interface{}, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, githubAPIURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Authorization", "token "+c.token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	log.Printf("[DEBUG] GitHub API: %s %s", method, path)
+	resp, err := githubHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("GitHub API returned %s: %s %s", resp.Status, method, path)
+	}
+	if out == nil {
+		return nil
+	}
+	src, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(src, out)
+}
+
+// baseSHA returns the commit of the base branch of the pull request
+func (c *githubClient) baseSHA(pr *githubPR) (string, error) {
+	var pull githubPullRequest
+	if err := c.do("GET", fmt.Sprintf("/repos/%s/%s/pulls/%d", pr.Owner, pr.Repo, pr.Number), nil, &pull); err != nil {
+		return "", err
+	}
+	return pull.Base.SHA, nil
+}
+
+// findComment returns the comment posted by TFLint in the pull request
+// It returns nil if the comment is not found.
+func (c *githubClient) findComment(pr *githubPR) (*githubComment, error) {
+	for page := 1; ; page++ {
+		var comments []*githubComment
+		if err := c.do("GET", fmt.Sprintf("/repos/%s/%s/issues/%d/comments?per_page=100&page=%d", pr.Owner, pr.Repo, pr.Number, page), nil, &comments); err != nil {
+			return nil, err
+		}
+		for _, comment := range comments {
+			if strings.Contains(comment.Body, githubCommentMarker) {
+				return comment, nil
+			}
+		}
+		if len(comments) < 100 {
+			return nil, nil
+		}
+	}
+}
+
+func (c *githubClient) postComment(pr *githubPR, body string) error {
+	return c.do("POST", fmt.Sprintf("/repos/%s/%s/issues/%d/comments", pr.Owner, pr.Repo, pr.Number), &githubComment{Body: body}, nil)
+}
+
+func (c *githubClient) updateComment(pr *githubPR, id int64, body string) error {
+	return c.do("PATCH", fmt.Sprintf("/repos/%s/%s/issues/comments/%d", pr.Owner, pr.Repo, id), &githubComment{Body: body}, nil)
+}
+
+// githubCommentBody returns a Markdown summary of the new issues
+func githubCommentBody(issues tflint.Issues) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, githubCommentMarker)
+	if len(issues) == 0 {
+		fmt.Fprintln(&b, "TFLint found no new issues. All issues reported before are resolved.")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "TFLint found %d new issue(s) in this pull request.\n\n", len(issues))
+	fmt.Fprintln(&b, "| Severity | Rule | Location | Message |")
+	fmt.Fprintln(&b, "| --- | --- | --- | --- |")
+	for _, issue := range issues.Sort() {
+		rule := fmt.Sprintf("`%s`", issue.Rule.Name())
+		if issue.Rule.Link() != "" {
+			rule = fmt.Sprintf("[%s](%s)", rule, issue.Rule.Link())
+		}
+		message := strings.Replace(strings.Join(strings.Fields(issue.Message), " "), "|", "\\|", -1)
+		fmt.Fprintf(&b, "| %s | %s | `%s:%d` | %s |\n", issue.Rule.Severity(), rule, issue.Range.Filename, issue.Range.Start.Line, message)
+	}
+	return b.String()
+}
+
+// commentOnGitHubPR posts the issues which are not reported on the base branch to the pull request
+// The base commit is inspected by the passed function, and issues are compared by fingerprints.
+// If there are no new issues, the previous comment is updated to tell that they are resolved.
+func (cli *CLI) commentOnGitHubPR(opts Options, issues tflint.Issues, inspectBase func(rev string) (map[string]bool, error)) error {
+	pr, err := parseGitHubPR(opts.GitHubPR)
+	if err != nil {
+		return err
+	}
+	token := opts.GitHubToken
+	if token == "" {
+		token = os.Getenv("GITHUB_TOKEN")
+	}
+	if token == "" {
+		return errors.New("GitHub token is required. Please specify `--github-token` or GITHUB_TOKEN environment variable")
+	}
+	client := &githubClient{token: token}
+
+	sha, err := client.baseSHA(pr)
+	if err != nil {
+		return err
+	}
+	baseline, err := inspectBase(sha)
+	if err != nil {
+		return fmt.Errorf("Failed to inspect the base commit `%s`: %s", sha, err)
+	}
+	fresh := newIssues(issues, baseline)
+	log.Printf("[INFO] %d of %d issues are new in %s/%s#%d", len(fresh), len(issues), pr.Owner, pr.Repo, pr.Number)
+
+	comment, err := client.findComment(pr)
+	if err != nil {
+		return err
+	}
+	body := githubCommentBody(fresh)
+	switch {
+	case comment != nil:
+		if comment.Body == body {
+			return nil
+		}
+		return client.updateComment(pr, comment.ID, body)
+	case len(fresh) > 0:
+		return client.postComment(pr, body)
+	default:
+		return nil
+	}
+}
+
+// inspectRevision returns fingerprints of issues in the git revision
+// The revision is inspected in the same way as the working tree, with the same config, plugins, exceptions and issue hook,
+// so that issues are not reported as new only because the baseline was inspected differently.
+func (cli *CLI) inspectRevision(ctx context.Context, cfg *tflint.Config, rev string, dir string, filterFiles []string, exceptions tflint.Exceptions, rulesets []tfplugin.RuleSet) (map[string]bool, error) {
+	fs, err := tflint.NewGitTreeFs(rev)
+	if err != nil {
+		return nil, err
+	}
+	loaderOpts := []tflint.LoaderOption{tflint.WithFS(fs)}
+	if cfg.ModuleDownload {
+		loaderOpts = append(loaderOpts, tflint.WithModuleResolver(tflint.RemoteModuleResolver(ctx, afero.Afero{Fs: fs})))
+	}
+	loader, err := tflint.NewLoader(cfg, loaderOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result, appErr := cli.runInspection(ctx, cfg, loader, dir, false, filterFiles, exceptions, rulesets)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if result.interrupted != "" {
+		return nil, fmt.Errorf("Interrupted %s", result.interrupted)
+	}
+
+	ret := map[string]bool{}
+	for _, issue := range result.issues {
+		ret[issue.Fingerprint()] = true
+	}
+	return ret, nil
+}
+
+// newIssues returns issues whose fingerprints are not in the baseline
+func newIssues(issues tflint.Issues, baseline map[string]bool) tflint.Issues {
+	ret := tflint.Issues{}
+	for _, issue := range issues {
+		if !baseline[issue.Fingerprint()] {
+			ret = append(ret, issue)
+		}
+	}
+	return ret
+}
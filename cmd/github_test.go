@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_parseGitHubPR(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Arg      string
+		Expected *githubPR
+		Error    string
+	}{
+		{
+			Name:     "valid",
+			Arg:      "terraform-linters/tflint#123",
+			Expected: &githubPR{Owner: "terraform-linters", Repo: "tflint", Number: 123},
+		},
+		{
+			Name:  "missing number",
+			Arg:   "terraform-linters/tflint",
+			Error: "`terraform-linters/tflint` is invalid pull request. Please specify OWNER/REPO#NUMBER",
+		},
+		{
+			Name:  "missing owner",
+			Arg:   "tflint#123",
+			Error: "`tflint#123` is invalid pull request. Please specify OWNER/REPO#NUMBER",
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := parseGitHubPR(tc.Arg)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if !cmp.Equal(tc.Expected, got) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, got))
+		}
+	}
+}
+
+func Test_githubCommentBody(t *testing.T) {
+	issues := tflint.Issues{
+		{
+			Rule:    &testRule{},
+			Message: "value is | invalid",
+			Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3}},
+		},
+	}
+
+	expected := `<!-- tflint-pr-comment -->
+TFLint found 1 new issue(s) in this pull request.
+
+| Severity | Rule | Location | Message |
+| --- | --- | --- | --- |
+| Error | ` + "`test_rule`" + ` | ` + "`main.tf:3`" + ` | value is \| invalid |
+`
+	if got := githubCommentBody(issues); got != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, got)
+	}
+
+	expected = `<!-- tflint-pr-comment -->
+TFLint found no new issues. All issues reported before are resolved.
+`
+	if got := githubCommentBody(tflint.Issues{}); got != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, got)
+	}
+}
+
+func Test_newIssues(t *testing.T) {
+	existing := &tflint.Issue{Rule: &testRule{}, Message: "existing", Range: hcl.Range{Filename: "main.tf"}}
+	fresh := &tflint.Issue{Rule: &testRule{}, Message: "fresh", Range: hcl.Range{Filename: "main.tf"}}
+
+	got := newIssues(tflint.Issues{existing, fresh}, map[string]bool{existing.Fingerprint(): true})
+	if len(got) != 1 || got[0] != fresh {
+		t.Fatalf("Expected only the fresh issue, but got %#v", got)
+	}
+}
+
+func Test_githubClient(t *testing.T) {
+	requests := []string{}
+	var posted githubComment
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.Header.Get("Authorization") != "token secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/owner/repo/pulls/1":
+			w.Write([]byte(`{"base": {"sha": "abc123"}}`))
+		case "GET /repos/owner/repo/issues/1/comments":
+			w.Write([]byte(`[{"id": 10, "body": "LGTM"}, {"id": 11, "body": "<!-- tflint-pr-comment -->\nTFLint found 1 new issue(s)"}]`))
+		case "PATCH /repos/owner/repo/issues/comments/11", "POST /repos/owner/repo/issues/1/comments":
+			json.NewDecoder(r.Body).Decode(&posted)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	original := githubAPIURL
+	githubAPIURL = server.URL
+	defer func() { githubAPIURL = original }()
+
+	client := &githubClient{token: "secret"}
+	pr := &githubPR{Owner: "owner", Repo: "repo", Number: 1}
+
+	sha, err := client.baseSHA(pr)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if sha != "abc123" {
+		t.Fatalf("Expected base SHA is `abc123`, but got `%s`", sha)
+	}
+
+	comment, err := client.findComment(pr)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if comment == nil || comment.ID != 11 {
+		t.Fatalf("Expected the comment 11, but got %#v", comment)
+	}
+
+	if err := client.updateComment(pr, comment.ID, "updated"); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if posted.Body != "updated" {
+		t.Fatalf("Expected body is `updated`, but got `%s`", posted.Body)
+	}
+
+	if err := client.postComment(pr, "posted"); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if posted.Body != "posted" {
+		t.Fatalf("Expected body is `posted`, but got `%s`", posted.Body)
+	}
+
+	expected := []string{
+		"GET /repos/owner/repo/pulls/1",
+		"GET /repos/owner/repo/issues/1/comments?per_page=100&page=1",
+		"PATCH /repos/owner/repo/issues/comments/11",
+		"POST /repos/owner/repo/issues/1/comments",
+	}
+	if !cmp.Equal(expected, requests) {
+		t.Fatalf("Unexpected requests: diff=%s", cmp.Diff(expected, requests))
+	}
+
+	client.token = "invalid"
+	if _, err := client.baseSHA(pr); err == nil || err.Error() != "GitHub API returned 401 Unauthorized: GET /repos/owner/repo/pulls/1" {
+		t.Fatalf("Expected an unauthorized error, but got `%v`", err)
+	}
+}
+
+func Test_commentOnGitHubPR(t *testing.T) {
+	var posted githubComment
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/owner/repo/pulls/1":
+			w.Write([]byte(`{"base": {"sha": "abc123"}}`))
+		case "GET /repos/owner/repo/issues/1/comments":
+			w.Write([]byte(`[]`))
+		case "POST /repos/owner/repo/issues/1/comments":
+			json.NewDecoder(r.Body).Decode(&posted)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	original := githubAPIURL
+	githubAPIURL = server.URL
+	defer func() { githubAPIURL = original }()
+
+	existing := &tflint.Issue{Rule: &testRule{}, Message: "existing", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}}}
+	fresh := &tflint.Issue{Rule: &testRule{}, Message: "fresh", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2}}}
+
+	inspected := ""
+	inspectBase := func(rev string) (map[string]bool, error) {
+		inspected = rev
+		return map[string]bool{existing.Fingerprint(): true}, nil
+	}
+
+	cli := NewCLI(new(bytes.Buffer), new(bytes.Buffer))
+	opts := Options{GitHubPR: "owner/repo#1", GitHubToken: "secret"}
+	if err := cli.commentOnGitHubPR(opts, tflint.Issues{existing, fresh}, inspectBase); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if inspected != "abc123" {
+		t.Fatalf("Expected the base commit `abc123` is inspected, but got `%s`", inspected)
+	}
+	if expected := githubCommentBody(tflint.Issues{fresh}); posted.Body != expected {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, posted.Body)
+	}
+}
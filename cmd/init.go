@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+
+	tfplugin "github.com/terraform-linters/tflint/plugin"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func (cli *CLI) init(opts Options) int {
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	for _, pluginCfg := range cfg.Plugins {
+		installCfg, err := tfplugin.NewInstallConfig(pluginCfg)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to read plugin config", err), map[string][]byte{})
+			return ExitCodeError
+		}
+		if installCfg.ManuallyInstalled() {
+			continue
+		}
+		installCfg.RequireSignature = cfg.PluginSignaturePolicy == "required"
+
+		path, err := installCfg.Install()
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError(fmt.Sprintf("Failed to install plugin `%s`", pluginCfg.Name), err), map[string][]byte{})
+			return ExitCodeError
+		}
+		if pluginCfg.SigningKey == "" {
+			fmt.Fprintf(cli.errStream, "Warning: `%s` is installed without signature verification. Set `signing_key` to verify the plugin\n", pluginCfg.Name)
+		}
+		fmt.Fprintf(cli.outStream, "Installed `%s` (source: %s, version: %s) to %s\n", pluginCfg.Name, pluginCfg.Source, pluginCfg.Version, path)
+	}
+
+	return ExitCodeOK
+}
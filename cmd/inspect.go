@@ -1,15 +1,139 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/formatter"
 	tfplugin "github.com/terraform-linters/tflint/plugin"
 	"github.com/terraform-linters/tflint/rules"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
 func (cli *CLI) inspect(opts Options, dir string, filterFiles []string) int {
+	if opts.Interactive && !opts.Fix {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", errors.New("`interactive` option must be used with `fix` option")), map[string][]byte{})
+		return ExitCodeError
+	}
+	if opts.Recursive {
+		conflicted := ""
+		switch {
+		case opts.Fix:
+			conflicted = "fix"
+		case opts.GitRev != "":
+			conflicted = "git-rev"
+		case opts.ModuleMode:
+			conflicted = "module-mode"
+		}
+		if conflicted != "" {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", fmt.Errorf("`%s` option cannot be used with `recursive` option", conflicted)), map[string][]byte{})
+			return ExitCodeError
+		}
+		if len(filterFiles) > 0 || tflint.IsArchive(dir) {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Cannot specify files with `recursive` option. Specify a directory instead")), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	var gitRev string
+	if opts.GitRev != "" {
+		if opts.Fix {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", errors.New("`fix` option cannot be used with `git-rev` option")), map[string][]byte{})
+			return ExitCodeError
+		}
+		if dir != "." || len(filterFiles) > 0 {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Cannot specify a directory or files with `git-rev` option. Use `REF:PATH` instead")), map[string][]byte{})
+			return ExitCodeError
+		}
+		gitRev, dir = parseGitRev(opts.GitRev)
+	}
+	var stdinFilename string
+	var stdinSrc []byte
+	if opts.Stdin {
+		conflicted := ""
+		switch {
+		case opts.Fix:
+			conflicted = "fix"
+		case opts.Recursive:
+			conflicted = "recursive"
+		case opts.GitRev != "":
+			conflicted = "git-rev"
+		}
+		if conflicted != "" {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", fmt.Errorf("`%s` option cannot be used with `stdin` option", conflicted)), map[string][]byte{})
+			return ExitCodeError
+		}
+		if dir != "." || len(filterFiles) > 0 {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Cannot specify a directory or files with `stdin` option. Use `stdin-filename` option instead")), map[string][]byte{})
+			return ExitCodeError
+		}
+		src, err := ioutil.ReadAll(cli.inStream)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to read stdin", err), map[string][]byte{})
+			return ExitCodeError
+		}
+		stdinSrc = src
+		// Only issues in the file are reported because other files in the directory are not being edited
+		stdinFilename = filepath.Clean(opts.StdinFilename)
+		dir = filepath.Dir(stdinFilename)
+		filterFiles = []string{stdinFilename}
+	}
+	if opts.Plan != "" {
+		conflicted := ""
+		switch {
+		case opts.Fix:
+			conflicted = "fix"
+		case opts.Recursive:
+			conflicted = "recursive"
+		case opts.GitRev != "":
+			conflicted = "git-rev"
+		case opts.Stdin:
+			conflicted = "stdin"
+		case opts.ModuleMode:
+			conflicted = "module-mode"
+		}
+		if conflicted != "" {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", fmt.Errorf("`%s` option cannot be used with `plan` option", conflicted)), map[string][]byte{})
+			return ExitCodeError
+		}
+		if dir != "." || len(filterFiles) > 0 {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Cannot specify a directory or files with `plan` option")), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	if opts.GitHubPR != "" {
+		conflicted := ""
+		switch {
+		case opts.Recursive:
+			conflicted = "recursive"
+		case opts.GitRev != "":
+			conflicted = "git-rev"
+		case opts.Stdin:
+			conflicted = "stdin"
+		case opts.Plan != "":
+			conflicted = "plan"
+		}
+		if conflicted != "" {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", fmt.Errorf("`%s` option cannot be used with `github-pr` option", conflicted)), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	var archive string
+	if tflint.IsArchive(dir) {
+		if opts.Fix {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI options", errors.New("`fix` option cannot be used with an archive")), map[string][]byte{})
+			return ExitCodeError
+		}
+		archive = dir
+	}
+
 	// Setup config
 	cfg, err := tflint.LoadConfig(opts.Config)
 	if err != nil {
@@ -17,27 +141,108 @@ func (cli *CLI) inspect(opts Options, dir string, filterFiles []string) int {
 		return ExitCodeError
 	}
 	cfg = cfg.Merge(opts.toConfig())
+	cli.formatter.Theme, err = formatter.NewTheme(cfg)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	if cfg.Template != "" {
+		cli.formatter.Template, err = formatter.LoadTemplate(cfg.Template)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load the template", err), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	exceptions, err := tflint.LoadExceptions(opts.Exceptions)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load exceptions", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	exceptions = exceptions.Active(time.Now())
+	if cfg.ModuleMode && cfg.DeepCheck {
+		log.Printf("[INFO] Deep check mode is disabled in module mode")
+		cfg.DeepCheck = false
+	}
+	if opts.Recursive && cfg.DeepCheck {
+		// The state and the workspace are read from the current directory, so they do not match configurations in subdirectories
+		log.Printf("[INFO] Deep check mode is disabled in recursive mode")
+		cfg.DeepCheck = false
+	}
+
+	if opts.Plan != "" {
+		// Resources of child modules are already expanded in the plan, and the state does not match converted resources
+		if cfg.Module || cfg.DeepCheck {
+			log.Printf("[INFO] Module inspection and deep check mode are disabled with a plan")
+		}
+		cfg.Module = false
+		cfg.ModuleDownload = false
+		cfg.DeepCheck = false
+	}
+	if cli.profiler != nil && cfg.DeepCheck {
+		log.Printf("[INFO] Deep check mode is disabled in benchmarks")
+		cfg.DeepCheck = false
+	}
+	if cfg.ModuleDownload && !cfg.Module {
+		log.Printf("[INFO] Module inspection is enabled to download modules")
+		cfg.Module = true
+	}
+
+	ctx, stop := interruptContext()
+	defer stop()
 
 	// Setup loader
+	stopPhase := cli.profiler.startPhase("load")
 	if !cli.testMode {
-		cli.loader, err = tflint.NewLoader(afero.Afero{Fs: afero.NewOsFs()}, cfg)
+		loaderOpts := []tflint.LoaderOption{}
+		var fs afero.Fs = afero.NewOsFs()
+		if gitRev != "" {
+			fs, err = tflint.NewGitTreeFs(gitRev)
+			if err != nil {
+				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError(fmt.Sprintf("Failed to load git revision `%s`", gitRev), err), map[string][]byte{})
+				return ExitCodeError
+			}
+			loaderOpts = append(loaderOpts, tflint.WithFS(fs))
+		}
+		if archive != "" {
+			fs, dir, err = tflint.NewArchiveFs(archive)
+			if err != nil {
+				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load archive", err), map[string][]byte{})
+				return ExitCodeError
+			}
+			loaderOpts = append(loaderOpts, tflint.WithFS(fs))
+		}
+		if opts.Stdin {
+			fs, err = tflint.NewStdinFs(fs, stdinFilename, stdinSrc)
+			if err != nil {
+				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to read stdin", err), map[string][]byte{})
+				return ExitCodeError
+			}
+			loaderOpts = append(loaderOpts, tflint.WithFS(fs))
+		}
+		if opts.Plan != "" {
+			fs, err = tflint.NewPlanFs(opts.Plan)
+			if err != nil {
+				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load plan", err), map[string][]byte{})
+				return ExitCodeError
+			}
+			loaderOpts = append(loaderOpts, tflint.WithFS(fs))
+		}
+		if cfg.ModuleDownload {
+			loaderOpts = append(loaderOpts, tflint.WithModuleResolver(tflint.RemoteModuleResolver(ctx, afero.Afero{Fs: fs})))
+		}
+
+		cli.loader, err = tflint.NewLoader(cfg, loaderOpts...)
 		if err != nil {
 			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to prepare loading", err), map[string][]byte{})
 			return ExitCodeError
 		}
 	}
-
-	// Setup runners
-	runners, appErr := cli.setupRunners(opts, cfg, dir)
-	if appErr != nil {
-		cli.formatter.Print(tflint.Issues{}, appErr, cli.loader.Sources())
-		return ExitCodeError
-	}
+	stopPhase()
 
 	// Lookup plugins and validation
 	plugin, err := tfplugin.Discovery(cfg)
 	if err != nil {
-		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to initialize plugins", err), cli.loader.Sources())
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to initialize plugins", err), map[string][]byte{})
 		return ExitCodeError
 	}
 	defer plugin.Clean()
@@ -47,42 +252,65 @@ func (cli *CLI) inspect(opts Options, dir string, filterFiles []string) int {
 		rulesets = append(rulesets, ruleset)
 	}
 	if err := cfg.ValidateRules(rulesets...); err != nil {
-		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to check rule config", err), cli.loader.Sources())
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to check rule config", err), map[string][]byte{})
 		return ExitCodeError
 	}
-
-	// Run inspection
-	for _, rule := range rules.NewRules(cfg) {
-		for _, runner := range runners {
-			err := rule.Check(runner)
-			if err != nil {
-				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError(fmt.Sprintf("Failed to check `%s` rule", rule.Name()), err), cli.loader.Sources())
-				return ExitCodeError
-			}
+	for _, ruleset := range plugin.RuleSets {
+		if err := ruleset.ApplyConfig(cfg.ToPluginConfig()); err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to apply config to plugins", err), map[string][]byte{})
 		}
 	}
 
-	for _, ruleset := range plugin.RuleSets {
-		err = ruleset.ApplyConfig(cfg.ToPluginConfig())
+	result, appErr := cli.runInspection(ctx, cfg, cli.loader, dir, opts.Recursive, filterFiles, exceptions, plugin.RuleSets)
+	if appErr != nil {
+		cli.formatter.Print(tflint.Issues{}, appErr, result.sources)
+		return ExitCodeError
+	}
+	if result.interrupted != "" {
+		return cli.printInterrupted(result.runners, filterFiles, exceptions, result.interrupted, result.sources)
+	}
+	sources := result.sources
+	issues := result.issues
+	cli.formatter.Exceptions = exceptions
+
+	stopPhase = cli.profiler.startPhase("report")
+	defer stopPhase()
+
+	// Fix issues
+	if opts.Fix {
+		issues, err = cli.fix(issues, cfg, dir, opts.Interactive)
 		if err != nil {
-			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to apply config to plugins", err), cli.loader.Sources())
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to fix issues", err), cli.loader.Sources())
+			return ExitCodeError
 		}
-		for _, runner := range runners {
-			err = ruleset.Check(tfplugin.NewServer(runner))
-			if err != nil {
-				cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to check ruleset", err), cli.loader.Sources())
-				return ExitCodeError
-			}
+	}
+
+	// Comment on the pull request
+	if opts.GitHubPR != "" {
+		inspectBase := func(rev string) (map[string]bool, error) {
+			return cli.inspectRevision(ctx, cfg, rev, dir, filterFiles, exceptions, plugin.RuleSets)
+		}
+		if err := cli.commentOnGitHubPR(opts, issues, inspectBase); err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to comment on the pull request", err), sources)
+			return ExitCodeError
 		}
 	}
 
-	issues := tflint.Issues{}
-	for _, runner := range runners {
-		issues = append(issues, runner.LookupIssues(filterFiles...)...)
+	// Check issue budgets
+	if cfg.HasBudgets() {
+		if err := cfg.CheckBudgets(issues); err != nil {
+			cli.formatter.Print(issues, tflint.NewContextError("Issue budgets exceeded", err), sources)
+			if cfg.Force {
+				return ExitCodeOK
+			}
+			return ExitCodeBudgetExceeded
+		}
+		cli.formatter.Print(issues, nil, sources)
+		return ExitCodeOK
 	}
 
 	// Print issues
-	cli.formatter.Print(issues, nil, cli.loader.Sources())
+	cli.formatter.Print(issues, nil, sources)
 
 	if len(issues) > 0 && !cfg.Force {
 		return ExitCodeIssuesFound
@@ -91,29 +319,176 @@ func (cli *CLI) inspect(opts Options, dir string, filterFiles []string) int {
 	return ExitCodeOK
 }
 
-func (cli *CLI) setupRunners(opts Options, cfg *tflint.Config, dir string) ([]*tflint.Runner, *tflint.Error) {
-	configs, err := cli.loader.LoadConfig(dir)
+// inspection is the result of running rules on a configuration
+type inspection struct {
+	runners []*tflint.Runner
+	sources map[string][]byte
+	// issues are found in the filtered files, and exceptions, the issue hook and escalation are applied to them
+	issues tflint.Issues
+	// interrupted is the progress when the inspection was interrupted. It is empty if the inspection finished
+	interrupted string
+}
+
+// runInspection runs built-in rules and rules of plugins on the configuration in the directory, and post-processes the issues
+// The working tree and the base revision of `--github-pr` are both inspected by it, so their issues are comparable.
+// Sources are returned with errors as well, so that errors can be printed with them.
+func (cli *CLI) runInspection(ctx context.Context, cfg *tflint.Config, loader tflint.AbstractLoader, dir string, recursive bool, filterFiles []string, exceptions tflint.Exceptions, rulesets []tfplugin.RuleSet) (*inspection, *tflint.Error) {
+	ret := &inspection{sources: map[string][]byte{}}
+
+	// Setup runners
+	stopPhase := cli.profiler.startPhase("load")
+	var appErr *tflint.Error
+	if recursive {
+		ret.runners, ret.sources, appErr = setupRecursiveRunners(ctx, cfg, dir)
+	} else {
+		ret.runners, appErr = setupRunners(ctx, loader, cfg, dir)
+		for filename, src := range loader.Sources() {
+			ret.sources[filename] = src
+		}
+	}
+	if appErr != nil {
+		return ret, appErr
+	}
+	stopPhase()
+	cli.profiler.observe(ret.runners, ret.sources)
+
+	// Run inspection
+	var deadline time.Time
+	if cfg.Timeout > 0 {
+		deadline = time.Now().Add(cfg.Timeout)
+	}
+
+	stopPhase = cli.profiler.startPhase("check")
+	enabledRules := rules.NewRules(cfg)
+	index := rules.NewRuleIndex(enabledRules)
+	guard := tflint.NewMemoryGuard(cfg.MemoryLimit)
+	guard.Check(ret.runners)
+	skipped := 0
+	routed := 0
+	for i, rule := range enabledRules {
+		guard.Check(ret.runners)
+		stopRule := cli.profiler.startRule(rule.Name())
+		for _, runner := range ret.runners {
+			if index.SkipProvider(rule, runner) {
+				routed++
+				continue
+			}
+			if cfg.Quick && index.Skip(rule, runner) {
+				skipped++
+				continue
+			}
+			if !runner.InRuleScope(rule.Name()) {
+				log.Printf("[DEBUG] Skip `%s` rule because no files of `%s` are in the rule scope", rule.Name(), runner.TFConfigPath())
+				continue
+			}
+			err := checkWithTimeout(ctx, cfg.RuleTimeout(rule.Name()), deadline, func() error {
+				return rule.Check(runner)
+			})
+			if err == errInterrupted {
+				ret.interrupted = fmt.Sprintf("after checking %d of %d rules", i, len(enabledRules))
+				return ret, nil
+			}
+			if err != nil {
+				return ret, tflint.NewContextError(fmt.Sprintf("Failed to check `%s` rule", rule.Name()), err)
+			}
+		}
+		stopRule()
+	}
+	stopPhase()
+	log.Printf("[INFO] Skipped %d checks of rules for providers not found in configurations", routed)
+	if cfg.Quick {
+		log.Printf("[INFO] Quick mode skipped %d checks of rules for resource types not found in modules", skipped)
+	}
+
+	stopPhase = cli.profiler.startPhase("plugins")
+	for _, ruleset := range rulesets {
+		for _, runner := range ret.runners {
+			err := checkWithTimeout(ctx, 0, deadline, func() error {
+				return ruleset.Check(tfplugin.NewServer(runner))
+			})
+			if err == errInterrupted {
+				ret.interrupted = "while checking rules of plugins"
+				return ret, nil
+			}
+			if err != nil {
+				return ret, tflint.NewContextError("Failed to check ruleset", err)
+			}
+		}
+	}
+	stopPhase()
+
+	// Inspect examples of the module as root modules
+	if cfg.ModuleMode {
+		stopPhase = cli.profiler.startPhase("examples")
+		exampleCfg := exampleConfig(cfg)
+		exampleRunners, exampleSources, appErr := setupExampleRunners(loader.FS(), exampleCfg, dir)
+		if appErr != nil {
+			return ret, appErr
+		}
+		for filename, src := range exampleSources {
+			ret.sources[filename] = src
+		}
+		ret.runners = append(ret.runners, exampleRunners...)
+
+		for _, rule := range rules.NewRules(exampleCfg) {
+			stopRule := cli.profiler.startRule(rule.Name())
+			for _, runner := range exampleRunners {
+				err := checkWithTimeout(ctx, exampleCfg.RuleTimeout(rule.Name()), deadline, func() error {
+					return rule.Check(runner)
+				})
+				if err == errInterrupted {
+					ret.interrupted = "while inspecting examples"
+					return ret, nil
+				}
+				if err != nil {
+					return ret, tflint.NewContextError(fmt.Sprintf("Failed to check `%s` rule", rule.Name()), err)
+				}
+			}
+			stopRule()
+		}
+		stopPhase()
+	}
+
+	// Post-process issues
+	stopPhase = cli.profiler.startPhase("report")
+	defer stopPhase()
+	issues := tflint.Issues{}
+	for _, runner := range ret.runners {
+		issues = append(issues, runner.LookupIssues(filterFiles...)...)
+	}
+	issues = exceptions.Apply(issues)
+	issues, err := tflint.RunIssueHook(cfg.IssueHook, issues)
+	if err != nil {
+		return ret, tflint.NewContextError("Failed to run the issue hook", err)
+	}
+	ret.issues = cfg.EscalateIssues(issues)
+	return ret, nil
+}
+
+func setupRunners(ctx context.Context, loader tflint.AbstractLoader, cfg *tflint.Config, dir string) ([]*tflint.Runner, *tflint.Error) {
+	configs, err := loader.LoadConfig(dir)
 	if err != nil {
 		return []*tflint.Runner{}, tflint.NewContextError("Failed to load configurations", err)
 	}
-	annotations, err := cli.loader.LoadAnnotations(dir)
+	annotations, err := loader.LoadAnnotations(dir)
 	if err != nil {
 		return []*tflint.Runner{}, tflint.NewContextError("Failed to load configuration tokens", err)
 	}
-	variables, err := cli.loader.LoadValuesFiles(cfg.Varfiles...)
+	variables, err := loader.LoadValuesFiles(cfg.Varfiles...)
 	if err != nil {
 		return []*tflint.Runner{}, tflint.NewContextError("Failed to load values files", err)
 	}
-	cliVars, err := tflint.ParseTFVariables(cfg.Variables, configs.Module.Variables)
+	variables, err = tflint.ArrangeTFVariables(variables, cfg, configs.Module.Variables)
 	if err != nil {
 		return []*tflint.Runner{}, tflint.NewContextError("Failed to parse variables", err)
 	}
-	variables = append(variables, cliVars)
 
-	runner, err := tflint.NewRunner(cfg, annotations, configs, variables...)
+	runner, err := tflint.NewRunnerWithContext(ctx, loader.FS(), cfg, annotations, configs, variables...)
 	if err != nil {
 		return []*tflint.Runner{}, tflint.NewContextError("Failed to initialize a runner", err)
 	}
+	runner.Sources = loader.Sources()
+	runner.EmitSyntaxErrors(loader.SyntaxErrors())
 
 	runners, err := tflint.NewModuleRunners(runner)
 	if err != nil {
@@ -122,3 +497,159 @@ func (cli *CLI) setupRunners(opts Options, cfg *tflint.Config, dir string) ([]*t
 
 	return append(runners, runner), nil
 }
+
+// setupRecursiveRunners returns runners of configurations in the directory and its subdirectories, and sources of them
+// Each configuration is loaded by its own loader so that modules installed by `terraform init` in the directory are found.
+func setupRecursiveRunners(ctx context.Context, cfg *tflint.Config, dir string) ([]*tflint.Runner, map[string][]byte, *tflint.Error) {
+	sources := map[string][]byte{}
+	fs := afero.Afero{Fs: afero.NewOsFs()}
+	matcher, err := tflint.LoadIgnoreMatcher(fs, cfg.Excludes)
+	if err != nil {
+		return []*tflint.Runner{}, sources, tflint.NewContextError("Failed to load ignore patterns", err)
+	}
+	// Excluded directories are not inspected at all
+	dirs, err := tflint.FindConfigDirs(afero.Afero{Fs: tflint.NewIgnoreFs(fs, matcher)}, dir)
+	if err != nil {
+		return []*tflint.Runner{}, sources, tflint.NewContextError("Failed to find configurations", err)
+	}
+
+	runners := []*tflint.Runner{}
+	for _, configDir := range dirs {
+		log.Printf("[INFO] Inspect configurations under %s", configDir)
+		loaderOpts := []tflint.LoaderOption{tflint.WithModuleManifestDir(configDir)}
+		if cfg.ModuleDownload {
+			loaderOpts = append(loaderOpts, tflint.WithModuleResolver(tflint.RemoteModuleResolver(ctx, afero.Afero{Fs: afero.NewOsFs()})))
+		}
+		loader, err := tflint.NewLoader(cfg, loaderOpts...)
+		if err != nil {
+			return []*tflint.Runner{}, sources, tflint.NewContextError(fmt.Sprintf("Failed to prepare loading `%s`", configDir), err)
+		}
+		dirRunners, appErr := setupRunners(ctx, loader, cfg, configDir)
+		for filename, src := range loader.Sources() {
+			sources[filename] = src
+		}
+		if appErr != nil {
+			return []*tflint.Runner{}, sources, appErr
+		}
+		runners = append(runners, dirRunners...)
+	}
+	return runners, sources, nil
+}
+
+// checkWithTimeout runs the check and aborts it when it takes longer than the rule timeout, exceeds the deadline of the run,
+// or the context is canceled. Zero values mean no limits. The aborted check keeps running in the background,
+// but its result is discarded because the inspection stops immediately. errInterrupted is returned when the context is canceled.
+// Issues found so far are then read with Runner.LookupIssues, which does not race with the aborted check.
+func checkWithTimeout(ctx context.Context, timeout time.Duration, deadline time.Time, check func() error) error {
+	if ctx.Err() != nil {
+		return errInterrupted
+	}
+	runTimeoutErr := errors.New("The inspection has exceeded the timeout")
+	err := fmt.Errorf("The rule did not finish within %s", timeout)
+
+	if !deadline.IsZero() {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return runTimeoutErr
+		}
+		if timeout == 0 || remaining < timeout {
+			timeout = remaining
+			err = runTimeoutErr
+		}
+	}
+	if timeout == 0 && ctx.Done() == nil {
+		return check()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- check()
+	}()
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		expired = time.After(timeout)
+	}
+	select {
+	case ret := <-done:
+		return ret
+	case <-expired:
+		return err
+	case <-ctx.Done():
+		return errInterrupted
+	}
+}
+
+// fix writes patched sources and returns remaining issues that could not be fixed
+// Patched sources are validated in memory first, and files that fail the validation are not written.
+// In interactive mode, only the fixes accepted at the prompts are applied.
+func (cli *CLI) fix(issues tflint.Issues, cfg *tflint.Config, dir string, interactive bool) (tflint.Issues, error) {
+	candidates := issues
+	if interactive {
+		candidates = cli.selectFixes(issues, cli.loader.Sources())
+	}
+	patched, fixed := tflint.ApplyFixes(cli.loader.Sources(), candidates)
+
+	failures := tflint.ValidateFixes(patched, fixed, func(sources map[string][]byte) (*tflint.Runner, error) {
+		return patchedRunner(sources, cfg, dir)
+	})
+	for filename, err := range failures {
+		fmt.Fprintf(cli.errStream, "Fixes for `%s` were not applied: %s\n", filename, err)
+		delete(patched, filename)
+	}
+
+	for filename, src := range patched {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return issues, err
+		}
+		if err := ioutil.WriteFile(filename, src, info.Mode()); err != nil {
+			return issues, err
+		}
+		log.Printf("[INFO] Fixed %s", filename)
+	}
+
+	fixedMap := map[*tflint.Issue]bool{}
+	for _, issue := range fixed {
+		if _, failed := failures[issue.Fix.Range.Filename]; !failed {
+			fixedMap[issue] = true
+		}
+	}
+
+	ret := tflint.Issues{}
+	for _, issue := range issues {
+		if !fixedMap[issue] {
+			ret = append(ret, issue)
+		}
+	}
+	return ret, nil
+}
+
+// patchedRunner returns a runner of the root module whose files are overlaid with the passed sources in memory
+func patchedRunner(sources map[string][]byte, cfg *tflint.Config, dir string) (*tflint.Runner, error) {
+	overlay := afero.NewMemMapFs()
+	for filename, src := range sources {
+		if err := afero.WriteFile(overlay, filename, src, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	loader, err := tflint.NewLoader(cfg, tflint.WithFS(afero.NewCopyOnWriteFs(afero.NewOsFs(), overlay)))
+	if err != nil {
+		return nil, err
+	}
+	runners, appErr := setupRunners(context.Background(), loader, cfg, dir)
+	if appErr != nil {
+		return nil, appErr
+	}
+	return runners[len(runners)-1], nil
+}
+
+// parseGitRev splits `REF[:PATH]` into the revision and the directory to inspect
+func parseGitRev(arg string) (string, string) {
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) == 1 || parts[1] == "" {
+		return parts[0], "."
+	}
+	return parts[0], filepath.Clean(parts[1])
+}
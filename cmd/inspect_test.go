@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_checkWithTimeout(t *testing.T) {
+	slow := func() error {
+		time.Sleep(time.Second)
+		return nil
+	}
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	canceling, cancelLater := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelLater()
+
+	cases := []struct {
+		Name     string
+		Context  context.Context
+		Timeout  time.Duration
+		Deadline time.Time
+		Check    func() error
+		Error    string
+	}{
+		{
+			Name:  "no limits",
+			Check: func() error { return nil },
+		},
+		{
+			Name:  "check error",
+			Check: func() error { return errors.New("check failed") },
+			Error: "check failed",
+		},
+		{
+			Name:    "rule timeout",
+			Timeout: 10 * time.Millisecond,
+			Check:   slow,
+			Error:   "The rule did not finish within 10ms",
+		},
+		{
+			Name:     "run timeout",
+			Timeout:  time.Minute,
+			Deadline: time.Now().Add(10 * time.Millisecond),
+			Check:    slow,
+			Error:    "The inspection has exceeded the timeout",
+		},
+		{
+			Name:     "deadline exceeded",
+			Deadline: time.Now().Add(-time.Second),
+			Check:    func() error { return nil },
+			Error:    "The inspection has exceeded the timeout",
+		},
+		{
+			Name:    "interrupted",
+			Context: canceled,
+			Check:   func() error { return nil },
+			Error:   "The inspection was interrupted",
+		},
+		{
+			Name:    "interrupted while checking",
+			Context: canceling,
+			Check:   slow,
+			Error:   "The inspection was interrupted",
+		},
+	}
+
+	for _, tc := range cases {
+		ctx := tc.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		err := checkWithTimeout(ctx, tc.Timeout, tc.Deadline, tc.Check)
+		if tc.Error == "" {
+			if err != nil {
+				t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tc.Error {
+			t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+		}
+	}
+}
+
+func Test_checkWithTimeout_abandoned(t *testing.T) {
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": `resource "null_resource" "test" {}`})
+	rule := &testRule{}
+
+	started := make(chan struct{})
+	stop := make(chan struct{})
+	defer close(stop)
+	check := func() error {
+		close(started)
+		for {
+			select {
+			case <-stop:
+				return nil
+			default:
+				runner.EmitIssue(rule, "abandoned", hcl.Range{Filename: "main.tf"})
+				time.Sleep(time.Microsecond)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	if err := checkWithTimeout(ctx, 0, time.Time{}, check); err != errInterrupted {
+		t.Fatalf("Expected `%s`, but got `%v`", errInterrupted, err)
+	}
+
+	// The abandoned check keeps emitting issues while they are read
+	for i := 0; i < 100; i++ {
+		for _, issue := range runner.LookupIssues() {
+			if issue.Message != "abandoned" {
+				t.Fatalf("Unexpected issue: %s", issue.Message)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// errInterrupted is returned by checks aborted by the interruption
+var errInterrupted = errors.New("The inspection was interrupted")
+
+// interruptContext returns a context which is canceled when the process receives SIGINT or SIGTERM
+// CI services send them to jobs being canceled or timed out. Module downloads and rule checks stop at the next
+// cancellation point, so that the issues found so far can be reported. Call the returned function to stop watching signals.
+func interruptContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-signals:
+			log.Printf("[INFO] Received %s. Stop the inspection", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+	}
+}
+
+// printInterrupted prints issues found before the interruption with an error which tells the progress
+// The output is a complete report in any format, so interrupted CI jobs still leave a parsable partial report.
+func (cli *CLI) printInterrupted(runners []*tflint.Runner, filterFiles []string, exceptions tflint.Exceptions, progress string, sources map[string][]byte) int {
+	issues := tflint.Issues{}
+	for _, runner := range runners {
+		issues = append(issues, runner.LookupIssues(filterFiles...)...)
+	}
+	issues = exceptions.Apply(issues)
+	cli.formatter.Exceptions = exceptions
+
+	cli.formatter.Print(issues, tflint.NewContextError("Inspection interrupted", fmt.Errorf("Interrupted %s. Only issues found so far are reported", progress)), sources)
+	return ExitCodeError
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"log"
 	"strings"
+	"time"
 
 	"github.com/terraform-linters/tflint/client"
 	"github.com/terraform-linters/tflint/tflint"
@@ -10,24 +11,75 @@ import (
 
 // Options is an option specified by arguments.
 type Options struct {
-	Version       bool     `short:"v" long:"version" description:"Print TFLint version"`
-	Langserver    bool     `long:"langserver" description:"Start language server"`
-	Format        string   `short:"f" long:"format" description:"Output format" choice:"default" choice:"json" choice:"checkstyle" default:"default"`
-	Config        string   `short:"c" long:"config" description:"Config file name" value-name:"FILE" default:".tflint.hcl"`
-	IgnoreModules []string `long:"ignore-module" description:"Ignore module sources" value-name:"SOURCE"`
-	EnableRules   []string `long:"enable-rule" description:"Enable rules from the command line" value-name:"RULE_NAME"`
-	DisableRules  []string `long:"disable-rule" description:"Disable rules from the command line" value-name:"RULE_NAME"`
-	Varfiles      []string `long:"var-file" description:"Terraform variable file name" value-name:"FILE"`
-	Variables     []string `long:"var" description:"Set a Terraform variable" value-name:"'foo=bar'"`
-	Module        bool     `long:"module" description:"Inspect modules"`
-	Deep          bool     `long:"deep" description:"Enable deep check mode"`
-	AwsAccessKey  string   `long:"aws-access-key" description:"AWS access key used in deep check mode" value-name:"ACCESS_KEY"`
-	AwsSecretKey  string   `long:"aws-secret-key" description:"AWS secret key used in deep check mode" value-name:"SECRET_KEY"`
-	AwsProfile    string   `long:"aws-profile" description:"AWS shared credential profile name used in deep check mode" value-name:"PROFILE"`
-	AwsCredsFile  string   `long:"aws-creds-file" description:"AWS shared credentials file path used in deep checking" value-name:"FILE"`
-	AwsRegion     string   `long:"aws-region" description:"AWS region used in deep check mode" value-name:"REGION"`
-	Force         bool     `long:"force" description:"Return zero exit status even if issues found"`
-	NoColor       bool     `long:"no-color" description:"Disable colorized output"`
+	Version        bool          `short:"v" long:"version" description:"Print TFLint version"`
+	Init           bool          `long:"init" description:"Install plugins"`
+	Langserver     bool          `long:"langserver" description:"Start language server"`
+	UpdateData     bool          `long:"update-data" description:"Update data of instance types used by rules"`
+	Format         []string      `short:"f" long:"format" description:"Output format. Use FORMAT:FILE to write to a file" value-name:"FORMAT[:FILE]" default:"default"`
+	Config         string        `short:"c" long:"config" description:"Config file name" value-name:"FILE" default:".tflint.hcl"`
+	Exceptions     string        `long:"exceptions" description:"Exceptions file name" value-name:"FILE" default:"exceptions.hcl"`
+	IgnoreModules  []string      `long:"ignore-module" description:"Ignore module sources" value-name:"SOURCE"`
+	EnableRules    []string      `long:"enable-rule" description:"Enable rules from the command line" value-name:"RULE_NAME"`
+	DisableRules   []string      `long:"disable-rule" description:"Disable rules from the command line" value-name:"RULE_NAME"`
+	Excludes       []string      `long:"exclude" description:"Exclude files matching the gitignore-style pattern" value-name:"PATTERN"`
+	Varfiles       []string      `long:"var-file" description:"Terraform variable file name" value-name:"FILE"`
+	Variables      []string      `long:"var" description:"Set a Terraform variable" value-name:"'foo=bar'"`
+	Module         bool          `long:"module" description:"Inspect modules"`
+	ModuleDownload bool          `long:"module-download" description:"Download remote modules without terraform init in module inspection"`
+	Deep           bool          `long:"deep" description:"Enable deep check mode"`
+	AwsAccessKey   string        `long:"aws-access-key" description:"AWS access key used in deep check mode" value-name:"ACCESS_KEY"`
+	AwsSecretKey   string        `long:"aws-secret-key" description:"AWS secret key used in deep check mode" value-name:"SECRET_KEY"`
+	AwsProfile     string        `long:"aws-profile" description:"AWS shared credential profile name used in deep check mode" value-name:"PROFILE"`
+	AwsCredsFile   string        `long:"aws-creds-file" description:"AWS shared credentials file path used in deep checking" value-name:"FILE"`
+	AwsRegion      string        `long:"aws-region" description:"AWS region used in deep check mode" value-name:"REGION"`
+	AwsCacheCreds  bool          `long:"aws-cache-creds" description:"Cache credentials of assumed roles across runs in deep check mode"`
+	Force          bool          `long:"force" description:"Return zero exit status even if issues found"`
+	Fix            bool          `long:"fix" description:"Fix issues automatically"`
+	Interactive    bool          `long:"interactive" description:"Prompt before applying each fix"`
+	GitRev         string        `long:"git-rev" description:"Inspect files in the git revision" value-name:"REF[:PATH]"`
+	GitHubPR       string        `long:"github-pr" description:"Comment new issues on the GitHub pull request" value-name:"OWNER/REPO#NUMBER"`
+	GitHubToken    string        `long:"github-token" description:"GitHub token used to comment on pull requests" value-name:"TOKEN"`
+	Stdin          bool          `long:"stdin" description:"Read the file from stdin"`
+	StdinFilename  string        `long:"stdin-filename" description:"File name of the source read from stdin" value-name:"FILE" default:"main.tf"`
+	Plan           string        `long:"plan" description:"Inspect planned values in the JSON plan printed by terraform show -json" value-name:"FILE"`
+	ModuleMode     bool          `long:"module-mode" description:"Inspect the directory as a reusable module"`
+	Recursive      bool          `long:"recursive" description:"Inspect configurations in subdirectories recursively"`
+	GenerateConfig string        `long:"generate-config" description:"Print config of the resource in the state" value-name:"ADDRESS"`
+	Timeout        time.Duration `long:"timeout" description:"Abort the inspection after the duration" value-name:"DURATION"`
+	Quick          bool          `long:"quick" description:"Skip rules for resource types not found in modules"`
+	MemoryLimit    int           `long:"memory-limit" description:"Drop optional caches when the heap exceeds the size" value-name:"MIB"`
+	ReportSyntax   bool          `long:"report-syntax-errors" description:"Report syntax errors as issues and inspect other files"`
+	EscalateAfter  int           `long:"escalate-after" description:"Report an error for rules with more issues than the number in a module" value-name:"N"`
+	Schemas        string        `long:"provider-schemas" description:"Validate resources with the output of terraform providers schema -json" value-name:"FILE"`
+	Workspace      string        `long:"workspace" description:"Workspace of the state and terraform.workspace instead of the selected one" value-name:"NAME"`
+	CacheDir       string        `long:"cache-dir" description:"Cache results of scanning files in the directory by their content hashes" value-name:"DIR"`
+	NoColor        bool          `long:"no-color" description:"Disable colorized output"`
+	AggregateAfter int           `long:"aggregate-after" description:"Print issues of a rule as one issue if more than the number are found in a file" value-name:"N"`
+	PathStyle      string        `long:"path-style" description:"Style of file paths in issues: relative, absolute or repo-root" value-name:"STYLE" default:"relative"`
+
+	// valuesOrder is the order of `--var-file` and `--var` on the command line
+	valuesOrder []string
+}
+
+// recordValuesOrder records the order of `--var-file` and `--var` in the arguments
+// As with Terraform, values passed later take precedence regardless of whether they are files or variables.
+func (opts *Options) recordValuesOrder(args []string) {
+	opts.valuesOrder = []string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return
+		}
+		for _, name := range []string{"var-file", "var"} {
+			if arg == "--"+name {
+				opts.valuesOrder = append(opts.valuesOrder, name)
+				// Skip the value, which may look like an option
+				i++
+			} else if strings.HasPrefix(arg, "--"+name+"=") {
+				opts.valuesOrder = append(opts.valuesOrder, name)
+			}
+		}
+	}
 }
 
 func (opts *Options) toConfig() *tflint.Config {
@@ -39,10 +91,34 @@ func (opts *Options) toConfig() *tflint.Config {
 		}
 	}
 
+	order := opts.valuesOrder
+	if len(order) != len(opts.Varfiles)+len(opts.Variables) {
+		order = []string{}
+		for range opts.Varfiles {
+			order = append(order, "var-file")
+		}
+		for range opts.Variables {
+			order = append(order, "var")
+		}
+	}
 	varfiles := []string{}
-	for _, vf := range opts.Varfiles {
-		// For the backward compatibility, allow specifying like `varfile1,varfile2` style
-		varfiles = append(varfiles, strings.Split(vf, ",")...)
+	positions := []int{}
+	next := 0
+	for _, name := range order {
+		if name == "var-file" {
+			// For the backward compatibility, allow specifying like `varfile1,varfile2` style
+			varfiles = append(varfiles, strings.Split(opts.Varfiles[next], ",")...)
+			next++
+		} else {
+			positions = append(positions, len(varfiles))
+		}
+	}
+	var variablePositions []int
+	for _, pos := range positions {
+		if pos < len(varfiles) {
+			variablePositions = positions
+			break
+		}
 	}
 	if opts.Variables == nil {
 		opts.Variables = []string{}
@@ -50,13 +126,25 @@ func (opts *Options) toConfig() *tflint.Config {
 
 	log.Printf("[DEBUG] CLI Options")
 	log.Printf("[DEBUG]   Module: %t", opts.Module)
+	log.Printf("[DEBUG]   ModuleDownload: %t", opts.ModuleDownload)
 	log.Printf("[DEBUG]   DeepCheck: %t", opts.Deep)
 	log.Printf("[DEBUG]   Force: %t", opts.Force)
 	log.Printf("[DEBUG]   IgnoreModules: %#v", ignoreModules)
 	log.Printf("[DEBUG]   EnableRules: %#v", opts.EnableRules)
 	log.Printf("[DEBUG]   DisableRules: %#v", opts.DisableRules)
+	log.Printf("[DEBUG]   Excludes: %#v", opts.Excludes)
 	log.Printf("[DEBUG]   Varfiles: %#v", varfiles)
-	log.Printf("[DEBUG]   Variables: %#v", opts.Variables)
+	log.Printf("[DEBUG]   Variables: %#v", tflint.RedactVariables(opts.Variables))
+	log.Printf("[DEBUG]   VariablePositions: %#v", variablePositions)
+	log.Printf("[DEBUG]   Timeout: %s", opts.Timeout)
+	log.Printf("[DEBUG]   ModuleMode: %t", opts.ModuleMode)
+	log.Printf("[DEBUG]   Quick: %t", opts.Quick)
+	log.Printf("[DEBUG]   MemoryLimit: %d", opts.MemoryLimit)
+	log.Printf("[DEBUG]   ReportSyntaxErrors: %t", opts.ReportSyntax)
+	log.Printf("[DEBUG]   EscalateAfter: %d", opts.EscalateAfter)
+	log.Printf("[DEBUG]   ProviderSchemas: %s", opts.Schemas)
+	log.Printf("[DEBUG]   Workspace: %s", opts.Workspace)
+	log.Printf("[DEBUG]   CacheDir: %s", opts.CacheDir)
 
 	rules := map[string]*tflint.RuleConfig{}
 	for _, rule := range opts.EnableRules {
@@ -82,11 +170,29 @@ func (opts *Options) toConfig() *tflint.Config {
 			Profile:   opts.AwsProfile,
 			CredsFile: opts.AwsCredsFile,
 			Region:    opts.AwsRegion,
+
+			CacheCredentials: opts.AwsCacheCreds,
 		},
 		IgnoreModules: ignoreModules,
 		Varfiles:      varfiles,
 		Variables:     opts.Variables,
 		Rules:         rules,
 		Plugins:       map[string]*tflint.PluginConfig{},
+		Themes:        map[string]*tflint.ThemeConfig{},
+		Accounts:      map[string]*tflint.AccountConfig{},
+		Timeout:       opts.Timeout,
+		ModuleMode:    opts.ModuleMode,
+
+		ModuleDownload: opts.ModuleDownload,
+		Quick:          opts.Quick,
+		MemoryLimit:    opts.MemoryLimit,
+		Excludes:       opts.Excludes,
+
+		ReportSyntaxErrors: opts.ReportSyntax,
+		EscalateAfter:      opts.EscalateAfter,
+		ProviderSchemas:    opts.Schemas,
+		Workspace:          opts.Workspace,
+		VariablePositions:  variablePositions,
+		CacheDir:           opts.CacheDir,
 	}
 }
@@ -3,6 +3,7 @@ package cmd
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	flags "github.com/jessevdk/go-flags"
@@ -34,6 +35,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -49,6 +52,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -64,6 +69,26 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
+			},
+		},
+		{
+			Name:    "--timeout",
+			Command: "./tflint --timeout 5m",
+			Expected: &tflint.Config{
+				Module:         false,
+				DeepCheck:      false,
+				Force:          false,
+				AwsCredentials: client.AwsCredentials{},
+				IgnoreModules:  map[string]bool{},
+				Varfiles:       []string{},
+				Variables:      []string{},
+				Rules:          map[string]*tflint.RuleConfig{},
+				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
+				Timeout:        5 * time.Minute,
 			},
 		},
 		{
@@ -83,6 +108,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:     []string{},
 				Rules:         map[string]*tflint.RuleConfig{},
 				Plugins:       map[string]*tflint.PluginConfig{},
+				Themes:        map[string]*tflint.ThemeConfig{},
+				Accounts:      map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -101,6 +128,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:     []string{},
 				Rules:         map[string]*tflint.RuleConfig{},
 				Plugins:       map[string]*tflint.PluginConfig{},
+				Themes:        map[string]*tflint.ThemeConfig{},
+				Accounts:      map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -120,6 +149,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:     []string{},
 				Rules:         map[string]*tflint.RuleConfig{},
 				Plugins:       map[string]*tflint.PluginConfig{},
+				Themes:        map[string]*tflint.ThemeConfig{},
+				Accounts:      map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -135,6 +166,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -150,6 +183,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -165,6 +200,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -180,6 +217,8 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -195,6 +234,26 @@ func Test_toConfig(t *testing.T) {
 				Variables:      []string{"foo=bar", "bar=baz"},
 				Rules:          map[string]*tflint.RuleConfig{},
 				Plugins:        map[string]*tflint.PluginConfig{},
+				Themes:         map[string]*tflint.ThemeConfig{},
+				Accounts:       map[string]*tflint.AccountConfig{},
+			},
+		},
+		{
+			Name:    "--var and --var-file",
+			Command: "./tflint --var foo=bar --var-file example1.tfvars,example2.tfvars --var=bar=baz --var-file=example3.tfvars",
+			Expected: &tflint.Config{
+				Module:            false,
+				DeepCheck:         false,
+				Force:             false,
+				AwsCredentials:    client.AwsCredentials{},
+				IgnoreModules:     map[string]bool{},
+				Varfiles:          []string{"example1.tfvars", "example2.tfvars", "example3.tfvars"},
+				Variables:         []string{"foo=bar", "bar=baz"},
+				VariablePositions: []int{0, 2},
+				Rules:             map[string]*tflint.RuleConfig{},
+				Plugins:           map[string]*tflint.PluginConfig{},
+				Themes:            map[string]*tflint.ThemeConfig{},
+				Accounts:          map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -218,7 +277,9 @@ func Test_toConfig(t *testing.T) {
 						Enabled: true,
 					},
 				},
-				Plugins: map[string]*tflint.PluginConfig{},
+				Plugins:  map[string]*tflint.PluginConfig{},
+				Themes:   map[string]*tflint.ThemeConfig{},
+				Accounts: map[string]*tflint.AccountConfig{},
 			},
 		},
 		{
@@ -242,7 +303,9 @@ func Test_toConfig(t *testing.T) {
 						Enabled: false,
 					},
 				},
-				Plugins: map[string]*tflint.PluginConfig{},
+				Plugins:  map[string]*tflint.PluginConfig{},
+				Themes:   map[string]*tflint.ThemeConfig{},
+				Accounts: map[string]*tflint.AccountConfig{},
 			},
 		},
 	}
@@ -251,7 +314,9 @@ func Test_toConfig(t *testing.T) {
 		var opts Options
 		parser := flags.NewParser(&opts, flags.HelpFlag)
 
-		_, err := parser.ParseArgs(strings.Split(tc.Command, " "))
+		args := strings.Split(tc.Command, " ")
+		opts.recordValuesOrder(args)
+		_, err := parser.ParseArgs(args)
 		if err != nil {
 			t.Fatalf("Failed `%s` test: %s", tc.Name, err)
 		}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	tfplugin "github.com/terraform-linters/tflint/plugin"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+const pluginCommandUsage = "Usage: tflint plugin [list | search TERM | add NAME]"
+
+// plugin handles `tflint plugin` subcommands for browsing the published index of known plugins
+func (cli *CLI) plugin(opts Options, args []string) int {
+	if len(args) == 0 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New(pluginCommandUsage)), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	switch {
+	case args[0] == "list" && len(args) == 1:
+	case (args[0] == "search" || args[0] == "add") && len(args) == 2:
+	default:
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New(pluginCommandUsage)), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	index, err := tfplugin.FetchIndex()
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to fetch plugin index", err), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	switch args[0] {
+	case "list":
+		cli.printPlugins(index.Plugins)
+	case "search":
+		cli.printPlugins(index.Search(args[1]))
+	case "add":
+		entry, exists := index.Find(args[1])
+		if !exists {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to add plugin", fmt.Errorf("Plugin `%s` is not found in the index", args[1])), map[string][]byte{})
+			return ExitCodeError
+		}
+		if err := addPluginBlock(opts.Config, entry); err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to add plugin", err), map[string][]byte{})
+			return ExitCodeError
+		}
+		fmt.Fprintf(cli.outStream, "Added `%s` to %s. Run `tflint --init` to install it.\n", entry.Name, opts.Config)
+	}
+
+	return ExitCodeOK
+}
+
+func (cli *CLI) printPlugins(entries []*tfplugin.IndexEntry) {
+	w := tabwriter.NewWriter(cli.outStream, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVERSION\tSOURCE\tDESCRIPTION")
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.Name, entry.Version, entry.Source, entry.Description)
+	}
+	w.Flush()
+}
+
+// addPluginBlock appends a `plugin` block for the passed plugin to the config file
+// If the config file does not exist, it will be created.
+func addPluginBlock(configPath string, entry *tfplugin.IndexEntry) error {
+	content, err := ioutil.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if len(content) > 0 {
+		cfg, err := tflint.LoadConfig(configPath)
+		if err != nil {
+			return err
+		}
+		if _, exists := cfg.Plugins[entry.Name]; exists {
+			return fmt.Errorf("Plugin `%s` is already declared in %s", entry.Name, configPath)
+		}
+	}
+
+	f, err := os.OpenFile(configPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	block := entry.ConfigBlock()
+	if len(content) > 0 {
+		block = "\n" + block
+		if content[len(content)-1] != '\n' {
+			block = "\n" + block
+		}
+	}
+	_, err = f.WriteString(block)
+	return err
+}
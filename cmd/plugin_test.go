@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfplugin "github.com/terraform-linters/tflint/plugin"
+)
+
+func Test_addPluginBlock(t *testing.T) {
+	entry := &tfplugin.IndexEntry{Name: "foo", Source: "github.com/owner/tflint-ruleset-foo", Version: "0.2.0"}
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected string
+		Error    string
+	}{
+		{
+			Name: "new file",
+			Expected: `plugin "foo" {
+  enabled = true
+  version = "0.2.0"
+  source  = "github.com/owner/tflint-ruleset-foo"
+}
+`,
+		},
+		{
+			Name: "append",
+			Content: `config {
+  module = true
+}`,
+			Expected: `config {
+  module = true
+}
+
+plugin "foo" {
+  enabled = true
+  version = "0.2.0"
+  source  = "github.com/owner/tflint-ruleset-foo"
+}
+`,
+		},
+		{
+			Name: "already declared",
+			Content: `plugin "foo" {
+  enabled = true
+}
+`,
+			Error: "Plugin `foo` is already declared in",
+		},
+	}
+
+	for _, tc := range cases {
+		dir, err := ioutil.TempDir("", "tflint-config")
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, ".tflint.hcl")
+		if tc.Content != "" {
+			if err := ioutil.WriteFile(path, []byte(tc.Content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		err = addPluginBlock(path, entry)
+		content, _ := ioutil.ReadFile(path)
+		os.RemoveAll(dir)
+
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error+" "+path {
+				t.Fatalf("Failed `%s` test: expected error is `%s %s`, but got `%v`", tc.Name, tc.Error, path, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if string(content) != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%s, got=%s", tc.Name, tc.Expected, string(content))
+		}
+	}
+}
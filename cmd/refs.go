@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// refs prints the definition and all reference sites of the passed address in the root module
+func (cli *CLI) refs(opts Options, args []string) int {
+	if len(args) != 1 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Usage: tflint refs ADDRESS")), map[string][]byte{})
+		return ExitCodeError
+	}
+	address := args[0]
+
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	cfg = cfg.Merge(opts.toConfig())
+
+	if !cli.testMode {
+		cli.loader, err = tflint.NewLoader(cfg)
+		if err != nil {
+			cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to prepare loading", err), map[string][]byte{})
+			return ExitCodeError
+		}
+	}
+	configs, err := cli.loader.LoadConfig(".")
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load configurations", err), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	index := tflint.NewReferenceIndex(configs.Module)
+	definition, defined := index.Definitions[address]
+	references := index.References[address]
+	if !defined && len(references) == 0 {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to find references", fmt.Errorf("`%s` is not found in the configuration", address)), cli.loader.Sources())
+		return ExitCodeError
+	}
+
+	if defined {
+		fmt.Fprintf(cli.outStream, "%s is defined at %s\n", address, definition.String())
+	} else {
+		fmt.Fprintf(cli.outStream, "%s is not defined\n", address)
+	}
+	fmt.Fprintf(cli.outStream, "%d reference(s) found:\n", len(references))
+	for _, ref := range references {
+		fmt.Fprintf(cli.outStream, "  %s\n", ref.String())
+	}
+
+	return ExitCodeOK
+}
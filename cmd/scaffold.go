@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/terraform-linters/tflint/rules/scaffold"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// scaffold generates a skeleton of a new rule in the TFLint repository
+// It must be run in the root of the repository because it writes the rule, its test and documentation
+// from the templates in the rules directory, and registers the rule with the provider.
+func (cli *CLI) scaffold(opts Options, args []string) int {
+	if len(args) != 2 || args[0] != "rule" {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to parse CLI arguments", errors.New("Usage: tflint scaffold rule NAME")), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	rule, err := scaffold.NewRule(args[1])
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to scaffold a rule", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	if err := scaffold.Generate(".", rule, cli.outStream); err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to scaffold a rule", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	fmt.Fprintf(cli.outStream, "Don't forget to add `%s` to docs/rules/README.md\n", rule.RuleName)
+
+	return ExitCodeOK
+}
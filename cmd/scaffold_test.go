@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_scaffold(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(currentDir)
+
+	dir, err := ioutil.TempDir("", "scaffold")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, d := range []string{"rules/awsrules", "rules/terraformrules", "docs/rules"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tmpl := range []string{"rule.go.tmpl", "rule_test.go.tmpl", "rule.md.tmpl"} {
+		src, err := ioutil.ReadFile(filepath.Join("..", "rules", tmpl))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, "rules", tmpl), src, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	provider := `package rules
+
+func manualDefaultRules() []Rule {
+	return []Rule{
+		terraformrules.NewTerraformDashInResourceNameRule(),
+	}
+}
+
+func manualDeepCheckRules() []Rule {
+	return []Rule{}
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "rules", "provider.go"), []byte(provider), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
+	cli := NewCLI(outStream, errStream)
+	status := cli.Run([]string{"./tflint", "scaffold", "rule", "aws_instance_invalid_ami_name"})
+	if status != ExitCodeOK {
+		t.Fatalf("Expected status is %d, but got %d: %s", ExitCodeOK, status, errStream.String())
+	}
+
+	for file, content := range map[string]string{
+		"rules/awsrules/aws_instance_invalid_ami_name.go":      "func NewAwsInstanceInvalidAMINameRule()",
+		"rules/awsrules/aws_instance_invalid_ami_name_test.go": "func Test_AwsInstanceInvalidAMIName(t *testing.T)",
+		"docs/rules/aws_instance_invalid_ami_name.md":          "# aws_instance_invalid_ami_name",
+	} {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Expected `%s` is created, but got error: %s", file, err)
+		}
+		if !strings.Contains(string(src), content) {
+			t.Fatalf("Expected `%s` contains `%s`, but got `%s`", file, content, string(src))
+		}
+	}
+
+	src, err := ioutil.ReadFile("rules/provider.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `package rules
+
+func manualDefaultRules() []Rule {
+	return []Rule{
+		terraformrules.NewTerraformDashInResourceNameRule(),
+		awsrules.NewAwsInstanceInvalidAMINameRule(),
+	}
+}
+
+func manualDeepCheckRules() []Rule {
+	return []Rule{}
+}
+`
+	if string(src) != expected {
+		t.Fatalf("Expected provider is `%s`, but got `%s`", expected, string(src))
+	}
+
+	status = cli.Run([]string{"./tflint", "scaffold", "rule", "aws_instance_invalid_ami_name"})
+	if status != ExitCodeError {
+		t.Fatalf("Expected status is %d, but got %d", ExitCodeError, status)
+	}
+	if !strings.Contains(errStream.String(), "`rules/awsrules/aws_instance_invalid_ami_name.go` already exists") {
+		t.Fatalf("Expected error about existing files, but got `%s`", errStream.String())
+	}
+}
+
+func Test_scaffold_invalidName(t *testing.T) {
+	outStream, errStream := new(bytes.Buffer), new(bytes.Buffer)
+	cli := NewCLI(outStream, errStream)
+
+	status := cli.Run([]string{"./tflint", "scaffold", "rule", "google-compute"})
+	if status != ExitCodeError {
+		t.Fatalf("Expected status is %d, but got %d", ExitCodeError, status)
+	}
+	expected := "`google-compute` is invalid rule name. It must start with `aws_` or `terraform_` and be in snake case"
+	if !strings.Contains(errStream.String(), expected) {
+		t.Fatalf("Expected error is `%s`, but got `%s`", expected, errStream.String())
+	}
+}
@@ -0,0 +1,27 @@
+module "web" {
+  source = "./modules/web"
+
+  instance_type = var.instance_type
+  ami           = "ami-1234abcd"
+}
+
+resource "aws_instance" "bastion" {
+  ami           = "ami-1234abcd"
+  instance_type = "t1.2xlarge"
+}
+
+resource "aws_security_group" "bastion" {
+  name = "bastion"
+
+  ingress {
+    from_port   = 22
+    to_port     = 22
+    protocol    = "tcp"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_db_instance" "main" {
+  instance_class = "db.t2.micro"
+  engine         = "mysql"
+}
@@ -0,0 +1,26 @@
+variable "instance_type" {
+  type = string
+}
+
+variable "ami" {
+  type = string
+}
+
+resource "aws_instance" "web" {
+  count = 2
+
+  ami           = var.ami
+  instance_type = var.instance_type
+}
+
+resource "aws_elb" "web" {
+  name      = "web"
+  instances = aws_instance.web[*].id
+
+  listener {
+    instance_port     = 80
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// updateData fetches instance types available in the region of the credentials and saves them for rules
+func (cli *CLI) updateData(opts Options) int {
+	cfg, err := tflint.LoadConfig(opts.Config)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to load TFLint config", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	cfg = cfg.Merge(opts.toConfig())
+
+	awsClient, err := client.NewAwsClient(cfg.AwsCredentials)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to prepare AWS client", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	types, err := awsClient.DescribeInstanceTypes()
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to fetch instance types", err), map[string][]byte{})
+		return ExitCodeError
+	}
+
+	data := &tflint.InstanceTypes{
+		UpdatedAt: time.Now().UTC(),
+		Region:    awsClient.Region,
+		Current:   []string{},
+		Previous:  []string{},
+	}
+	for instanceType, current := range types {
+		if current {
+			data.Current = append(data.Current, instanceType)
+		} else {
+			data.Previous = append(data.Previous, instanceType)
+		}
+	}
+	sort.Strings(data.Current)
+	sort.Strings(data.Previous)
+
+	path, err := tflint.SaveInstanceTypes(data)
+	if err != nil {
+		cli.formatter.Print(tflint.Issues{}, tflint.NewContextError("Failed to save instance types", err), map[string][]byte{})
+		return ExitCodeError
+	}
+	fmt.Fprintf(cli.outStream, "Saved %d current and %d previous generation instance types in %s to %s\n", len(data.Current), len(data.Previous), data.Region, path)
+
+	return ExitCodeOK
+}
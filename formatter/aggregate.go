@@ -0,0 +1,46 @@
+package formatter
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// aggregate returns issues where issues of a rule reported more than AggregateAfter times in a file are collapsed
+// The summarized issue is placed at the first issue and keeps the collapsed issues in Aggregated, so that
+// the JSON output can still list all of them. Other issues are returned as they are.
+func (f *Formatter) aggregate(issues tflint.Issues) tflint.Issues {
+	type group struct {
+		rule     string
+		filename string
+	}
+	groups := map[group]tflint.Issues{}
+	for _, issue := range issues {
+		key := group{rule: issue.Rule.Name(), filename: issue.Range.Filename}
+		groups[key] = append(groups[key], issue)
+	}
+
+	ret := tflint.Issues{}
+	for _, issue := range issues {
+		grouped := groups[group{rule: issue.Rule.Name(), filename: issue.Range.Filename}]
+		if len(grouped) <= f.AggregateAfter {
+			ret = append(ret, issue)
+		}
+	}
+	for _, grouped := range groups {
+		if len(grouped) <= f.AggregateAfter {
+			continue
+		}
+		aggregated := append(tflint.Issues{}, grouped...).Sort()
+		first := aggregated[0]
+		ret = append(ret, &tflint.Issue{
+			Rule:       first.Rule,
+			Message:    fmt.Sprintf("%s (and %d more issues of the rule in this file)", first.Message, len(aggregated)-1),
+			Range:      first.Range,
+			Callers:    first.Callers,
+			Address:    first.Address,
+			Aggregated: aggregated,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,78 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_PrintWithAggregation(t *testing.T) {
+	issues := tflint.Issues{
+		{Rule: &testRule{}, Message: "second", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 5}}},
+		{Rule: &testRule{}, Message: "first", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}}},
+		{Rule: &testRule{}, Message: "other file", Range: hcl.Range{Filename: "variables.tf", Start: hcl.Pos{Line: 1}}},
+	}
+
+	cases := []struct {
+		Name     string
+		After    int
+		Messages []string
+		Counts   []int
+	}{
+		{
+			Name:     "disabled",
+			After:    0,
+			Messages: []string{"first", "second", "other file"},
+			Counts:   []int{0, 0, 0},
+		},
+		{
+			Name:     "not exceeded",
+			After:    2,
+			Messages: []string{"first", "second", "other file"},
+			Counts:   []int{0, 0, 0},
+		},
+		{
+			Name:     "exceeded",
+			After:    1,
+			Messages: []string{"first (and 1 more issues of the rule in this file)", "other file"},
+			Counts:   []int{2, 0},
+		},
+	}
+
+	for _, tc := range cases {
+		stdout := &bytes.Buffer{}
+		formatter := &Formatter{Stdout: stdout, Stderr: &bytes.Buffer{}, Format: "json", AggregateAfter: tc.After}
+		formatter.Print(issues, nil, map[string][]byte{})
+
+		var out JSONOutput
+		if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+			t.Fatalf("Failed `%s` test: %s", tc.Name, err)
+		}
+		if len(out.Issues) != len(tc.Messages) {
+			t.Fatalf("Failed `%s` test: expected %d issues, but got %d issues", tc.Name, len(tc.Messages), len(out.Issues))
+		}
+		for i, issue := range out.Issues {
+			if issue.Message != tc.Messages[i] {
+				t.Fatalf("Failed `%s` test: expected `%s`, but got `%s`", tc.Name, tc.Messages[i], issue.Message)
+			}
+			if issue.Count != tc.Counts[i] || len(issue.Details) != tc.Counts[i] {
+				t.Fatalf("Failed `%s` test: expected %d details, but got count=%d details=%d", tc.Name, tc.Counts[i], issue.Count, len(issue.Details))
+			}
+		}
+	}
+
+	// Details are listed in the order of the output
+	stdout := &bytes.Buffer{}
+	formatter := &Formatter{Stdout: stdout, Stderr: &bytes.Buffer{}, Format: "json", AggregateAfter: 1}
+	formatter.Print(issues, nil, map[string][]byte{})
+	var out JSONOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Issues[0].Details[0].Message != "first" || out.Issues[0].Details[1].Message != "second" {
+		t.Fatalf("Unexpected details: %#v", out.Issues[0].Details)
+	}
+}
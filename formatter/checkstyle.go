@@ -3,6 +3,8 @@ package formatter
 import (
 	"encoding/xml"
 	"fmt"
+	"path/filepath"
+	"sort"
 
 	"github.com/terraform-linters/tflint/tflint"
 )
@@ -28,7 +30,7 @@ type checkstyle struct {
 
 func (f *Formatter) checkstylePrint(issues tflint.Issues, tferr *tflint.Error, sources map[string][]byte) {
 	files := map[string]*checkstyleFile{}
-	for _, issue := range issues {
+	for _, issue := range issues.Sort() {
 		cherr := &checkstyleError{
 			Rule:     issue.Rule.Name(),
 			Line:     issue.Range.Start.Line,
@@ -38,11 +40,12 @@ func (f *Formatter) checkstylePrint(issues tflint.Issues, tferr *tflint.Error, s
 			Link:     issue.Rule.Link(),
 		}
 
-		if file, exists := files[issue.Range.Filename]; exists {
+		filename := filepath.ToSlash(issue.Range.Filename)
+		if file, exists := files[filename]; exists {
 			file.Errors = append(file.Errors, cherr)
 		} else {
-			files[issue.Range.Filename] = &checkstyleFile{
-				Name:   issue.Range.Filename,
+			files[filename] = &checkstyleFile{
+				Name:   filename,
 				Errors: []*checkstyleError{cherr},
 			}
 		}
@@ -52,6 +55,10 @@ func (f *Formatter) checkstylePrint(issues tflint.Issues, tferr *tflint.Error, s
 	for _, file := range files {
 		ret.Files = append(ret.Files, file)
 	}
+	// Files are sorted so that the output is the same on every run
+	sort.Slice(ret.Files, func(i, j int) bool {
+		return ret.Files[i].Name < ret.Files[j].Name
+	})
 
 	out, err := xml.MarshalIndent(ret, "", "  ")
 	if err != nil {
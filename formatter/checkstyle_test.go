@@ -39,6 +39,48 @@ func Test_checkstylePrint(t *testing.T) {
   <file name="test.tf">
     <error rule="test_rule" line="1" column="1" severity="error" message="test" link="https://github.com"></error>
   </file>
+</checkstyle>`,
+		},
+		{
+			Name: "multiple files",
+			Issues: tflint.Issues{
+				{
+					Rule:    &testRule{},
+					Message: "test",
+					Range: hcl.Range{
+						Filename: "b.tf",
+						Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+						End:      hcl.Pos{Line: 1, Column: 4, Byte: 3},
+					},
+				},
+				{
+					Rule:    &testRule{},
+					Message: "test",
+					Range: hcl.Range{
+						Filename: "a.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1, Byte: 0},
+						End:      hcl.Pos{Line: 2, Column: 4, Byte: 3},
+					},
+				},
+				{
+					Rule:    &testRule{},
+					Message: "test",
+					Range: hcl.Range{
+						Filename: "a.tf",
+						Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+						End:      hcl.Pos{Line: 1, Column: 4, Byte: 3},
+					},
+				},
+			},
+			Stdout: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle>
+  <file name="a.tf">
+    <error rule="test_rule" line="1" column="1" severity="error" message="test" link="https://github.com"></error>
+    <error rule="test_rule" line="2" column="1" severity="error" message="test" link="https://github.com"></error>
+  </file>
+  <file name="b.tf">
+    <error rule="test_rule" line="1" column="1" severity="error" message="test" link="https://github.com"></error>
+  </file>
 </checkstyle>`,
 		},
 	}
@@ -3,6 +3,7 @@ package formatter
 import (
 	"fmt"
 	"io"
+	"text/template"
 
 	"github.com/terraform-linters/tflint/tflint"
 )
@@ -13,15 +14,39 @@ type Formatter struct {
 	Stderr  io.Writer
 	Format  string
 	NoColor bool
+	// Exceptions are active exceptions reported with issues for auditing
+	Exceptions tflint.Exceptions
+	// Theme is the color theme of the default output. The default theme is used if nil
+	Theme *Theme
+	// Template replaces the issues part of the default output if not nil
+	Template *template.Template
+	// Sinks are outputs in multiple formats. If empty, the output in Format is written to Stdout
+	Sinks []*Sink
+	// PathStyle is the style of file paths in issues. Paths are printed as loaded if empty
+	PathStyle string
+	// AggregateAfter is a number of issues of a rule in a file after which they are printed as one issue. Zero means never
+	AggregateAfter int
 }
 
 // Print outputs the given issues and errors according to configured format
 func (f *Formatter) Print(issues tflint.Issues, err *tflint.Error, sources map[string][]byte) {
+	if f.PathStyle != "" && f.PathStyle != PathStyleRelative {
+		issues, sources = f.rewritePaths(issues, sources)
+	}
+	if f.AggregateAfter > 0 {
+		issues = f.aggregate(issues)
+	}
+
+	if len(f.Sinks) > 0 {
+		f.printSinks(issues, err, sources)
+		return
+	}
+
 	switch f.Format {
 	case "default":
 		f.prettyPrint(issues, err, sources)
 	case "json":
-		f.jsonPrint(issues, err)
+		f.jsonPrint(issues, err, sources)
 	case "checkstyle":
 		f.checkstylePrint(issues, err, sources)
 	default:
@@ -29,6 +54,13 @@ func (f *Formatter) Print(issues tflint.Issues, err *tflint.Error, sources map[s
 	}
 }
 
+func (f *Formatter) theme() *Theme {
+	if f.Theme == nil {
+		return defaultTheme
+	}
+	return f.Theme
+}
+
 func toSeverity(lintType string) string {
 	switch lintType {
 	case tflint.ERROR:
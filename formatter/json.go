@@ -3,16 +3,39 @@ package formatter
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
 type jsonIssue struct {
-	Rule    jsonRule    `json:"rule"`
-	Message string      `json:"message"`
-	Range   jsonRange   `json:"range"`
-	Callers []jsonRange `json:"callers"`
+	Rule        jsonRule      `json:"rule"`
+	Message     string        `json:"message"`
+	Range       jsonRange     `json:"range"`
+	Callers     []jsonRange   `json:"callers"`
+	Address     string        `json:"address"`
+	Fingerprint string        `json:"fingerprint"`
+	Evidence    *jsonEvidence `json:"evidence,omitempty"`
+	Fix         *jsonFix      `json:"fix,omitempty"`
+	// Count and Details are set only when the issue summarizes aggregated issues
+	Count   int         `json:"count,omitempty"`
+	Details []jsonIssue `json:"details,omitempty"`
+}
+
+type jsonEvidence struct {
+	Operation   string   `json:"operation"`
+	Identifiers []string `json:"identifiers"`
+	Region      string   `json:"region"`
+	AccountID   string   `json:"account_id"`
+}
+
+// jsonFix is a patch which resolves the issue
+// Range and Text are the raw text edit, and Diff is the same edit as a unified diff.
+type jsonFix struct {
+	Range jsonRange `json:"range"`
+	Text  string    `json:"text"`
+	Diff  string    `json:"diff"`
 }
 
 type jsonRule struct {
@@ -32,41 +55,40 @@ type jsonPos struct {
 	Column int `json:"column"`
 }
 
+type jsonException struct {
+	Rule     string `json:"rule"`
+	Address  string `json:"address"`
+	Approver string `json:"approver"`
+	Ticket   string `json:"ticket"`
+	Expires  string `json:"expires"`
+}
+
 type jsonError struct {
 	Message string `json:"message"`
 }
 
 // JSONOutput is a temporary structure for converting to JSON
 type JSONOutput struct {
-	Issues []jsonIssue `json:"issues"`
-	Errors []jsonError `json:"errors"`
+	Issues     []jsonIssue     `json:"issues"`
+	Errors     []jsonError     `json:"errors"`
+	Exceptions []jsonException `json:"exceptions,omitempty"`
 }
 
-func (f *Formatter) jsonPrint(issues tflint.Issues, tferr *tflint.Error) {
+func (f *Formatter) jsonPrint(issues tflint.Issues, tferr *tflint.Error, sources map[string][]byte) {
 	ret := &JSONOutput{Issues: make([]jsonIssue, len(issues)), Errors: []jsonError{}}
 
 	for idx, issue := range issues.Sort() {
-		ret.Issues[idx] = jsonIssue{
-			Rule: jsonRule{
-				Name:     issue.Rule.Name(),
-				Severity: toSeverity(issue.Rule.Severity()),
-				Link:     issue.Rule.Link(),
-			},
-			Message: issue.Message,
-			Range: jsonRange{
-				Filename: issue.Range.Filename,
-				Start:    jsonPos{Line: issue.Range.Start.Line, Column: issue.Range.Start.Column},
-				End:      jsonPos{Line: issue.Range.End.Line, Column: issue.Range.End.Column},
-			},
-			Callers: make([]jsonRange, len(issue.Callers)),
-		}
-		for i, caller := range issue.Callers {
-			ret.Issues[idx].Callers[i] = jsonRange{
-				Filename: caller.Filename,
-				Start:    jsonPos{Line: caller.Start.Line, Column: caller.Start.Column},
-				End:      jsonPos{Line: caller.End.Line, Column: caller.End.Column},
-			}
-		}
+		ret.Issues[idx] = toJSONIssue(issue, sources)
+	}
+
+	for _, exception := range f.Exceptions {
+		ret.Exceptions = append(ret.Exceptions, jsonException{
+			Rule:     exception.Rule,
+			Address:  exception.Address,
+			Approver: exception.Approver,
+			Ticket:   exception.Ticket,
+			Expires:  exception.Expires,
+		})
 	}
 
 	if tferr != nil {
@@ -89,3 +111,56 @@ func (f *Formatter) jsonPrint(issues tflint.Issues, tferr *tflint.Error) {
 	}
 	fmt.Fprint(f.Stdout, string(out))
 }
+
+func toJSONIssue(issue *tflint.Issue, sources map[string][]byte) jsonIssue {
+	ret := jsonIssue{
+		Rule: jsonRule{
+			Name:     issue.Rule.Name(),
+			Severity: toSeverity(issue.Rule.Severity()),
+			Link:     issue.Rule.Link(),
+		},
+		Message: issue.Message,
+		Range: jsonRange{
+			Filename: filepath.ToSlash(issue.Range.Filename),
+			Start:    jsonPos{Line: issue.Range.Start.Line, Column: issue.Range.Start.Column},
+			End:      jsonPos{Line: issue.Range.End.Line, Column: issue.Range.End.Column},
+		},
+		Callers:     make([]jsonRange, len(issue.Callers)),
+		Address:     issue.Address,
+		Fingerprint: issue.Fingerprint(),
+	}
+	for i, caller := range issue.Callers {
+		ret.Callers[i] = jsonRange{
+			Filename: filepath.ToSlash(caller.Filename),
+			Start:    jsonPos{Line: caller.Start.Line, Column: caller.Start.Column},
+			End:      jsonPos{Line: caller.End.Line, Column: caller.End.Column},
+		}
+	}
+	if issue.Evidence != nil {
+		ret.Evidence = &jsonEvidence{
+			Operation:   issue.Evidence.Operation,
+			Identifiers: issue.Evidence.Identifiers,
+			Region:      issue.Evidence.Region,
+			AccountID:   issue.Evidence.AccountID,
+		}
+	}
+	if issue.Fix != nil {
+		ret.Fix = &jsonFix{
+			Range: jsonRange{
+				Filename: filepath.ToSlash(issue.Fix.Range.Filename),
+				Start:    jsonPos{Line: issue.Fix.Range.Start.Line, Column: issue.Fix.Range.Start.Column},
+				End:      jsonPos{Line: issue.Fix.Range.End.Line, Column: issue.Fix.Range.End.Column},
+			},
+			Text: issue.Fix.Text,
+			Diff: issue.Fix.Diff(sources[issue.Fix.Range.Filename]),
+		}
+	}
+	if len(issue.Aggregated) > 0 {
+		ret.Count = len(issue.Aggregated)
+		ret.Details = make([]jsonIssue, len(issue.Aggregated))
+		for i, aggregated := range issue.Aggregated {
+			ret.Details[i] = toJSONIssue(aggregated, sources)
+		}
+	}
+	return ret
+}
@@ -5,21 +5,84 @@ import (
 	"errors"
 	"testing"
 
+	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
 func Test_jsonPrint(t *testing.T) {
 	cases := []struct {
-		Name   string
-		Issues tflint.Issues
-		Error  *tflint.Error
-		Stdout string
+		Name       string
+		Issues     tflint.Issues
+		Error      *tflint.Error
+		Exceptions tflint.Exceptions
+		Sources    map[string][]byte
+		Stdout     string
 	}{
 		{
 			Name:   "no issues",
 			Issues: tflint.Issues{},
 			Stdout: `{"issues":[],"errors":[]}`,
 		},
+		{
+			Name: "issues with evidence",
+			Issues: tflint.Issues{
+				{
+					Rule:    &testRule{},
+					Message: "test",
+					Range: hcl.Range{
+						Filename: "test.tf",
+						Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+						End:      hcl.Pos{Line: 1, Column: 4, Byte: 3},
+					},
+					Address: "aws_instance.web",
+					Evidence: &tflint.Evidence{
+						Operation:   "DescribeImages",
+						Identifiers: []string{"ami-1234567"},
+						Region:      "us-east-1",
+						AccountID:   "123456789012",
+					},
+				},
+			},
+			Stdout: `{"issues":[{"rule":{"name":"test_rule","severity":"error","link":"https://github.com"},"message":"test","range":{"filename":"test.tf","start":{"line":1,"column":1},"end":{"line":1,"column":4}},"callers":[],"address":"aws_instance.web","fingerprint":"8c39503f9ba877e4e4e38f05ac5f93316dbf7e6e8fbb2541f3fde162cb29430b","evidence":{"operation":"DescribeImages","identifiers":["ami-1234567"],"region":"us-east-1","account_id":"123456789012"}}],"errors":[]}`,
+		},
+		{
+			Name: "issues with fix",
+			Issues: tflint.Issues{
+				{
+					Rule:    &testRule{},
+					Message: "test",
+					Range: hcl.Range{
+						Filename: "test.tf",
+						Start:    hcl.Pos{Line: 2, Column: 3, Byte: 11},
+						End:      hcl.Pos{Line: 2, Column: 8, Byte: 16},
+					},
+					Fix: &tflint.Fix{
+						Range: hcl.Range{
+							Filename: "test.tf",
+							Start:    hcl.Pos{Line: 2, Column: 11, Byte: 19},
+							End:      hcl.Pos{Line: 2, Column: 16, Byte: 24},
+						},
+						Text: `"bar"`,
+					},
+				},
+			},
+			Sources: map[string][]byte{"test.tf": []byte("locals {\n  value = \"foo\"\n}\n")},
+			Stdout:  `{"issues":[{"rule":{"name":"test_rule","severity":"error","link":"https://github.com"},"message":"test","range":{"filename":"test.tf","start":{"line":2,"column":3},"end":{"line":2,"column":8}},"callers":[],"address":"","fingerprint":"ccdfe54fcf01f77484d5c5b48633e7126939d0859c03e17ee067e685c248c565","fix":{"range":{"filename":"test.tf","start":{"line":2,"column":11},"end":{"line":2,"column":16}},"text":"\"bar\"","diff":"--- a/test.tf\n+++ b/test.tf\n@@ -2,1 +2,1 @@\n-  value = \"foo\"\n+  value = \"bar\"\n"}}],"errors":[]}`,
+		},
+		{
+			Name:   "exceptions",
+			Issues: tflint.Issues{},
+			Exceptions: tflint.Exceptions{
+				{
+					Rule:     "test_rule",
+					Address:  "aws_instance.web",
+					Approver: "security-team@example.com",
+					Ticket:   "https://jira.example.com/browse/SEC-123",
+					Expires:  "2020-12-31",
+				},
+			},
+			Stdout: `{"issues":[],"errors":[],"exceptions":[{"rule":"test_rule","address":"aws_instance.web","approver":"security-team@example.com","ticket":"https://jira.example.com/browse/SEC-123","expires":"2020-12-31"}]}`,
+		},
 		{
 			Name:   "error",
 			Error:  tflint.NewContextError("Failed to work", errors.New("I don't feel like working")),
@@ -30,9 +93,9 @@ func Test_jsonPrint(t *testing.T) {
 	for _, tc := range cases {
 		stdout := &bytes.Buffer{}
 		stderr := &bytes.Buffer{}
-		formatter := &Formatter{Stdout: stdout, Stderr: stderr}
+		formatter := &Formatter{Stdout: stdout, Stderr: stderr, Exceptions: tc.Exceptions}
 
-		formatter.jsonPrint(tc.Issues, tc.Error)
+		formatter.jsonPrint(tc.Issues, tc.Error, tc.Sources)
 
 		if stdout.String() != tc.Stdout {
 			t.Fatalf("Failed %s test: expected=%s, stdout=%s", tc.Name, tc.Stdout, stdout.String())
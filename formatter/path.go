@@ -0,0 +1,99 @@
+package formatter
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// Styles of file paths in issues
+const (
+	// PathStyleRelative prints paths relative to the current directory as loaded
+	PathStyleRelative = "relative"
+	// PathStyleAbsolute prints absolute paths
+	PathStyleAbsolute = "absolute"
+	// PathStyleRepoRoot prints paths relative to the root of the git repository
+	PathStyleRepoRoot = "repo-root"
+)
+
+// rewritePaths returns copies of the issues and sources with file names in the path style
+// File names in the loader are relative to the current directory, so they are resolved from it.
+// If the base directory cannot be determined, file names are left as they are.
+func (f *Formatter) rewritePaths(issues tflint.Issues, sources map[string][]byte) (tflint.Issues, map[string][]byte) {
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Printf("[WARN] Failed to get the current directory. Paths are printed as they are: %s", err)
+		return issues, sources
+	}
+
+	var rewrite func(string) string
+	switch f.PathStyle {
+	case PathStyleAbsolute:
+		rewrite = func(filename string) string {
+			return absPath(wd, filename)
+		}
+	case PathStyleRepoRoot:
+		root, found := findRepoRoot(wd)
+		if !found {
+			log.Printf("[WARN] `%s` is not in a git repository. Paths are printed as they are", wd)
+			return issues, sources
+		}
+		rewrite = func(filename string) string {
+			rel, err := filepath.Rel(root, absPath(wd, filename))
+			if err != nil {
+				return filename
+			}
+			return rel
+		}
+	default:
+		return issues, sources
+	}
+
+	ret := make(tflint.Issues, len(issues))
+	for i, issue := range issues {
+		copied := *issue
+		copied.Range.Filename = rewrite(issue.Range.Filename)
+		copied.Callers = make([]hcl.Range, len(issue.Callers))
+		for j, caller := range issue.Callers {
+			caller.Filename = rewrite(caller.Filename)
+			copied.Callers[j] = caller
+		}
+		if issue.Fix != nil {
+			fix := *issue.Fix
+			fix.Range.Filename = rewrite(issue.Fix.Range.Filename)
+			copied.Fix = &fix
+		}
+		ret[i] = &copied
+	}
+
+	rewritten := map[string][]byte{}
+	for filename, src := range sources {
+		rewritten[rewrite(filename)] = src
+	}
+	return ret, rewritten
+}
+
+func absPath(wd string, filename string) string {
+	if filepath.IsAbs(filename) {
+		return filename
+	}
+	return filepath.Join(wd, filename)
+}
+
+// findRepoRoot returns the nearest ancestor directory containing `.git`
+// `.git` can be a file in worktrees and submodules, so it is not required to be a directory.
+func findRepoRoot(dir string) (string, bool) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,91 @@
+package formatter
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_PrintWithPathStyle(t *testing.T) {
+	root, err := ioutil.TempDir("", "tflint-path-style")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	// Resolve symlinks such as /tmp on macOS so that paths match the working directory
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "modules", "vpc")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(currentDir)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		Name      string
+		PathStyle string
+		Expected  string
+	}{
+		{
+			Name:      "default",
+			PathStyle: "",
+			Expected:  "main.tf",
+		},
+		{
+			Name:      "relative",
+			PathStyle: PathStyleRelative,
+			Expected:  "main.tf",
+		},
+		{
+			Name:      "absolute",
+			PathStyle: PathStyleAbsolute,
+			Expected:  filepath.ToSlash(filepath.Join(dir, "main.tf")),
+		},
+		{
+			Name:      "repo-root",
+			PathStyle: PathStyleRepoRoot,
+			Expected:  "modules/vpc/main.tf",
+		},
+	}
+
+	for _, tc := range cases {
+		issue := &tflint.Issue{
+			Rule:    &testRule{},
+			Message: "test",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 1, Column: 4, Byte: 3},
+			},
+		}
+		stdout := &bytes.Buffer{}
+		formatter := &Formatter{Stdout: stdout, Stderr: &bytes.Buffer{}, Format: "checkstyle", PathStyle: tc.PathStyle}
+
+		formatter.Print(tflint.Issues{issue}, nil, map[string][]byte{"main.tf": []byte("foo")})
+
+		if !bytes.Contains(stdout.Bytes(), []byte(`<file name="`+tc.Expected+`">`)) {
+			t.Fatalf("Failed `%s` test: expected file name is `%s`, but got %s", tc.Name, tc.Expected, stdout.String())
+		}
+		if issue.Range.Filename != "main.tf" {
+			t.Fatalf("Failed `%s` test: the original issue is modified: %s", tc.Name, issue.Range.Filename)
+		}
+	}
+}
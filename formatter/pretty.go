@@ -3,23 +3,19 @@ package formatter
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"log"
 	"strings"
 
-	"github.com/fatih/color"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
-var colorBold = color.New(color.Bold).SprintfFunc()
-var colorHighlight = color.New(color.Bold).Add(color.Underline).SprintFunc()
-var colorError = color.New(color.FgRed).SprintFunc()
-var colorWarning = color.New(color.FgYellow).SprintFunc()
-var colorNotice = color.New(color.FgHiWhite).SprintFunc()
-
 func (f *Formatter) prettyPrint(issues tflint.Issues, err *tflint.Error, sources map[string][]byte) {
-	if len(issues) > 0 {
+	if f.Template != nil {
+		f.templatePrint(issues, sources)
+	} else if len(issues) > 0 {
 		fmt.Fprintf(f.Stdout, "%d issue(s) found:\n\n", len(issues))
 
 		for _, issue := range issues.Sort() {
@@ -36,14 +32,32 @@ func (f *Formatter) printIssueWithSource(issue *tflint.Issue, sources map[string
 	fmt.Fprintf(
 		f.Stdout,
 		"%s: %s (%s)\n\n",
-		colorSeverity(issue.Rule.Severity()), colorBold(issue.Message), issue.Rule.Name(),
+		f.theme().severity(issue.Rule.Severity()), f.theme().Message.Sprint(issue.Message), issue.Rule.Name(),
 	)
 	fmt.Fprintf(f.Stdout, "  on %s line %d:\n", issue.Range.Filename, issue.Range.Start.Line)
 
+	f.printSource(f.Stdout, issue, sources)
+
+	if len(issue.Callers) > 0 {
+		fmt.Fprint(f.Stdout, "\nCallers:\n")
+		for _, caller := range issue.Callers {
+			fmt.Fprintf(f.Stdout, "   %s\n", caller)
+		}
+	}
+
+	if issue.Rule.Link() != "" {
+		fmt.Fprintf(f.Stdout, "\nReference: %s\n", issue.Rule.Link())
+	}
+
+	fmt.Fprint(f.Stdout, "\n")
+}
+
+// printSource writes the lines of the issue with the highlighted range
+func (f *Formatter) printSource(w io.Writer, issue *tflint.Issue, sources map[string][]byte) {
 	src := sources[issue.Range.Filename]
 
 	if src == nil {
-		fmt.Fprintf(f.Stdout, "   (source code not available)\n")
+		fmt.Fprintf(w, "   (source code not available)\n")
 	} else {
 		sc := hcl.NewRangeScanner(src, issue.Range.Filename, bufio.ScanLines)
 
@@ -55,35 +69,22 @@ func (f *Formatter) printIssueWithSource(issue *tflint.Issue, sources map[string
 
 			beforeRange, highlightedRange, afterRange := lineRange.PartitionAround(issue.Range)
 			if highlightedRange.Empty() {
-				fmt.Fprintf(f.Stdout, "%4d: %s\n", lineRange.Start.Line, sc.Bytes())
+				fmt.Fprintf(w, "%4d: %s\n", lineRange.Start.Line, sc.Bytes())
 			} else {
 				before := beforeRange.SliceBytes(src)
 				highlighted := highlightedRange.SliceBytes(src)
 				after := afterRange.SliceBytes(src)
 				fmt.Fprintf(
-					f.Stdout,
+					w,
 					"%4d: %s%s%s\n",
 					lineRange.Start.Line,
 					before,
-					colorHighlight(string(highlighted)),
+					f.theme().Highlight.Sprint(string(highlighted)),
 					after,
 				)
 			}
 		}
 	}
-
-	if len(issue.Callers) > 0 {
-		fmt.Fprint(f.Stdout, "\nCallers:\n")
-		for _, caller := range issue.Callers {
-			fmt.Fprintf(f.Stdout, "   %s\n", caller)
-		}
-	}
-
-	if issue.Rule.Link() != "" {
-		fmt.Fprintf(f.Stdout, "\nReference: %s\n", issue.Rule.Link())
-	}
-
-	fmt.Fprint(f.Stdout, "\n")
 }
 
 func (f *Formatter) printErrors(err *tflint.Error, sources map[string][]byte) {
@@ -94,7 +95,7 @@ func (f *Formatter) printErrors(err *tflint.Error, sources map[string][]byte) {
 		writer.WriteDiagnostics(diags)
 	} else {
 		fmt.Fprintf(f.Stderr, "%s. An error occurred:\n\n", err.Message)
-		fmt.Fprintf(f.Stderr, "%s: %s\n\n", colorError("Error"), err.Cause.Error())
+		fmt.Fprintf(f.Stderr, "%s: %s\n\n", f.theme().Error.Sprint("Error"), err.Cause.Error())
 	}
 }
 
@@ -119,16 +120,3 @@ func parseSources(sources map[string][]byte) map[string]*hcl.File {
 
 	return ret
 }
-
-func colorSeverity(severity string) string {
-	switch severity {
-	case tflint.ERROR:
-		return colorError(severity)
-	case tflint.WARNING:
-		return colorWarning(severity)
-	case tflint.NOTICE:
-		return colorNotice(severity)
-	default:
-		panic("Unreachable")
-	}
-}
@@ -0,0 +1,106 @@
+package formatter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// formats are available output formats
+var formats = []string{"default", "json", "checkstyle"}
+
+// Sink is a destination of the output in a format
+type Sink struct {
+	Format string
+	// Path is the file the output is written to. The output is written to stdout if empty
+	Path string
+}
+
+// plainTheme is used for writing the default output to files, which should not contain escape sequences
+var plainTheme = &Theme{
+	Error:     plainColor(),
+	Warning:   plainColor(),
+	Notice:    plainColor(),
+	Message:   plainColor(),
+	Highlight: plainColor(),
+}
+
+func plainColor() *color.Color {
+	c := color.New()
+	c.DisableColor()
+	return c
+}
+
+// ParseSinks parses `FORMAT[:FILE]` specifications of outputs
+// At most one sink can write to stdout. It returns the default output to stdout if no specifications are passed.
+func ParseSinks(specs []string) ([]*Sink, error) {
+	if len(specs) == 0 {
+		return []*Sink{{Format: "default"}}, nil
+	}
+
+	sinks := []*Sink{}
+	stdout := false
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, ":", 2)
+		sink := &Sink{Format: parts[0]}
+		if len(parts) == 2 {
+			if parts[1] == "" {
+				return nil, fmt.Errorf("`%s` has no file name. Use FORMAT:FILE to write to a file", spec)
+			}
+			sink.Path = parts[1]
+		}
+
+		valid := false
+		for _, format := range formats {
+			if sink.Format == format {
+				valid = true
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("`%s` is invalid format. Please specify %s", sink.Format, strings.Join(formats, ", "))
+		}
+
+		if sink.Path == "" {
+			if stdout {
+				return nil, errors.New("Only one format can be written to stdout. Use FORMAT:FILE to write others to files")
+			}
+			stdout = true
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+// printSinks writes the output to each sink
+// Files are written without colors. Failures of writing files are reported to stderr and do not stop other sinks.
+func (f *Formatter) printSinks(issues tflint.Issues, err *tflint.Error, sources map[string][]byte) {
+	for _, sink := range f.Sinks {
+		out := *f
+		out.Sinks = nil
+		out.Format = sink.Format
+		// Paths are already rewritten in the style
+		out.PathStyle = ""
+
+		if sink.Path == "" {
+			out.Print(issues, err, sources)
+			continue
+		}
+
+		file, ferr := os.Create(sink.Path)
+		if ferr != nil {
+			fmt.Fprintf(f.Stderr, "Failed to write `%s`: %s\n", sink.Path, ferr)
+			continue
+		}
+		out.Stdout = file
+		out.NoColor = true
+		out.Theme = plainTheme
+		out.Print(issues, err, sources)
+		if cerr := file.Close(); cerr != nil {
+			fmt.Fprintf(f.Stderr, "Failed to write `%s`: %s\n", sink.Path, cerr)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package formatter
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_ParseSinks(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Specs    []string
+		Expected []*Sink
+		Error    string
+	}{
+		{
+			Name:     "no specs",
+			Specs:    []string{},
+			Expected: []*Sink{{Format: "default"}},
+		},
+		{
+			Name:  "stdout and file",
+			Specs: []string{"json:report.json", "default"},
+			Expected: []*Sink{
+				{Format: "json", Path: "report.json"},
+				{Format: "default"},
+			},
+		},
+		{
+			Name:  "invalid format",
+			Specs: []string{"xml"},
+			Error: "`xml` is invalid format. Please specify default, json, checkstyle",
+		},
+		{
+			Name:  "empty file name",
+			Specs: []string{"json:"},
+			Error: "`json:` has no file name. Use FORMAT:FILE to write to a file",
+		},
+		{
+			Name:  "multiple stdout",
+			Specs: []string{"json", "default"},
+			Error: "Only one format can be written to stdout. Use FORMAT:FILE to write others to files",
+		},
+	}
+
+	for _, tc := range cases {
+		sinks, err := ParseSinks(tc.Specs)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if !cmp.Equal(tc.Expected, sinks) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, sinks))
+		}
+	}
+}
+
+func Test_printSinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sinks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	issues := tflint.Issues{
+		{
+			Rule:    &testRule{},
+			Message: "test",
+			Range: hcl.Range{
+				Filename: "test.tf",
+				Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 1, Column: 4, Byte: 3},
+			},
+		},
+	}
+	report := filepath.Join(dir, "report.json")
+
+	stdout := &bytes.Buffer{}
+	formatter := &Formatter{
+		Stdout: stdout,
+		Stderr: &bytes.Buffer{},
+		Sinks: []*Sink{
+			{Format: "json", Path: report},
+			{Format: "checkstyle"},
+		},
+	}
+	formatter.Print(issues, nil, map[string][]byte{})
+
+	jsonOut, err := ioutil.ReadFile(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"issues":[{"rule":{"name":"test_rule","severity":"error","link":"https://github.com"},"message":"test","range":{"filename":"test.tf","start":{"line":1,"column":1},"end":{"line":1,"column":4}},"callers":[],"address":"","fingerprint":"` + issues[0].Fingerprint() + `"}],"errors":[]}`
+	if string(jsonOut) != expected {
+		t.Fatalf("Failed to match the file: expected=%s, got=%s", expected, jsonOut)
+	}
+	if !bytes.Contains(stdout.Bytes(), []byte("<checkstyle>")) {
+		t.Fatalf("Checkstyle output is not written to stdout: %s", stdout.String())
+	}
+}
@@ -0,0 +1,58 @@
+package formatter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// templateData is the data passed to the template of the default output
+type templateData struct {
+	Issues tflint.Issues
+}
+
+// LoadTemplate parses the text/template file for the default output
+// The template is executed with `.Issues`. It can use `severity`, `message` and `highlight` functions to color strings
+// with the theme, and `source` function to render the source lines of the issue like the default output.
+func LoadTemplate(file string) (*template.Template, error) {
+	src, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// Functions are replaced when executing because they depend on the formatter and sources
+	tmpl, err := template.New(file).Funcs((&Formatter{}).templateFuncs(map[string][]byte{})).Parse(string(src))
+	if err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+func (f *Formatter) templatePrint(issues tflint.Issues, sources map[string][]byte) {
+	err := f.Template.Funcs(f.templateFuncs(sources)).Execute(f.Stdout, &templateData{Issues: issues.Sort()})
+	if err != nil {
+		fmt.Fprintf(f.Stderr, "Failed to execute the template. An error occurred:\n\n%s: %s\n\n", f.theme().Error.Sprint("Error"), err)
+	}
+}
+
+func (f *Formatter) templateFuncs(sources map[string][]byte) template.FuncMap {
+	return template.FuncMap{
+		"severity": func(severity string) string {
+			return f.theme().severity(severity)
+		},
+		"message": func(message string) string {
+			return f.theme().Message.Sprint(message)
+		},
+		"highlight": func(str string) string {
+			return f.theme().Highlight.Sprint(str)
+		},
+		"source": func(issue *tflint.Issue) string {
+			var b strings.Builder
+			f.printSource(&b, issue, sources)
+			return b.String()
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package formatter
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatih/color"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_templatePrint(t *testing.T) {
+	// Disable color
+	color.NoColor = true
+
+	dir, err := ioutil.TempDir("", "template")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "tflint.tmpl")
+	content := `{{range .Issues}}{{.Range.Filename}}:{{.Range.Start.Line}}: [{{severity .Rule.Severity}}] {{message .Message}} ({{.Rule.Name}})
+{{source .}}{{end}}`
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := LoadTemplate(file)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	issues := tflint.Issues{
+		{
+			Rule:    &testRule{},
+			Message: "test",
+			Range: hcl.Range{
+				Filename: "test.tf",
+				Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+				End:      hcl.Pos{Line: 1, Column: 4, Byte: 3},
+			},
+		},
+	}
+	sources := map[string][]byte{
+		"test.tf": []byte("foo = 1"),
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	formatter := &Formatter{Stdout: stdout, Stderr: stderr, Template: tmpl}
+
+	formatter.prettyPrint(issues, nil, sources)
+
+	expected := `test.tf:1: [Error] test (test_rule)
+   1: foo = 1
+`
+	if stdout.String() != expected {
+		t.Fatalf("Failed: expected=%s, stdout=%s", expected, stdout.String())
+	}
+	if stderr.String() != "" {
+		t.Fatalf("Failed: stderr=%s", stderr.String())
+	}
+}
+
+func Test_LoadTemplate_error(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "tflint.tmpl")
+	if err := ioutil.WriteFile(file, []byte("{{unknown .Issues}}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadTemplate(file); err == nil {
+		t.Fatal("Expected error is not occurred")
+	}
+}
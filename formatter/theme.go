@@ -0,0 +1,131 @@
+package formatter
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// Theme is a set of colors used in the default output
+type Theme struct {
+	Error     *color.Color
+	Warning   *color.Color
+	Notice    *color.Color
+	Message   *color.Color
+	Highlight *color.Color
+}
+
+var defaultTheme = &Theme{
+	Error:     color.New(color.FgRed),
+	Warning:   color.New(color.FgYellow),
+	Notice:    color.New(color.FgHiWhite),
+	Message:   color.New(color.Bold),
+	Highlight: color.New(color.Bold, color.Underline),
+}
+
+var builtinThemes = map[string]*Theme{
+	"default": defaultTheme,
+	"high-contrast": {
+		Error:     color.New(color.FgHiRed, color.Bold),
+		Warning:   color.New(color.FgHiYellow, color.Bold),
+		Notice:    color.New(color.FgHiCyan, color.Bold),
+		Message:   color.New(color.Bold),
+		Highlight: color.New(color.Bold, color.Underline),
+	},
+	"monochrome": {
+		Error:     color.New(color.Bold),
+		Warning:   color.New(color.Bold),
+		Notice:    color.New(color.Faint),
+		Message:   color.New(color.Bold),
+		Highlight: color.New(color.Underline),
+	},
+}
+
+var colorAttributes = map[string]color.Attribute{
+	"bold":      color.Bold,
+	"faint":     color.Faint,
+	"italic":    color.Italic,
+	"underline": color.Underline,
+
+	"black":   color.FgBlack,
+	"red":     color.FgRed,
+	"green":   color.FgGreen,
+	"yellow":  color.FgYellow,
+	"blue":    color.FgBlue,
+	"magenta": color.FgMagenta,
+	"cyan":    color.FgCyan,
+	"white":   color.FgWhite,
+
+	"hi-black":   color.FgHiBlack,
+	"hi-red":     color.FgHiRed,
+	"hi-green":   color.FgHiGreen,
+	"hi-yellow":  color.FgHiYellow,
+	"hi-blue":    color.FgHiBlue,
+	"hi-magenta": color.FgHiMagenta,
+	"hi-cyan":    color.FgHiCyan,
+	"hi-white":   color.FgHiWhite,
+
+	"bg-black":   color.BgBlack,
+	"bg-red":     color.BgRed,
+	"bg-green":   color.BgGreen,
+	"bg-yellow":  color.BgYellow,
+	"bg-blue":    color.BgBlue,
+	"bg-magenta": color.BgMagenta,
+	"bg-cyan":    color.BgCyan,
+	"bg-white":   color.BgWhite,
+}
+
+// NewTheme returns the color theme selected in the config
+// Themes defined in the config take precedence over built-in themes. It returns the default theme if no theme is selected.
+func NewTheme(cfg *tflint.Config) (*Theme, error) {
+	if cfg.Theme == "" {
+		return defaultTheme, nil
+	}
+
+	if themeCfg, exists := cfg.Themes[cfg.Theme]; exists {
+		theme := *defaultTheme
+		for _, c := range []struct {
+			dest  **color.Color
+			names []string
+		}{
+			{&theme.Error, themeCfg.Error},
+			{&theme.Warning, themeCfg.Warning},
+			{&theme.Notice, themeCfg.Notice},
+			{&theme.Message, themeCfg.Message},
+			{&theme.Highlight, themeCfg.Highlight},
+		} {
+			if c.names == nil {
+				continue
+			}
+			attrs := []color.Attribute{}
+			for _, name := range c.names {
+				attr, ok := colorAttributes[name]
+				if !ok {
+					return nil, fmt.Errorf("`%s` is invalid color in `%s` theme", name, cfg.Theme)
+				}
+				attrs = append(attrs, attr)
+			}
+			*c.dest = color.New(attrs...)
+		}
+		return &theme, nil
+	}
+
+	if theme, exists := builtinThemes[cfg.Theme]; exists {
+		return theme, nil
+	}
+	return nil, fmt.Errorf("Theme not found: %s", cfg.Theme)
+}
+
+func (t *Theme) severity(severity string) string {
+	switch severity {
+	case tflint.ERROR:
+		return t.Error.Sprint(severity)
+	case tflint.WARNING:
+		return t.Warning.Sprint(severity)
+	case tflint.NOTICE:
+		return t.Notice.Sprint(severity)
+	default:
+		panic("Unreachable")
+	}
+}
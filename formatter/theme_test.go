@@ -0,0 +1,90 @@
+package formatter
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_NewTheme(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	cases := []struct {
+		Name    string
+		Theme   string
+		Themes  map[string]*tflint.ThemeConfig
+		Error   string
+		Warning string
+		Message string
+		Err     string
+	}{
+		{
+			Name:    "default",
+			Error:   "\x1b[31mError\x1b[0m",
+			Warning: "\x1b[33mWarning\x1b[0m",
+			Message: "\x1b[1mmessage\x1b[0m",
+		},
+		{
+			Name:    "built-in",
+			Theme:   "high-contrast",
+			Error:   "\x1b[91;1mError\x1b[0m",
+			Warning: "\x1b[93;1mWarning\x1b[0m",
+			Message: "\x1b[1mmessage\x1b[0m",
+		},
+		{
+			Name:  "custom",
+			Theme: "custom",
+			Themes: map[string]*tflint.ThemeConfig{
+				"custom": {Name: "custom", Error: []string{"hi-magenta", "bold"}, Message: []string{}},
+			},
+			Error:   "\x1b[95;1mError\x1b[0m",
+			Warning: "\x1b[33mWarning\x1b[0m",
+			Message: "\x1b[mmessage\x1b[0m",
+		},
+		{
+			Name:  "invalid color",
+			Theme: "custom",
+			Themes: map[string]*tflint.ThemeConfig{
+				"custom": {Name: "custom", Error: []string{"pink"}},
+			},
+			Err: "`pink` is invalid color in `custom` theme",
+		},
+		{
+			Name:  "not found",
+			Theme: "unknown",
+			Err:   "Theme not found: unknown",
+		},
+	}
+
+	for _, tc := range cases {
+		cfg := tflint.EmptyConfig()
+		cfg.Theme = tc.Theme
+		if tc.Themes != nil {
+			cfg.Themes = tc.Themes
+		}
+
+		theme, err := NewTheme(cfg)
+		if tc.Err != "" {
+			if err == nil || err.Error() != tc.Err {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got %v", tc.Name, tc.Err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if ret := theme.severity(tflint.ERROR); ret != tc.Error {
+			t.Fatalf("Failed `%s` test: expected=%q, got=%q", tc.Name, tc.Error, ret)
+		}
+		if ret := theme.severity(tflint.WARNING); ret != tc.Warning {
+			t.Fatalf("Failed `%s` test: expected=%q, got=%q", tc.Name, tc.Warning, ret)
+		}
+		if ret := theme.Message.Sprint("message"); ret != tc.Message {
+			t.Fatalf("Failed `%s` test: expected=%q, got=%q", tc.Name, tc.Message, ret)
+		}
+	}
+}
@@ -5,20 +5,26 @@ go 1.14
 require (
 	github.com/aws/aws-sdk-go v1.30.3
 	github.com/fatih/color v1.9.0
+	github.com/go-interpreter/wagon v0.6.0
 	github.com/golang/mock v1.4.3
 	github.com/google/go-cmp v0.4.0
 	github.com/hashicorp/aws-sdk-go-base v0.4.0
+	github.com/hashicorp/go-getter v1.4.2-0.20200106182914-9813cbd4eb02
 	github.com/hashicorp/go-plugin v1.2.0
 	github.com/hashicorp/go-version v1.2.0
 	github.com/hashicorp/hcl/v2 v2.3.0
 	github.com/hashicorp/logutils v1.0.0
 	github.com/hashicorp/terraform v0.12.24
+	github.com/hashicorp/terraform-svchost v0.0.0-20191011084731-65d371908596
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/mattn/go-colorable v0.1.6
 	github.com/mitchellh/go-homedir v1.1.0
+	github.com/serenize/snaker v0.0.0-20171204205717-a683aaf2d516
 	github.com/sourcegraph/go-lsp v0.0.0-20181119182933-0c7d621186c1
 	github.com/sourcegraph/jsonrpc2 v0.0.0-20190106185902-35a74f039c6a
 	github.com/spf13/afero v1.2.2
 	github.com/terraform-linters/tflint-plugin-sdk v0.1.0
+	github.com/zalando/go-keyring v0.1.0
 	github.com/zclconf/go-cty v1.3.1
+	golang.org/x/crypto v0.0.0-20190701094942-4def268fd1a4
 )
@@ -0,0 +1,7 @@
+resource "aws_db_instance" "main" {
+  instance_class = "db.t1.micro"
+}
+
+resource "aws_instance" "web" {
+  instance_type = "t1.xmicro"
+}
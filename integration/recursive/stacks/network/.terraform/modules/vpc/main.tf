@@ -0,0 +1,3 @@
+resource "aws_instance" "ignored" {
+  instance_type = "t1.2xlarge"
+}
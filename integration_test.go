@@ -72,6 +72,11 @@ func TestIntegration(t *testing.T) {
 			Command: "./tflint --format json",
 			Dir:     "jsonsyntax",
 		},
+		{
+			Name:    "recursive",
+			Command: "./tflint --format json --recursive",
+			Dir:     "recursive",
+		},
 	}
 
 	dir, _ := os.Getwd()
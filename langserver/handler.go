@@ -125,7 +125,7 @@ func (h *handler) chdir(dir string) error {
 func (h *handler) inspect() (map[string][]lsp.Diagnostic, error) {
 	ret := map[string][]lsp.Diagnostic{}
 
-	loader, err := tflint.NewLoader(afero.Afero{Fs: h.fs}, h.config)
+	loader, err := tflint.NewLoader(h.config, tflint.WithFS(h.fs))
 	if err != nil {
 		return ret, fmt.Errorf("Failed to prepare loading: %s", err)
 	}
@@ -142,16 +142,16 @@ func (h *handler) inspect() (map[string][]lsp.Diagnostic, error) {
 	if err != nil {
 		return ret, fmt.Errorf("Failed to load values files: %s", err)
 	}
-	cliVars, err := tflint.ParseTFVariables(h.config.Variables, configs.Module.Variables)
+	variables, err = tflint.ArrangeTFVariables(variables, h.config, configs.Module.Variables)
 	if err != nil {
 		return ret, fmt.Errorf("Failed to parse variables: %s", err)
 	}
-	variables = append(variables, cliVars)
 
-	runner, err := tflint.NewRunner(h.config, annotations, configs, variables...)
+	runner, err := tflint.NewRunnerWithFS(loader.FS(), h.config, annotations, configs, variables...)
 	if err != nil {
 		return ret, fmt.Errorf("Failed to initialize a runner: %s", err)
 	}
+	runner.Sources = loader.Sources()
 	runners, err := tflint.NewModuleRunners(runner)
 	if err != nil {
 		return ret, fmt.Errorf("Failed to prepare rule checking: %s", err)
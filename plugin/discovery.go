@@ -21,7 +21,11 @@ import (
 //   2. Home directory (~/.tflint.d/plugins)
 //
 // Files under these directories that satisfy the "tflint-ruleset-*" naming rules
-// enabled in the configuration are treated as plugins.
+// enabled in the configuration are treated as plugins. If "tflint-ruleset-*.wasm" exists,
+// it is loaded as a WebAssembly plugin in preference to a native binary.
+//
+// Plugins with `source` are searched from the cache installed by `tflint --init`
+// under the home directory instead, like ~/.tflint.d/plugins/github.com/owner/repo/0.1.0.
 func Discovery(config *tflint.Config) (*Plugin, error) {
 	if _, err := os.Stat(localPluginRoot); !os.IsNotExist(err) {
 		return findPlugins(config, localPluginRoot)
@@ -36,15 +40,39 @@ func Discovery(config *tflint.Config) (*Plugin, error) {
 
 func findPlugins(config *tflint.Config, dir string) (*Plugin, error) {
 	clients := []*plugin.Client{}
-	rulesets := []*tfplugin.Client{}
+	rulesets := []RuleSet{}
 
 	for _, cfg := range config.Plugins {
+		installCfg, err := NewInstallConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+
 		pluginPath := filepath.Join(dir, pluginFileName(cfg.Name))
-		if _, err := os.Stat(pluginPath); os.IsNotExist(err) {
+		wasmPath := filepath.Join(dir, wasmPluginFileName(cfg.Name))
+		if !installCfg.ManuallyInstalled() {
+			root, err := homedir.Expand(PluginRoot)
+			if err != nil {
+				return nil, err
+			}
+			pluginPath = filepath.Join(root, installCfg.InstallPath())
+			wasmPath = filepath.Join(root, installCfg.WasmInstallPath())
+			if !fileExists(pluginPath) && !fileExists(wasmPath) {
+				return nil, fmt.Errorf("Plugin `%s` not found. Did you run `tflint --init`?", cfg.Name)
+			}
+		} else if !fileExists(pluginPath) && !fileExists(wasmPath) {
 			return nil, fmt.Errorf("Plugin `%s` not found in %s", cfg.Name, dir)
 		}
 
-		if cfg.Enabled {
+		if cfg.Enabled && fileExists(wasmPath) {
+			log.Printf("[INFO] WebAssembly plugin `%s` found", cfg.Name)
+
+			ruleset, err := NewWasmRuleSet(wasmPath)
+			if err != nil {
+				return nil, err
+			}
+			rulesets = append(rulesets, ruleset)
+		} else if cfg.Enabled {
 			log.Printf("[INFO] Plugin `%s` found", cfg.Name)
 
 			client := tfplugin.NewClient(&tfplugin.ClientOpts{
@@ -61,7 +89,7 @@ func findPlugins(config *tflint.Config, dir string) (*Plugin, error) {
 			ruleset := raw.(*tfplugin.Client)
 
 			clients = append(clients, client)
-			rulesets = append(rulesets, ruleset)
+			rulesets = append(rulesets, &processRuleSet{Client: ruleset})
 		} else {
 			log.Printf("[INFO] Plugin `%s` found, but the plugin is disabled", cfg.Name)
 		}
@@ -76,3 +104,12 @@ func pluginFileName(name string) string {
 	}
 	return fmt.Sprintf("tflint-ruleset-%s", name)
 }
+
+func wasmPluginFileName(name string) string {
+	return fmt.Sprintf("tflint-ruleset-%s.wasm", name)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return !os.IsNotExist(err)
+}
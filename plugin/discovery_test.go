@@ -42,6 +42,38 @@ func Test_Discovery(t *testing.T) {
 	}
 }
 
+func Test_Discovery_wasm(t *testing.T) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	original := PluginRoot
+	PluginRoot = filepath.Join(cwd, "test-fixtures", "plugins")
+	defer func() { PluginRoot = original }()
+
+	plugin, err := Discovery(&tflint.Config{
+		Plugins: map[string]*tflint.PluginConfig{
+			"wasm": {
+				Name:    "wasm",
+				Enabled: true,
+			},
+		},
+	})
+	defer plugin.Clean()
+
+	if err != nil {
+		t.Fatalf("Unexpected error occurred %s", err)
+	}
+
+	if len(plugin.RuleSets) != 1 {
+		t.Fatalf("Only one plugin must be enabled, but %d plugins are enabled", len(plugin.RuleSets))
+	}
+	if _, ok := plugin.RuleSets[0].(*WasmRuleSet); !ok {
+		t.Fatalf("The plugin must be loaded as a WebAssembly plugin, but got %T", plugin.RuleSets[0])
+	}
+}
+
 func Test_Discovery_local(t *testing.T) {
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// IndexURL is the URL of the published index of known plugins
+// This variable is exposed for testing.
+var IndexURL = "https://raw.githubusercontent.com/terraform-linters/tflint/master/plugins.json"
+
+// Index is a list of known plugins
+type Index struct {
+	Plugins []*IndexEntry `json:"plugins"`
+}
+
+// IndexEntry is a plugin listed in the index
+type IndexEntry struct {
+	Name        string `json:"name"`
+	Source      string `json:"source"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// FetchIndex downloads the index of known plugins
+// The returned plugins are sorted by name.
+func FetchIndex() (*Index, error) {
+	log.Printf("[INFO] Fetch plugin index: %s", IndexURL)
+	resp, err := http.Get(IndexURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to fetch plugin index: %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var index Index
+	if err := json.Unmarshal(body, &index); err != nil {
+		return nil, fmt.Errorf("Failed to parse plugin index: %s", err)
+	}
+	sort.Slice(index.Plugins, func(i, j int) bool {
+		return index.Plugins[i].Name < index.Plugins[j].Name
+	})
+
+	return &index, nil
+}
+
+// Search returns plugins whose name or description contains the passed term (case-insensitive)
+func (i *Index) Search(term string) []*IndexEntry {
+	term = strings.ToLower(term)
+
+	ret := []*IndexEntry{}
+	for _, entry := range i.Plugins {
+		if strings.Contains(strings.ToLower(entry.Name), term) || strings.Contains(strings.ToLower(entry.Description), term) {
+			ret = append(ret, entry)
+		}
+	}
+	return ret
+}
+
+// Find returns the plugin which has the passed name
+func (i *Index) Find(name string) (*IndexEntry, bool) {
+	for _, entry := range i.Plugins {
+		if entry.Name == name {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// ConfigBlock returns a `plugin` block of .tflint.hcl for installing the plugin
+func (e *IndexEntry) ConfigBlock() string {
+	return fmt.Sprintf(`plugin "%s" {
+  enabled = true
+  version = "%s"
+  source  = "%s"
+}
+`, e.Name, e.Version, e.Source)
+}
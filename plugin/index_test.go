@@ -0,0 +1,66 @@
+package plugin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_FetchIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+  "plugins": [
+    {"name": "foo", "source": "github.com/owner/tflint-ruleset-foo", "version": "0.2.0", "description": "Rules for Foo"},
+    {"name": "bar", "source": "github.com/owner/tflint-ruleset-bar", "version": "0.1.0", "description": "Naming conventions"}
+  ]
+}`))
+	}))
+	defer server.Close()
+
+	original := IndexURL
+	IndexURL = server.URL
+	defer func() { IndexURL = original }()
+
+	index, err := FetchIndex()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	foo := &IndexEntry{Name: "foo", Source: "github.com/owner/tflint-ruleset-foo", Version: "0.2.0", Description: "Rules for Foo"}
+	bar := &IndexEntry{Name: "bar", Source: "github.com/owner/tflint-ruleset-bar", Version: "0.1.0", Description: "Naming conventions"}
+
+	cases := []struct {
+		Name     string
+		Got      []*IndexEntry
+		Expected []*IndexEntry
+	}{
+		{
+			Name:     "sorted by name",
+			Got:      index.Plugins,
+			Expected: []*IndexEntry{bar, foo},
+		},
+		{
+			Name:     "search by name",
+			Got:      index.Search("FOO"),
+			Expected: []*IndexEntry{foo},
+		},
+		{
+			Name:     "search by description",
+			Got:      index.Search("naming"),
+			Expected: []*IndexEntry{bar},
+		},
+		{
+			Name:     "not found",
+			Got:      index.Search("baz"),
+			Expected: []*IndexEntry{},
+		},
+	}
+
+	for _, tc := range cases {
+		if !cmp.Equal(tc.Expected, tc.Got) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, tc.Got))
+		}
+	}
+}
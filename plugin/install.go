@@ -0,0 +1,300 @@
+package plugin
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-linters/tflint/tflint"
+	"golang.org/x/crypto/openpgp"
+)
+
+// GitHubReleaseURL is the base URL of GitHub releases
+// This variable is exposed for testing.
+var GitHubReleaseURL = "https://github.com"
+
+// pluginHTTPClient is the HTTP client downloading release assets
+// Requests time out so that a stalled release host does not hang `--init`.
+var pluginHTTPClient = &http.Client{Timeout: 5 * time.Minute}
+
+// maxDownloadSize is the maximum size of a release asset
+// Larger responses are rejected instead of being read into memory.
+var maxDownloadSize int64 = 256 << 20
+
+// InstallConfig is a config for plugin installation
+type InstallConfig struct {
+	*tflint.PluginConfig
+
+	SourceOwner string
+	SourceRepo  string
+
+	// RequireSignature rejects plugins which cannot be verified by the pinned signing key
+	RequireSignature bool
+}
+
+// NewInstallConfig returns a new InstallConfig from passed PluginConfig
+// Only GitHub releases are supported as a source, so the source must be in the form of `github.com/owner/repo`.
+func NewInstallConfig(config *tflint.PluginConfig) (*InstallConfig, error) {
+	if config.Source == "" {
+		return &InstallConfig{PluginConfig: config}, nil
+	}
+	if config.Version == "" {
+		return nil, fmt.Errorf("Plugin `%s` requires `version` when `source` is set", config.Name)
+	}
+
+	parts := strings.Split(config.Source, "/")
+	if len(parts) != 3 || parts[0] != "github.com" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Plugin `%s` has invalid source `%s`. The source must be in the form of `github.com/owner/repo`", config.Name, config.Source)
+	}
+
+	return &InstallConfig{
+		PluginConfig: config,
+		SourceOwner:  parts[1],
+		SourceRepo:   parts[2],
+	}, nil
+}
+
+// ManuallyInstalled returns whether the plugin is placed by users instead of `tflint --init`
+func (c *InstallConfig) ManuallyInstalled() bool {
+	return c.Source == ""
+}
+
+// InstallPath returns a path relative to the plugin root where the plugin is installed
+// Plugins are cached per version, like `github.com/owner/repo/0.1.0/tflint-ruleset-NAME`.
+func (c *InstallConfig) InstallPath() string {
+	return filepath.Join(c.Source, c.Version, pluginFileName(c.Name))
+}
+
+// WasmInstallPath returns a path relative to the plugin root where the WebAssembly plugin is installed
+func (c *InstallConfig) WasmInstallPath() string {
+	return filepath.Join(c.Source, c.Version, wasmPluginFileName(c.Name))
+}
+
+// Install downloads the release asset of the plugin, verifies its checksum, and extracts it into the plugin root
+// If the release has a WebAssembly module (tflint-ruleset-NAME.wasm), it is installed instead of the platform-specific binary.
+// If the plugin is already installed, it does nothing and returns the path.
+func (c *InstallConfig) Install() (string, error) {
+	dir, err := homedir.Expand(PluginRoot)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, c.InstallPath())
+	wasmPath := filepath.Join(dir, c.WasmInstallPath())
+
+	for _, installed := range []string{wasmPath, path} {
+		if fileExists(installed) {
+			log.Printf("[INFO] Plugin `%s` is already installed in %s", c.Name, installed)
+			return installed, nil
+		}
+	}
+
+	log.Printf("[INFO] Download checksums of `%s`", c.Name)
+	checksums, err := c.fetch(c.downloadURL("checksums.txt"))
+	if err != nil {
+		return "", err
+	}
+	if err := c.verifySignature(checksums); err != nil {
+		return "", err
+	}
+	sums, err := parseChecksums(checksums)
+	if err != nil {
+		return "", fmt.Errorf("Failed to parse checksums.txt: %s", err)
+	}
+
+	wasmAssetName := c.SourceRepo + ".wasm"
+	if _, exists := sums[wasmAssetName]; exists {
+		asset, err := c.download(wasmAssetName, sums)
+		if err != nil {
+			return "", err
+		}
+		if err := os.MkdirAll(filepath.Dir(wasmPath), 0755); err != nil {
+			return "", err
+		}
+		if err := writePluginFile(bytes.NewReader(asset), wasmPath, 0644); err != nil {
+			return "", err
+		}
+
+		log.Printf("[INFO] Plugin `%s` is installed in %s", c.Name, wasmPath)
+		return wasmPath, nil
+	}
+
+	assetName := fmt.Sprintf("%s_%s_%s.zip", c.SourceRepo, runtime.GOOS, runtime.GOARCH)
+	asset, err := c.download(assetName, sums)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := extractPlugin(asset, c.SourceRepo, path); err != nil {
+		return "", err
+	}
+
+	log.Printf("[INFO] Plugin `%s` is installed in %s", c.Name, path)
+	return path, nil
+}
+
+// verifySignature verifies checksums.txt with the detached signature (checksums.txt.sig) and the pinned signing key
+// If the signing key is not set, the plugin is installed without verification unless the signature is required.
+func (c *InstallConfig) verifySignature(checksums []byte) error {
+	if c.SigningKey == "" {
+		if c.RequireSignature {
+			return fmt.Errorf("Plugin `%s` cannot be verified because `signing_key` is not set. Unsigned plugins are not allowed by plugin_signature_policy", c.Name)
+		}
+		log.Printf("[WARN] Plugin `%s` is not verified because `signing_key` is not set", c.Name)
+		return nil
+	}
+
+	log.Printf("[INFO] Download signature of `%s`", c.Name)
+	signature, err := c.fetch(c.downloadURL("checksums.txt.sig"))
+	if err != nil {
+		return err
+	}
+
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(c.SigningKey))
+	if err != nil {
+		return fmt.Errorf("Failed to read signing key of `%s`: %s", c.Name, err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(signature), []byte("-----BEGIN")) {
+		_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(checksums), bytes.NewReader(signature))
+	} else {
+		_, err = openpgp.CheckDetachedSignature(keyring, bytes.NewReader(checksums), bytes.NewReader(signature))
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to verify signature of checksums.txt: %s", err)
+	}
+
+	log.Printf("[INFO] Signature of `%s` is verified", c.Name)
+	return nil
+}
+
+// download downloads the release asset and verifies it against checksums.txt
+func (c *InstallConfig) download(assetName string, sums map[string]string) ([]byte, error) {
+	expected, exists := sums[assetName]
+	if !exists {
+		return nil, fmt.Errorf("%s is not found in checksums.txt", assetName)
+	}
+
+	log.Printf("[INFO] Download %s", assetName)
+	asset, err := c.fetch(c.downloadURL(assetName))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(asset)
+	if actual := hex.EncodeToString(sum[:]); actual != expected {
+		return nil, fmt.Errorf("Checksum of %s is mismatched: expected=%s, actual=%s", assetName, expected, actual)
+	}
+	return asset, nil
+}
+
+func (c *InstallConfig) downloadURL(file string) string {
+	return fmt.Sprintf("%s/%s/%s/releases/download/v%s/%s", GitHubReleaseURL, c.SourceOwner, c.SourceRepo, c.Version, file)
+}
+
+func (c *InstallConfig) fetch(url string) ([]byte, error) {
+	resp, err := pluginHTTPClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to download %s: %s", url, resp.Status)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxDownloadSize {
+		return nil, fmt.Errorf("Failed to download %s: the response exceeds %d bytes", url, maxDownloadSize)
+	}
+	return body, nil
+}
+
+// parseChecksums parses a checksums.txt in the `sha256sum` format
+// File names written by `sha256sum -b` are prefixed with `*`, which marks the binary mode.
+func parseChecksums(content []byte) (map[string]string, error) {
+	ret := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("Invalid line: %s", line)
+		}
+		ret[strings.TrimPrefix(fields[1], "*")] = fields[0]
+	}
+
+	return ret, scanner.Err()
+}
+
+// extractPlugin writes the plugin binary in the zip archive to dst
+func extractPlugin(archive []byte, repo string, dst string) error {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return err
+	}
+
+	binaryName := repo
+	if runtime.GOOS == "windows" {
+		binaryName = repo + ".exe"
+	}
+
+	for _, f := range reader.File {
+		if f.Name != binaryName {
+			continue
+		}
+
+		src, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		return writePluginFile(src, dst, 0755)
+	}
+
+	return fmt.Errorf("%s is not found in the release asset", binaryName)
+}
+
+// writePluginFile writes the plugin to dst with the permission
+// The plugin is written to a temporary file and renamed to dst, so an interrupted write never leaves a partial plugin
+// which would be regarded as installed.
+func writePluginFile(src io.Reader, dst string, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), "."+filepath.Base(dst))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, src); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
@@ -0,0 +1,453 @@
+package plugin
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/terraform-linters/tflint/tflint"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func Test_NewInstallConfig(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   *tflint.PluginConfig
+		Expected *InstallConfig
+		Error    string
+	}{
+		{
+			Name:   "manually installed",
+			Config: &tflint.PluginConfig{Name: "foo", Enabled: true},
+			Expected: &InstallConfig{
+				PluginConfig: &tflint.PluginConfig{Name: "foo", Enabled: true},
+			},
+		},
+		{
+			Name:   "GitHub source",
+			Config: &tflint.PluginConfig{Name: "foo", Enabled: true, Source: "github.com/owner/tflint-ruleset-foo", Version: "0.1.0"},
+			Expected: &InstallConfig{
+				PluginConfig: &tflint.PluginConfig{Name: "foo", Enabled: true, Source: "github.com/owner/tflint-ruleset-foo", Version: "0.1.0"},
+				SourceOwner:  "owner",
+				SourceRepo:   "tflint-ruleset-foo",
+			},
+		},
+		{
+			Name:   "missing version",
+			Config: &tflint.PluginConfig{Name: "foo", Enabled: true, Source: "github.com/owner/tflint-ruleset-foo"},
+			Error:  "Plugin `foo` requires `version` when `source` is set",
+		},
+		{
+			Name:   "unsupported source",
+			Config: &tflint.PluginConfig{Name: "foo", Enabled: true, Source: "gitlab.com/owner/tflint-ruleset-foo", Version: "0.1.0"},
+			Error:  "Plugin `foo` has invalid source `gitlab.com/owner/tflint-ruleset-foo`. The source must be in the form of `github.com/owner/repo`",
+		},
+	}
+
+	for _, tc := range cases {
+		ret, err := NewInstallConfig(tc.Config)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if ret.SourceOwner != tc.Expected.SourceOwner || ret.SourceRepo != tc.Expected.SourceRepo || *ret.PluginConfig != *tc.Expected.PluginConfig {
+			t.Fatalf("Failed `%s` test: expected=%#v, got=%#v", tc.Name, tc.Expected, ret)
+		}
+	}
+}
+
+func Test_Install(t *testing.T) {
+	asset := buildPluginArchive(t, "tflint-ruleset-foo", "plugin binary")
+	assetName := fmt.Sprintf("tflint-ruleset-foo_%s_%s.zip", runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(asset)
+
+	cases := []struct {
+		Name      string
+		Checksums string
+		Error     string
+	}{
+		{
+			Name:      "valid checksum",
+			Checksums: fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), assetName),
+		},
+		{
+			Name:      "binary mode checksum",
+			Checksums: fmt.Sprintf("%s *%s\n", hex.EncodeToString(sum[:]), assetName),
+		},
+		{
+			Name:      "mismatched checksum",
+			Checksums: fmt.Sprintf("%s  %s\n", "0000", assetName),
+			Error:     fmt.Sprintf("Checksum of %s is mismatched: expected=0000, actual=%s", assetName, hex.EncodeToString(sum[:])),
+		},
+		{
+			Name:      "missing asset",
+			Checksums: "0000  other.zip\n",
+			Error:     fmt.Sprintf("%s is not found in checksums.txt", assetName),
+		},
+	}
+
+	for _, tc := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/checksums.txt":
+				w.Write([]byte(tc.Checksums))
+			case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/" + assetName:
+				w.Write(asset)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+
+		dir, err := ioutil.TempDir("", "tflint-plugins")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		originalRoot, originalURL := PluginRoot, GitHubReleaseURL
+		PluginRoot, GitHubReleaseURL = dir, server.URL
+
+		installCfg, err := NewInstallConfig(&tflint.PluginConfig{Name: "foo", Enabled: true, Source: "github.com/owner/tflint-ruleset-foo", Version: "0.1.0"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		path, err := installCfg.Install()
+
+		PluginRoot, GitHubReleaseURL = originalRoot, originalURL
+		server.Close()
+
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+		} else {
+			if err != nil {
+				t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+			}
+			expected := filepath.Join(dir, "github.com", "owner", "tflint-ruleset-foo", "0.1.0", pluginFileName("foo"))
+			if path != expected {
+				t.Fatalf("Failed `%s` test: expected path is %s, but got %s", tc.Name, expected, path)
+			}
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != "plugin binary" {
+				t.Fatalf("Failed `%s` test: unexpected content: %s", tc.Name, string(content))
+			}
+		}
+
+		os.RemoveAll(dir)
+	}
+}
+
+func Test_fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/small":
+			w.Write([]byte("0123456789"))
+		case "/large":
+			w.Write([]byte("0123456789abcdef"))
+		case "/stalled":
+			time.Sleep(time.Second)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	originalClient, originalSize := pluginHTTPClient, maxDownloadSize
+	defer func() { pluginHTTPClient, maxDownloadSize = originalClient, originalSize }()
+	pluginHTTPClient = &http.Client{Timeout: 100 * time.Millisecond}
+	maxDownloadSize = 10
+
+	cases := []struct {
+		Name     string
+		Path     string
+		Expected string
+		Error    string
+	}{
+		{
+			Name:     "within the limit",
+			Path:     "/small",
+			Expected: "0123456789",
+		},
+		{
+			Name:  "exceeding the limit",
+			Path:  "/large",
+			Error: fmt.Sprintf("Failed to download %s/large: the response exceeds 10 bytes", server.URL),
+		},
+		{
+			Name:  "stalled host",
+			Path:  "/stalled",
+			Error: "Client.Timeout exceeded",
+		},
+	}
+
+	installCfg := &InstallConfig{}
+	for _, tc := range cases {
+		body, err := installCfg.fetch(server.URL + tc.Path)
+		if tc.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if string(body) != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%s, got=%s", tc.Name, tc.Expected, string(body))
+		}
+	}
+}
+
+func buildPluginArchive(t *testing.T, name string, content string) []byte {
+	if runtime.GOOS == "windows" {
+		name = name + ".exe"
+	}
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	f, err := w.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_Install_wasm(t *testing.T) {
+	asset := buildPluginArchive(t, "tflint-ruleset-foo", "plugin binary")
+	assetName := fmt.Sprintf("tflint-ruleset-foo_%s_%s.zip", runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(asset)
+	module := []byte("wasm module")
+	wasmSum := sha256.Sum256(module)
+	checksums := fmt.Sprintf("%s  %s\n%s  tflint-ruleset-foo.wasm\n", hex.EncodeToString(sum[:]), assetName, hex.EncodeToString(wasmSum[:]))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/checksums.txt":
+			w.Write([]byte(checksums))
+		case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/" + assetName:
+			w.Write(asset)
+		case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/tflint-ruleset-foo.wasm":
+			w.Write(module)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "tflint-plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	originalRoot, originalURL := PluginRoot, GitHubReleaseURL
+	PluginRoot, GitHubReleaseURL = dir, server.URL
+	defer func() { PluginRoot, GitHubReleaseURL = originalRoot, originalURL }()
+
+	installCfg, err := NewInstallConfig(&tflint.PluginConfig{Name: "foo", Enabled: true, Source: "github.com/owner/tflint-ruleset-foo", Version: "0.1.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := installCfg.Install()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := filepath.Join(dir, "github.com", "owner", "tflint-ruleset-foo", "0.1.0", "tflint-ruleset-foo.wasm")
+	if path != expected {
+		t.Fatalf("Expected path is %s, but got %s", expected, path)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "wasm module" {
+		t.Fatalf("Unexpected content: %s", string(content))
+	}
+	if _, err := os.Stat(filepath.Join(dir, installCfg.InstallPath())); !os.IsNotExist(err) {
+		t.Fatalf("Expected the native binary is not installed, but got `%v`", err)
+	}
+}
+
+func Test_extractPlugin_corrupted(t *testing.T) {
+	archive := buildPluginArchive(t, "tflint-ruleset-foo", "plugin binary")
+	// Corrupt the content so that the extraction fails with a checksum error after writing a part of the binary
+	corrupted := bytes.Replace(archive, []byte("plugin binary"), []byte("plugin b1nary"), 1)
+	if bytes.Equal(archive, corrupted) {
+		corrupted = bytes.Replace(archive, []byte("binary"), []byte("b1nary"), 1)
+	}
+
+	dir, err := ioutil.TempDir("", "tflint-plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dst := filepath.Join(dir, pluginFileName("foo"))
+
+	if err := extractPlugin(corrupted, "tflint-ruleset-foo", dst); err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+	if _, err := os.Stat(dst); !os.IsNotExist(err) {
+		t.Fatalf("Expected the plugin is not installed, but got `%v`", err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("Expected no files are left, but got %d files", len(files))
+	}
+
+	if err := extractPlugin(archive, "tflint-ruleset-foo", dst); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	content, err := ioutil.ReadFile(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "plugin binary" {
+		t.Fatalf("Expected content is `plugin binary`, but got `%s`", content)
+	}
+}
+
+func Test_Install_signature(t *testing.T) {
+	asset := buildPluginArchive(t, "tflint-ruleset-foo", "plugin binary")
+	assetName := fmt.Sprintf("tflint-ruleset-foo_%s_%s.zip", runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(asset)
+	checksums := []byte(fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), assetName))
+
+	signer := newSigningEntity(t)
+	other := newSigningEntity(t)
+
+	cases := []struct {
+		Name             string
+		SigningKey       string
+		RequireSignature bool
+		Signer           *openpgp.Entity
+		Error            string
+	}{
+		{
+			Name:       "valid signature",
+			SigningKey: armoredPublicKey(t, signer),
+			Signer:     signer,
+		},
+		{
+			Name:       "signed by another key",
+			SigningKey: armoredPublicKey(t, signer),
+			Signer:     other,
+			Error:      "Failed to verify signature of checksums.txt: openpgp: signature made by unknown entity",
+		},
+		{
+			Name:   "unsigned plugin is allowed with warning",
+			Signer: signer,
+		},
+		{
+			Name:             "unsigned plugin is not allowed",
+			RequireSignature: true,
+			Signer:           signer,
+			Error:            "Plugin `foo` cannot be verified because `signing_key` is not set. Unsigned plugins are not allowed by plugin_signature_policy",
+		},
+	}
+
+	for _, tc := range cases {
+		signature := new(bytes.Buffer)
+		if err := openpgp.DetachSign(signature, tc.Signer, bytes.NewReader(checksums), nil); err != nil {
+			t.Fatal(err)
+		}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/checksums.txt":
+				w.Write(checksums)
+			case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/checksums.txt.sig":
+				w.Write(signature.Bytes())
+			case "/owner/tflint-ruleset-foo/releases/download/v0.1.0/" + assetName:
+				w.Write(asset)
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+
+		dir, err := ioutil.TempDir("", "tflint-plugins")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		originalRoot, originalURL := PluginRoot, GitHubReleaseURL
+		PluginRoot, GitHubReleaseURL = dir, server.URL
+
+		installCfg, err := NewInstallConfig(&tflint.PluginConfig{
+			Name:       "foo",
+			Enabled:    true,
+			Source:     "github.com/owner/tflint-ruleset-foo",
+			Version:    "0.1.0",
+			SigningKey: tc.SigningKey,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		installCfg.RequireSignature = tc.RequireSignature
+		_, err = installCfg.Install()
+
+		PluginRoot, GitHubReleaseURL = originalRoot, originalURL
+		server.Close()
+		os.RemoveAll(dir)
+
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+		} else if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+	}
+}
+
+func newSigningEntity(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("tflint", "test", "tflint@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entity
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	buf := new(bytes.Buffer)
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
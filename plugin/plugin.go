@@ -3,6 +3,7 @@ package plugin
 import (
 	plugin "github.com/hashicorp/go-plugin"
 	tfplugin "github.com/terraform-linters/tflint-plugin-sdk/plugin"
+	sdk "github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
 
 // PluginRoot is the root directory of the plugins
@@ -10,10 +11,20 @@ import (
 var PluginRoot = "~/.tflint.d/plugins"
 var localPluginRoot = "./.tflint.d/plugins"
 
+// RuleSet is a set of rules provided by a plugin
+// Both process plugins and WebAssembly plugins satisfy this interface.
+type RuleSet interface {
+	RuleSetName() (string, error)
+	RuleSetVersion() (string, error)
+	RuleNames() ([]string, error)
+	ApplyConfig(*sdk.Config) error
+	Check(*Server) error
+}
+
 // Plugin is an object handling plugins
 // Basically, it is a wrapper for go-plugin and provides an API to handle them collectively.
 type Plugin struct {
-	RuleSets []*tfplugin.Client
+	RuleSets []RuleSet
 
 	clients []*plugin.Client
 }
@@ -24,3 +35,13 @@ func (p *Plugin) Clean() {
 		client.Kill()
 	}
 }
+
+// processRuleSet is a ruleset served by a plugin process via RPC
+type processRuleSet struct {
+	*tfplugin.Client
+}
+
+// Check runs inspection of the ruleset in the plugin process
+func (r *processRuleSet) Check(server *Server) error {
+	return r.Client.Check(server)
+}
@@ -0,0 +1,109 @@
+;; A WebAssembly ruleset for testing. tflint-ruleset-wasm.wasm is built from this file by `wat2wasm`.
+;;
+;; - wasm_instance_previous_type: Reports `instance_type = "t1.micro"` in `aws_instance`
+;; - wasm_trap: Traps while checking (disabled by default)
+(module
+  (import "tflint" "walk_resource_attributes" (func $walk (param i32 i32 i32 i32) (result i32)))
+  (import "tflint" "attribute_value" (func $value (param i32) (result i32)))
+  (import "tflint" "read_response" (func $read (param i32)))
+  (import "tflint" "emit_issue" (func $emit (param i32 i32 i32)))
+
+  (memory 1)
+
+  ;; Length-prefixed ruleset metadata returned by tflint_ruleset
+  (data (i32.const 0) "\c2\00\00\00"
+    "{\"name\":\"wasm\",\"version\":\"0.1.0\",\"rules\":["
+    "{\"name\":\"wasm_instance_previous_type\",\"enabled\":true,\"severity\":\"Warning\",\"link\":\"\"},"
+    "{\"name\":\"wasm_trap\",\"enabled\":false,\"severity\":\"Error\",\"link\":\"\"}]}")
+  (data (i32.const 512) "aws_instance")
+  (data (i32.const 528) "instance_type")
+  (data (i32.const 544) "\"t1.micro\"")
+  (data (i32.const 560) "t1.micro is a previous generation instance type")
+
+  (func $ruleset (result i32)
+    i32.const 0)
+
+  (func $check (param $rule i32) (result i32)
+    (local $n i32)
+    (local $i i32)
+    local.get $rule
+    i32.const 1
+    i32.eq
+    if
+      unreachable
+    end
+    i32.const 512
+    i32.const 12
+    i32.const 528
+    i32.const 13
+    call $walk
+    local.set $n
+    block $done
+      loop $next
+        local.get $i
+        local.get $n
+        i32.ge_s
+        br_if $done
+        local.get $i
+        call $value
+        i32.const 10
+        i32.eq
+        if
+          i32.const 1024
+          call $read
+          i32.const 1024
+          i32.const 544
+          i32.const 10
+          call $memeq
+          if
+            local.get $i
+            i32.const 560
+            i32.const 47
+            call $emit
+          end
+        end
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $next
+      end
+    end
+    i32.const 0)
+
+  (func $memeq (param $a i32) (param $b i32) (param $n i32) (result i32)
+    block $ne
+      loop $next
+        local.get $n
+        i32.eqz
+        if
+          i32.const 1
+          return
+        end
+        local.get $a
+        i32.load8_u
+        local.get $b
+        i32.load8_u
+        i32.ne
+        br_if $ne
+        local.get $a
+        i32.const 1
+        i32.add
+        local.set $a
+        local.get $b
+        i32.const 1
+        i32.add
+        local.set $b
+        local.get $n
+        i32.const 1
+        i32.sub
+        local.set $n
+        br $next
+      end
+    end
+    i32.const 0)
+
+  (export "memory" (memory 0))
+  (export "tflint_ruleset" (func $ruleset))
+  (export "tflint_check" (func $check))
+)
@@ -0,0 +1,409 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/go-interpreter/wagon/exec"
+	"github.com/go-interpreter/wagon/wasm"
+	hcl "github.com/hashicorp/hcl/v2"
+	tfplugin "github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// WasmRuleSet is a ruleset compiled to WebAssembly
+//
+// Unlike process plugins, the module is executed by an interpreter in the TFLint process. The module can import only
+// the functions of the "tflint" module listed below, so rules have no access to files, networks, environment variables
+// and so on. The module must export the following:
+//
+//   memory                   The linear memory
+//   tflint_ruleset() -> i32  Returns a pointer to the ruleset metadata, which is length-prefixed JSON like
+//                            {"name":"NAME","version":"0.1.0","rules":[{"name":"...","enabled":true,"severity":"Error","link":"..."}]}
+//   tflint_check(i32) -> i32 Checks the rule at the index of the metadata. Returns 0, or a pointer to a length-prefixed error message
+//
+// TFLint provides the following functions:
+//
+//   walk_resource_attributes(resource_ptr, resource_len, name_ptr, name_len i32) -> i32
+//     Finds the attributes of the resources and returns the number of them. Attributes are referred by the index until the next call.
+//   attribute_value(attribute i32) -> i32
+//     Evaluates the attribute and returns the length of the JSON value. It returns -1 if the value is unknown, null, or unevaluable.
+//   read_response(ptr i32)
+//     Copies the JSON value evaluated last to the memory.
+//   emit_issue(attribute, message_ptr, message_len i32)
+//     Emits an issue of the rule being checked on the attribute's expression.
+//
+// Length-prefixed data starts with its byte length as a 32-bit little-endian integer.
+// Each check instantiates the module with fresh memory, so rules cannot keep states between modules.
+type WasmRuleSet struct {
+	code     []byte
+	metadata *wasmRuleSetMetadata
+	enabled  []bool
+}
+
+type wasmRuleSetMetadata struct {
+	Name    string              `json:"name"`
+	Version string              `json:"version"`
+	Rules   []*wasmRuleMetadata `json:"rules"`
+}
+
+type wasmRuleMetadata struct {
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Severity string `json:"severity"`
+	Link     string `json:"link"`
+}
+
+// NewWasmRuleSet loads the WebAssembly module and reads the ruleset metadata from it
+func NewWasmRuleSet(path string) (*WasmRuleSet, error) {
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ruleset := &WasmRuleSet{code: code}
+
+	call := &wasmCall{}
+	instance, err := ruleset.instantiate(call)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load %s: %s", path, err)
+	}
+	ret, err := instance.call("tflint_ruleset")
+	if call.err != nil {
+		err = call.err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load %s: %s", path, err)
+	}
+	raw, err := readLengthPrefixed(instance.vm.Memory(), ret)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load %s: %s", path, err)
+	}
+
+	var metadata wasmRuleSetMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return nil, fmt.Errorf("Failed to parse the ruleset metadata of %s: %s", path, err)
+	}
+	if metadata.Name == "" {
+		return nil, fmt.Errorf("Failed to load %s: The ruleset name is empty", path)
+	}
+	ruleset.metadata = &metadata
+	ruleset.enabled = make([]bool, len(metadata.Rules))
+	for i, rule := range metadata.Rules {
+		ruleset.enabled[i] = rule.Enabled
+	}
+
+	return ruleset, nil
+}
+
+// RuleSetName returns the name of the ruleset
+func (r *WasmRuleSet) RuleSetName() (string, error) {
+	return r.metadata.Name, nil
+}
+
+// RuleSetVersion returns the version of the ruleset
+func (r *WasmRuleSet) RuleSetVersion() (string, error) {
+	return r.metadata.Version, nil
+}
+
+// RuleNames returns the names of the rules in the ruleset
+func (r *WasmRuleSet) RuleNames() ([]string, error) {
+	names := make([]string, len(r.metadata.Rules))
+	for i, rule := range r.metadata.Rules {
+		names[i] = rule.Name
+	}
+	return names, nil
+}
+
+// ApplyConfig enables or disables rules according to the config
+func (r *WasmRuleSet) ApplyConfig(config *tfplugin.Config) error {
+	for i, rule := range r.metadata.Rules {
+		r.enabled[i] = rule.Enabled
+		if cfg := config.Rules[rule.Name]; cfg != nil {
+			r.enabled[i] = cfg.Enabled
+		}
+	}
+	return nil
+}
+
+// Check runs enabled rules against the runner of the server
+func (r *WasmRuleSet) Check(server *Server) error {
+	for i, rule := range r.metadata.Rules {
+		if !r.enabled[i] {
+			continue
+		}
+		log.Printf("[TRACE] Check `%s` rule of WebAssembly plugin `%s`", rule.Name, r.metadata.Name)
+
+		if err := r.check(server, i); err != nil {
+			return fmt.Errorf("Failed to check `%s` rule: %s", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *WasmRuleSet) check(server *Server, rule int) error {
+	call := &wasmCall{server: server, rule: r.metadata.Rules[rule]}
+	instance, err := r.instantiate(call)
+	if err != nil {
+		return err
+	}
+
+	ret, err := instance.call("tflint_check", uint64(rule))
+	if call.err != nil {
+		return call.err
+	}
+	if err != nil {
+		return err
+	}
+	if ret == 0 {
+		return nil
+	}
+
+	message, err := readLengthPrefixed(instance.vm.Memory(), ret)
+	if err != nil {
+		return err
+	}
+	return errors.New(string(message))
+}
+
+// wasmInstance is an instance of the module with its own memory
+type wasmInstance struct {
+	vm     *exec.VM
+	module *wasm.Module
+}
+
+func (r *WasmRuleSet) instantiate(call *wasmCall) (*wasmInstance, error) {
+	module, err := wasm.ReadModule(bytes.NewReader(r.code), call.resolve)
+	if err != nil {
+		return nil, err
+	}
+	vm, err := exec.NewVM(module)
+	if err != nil {
+		return nil, err
+	}
+	// Traps such as out of bounds memory access are returned as errors instead of panics
+	vm.RecoverPanic = true
+	return &wasmInstance{vm: vm, module: module}, nil
+}
+
+// call calls the exported function which returns an i32 value
+// If the execution is aborted by the host, the returned value is meaningless. Check the error of the wasmCall instead.
+func (i *wasmInstance) call(name string, args ...uint64) (uint32, error) {
+	module := i.module
+	if module.Export == nil {
+		return 0, fmt.Errorf("`%s` is not exported", name)
+	}
+	export, exists := module.Export.Entries[name]
+	if !exists || export.Kind != wasm.ExternalFunction {
+		return 0, fmt.Errorf("`%s` is not exported", name)
+	}
+	fn := module.GetFunction(int(export.Index))
+	if fn == nil || len(fn.Sig.ParamTypes) != len(args) || len(fn.Sig.ReturnTypes) != 1 || fn.Sig.ReturnTypes[0] != wasm.ValueTypeI32 {
+		return 0, fmt.Errorf("`%s` has an invalid signature", name)
+	}
+
+	ret, err := i.vm.ExecCode(int64(export.Index), args...)
+	if err != nil {
+		return 0, err
+	}
+	return ret.(uint32), nil
+}
+
+// wasmCall holds the states of an instance of the module while checking a rule
+type wasmCall struct {
+	server     *Server
+	rule       *wasmRuleMetadata
+	attributes []*hcl.Attribute
+	response   []byte
+	err        error
+}
+
+func (c *wasmCall) resolve(name string) (*wasm.Module, error) {
+	if name != "tflint" {
+		return nil, fmt.Errorf("`%s` module cannot be imported. WebAssembly plugins can import only `tflint` module", name)
+	}
+
+	return newHostModule(map[string]interface{}{
+		"walk_resource_attributes": c.walkResourceAttributes,
+		"attribute_value":          c.attributeValue,
+		"read_response":            c.readResponse,
+		"emit_issue":               c.emitIssue,
+	}), nil
+}
+
+func (c *wasmCall) walkResourceAttributes(proc *exec.Process, resourcePtr, resourceLen, namePtr, nameLen int32) int32 {
+	if c.server == nil {
+		return c.abort(proc, errors.New("Rules cannot be checked while loading the ruleset"))
+	}
+	resource, err := readMemory(proc, resourcePtr, resourceLen)
+	if err != nil {
+		return c.abort(proc, err)
+	}
+	name, err := readMemory(proc, namePtr, nameLen)
+	if err != nil {
+		return c.abort(proc, err)
+	}
+
+	var resp tfplugin.AttributesResponse
+	if err := c.server.Attributes(&tfplugin.AttributesRequest{Resource: string(resource), AttributeName: string(name)}, &resp); err != nil {
+		return c.abort(proc, err)
+	}
+	if resp.Err != nil {
+		return c.abort(proc, resp.Err)
+	}
+
+	c.attributes = resp.Attributes
+	return int32(len(c.attributes))
+}
+
+func (c *wasmCall) attributeValue(proc *exec.Process, attribute int32) int32 {
+	attr, err := c.attribute(attribute)
+	if err != nil {
+		return c.abort(proc, err)
+	}
+
+	val, err := c.server.runner.EvalExpr(attr.Expr, nil, cty.DynamicPseudoType)
+	if err != nil {
+		if appErr, ok := err.(*tflint.Error); ok && appErr.Level == tflint.WarningLevel {
+			return -1
+		}
+		return c.abort(proc, err)
+	}
+
+	c.response, err = ctyjson.SimpleJSONValue{Value: val}.MarshalJSON()
+	if err != nil {
+		return c.abort(proc, err)
+	}
+	return int32(len(c.response))
+}
+
+func (c *wasmCall) readResponse(proc *exec.Process, ptr int32) {
+	if ptr < 0 || int(ptr)+len(c.response) > proc.MemSize() {
+		c.abort(proc, fmt.Errorf("Out of bounds memory access at %d", ptr))
+		return
+	}
+	if _, err := proc.WriteAt(c.response, int64(ptr)); err != nil {
+		c.abort(proc, err)
+	}
+}
+
+func (c *wasmCall) emitIssue(proc *exec.Process, attribute, messagePtr, messageLen int32) {
+	attr, err := c.attribute(attribute)
+	if err != nil {
+		c.abort(proc, err)
+		return
+	}
+	message, err := readMemory(proc, messagePtr, messageLen)
+	if err != nil {
+		c.abort(proc, err)
+		return
+	}
+
+	req := &tfplugin.EmitIssueRequest{
+		Rule: &tfplugin.RuleObject{
+			Data: &tfplugin.RuleObjectData{
+				Name:     c.rule.Name,
+				Enabled:  true,
+				Severity: c.rule.Severity,
+				Link:     c.rule.Link,
+			},
+		},
+		Message:  string(message),
+		Location: attr.Expr.Range(),
+		Meta:     tfplugin.Metadata{Expr: attr.Expr},
+	}
+	if err := c.server.EmitIssue(req, new(interface{})); err != nil {
+		c.abort(proc, err)
+	}
+}
+
+func (c *wasmCall) attribute(index int32) (*hcl.Attribute, error) {
+	if c.server == nil {
+		return nil, errors.New("Rules cannot be checked while loading the ruleset")
+	}
+	if index < 0 || int(index) >= len(c.attributes) {
+		return nil, fmt.Errorf("Attribute %d is not found", index)
+	}
+	return c.attributes[index], nil
+}
+
+// abort terminates the execution of the module
+// The error is returned from the check instead of the result of the module.
+func (c *wasmCall) abort(proc *exec.Process, err error) int32 {
+	if c.err == nil {
+		c.err = err
+	}
+	proc.Terminate()
+	return -1
+}
+
+// newHostModule builds a module that exports the passed Go functions
+// Each function must take *exec.Process as the first argument, and other arguments and results must be int32.
+func newHostModule(funcs map[string]interface{}) *wasm.Module {
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	m := wasm.NewModule()
+	m.Types = &wasm.SectionTypes{Entries: make([]wasm.FunctionSig, len(names))}
+	m.FunctionIndexSpace = make([]wasm.Function, len(names))
+	m.Export = &wasm.SectionExports{Entries: map[string]wasm.ExportEntry{}}
+
+	for i, name := range names {
+		fn := reflect.ValueOf(funcs[name])
+
+		sig := &m.Types.Entries[i]
+		for j := 1; j < fn.Type().NumIn(); j++ {
+			sig.ParamTypes = append(sig.ParamTypes, wasm.ValueTypeI32)
+		}
+		for j := 0; j < fn.Type().NumOut(); j++ {
+			sig.ReturnTypes = append(sig.ReturnTypes, wasm.ValueTypeI32)
+		}
+
+		m.FunctionIndexSpace[i] = wasm.Function{
+			Sig:  sig,
+			Host: fn,
+			Body: &wasm.FunctionBody{},
+		}
+		m.Export.Entries[name] = wasm.ExportEntry{
+			FieldStr: name,
+			Kind:     wasm.ExternalFunction,
+			Index:    uint32(i),
+		}
+	}
+
+	return m
+}
+
+func readMemory(proc *exec.Process, ptr, size int32) ([]byte, error) {
+	if ptr < 0 || size < 0 || int(ptr)+int(size) > proc.MemSize() {
+		return nil, fmt.Errorf("Out of bounds memory access at %d (size: %d)", ptr, size)
+	}
+	buf := make([]byte, size)
+	if _, err := proc.ReadAt(buf, int64(ptr)); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func readLengthPrefixed(mem []byte, ptr uint32) ([]byte, error) {
+	if uint64(ptr)+4 > uint64(len(mem)) {
+		return nil, fmt.Errorf("Out of bounds memory access at %d", ptr)
+	}
+	size := binary.LittleEndian.Uint32(mem[ptr:])
+	start := uint64(ptr) + 4
+	if start+uint64(size) > uint64(len(mem)) {
+		return nil, fmt.Errorf("Out of bounds memory access at %d (size: %d)", ptr, size)
+	}
+	return mem[start : start+uint64(size)], nil
+}
@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+	tfplugin "github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_NewWasmRuleSet(t *testing.T) {
+	ruleset, err := NewWasmRuleSet(filepath.Join("test-fixtures", "plugins", "tflint-ruleset-wasm.wasm"))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	name, _ := ruleset.RuleSetName()
+	if name != "wasm" {
+		t.Fatalf("Expected ruleset name is `wasm`, but got `%s`", name)
+	}
+	version, _ := ruleset.RuleSetVersion()
+	if version != "0.1.0" {
+		t.Fatalf("Expected ruleset version is `0.1.0`, but got `%s`", version)
+	}
+	names, _ := ruleset.RuleNames()
+	expected := []string{"wasm_instance_previous_type", "wasm_trap"}
+	if !cmp.Equal(expected, names) {
+		t.Fatalf("Rule names are not matched: %s", cmp.Diff(expected, names))
+	}
+}
+
+func Test_NewWasmRuleSet_invalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-plugins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "tflint-ruleset-invalid.wasm")
+	if err := ioutil.WriteFile(path, []byte("not a module"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewWasmRuleSet(path); err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+}
+
+func Test_WasmRuleSet_Check(t *testing.T) {
+	source := `
+variable "unknown" {}
+
+resource "aws_instance" "previous" {
+  instance_type = "t1.micro"
+}
+
+resource "aws_instance" "current" {
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "unknown" {
+  instance_type = var.unknown
+}`
+
+	ruleset, err := NewWasmRuleSet(filepath.Join("test-fixtures", "plugins", "tflint-ruleset-wasm.wasm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": source})
+
+	if err := ruleset.Check(NewServer(runner)); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := tflint.Issues{
+		{
+			Message: "t1.micro is a previous generation instance type",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 5, Column: 19},
+				End:      hcl.Pos{Line: 5, Column: 29},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+	if runner.Issues[0].Rule.Name() != "wasm_instance_previous_type" || runner.Issues[0].Rule.Severity() != tflint.WARNING {
+		t.Fatalf("Unexpected rule: %#v", runner.Issues[0].Rule)
+	}
+}
+
+func Test_WasmRuleSet_Check_trap(t *testing.T) {
+	ruleset, err := NewWasmRuleSet(filepath.Join("test-fixtures", "plugins", "tflint-ruleset-wasm.wasm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ruleset.ApplyConfig(&tfplugin.Config{
+		Rules: map[string]*tfplugin.RuleConfig{
+			"wasm_instance_previous_type": {Name: "wasm_instance_previous_type", Enabled: false},
+			"wasm_trap":                   {Name: "wasm_trap", Enabled: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ruleset.Check(NewServer(tflint.TestRunner(t, map[string]string{})))
+	if err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+	expected := "Failed to check `wasm_trap` rule: exec: reached unreachable"
+	if err.Error() != expected {
+		t.Fatalf("Expected error is `%s`, but got `%s`", expected, err)
+	}
+}
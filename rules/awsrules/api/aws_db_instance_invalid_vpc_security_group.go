@@ -7,6 +7,7 @@ import (
 	"log"
 
 	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/client"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
@@ -14,8 +15,7 @@ import (
 type AwsDBInstanceInvalidVpcSecurityGroupRule struct {
 	resourceType  string
 	attributeName string
-	data          map[string]bool
-	dataPrepared  bool
+	data          map[*client.AwsClient]map[string]bool
 }
 
 // NewAwsDBInstanceInvalidVpcSecurityGroupRule returns new rule with default attributes
@@ -23,8 +23,7 @@ func NewAwsDBInstanceInvalidVpcSecurityGroupRule() *AwsDBInstanceInvalidVpcSecur
 	return &AwsDBInstanceInvalidVpcSecurityGroupRule{
 		resourceType:  "aws_db_instance",
 		attributeName: "vpc_security_group_ids",
-		data:          map[string]bool{},
-		dataPrepared:  false,
+		data:          map[*client.AwsClient]map[string]bool{},
 	}
 }
 
@@ -45,7 +44,17 @@ func (r *AwsDBInstanceInvalidVpcSecurityGroupRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsDBInstanceInvalidVpcSecurityGroupRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDBInstanceInvalidVpcSecurityGroupRule) Doc() *tflint.RuleDoc {
+	return existenceDoc(r.resourceType, r.attributeName, "DescribeSecurityGroups", true)
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDBInstanceInvalidVpcSecurityGroupRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks whether the attributes are included in the list retrieved by DescribeSecurityGroups
@@ -53,10 +62,12 @@ func (r *AwsDBInstanceInvalidVpcSecurityGroupRule) Check(runner *tflint.Runner)
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
 
 	return runner.WalkResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
-		if !r.dataPrepared {
+		awsClient := runner.AwsClientAt(attribute.Expr.Range())
+		data, prepared := r.data[awsClient]
+		if !prepared {
 			log.Print("[DEBUG] invoking DescribeSecurityGroups")
 			var err error
-			r.data, err = runner.AwsClient.DescribeSecurityGroups()
+			data, err = awsClient.DescribeSecurityGroups()
 			if err != nil {
 				err := &tflint.Error{
 					Code:    tflint.ExternalAPIError,
@@ -67,15 +78,16 @@ func (r *AwsDBInstanceInvalidVpcSecurityGroupRule) Check(runner *tflint.Runner)
 				log.Printf("[ERROR] %s", err)
 				return err
 			}
-			r.dataPrepared = true
+			r.data[awsClient] = data
 		}
 
 		return runner.EachStringSliceExprs(attribute.Expr, func(val string, expr hcl.Expression) {
-			if !r.data[val] {
-				runner.EmitIssue(
+			if !data[val] {
+				runner.EmitIssueWithEvidence(
 					r,
 					fmt.Sprintf(`"%s" is invalid security group.`, val),
 					expr.Range(),
+					runner.NewEvidence("DescribeSecurityGroups", val),
 				)
 			}
 		})
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/client"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
@@ -14,8 +15,7 @@ import (
 type AwsInstanceInvalidSubnetRule struct {
 	resourceType  string
 	attributeName string
-	data          map[string]bool
-	dataPrepared  bool
+	data          map[*client.AwsClient]map[string]bool
 }
 
 // NewAwsInstanceInvalidSubnetRule returns new rule with default attributes
@@ -23,8 +23,7 @@ func NewAwsInstanceInvalidSubnetRule() *AwsInstanceInvalidSubnetRule {
 	return &AwsInstanceInvalidSubnetRule{
 		resourceType:  "aws_instance",
 		attributeName: "subnet_id",
-		data:          map[string]bool{},
-		dataPrepared:  false,
+		data:          map[*client.AwsClient]map[string]bool{},
 	}
 }
 
@@ -45,7 +44,17 @@ func (r *AwsInstanceInvalidSubnetRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsInstanceInvalidSubnetRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsInstanceInvalidSubnetRule) Doc() *tflint.RuleDoc {
+	return existenceDoc(r.resourceType, r.attributeName, "DescribeSubnets", false)
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsInstanceInvalidSubnetRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks whether the attributes are included in the list retrieved by DescribeSubnets
@@ -53,10 +62,12 @@ func (r *AwsInstanceInvalidSubnetRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
 
 	return runner.WalkResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
-		if !r.dataPrepared {
+		awsClient := runner.AwsClientAt(attribute.Expr.Range())
+		data, prepared := r.data[awsClient]
+		if !prepared {
 			log.Print("[DEBUG] invoking DescribeSubnets")
 			var err error
-			r.data, err = runner.AwsClient.DescribeSubnets()
+			data, err = awsClient.DescribeSubnets()
 			if err != nil {
 				err := &tflint.Error{
 					Code:    tflint.ExternalAPIError,
@@ -67,18 +78,19 @@ func (r *AwsInstanceInvalidSubnetRule) Check(runner *tflint.Runner) error {
 				log.Printf("[ERROR] %s", err)
 				return err
 			}
-			r.dataPrepared = true
+			r.data[awsClient] = data
 		}
 
 		var val string
 		err := runner.EvaluateExpr(attribute.Expr, &val)
 
 		return runner.EnsureNoError(err, func() error {
-			if !r.data[val] {
-				runner.EmitIssue(
+			if !data[val] {
+				runner.EmitIssueWithEvidence(
 					r,
 					fmt.Sprintf(`"%s" is invalid subnet ID.`, val),
 					attribute.Expr.Range(),
+					runner.NewEvidence("DescribeSubnets", val),
 				)
 			}
 			return nil
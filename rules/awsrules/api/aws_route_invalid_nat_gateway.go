@@ -7,6 +7,7 @@ import (
 	"log"
 
 	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/client"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
@@ -14,8 +15,7 @@ import (
 type AwsRouteInvalidNatGatewayRule struct {
 	resourceType  string
 	attributeName string
-	data          map[string]bool
-	dataPrepared  bool
+	data          map[*client.AwsClient]map[string]bool
 }
 
 // NewAwsRouteInvalidNatGatewayRule returns new rule with default attributes
@@ -23,8 +23,7 @@ func NewAwsRouteInvalidNatGatewayRule() *AwsRouteInvalidNatGatewayRule {
 	return &AwsRouteInvalidNatGatewayRule{
 		resourceType:  "aws_route",
 		attributeName: "nat_gateway_id",
-		data:          map[string]bool{},
-		dataPrepared:  false,
+		data:          map[*client.AwsClient]map[string]bool{},
 	}
 }
 
@@ -45,7 +44,17 @@ func (r *AwsRouteInvalidNatGatewayRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsRouteInvalidNatGatewayRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsRouteInvalidNatGatewayRule) Doc() *tflint.RuleDoc {
+	return existenceDoc(r.resourceType, r.attributeName, "DescribeNatGateways", false)
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRouteInvalidNatGatewayRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks whether the attributes are included in the list retrieved by DescribeNatGateways
@@ -53,10 +62,12 @@ func (r *AwsRouteInvalidNatGatewayRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
 
 	return runner.WalkResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
-		if !r.dataPrepared {
+		awsClient := runner.AwsClientAt(attribute.Expr.Range())
+		data, prepared := r.data[awsClient]
+		if !prepared {
 			log.Print("[DEBUG] invoking DescribeNatGateways")
 			var err error
-			r.data, err = runner.AwsClient.DescribeNatGateways()
+			data, err = awsClient.DescribeNatGateways()
 			if err != nil {
 				err := &tflint.Error{
 					Code:    tflint.ExternalAPIError,
@@ -67,18 +78,19 @@ func (r *AwsRouteInvalidNatGatewayRule) Check(runner *tflint.Runner) error {
 				log.Printf("[ERROR] %s", err)
 				return err
 			}
-			r.dataPrepared = true
+			r.data[awsClient] = data
 		}
 
 		var val string
 		err := runner.EvaluateExpr(attribute.Expr, &val)
 
 		return runner.EnsureNoError(err, func() error {
-			if !r.data[val] {
-				runner.EmitIssue(
+			if !data[val] {
+				runner.EmitIssueWithEvidence(
 					r,
 					fmt.Sprintf(`"%s" is invalid NAT gateway ID.`, val),
 					attribute.Expr.Range(),
+					runner.NewEvidence("DescribeNatGateways", val),
 				)
 			}
 			return nil
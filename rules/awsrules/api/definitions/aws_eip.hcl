@@ -0,0 +1,6 @@
+rule "aws_eip_invalid_network_interface" {
+    resource      = "aws_eip"
+    attribute     = "network_interface"
+    source_action = "DescribeNetworkInterfaces"
+    template      = "\"%s\" is invalid network interface ID."
+}
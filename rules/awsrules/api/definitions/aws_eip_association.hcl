@@ -0,0 +1,13 @@
+rule "aws_eip_association_invalid_allocation" {
+    resource      = "aws_eip_association"
+    attribute     = "allocation_id"
+    source_action = "DescribeAddresses"
+    template      = "\"%s\" is invalid allocation ID."
+}
+
+rule "aws_eip_association_invalid_network_interface" {
+    resource      = "aws_eip_association"
+    attribute     = "network_interface_id"
+    source_action = "DescribeNetworkInterfaces"
+    template      = "\"%s\" is invalid network interface ID."
+}
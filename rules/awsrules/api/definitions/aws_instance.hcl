@@ -1,3 +1,10 @@
+rule "aws_instance_invalid_availability_zone" {
+    resource      = "aws_instance"
+    attribute     = "availability_zone"
+    source_action = "DescribeAvailabilityZones"
+    template      = "\"%s\" is not an available availability zone."
+}
+
 rule "aws_instance_invalid_iam_profile" {
     resource      = "aws_instance"
     attribute     = "iam_instance_profile"
@@ -25,3 +32,10 @@ rule "aws_instance_invalid_vpc_security_group" {
     source_action = "DescribeSecurityGroups"
     template      = "\"%s\" is invalid security group."
 }
+
+rule "aws_instance_unavailable_type" {
+    resource      = "aws_instance"
+    attribute     = "instance_type"
+    source_action = "DescribeInstanceTypeOfferings"
+    template      = "\"%s\" is not offered in the region."
+}
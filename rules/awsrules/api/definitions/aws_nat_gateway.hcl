@@ -0,0 +1,6 @@
+rule "aws_nat_gateway_invalid_allocation" {
+    resource      = "aws_nat_gateway"
+    attribute     = "allocation_id"
+    source_action = "DescribeAddresses"
+    template      = "\"%s\" is invalid allocation ID."
+}
@@ -0,0 +1,6 @@
+rule "aws_network_interface_attachment_invalid_network_interface" {
+    resource      = "aws_network_interface_attachment"
+    attribute     = "network_interface_id"
+    source_action = "DescribeNetworkInterfaces"
+    template      = "\"%s\" is invalid network interface ID."
+}
@@ -0,0 +1,6 @@
+rule "aws_subnet_invalid_availability_zone" {
+    resource      = "aws_subnet"
+    attribute     = "availability_zone"
+    source_action = "DescribeAvailabilityZones"
+    template      = "\"%s\" is not an available availability zone."
+}
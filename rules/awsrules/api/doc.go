@@ -0,0 +1,59 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// actionExample is an example of values looked up by the API action
+// The invalid one is a literal which does not exist, and the valid one refers to the managed resource.
+type actionExample struct {
+	invalid string
+	valid   string
+}
+
+var actionExamples = map[string]actionExample{
+	"DescribeAddresses":                  {invalid: `"eipalloc-1234abcd"`, valid: "aws_eip.example.id"},
+	"DescribeAvailabilityZones":          {invalid: `"us-east-1z"`, valid: "data.aws_availability_zones.available.names[0]"},
+	"DescribeCacheParameterGroups":       {invalid: `"app-redis"`, valid: "aws_elasticache_parameter_group.example.name"},
+	"DescribeCacheSubnetGroups":          {invalid: `"app-subnet-group"`, valid: "aws_elasticache_subnet_group.example.name"},
+	"DescribeDBParameterGroups":          {invalid: `"app-mysql"`, valid: "aws_db_parameter_group.example.name"},
+	"DescribeDBSubnetGroups":             {invalid: `"app-subnet-group"`, valid: "aws_db_subnet_group.example.name"},
+	"DescribeEgressOnlyInternetGateways": {invalid: `"eigw-1234abcd"`, valid: "aws_egress_only_internet_gateway.example.id"},
+	"DescribeInstanceTypeOfferings":      {invalid: `"p4d.24xlarge"`, valid: `"t3.micro"`},
+	"DescribeInstances":                  {invalid: `"i-1234abcd"`, valid: "aws_instance.example.id"},
+	"DescribeInternetGateways":           {invalid: `"igw-1234abcd"`, valid: "aws_internet_gateway.example.id"},
+	"DescribeKeyPairs":                   {invalid: `"app-key"`, valid: "aws_key_pair.example.key_name"},
+	"DescribeNatGateways":                {invalid: `"nat-1234abcd"`, valid: "aws_nat_gateway.example.id"},
+	"DescribeNetworkInterfaces":          {invalid: `"eni-1234abcd"`, valid: "aws_network_interface.example.id"},
+	"DescribeOptionGroups":               {invalid: `"app-option-group"`, valid: "aws_db_option_group.example.name"},
+	"DescribeRouteTables":                {invalid: `"rtb-1234abcd"`, valid: "aws_route_table.example.id"},
+	"DescribeSecurityGroups":             {invalid: `"sg-1234abcd"`, valid: "aws_security_group.example.id"},
+	"DescribeSubnets":                    {invalid: `"subnet-1234abcd"`, valid: "aws_subnet.example.id"},
+	"DescribeVpcPeeringConnections":      {invalid: `"pcx-1234abcd"`, valid: "aws_vpc_peering_connection.example.id"},
+	"ListInstanceProfiles":               {invalid: `"app-profile"`, valid: "aws_iam_instance_profile.example.name"},
+}
+
+// existenceDoc returns the documentation of the rule checking whether values of the attribute exist by the API action
+// The list argument is whether the attribute is a list of values.
+func existenceDoc(resourceType string, attributeName string, action string, list bool) *tflint.RuleDoc {
+	example := actionExamples[action]
+	value := func(v string) string {
+		if list {
+			return fmt.Sprintf("[%s]", v)
+		}
+		return v
+	}
+	snippet := func(v string) string {
+		return fmt.Sprintf("resource \"%s\" \"example\" {\n  %s = %s\n}\n", resourceType, attributeName, value(v))
+	}
+
+	return &tflint.RuleDoc{
+		Description: fmt.Sprintf("Checks whether `%s` of `%s` exists in the account and the region of the provider, by looking it up with the %s API. It is run only in deep check mode.", attributeName, resourceType, action),
+		Rationale:   "Terraform does not check whether referenced AWS resources exist, so a typo or a resource in another account or region is rejected only when running `terraform apply`, possibly after other resources have been changed.",
+		Violating:   snippet(example.invalid),
+		Compliant:   snippet(example.valid),
+		Fix:         fmt.Sprintf("Set `%s` to a value which exists in the account and the region of the provider, or refer to the managed resource so that Terraform creates it first. Values unknown until `terraform apply` are not looked up.", attributeName),
+	}
+}
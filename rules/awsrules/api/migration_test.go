@@ -188,6 +188,7 @@ resource "aws_alb" "balancer" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsALBInvalidSecurityGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -340,6 +341,7 @@ resource "aws_alb" "balancer" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsALBInvalidSubnetRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -460,6 +462,7 @@ resource "aws_db_instance" "mysql" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsDBInstanceInvalidDBSubnetGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -580,6 +583,7 @@ resource "aws_db_instance" "mysql" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsDBInstanceInvalidOptionGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -700,6 +704,7 @@ resource "aws_db_instance" "mysql" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsDBInstanceInvalidParameterGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -871,6 +876,7 @@ resource "aws_db_instance" "mysql" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsDBInstanceInvalidVpcSecurityGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -991,6 +997,7 @@ resource "aws_elasticache_cluster" "redis" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsElastiCacheClusterInvalidParameterGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -1162,6 +1169,7 @@ resource "aws_elasticache_cluster" "redis" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsElastiCacheClusterInvalidSecurityGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -1282,6 +1290,7 @@ resource "aws_elasticache_cluster" "redis" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsElastiCacheClusterInvalidSubnetGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -1457,6 +1466,7 @@ resource "aws_elb" "balancer" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsELBInvalidInstanceRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -1628,6 +1638,7 @@ resource "aws_elb" "balancer" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsELBInvalidSecurityGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -1799,6 +1810,7 @@ resource "aws_elb" "balancer" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsELBInvalidSubnetRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -1919,6 +1931,7 @@ resource "aws_instance" "web" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsInstanceInvalidIAMProfileRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2036,6 +2049,7 @@ resource "aws_instance" "web" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsInstanceInvalidKeyNameRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2153,6 +2167,7 @@ resource "aws_instance" "web" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsInstanceInvalidSubnetRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2324,6 +2339,7 @@ resource "aws_instance" "web" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsInstanceInvalidVpcSecurityGroupRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2444,6 +2460,7 @@ resource "aws_launch_configuration" "web" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsLaunchConfigurationInvalidIAMProfileRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2561,6 +2578,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidEgressOnlyGatewayRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2678,6 +2696,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidGatewayRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2799,6 +2818,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidInstanceRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -2916,6 +2936,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidNatGatewayRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -3033,6 +3054,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidNetworkInterfaceRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -3150,6 +3172,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidRouteTableRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -3267,6 +3290,7 @@ resource "aws_route" "foo" {
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsRouteInvalidVpcPeeringConnectionRule{}),
 			cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Address", "Evidence"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
@@ -0,0 +1,183 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// availabilityZonePattern matches "<region><zone letter>" and local zones like "us-west-2-lax-1a"
+var availabilityZonePattern = regexp.MustCompile(`^([a-z]{2}(?:-gov|-iso|-isob)?-[a-z]+-\d+)(?:-[a-z]+-\d+)?([a-z])$`)
+
+// AwsAvailabilityZoneInvalidNameRule checks whether availability zone names are valid
+type AwsAvailabilityZoneInvalidNameRule struct {
+	attributes map[string][]string
+	moduleArgs []string
+	regions    []string
+}
+
+// NewAwsAvailabilityZoneInvalidNameRule returns new rule with default attributes
+func NewAwsAvailabilityZoneInvalidNameRule() *AwsAvailabilityZoneInvalidNameRule {
+	return &AwsAvailabilityZoneInvalidNameRule{
+		attributes: map[string][]string{
+			"aws_autoscaling_group":     {"availability_zones"},
+			"aws_db_instance":           {"availability_zone"},
+			"aws_ebs_volume":            {"availability_zone"},
+			"aws_elasticache_cluster":   {"availability_zone", "preferred_availability_zones"},
+			"aws_elb":                   {"availability_zones"},
+			"aws_instance":              {"availability_zone"},
+			"aws_rds_cluster":           {"availability_zones"},
+			"aws_spot_instance_request": {"availability_zone"},
+			"aws_subnet":                {"availability_zone"},
+		},
+		// `azs` is a conventional input name used by community modules (e.g. terraform-aws-modules/vpc)
+		moduleArgs: []string{"azs"},
+	}
+}
+
+// SetRegions replaces the endpoints data with the passed regions
+func (r *AwsAvailabilityZoneInvalidNameRule) SetRegions(regions []string) {
+	r.regions = regions
+}
+
+// Name returns the rule name
+func (r *AwsAvailabilityZoneInvalidNameRule) Name() string {
+	return "aws_availability_zone_invalid_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsAvailabilityZoneInvalidNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsAvailabilityZoneInvalidNameRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsAvailabilityZoneInvalidNameRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAvailabilityZoneInvalidNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether availability zones follow the naming convention of AWS, such as `us-east-1a`, and belong to the region of the provider. Availability zones passed to modules as `azs` are also checked.",
+		Rationale:   "An availability zone in another region or a misspelled one is rejected only when running `terraform apply`.",
+		Violating: `provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_instance" "web" {
+  availability_zone = "us-west-2a"
+}
+`,
+		Compliant: `provider "aws" {
+  region = "us-east-1"
+}
+
+resource "aws_instance" "web" {
+  availability_zone = "us-east-1a"
+}
+`,
+		Fix: "Use an availability zone of the region of the provider. If the resource should be created in another region, use a provider alias for the region.",
+	}
+}
+
+// Check checks whether availability zones follow the naming convention and belong to the provider's region
+// Resources of alias providers are checked with the regions of the aliases.
+func (r *AwsAvailabilityZoneInvalidNameRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for resourceType, attributes := range r.attributes {
+		for _, attributeName := range attributes {
+			err := runner.WalkResourceAttributes(resourceType, attributeName, func(attribute *hcl.Attribute) error {
+				return r.checkZones(runner, attribute.Expr, strings.HasSuffix(attributeName, "zones"))
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, call := range runner.TFConfig.Module.ModuleCalls {
+		schema := &hcl.BodySchema{}
+		for _, name := range r.moduleArgs {
+			schema.Attributes = append(schema.Attributes, hcl.AttributeSchema{Name: name})
+		}
+		content, _, diags := call.Config.PartialContent(schema)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, attribute := range content.Attributes {
+			err := runner.WithExpressionContext(attribute.Expr, func() error {
+				return r.checkZones(runner, attribute.Expr, true)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsAvailabilityZoneInvalidNameRule) checkZones(runner *tflint.Runner, expr hcl.Expression, list bool) error {
+	if list {
+		return runner.EachStringSliceExprs(expr, func(zone string, expr hcl.Expression) {
+			r.checkZone(runner, zone, expr)
+		})
+	}
+
+	var zone string
+	err := runner.EvaluateExpr(expr, &zone)
+	return runner.EnsureNoError(err, func() error {
+		r.checkZone(runner, zone, expr)
+		return nil
+	})
+}
+
+func (r *AwsAvailabilityZoneInvalidNameRule) checkZone(runner *tflint.Runner, zone string, expr hcl.Expression) {
+	matches := availabilityZonePattern.FindStringSubmatch(zone)
+	if matches == nil || !isKnownRegion(matches[1], r.regions) {
+		runner.EmitIssue(r, fmt.Sprintf("\"%s\" is an invalid availability zone name.", zone), expr.Range())
+		return
+	}
+
+	region, letter := matches[1], matches[2]
+	// No region has more than 6 availability zones so far
+	if letter > "f" {
+		runner.EmitIssue(r, fmt.Sprintf("\"%s\" is an invalid availability zone name.", zone), expr.Range())
+		return
+	}
+
+	if providerRegion := runner.AwsRegionAt(expr.Range()); providerRegion != "" && region != providerRegion {
+		runner.EmitIssue(r, fmt.Sprintf("\"%s\" is not in the %s region.", zone, providerRegion), expr.Range())
+	}
+}
+
+// isKnownRegion returns whether the passed region exists in the endpoints data bundled with the AWS SDK
+// If regions are overridden by `data_files`, they are used instead of the endpoints data.
+func isKnownRegion(region string, regions []string) bool {
+	if regions != nil {
+		for _, known := range regions {
+			if known == region {
+				return true
+			}
+		}
+		return false
+	}
+	for _, partition := range endpoints.DefaultPartitions() {
+		if _, ok := partition.Regions()[region]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsAvailabilityZoneInvalidName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "invalid zone letter",
+			Content: `
+resource "aws_subnet" "main" {
+  availability_zone = "us-east-1z"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsAvailabilityZoneInvalidNameRule(),
+					Message: "\"us-east-1z\" is an invalid availability zone name.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 23},
+						End:      hcl.Pos{Line: 3, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown region",
+			Content: `
+resource "aws_elb" "main" {
+  availability_zones = ["us-east-1a", "us-esat-1b"]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsAvailabilityZoneInvalidNameRule(),
+					Message: "\"us-esat-1b\" is an invalid availability zone name.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 39},
+						End:      hcl.Pos{Line: 3, Column: 51},
+					},
+				},
+			},
+		},
+		{
+			Name: "zone in another region",
+			Content: `
+provider "aws" {
+  region = "us-west-2"
+}
+
+module "vpc" {
+  source = "./vpc"
+  azs    = ["us-west-2a", "us-east-1b"]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsAvailabilityZoneInvalidNameRule(),
+					Message: "\"us-east-1b\" is not in the us-west-2 region.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 27},
+						End:      hcl.Pos{Line: 8, Column: 39},
+					},
+				},
+			},
+		},
+		{
+			Name: "alias provider in another region",
+			Content: `
+provider "aws" {
+  region = "us-west-2"
+}
+
+provider "aws" {
+  alias  = "east"
+  region = "us-east-1"
+}
+
+resource "aws_instance" "west" {
+  availability_zone = "us-west-2a"
+}
+
+resource "aws_instance" "east" {
+  provider          = aws.east
+  availability_zone = "us-east-1b"
+}
+
+resource "aws_instance" "wrong" {
+  provider          = aws.east
+  availability_zone = "us-west-2a"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsAvailabilityZoneInvalidNameRule(),
+					Message: "\"us-west-2a\" is not in the us-east-1 region.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 22, Column: 23},
+						End:      hcl.Pos{Line: 22, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid zones",
+			Content: `
+resource "aws_instance" "web" {
+  availability_zone = "us-west-2-lax-1a"
+}
+
+resource "aws_autoscaling_group" "web" {
+  availability_zones = ["ap-northeast-1a", "ap-northeast-1c"]
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsAvailabilityZoneInvalidNameRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
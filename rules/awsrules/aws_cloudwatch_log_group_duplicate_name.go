@@ -0,0 +1,108 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsCloudWatchLogGroupDuplicateNameRule checks whether the log group name of a new "aws_cloudwatch_log_group" already exists
+type AwsCloudWatchLogGroupDuplicateNameRule struct {
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsCloudWatchLogGroupDuplicateNameRule returns new rule with default attributes
+func NewAwsCloudWatchLogGroupDuplicateNameRule() *AwsCloudWatchLogGroupDuplicateNameRule {
+	return &AwsCloudWatchLogGroupDuplicateNameRule{
+		resourceType:  "aws_cloudwatch_log_group",
+		attributeName: "name",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) Name() string {
+	return "aws_cloudwatch_log_group_duplicate_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether the log group name of a new `aws_cloudwatch_log_group` is already taken in the account. Resources recorded in the state are not reported. It is run only in deep check mode, and only when the state is read, since resources already applied cannot be told apart from new ones without it.",
+		Rationale:   "The log group name must be unique, so a new resource with a taken one fails only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_cloudwatch_log_group" "example" {
+  name = "/app/existing"
+}
+`,
+		Compliant: `resource "aws_cloudwatch_log_group" "example" {
+  name = "/app/new"
+}
+`,
+		Fix: "Use another log group name, or import the existing resource into the state by `terraform import` if it should be managed by this configuration.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check checks whether the log group name is already taken by a resource that isn't in the state
+func (r *AwsCloudWatchLogGroupDuplicateNameRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkNewResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
+		var name string
+		err := runner.EvaluateExpr(attribute.Expr, &name)
+
+		return runner.EnsureNoError(err, func() error {
+			log.Printf("[DEBUG] Invoking DescribeLogGroups: %s", runner.Redact(name))
+			resp, err := runner.AwsClientAt(attribute.Expr.Range()).CloudWatchLogs.DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: aws.String(name),
+			})
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeLogGroups",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			// DescribeLogGroups only supports prefix matching, so it looks for an exact match
+			for _, group := range resp.LogGroups {
+				if aws.StringValue(group.LogGroupName) == name {
+					runner.EmitIssueWithEvidence(
+						r,
+						fmt.Sprintf("\"%s\" already exists.", name),
+						attribute.Expr.Range(),
+						runner.NewEvidence("DescribeLogGroups", name),
+					)
+					return nil
+				}
+			}
+			return nil
+		})
+	})
+}
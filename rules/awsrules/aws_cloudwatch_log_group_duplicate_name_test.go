@@ -0,0 +1,79 @@
+package awsrules
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsCloudWatchLogGroupDuplicateName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Response []*cloudwatchlogs.LogGroup
+		Expected tflint.Issues
+	}{
+		{
+			Name: "log group already exists",
+			Content: `
+resource "aws_cloudwatch_log_group" "app" {
+	name = "/app/web"
+}`,
+			Response: []*cloudwatchlogs.LogGroup{
+				{LogGroupName: aws.String("/app/web")},
+				{LogGroupName: aws.String("/app/web-worker")},
+			},
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsCloudWatchLogGroupDuplicateNameRule(),
+					Message: "\"/app/web\" already exists.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 9},
+						End:      hcl.Pos{Line: 3, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "only prefix matched",
+			Content: `
+resource "aws_cloudwatch_log_group" "app" {
+	name = "/app/web"
+}`,
+			Response: []*cloudwatchlogs.LogGroup{
+				{LogGroupName: aws.String("/app/web-worker")},
+			},
+			Expected: tflint.Issues{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := NewAwsCloudWatchLogGroupDuplicateNameRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": tc.Content}, tflint.EmptyConfig(), states.NewState())
+
+		mock := client.NewMockCloudWatchLogsAPI(ctrl)
+		mock.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+			LogGroupNamePrefix: aws.String("/app/web"),
+		}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+			LogGroups: tc.Response,
+		}, nil)
+		runner.AwsClient.CloudWatchLogs = mock
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
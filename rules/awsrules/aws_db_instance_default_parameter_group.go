@@ -43,6 +43,28 @@ func (r *AwsDBInstanceDefaultParameterGroupRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsDBInstanceDefaultParameterGroupRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows using default DB parameter groups, such as `default.mysql5.7`.",
+		Rationale:   "You can modify parameter values in a custom DB parameter group, but you cannot change the parameter values in a default DB parameter group.",
+		Violating: `resource "aws_db_instance" "mysql" {
+  parameter_group_name = "default.mysql5.7"
+}
+`,
+		Compliant: `resource "aws_db_instance" "mysql" {
+  parameter_group_name = aws_db_parameter_group.mysql.name
+}
+`,
+		Fix: "Create a new parameter group, and change `parameter_group_name` to it.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDBInstanceDefaultParameterGroupRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 var defaultDBParameterGroupRegexp = regexp.MustCompile("^default")
 
 // Check checks the parameter group name starts with `default`
@@ -0,0 +1,106 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsDBInstanceDuplicateIdentifierRule checks whether the DB instance identifier of a new "aws_db_instance" already exists
+type AwsDBInstanceDuplicateIdentifierRule struct {
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsDBInstanceDuplicateIdentifierRule returns new rule with default attributes
+func NewAwsDBInstanceDuplicateIdentifierRule() *AwsDBInstanceDuplicateIdentifierRule {
+	return &AwsDBInstanceDuplicateIdentifierRule{
+		resourceType:  "aws_db_instance",
+		attributeName: "identifier",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsDBInstanceDuplicateIdentifierRule) Name() string {
+	return "aws_db_instance_duplicate_identifier"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsDBInstanceDuplicateIdentifierRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsDBInstanceDuplicateIdentifierRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsDBInstanceDuplicateIdentifierRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDBInstanceDuplicateIdentifierRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether the DB instance identifier of a new `aws_db_instance` is already taken in the account. Resources recorded in the state are not reported. It is run only in deep check mode, and only when the state is read, since resources already applied cannot be told apart from new ones without it.",
+		Rationale:   "The DB instance identifier must be unique, so a new resource with a taken one fails only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_db_instance" "example" {
+  identifier = "existing-db"
+}
+`,
+		Compliant: `resource "aws_db_instance" "example" {
+  identifier = "new-db"
+}
+`,
+		Fix: "Use another DB instance identifier, or import the existing resource into the state by `terraform import` if it should be managed by this configuration.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDBInstanceDuplicateIdentifierRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check checks whether the DB instance identifier is already taken by a resource that isn't in the state
+func (r *AwsDBInstanceDuplicateIdentifierRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkNewResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
+		var name string
+		err := runner.EvaluateExpr(attribute.Expr, &name)
+
+		return runner.EnsureNoError(err, func() error {
+			log.Printf("[DEBUG] Invoking DescribeDBInstances: %s", runner.Redact(name))
+			_, err := runner.AwsClientAt(attribute.Expr.Range()).RDS.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+				DBInstanceIdentifier: aws.String(name),
+			})
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == rds.ErrCodeDBInstanceNotFoundFault {
+					return nil
+				}
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeDBInstances",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf("\"%s\" already exists.", name),
+				attribute.Expr.Range(),
+				runner.NewEvidence("DescribeDBInstances", name),
+			)
+			return nil
+		})
+	})
+}
@@ -117,6 +117,28 @@ func (r *AwsDBInstanceInvalidTypeRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsDBInstanceInvalidTypeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `instance_class` of `aws_db_instance` is a valid DB instance class.",
+		Rationale:   "Terraform does not validate instance classes, so a typo is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_db_instance" "mysql" {
+  instance_class = "m4.2xlarge"
+}
+`,
+		Compliant: `resource "aws_db_instance" "mysql" {
+  instance_class = "db.m4.2xlarge"
+}
+`,
+		Fix: "Use one of the DB instance classes listed in the RDS documentation. They start with `db.`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDBInstanceInvalidTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether "aws_db_instance" has invalid instance type.
 func (r *AwsDBInstanceInvalidTypeRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
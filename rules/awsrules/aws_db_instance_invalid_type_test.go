@@ -39,6 +39,29 @@ resource "aws_db_instance" "mysql" {
 }`,
 			Expected: tflint.Issues{},
 		},
+		{
+			Name: "count.index",
+			Content: `
+variable "instance_classes" {
+    default = ["db.m4.large", "m4.xlarge"]
+}
+
+resource "aws_db_instance" "mysql" {
+    count          = 2
+    instance_class = var.instance_classes[count.index]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsDBInstanceInvalidTypeRule(),
+					Message: "\"m4.xlarge\" is invalid instance type. (count.index = 1)",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 22},
+						End:      hcl.Pos{Line: 8, Column: 55},
+					},
+				},
+			},
+		},
 	}
 
 	rule := NewAwsDBInstanceInvalidTypeRule()
@@ -63,6 +63,28 @@ func (r *AwsDBInstancePreviousTypeRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsDBInstancePreviousTypeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows using previous generation DB instance classes.",
+		Rationale:   "Previous generation instance classes are inferior to current generation in terms of performance and fee. Unless there is a special reason, you should avoid them.",
+		Violating: `resource "aws_db_instance" "mysql" {
+  instance_class = "db.t1.micro"
+}
+`,
+		Compliant: `resource "aws_db_instance" "mysql" {
+  instance_class = "db.t3.micro"
+}
+`,
+		Fix: "Select a current generation instance class according to the upgrade paths in the RDS documentation.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDBInstancePreviousTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether the resource's `instance_class` is included in the list of previous generation instance type
 func (r *AwsDBInstancePreviousTypeRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
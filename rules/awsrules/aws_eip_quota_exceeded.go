@@ -0,0 +1,130 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsEipQuotaExceededRule checks whether new Elastic IPs exceed the "EC2-VPC Elastic IPs" quota
+type AwsEipQuotaExceededRule struct {
+	resourceType string
+	serviceCode  string
+	quotaCode    string
+}
+
+// NewAwsEipQuotaExceededRule returns new rule with default attributes
+func NewAwsEipQuotaExceededRule() *AwsEipQuotaExceededRule {
+	return &AwsEipQuotaExceededRule{
+		resourceType: "aws_eip",
+		serviceCode:  "ec2",
+		quotaCode:    "L-0263D0A3",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsEipQuotaExceededRule) Name() string {
+	return "aws_eip_quota_exceeded"
+}
+
+// Enabled returns whether the rule is enabled by default
+// This rule is disabled by default because it requires the permission for Service Quotas
+func (r *AwsEipQuotaExceededRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsEipQuotaExceededRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsEipQuotaExceededRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsEipQuotaExceededRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether new `aws_eip` resources exceed the \"EC2-VPC Elastic IPs\" quota of the account, by comparing existing and new resources with the quota from Service Quotas. Instances created by `count` and `for_each` are counted in the region of the provider of each resource. It is run only in deep check mode when the state is read, and requires the `servicequotas:GetServiceQuota` and `servicequotas:GetAWSDefaultServiceQuota` permissions.",
+		Rationale:   "Resources beyond the quota fail only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_eip" "nat" {
+  count = 10
+  vpc   = true
+}
+`,
+		Compliant: `resource "aws_eip" "nat" {
+  count = 2
+  vpc   = true
+}
+`,
+		Fix: "Release unused Elastic IPs, reduce the number of new ones, or request a quota increase in Service Quotas.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsEipQuotaExceededRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check compares the number of existing and new Elastic IPs with the quota
+// Instances of resources with `count` or `for_each` are counted, and Elastic IPs are compared per region of their providers.
+func (r *AwsEipQuotaExceededRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	resources, err := lookupNewResourceInstances(runner, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	// Quotas and usages are per account and region, so they are looked up with the client of each resource
+	quotas := map[*client.AwsClient]int{}
+	usages := map[*client.AwsClient]int{}
+	requested := map[*client.AwsClient]int{}
+	for _, resource := range resources {
+		if _, exists := quotas[resource.client]; !exists {
+			log.Print("[DEBUG] invoking GetServiceQuota")
+			quota, err := resource.client.GetServiceQuota(r.serviceCode, r.quotaCode)
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking GetServiceQuota",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			log.Print("[DEBUG] invoking DescribeAddresses")
+			addresses, err := resource.client.DescribeAddresses()
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeAddresses",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			quotas[resource.client] = quota
+			usages[resource.client] = len(addresses)
+		}
+
+		requested[resource.client] += resource.count
+		if resource.count > 0 && usages[resource.client]+requested[resource.client] > quotas[resource.client] {
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf("Allocating this Elastic IP exceeds the quota of %d Elastic IPs per region. %d Elastic IPs are already allocated.", quotas[resource.client], usages[resource.client]),
+				resource.resource.DeclRange,
+				runner.NewEvidence("GetServiceQuota", r.serviceCode, r.quotaCode),
+			)
+		}
+	}
+
+	return nil
+}
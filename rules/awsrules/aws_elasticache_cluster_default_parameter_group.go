@@ -43,6 +43,28 @@ func (r *AwsElastiCacheClusterDefaultParameterGroupRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsElastiCacheClusterDefaultParameterGroupRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows using default ElastiCache parameter groups, such as `default.redis3.2`.",
+		Rationale:   "You can modify parameter values in a custom parameter group, but you cannot change the parameter values in a default parameter group.",
+		Violating: `resource "aws_elasticache_cluster" "redis" {
+  parameter_group_name = "default.redis3.2"
+}
+`,
+		Compliant: `resource "aws_elasticache_cluster" "redis" {
+  parameter_group_name = aws_elasticache_parameter_group.redis.name
+}
+`,
+		Fix: "Create a new parameter group, and change `parameter_group_name` to it.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsElastiCacheClusterDefaultParameterGroupRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 var defaultElastiCacheParameterGroupRegexp = regexp.MustCompile("^default")
 
 // Check checks the parameter group name starts with `default`
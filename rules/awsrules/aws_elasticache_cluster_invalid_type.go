@@ -92,6 +92,28 @@ func (r *AwsElastiCacheClusterInvalidTypeRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsElastiCacheClusterInvalidTypeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `node_type` of `aws_elasticache_cluster` is a valid node type.",
+		Rationale:   "Terraform does not validate node types, so a typo is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_elasticache_cluster" "redis" {
+  node_type = "m4.large"
+}
+`,
+		Compliant: `resource "aws_elasticache_cluster" "redis" {
+  node_type = "cache.m4.large"
+}
+`,
+		Fix: "Use one of the node types listed in the ElastiCache documentation. They start with `cache.`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsElastiCacheClusterInvalidTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether "aws_elasticache_cluster" has invalid node type.
 func (r *AwsElastiCacheClusterInvalidTypeRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
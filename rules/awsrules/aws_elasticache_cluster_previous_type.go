@@ -63,6 +63,28 @@ func (r *AwsElastiCacheClusterPreviousTypeRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsElastiCacheClusterPreviousTypeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows using previous generation ElastiCache node types.",
+		Rationale:   "Previous generation node types are inferior to current generation in terms of performance and fee. Unless there is a special reason, you should avoid them.",
+		Violating: `resource "aws_elasticache_cluster" "redis" {
+  node_type = "cache.t1.micro"
+}
+`,
+		Compliant: `resource "aws_elasticache_cluster" "redis" {
+  node_type = "cache.t3.micro"
+}
+`,
+		Fix: "Select a current generation node type according to the upgrade paths in the ElastiCache documentation.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsElastiCacheClusterPreviousTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether the resource's `node_type` is included in the list of previous generation node type
 func (r *AwsElastiCacheClusterPreviousTypeRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
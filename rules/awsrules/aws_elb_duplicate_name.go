@@ -0,0 +1,106 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elb"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsELBDuplicateNameRule checks whether the load balancer name of a new "aws_elb" already exists
+type AwsELBDuplicateNameRule struct {
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsELBDuplicateNameRule returns new rule with default attributes
+func NewAwsELBDuplicateNameRule() *AwsELBDuplicateNameRule {
+	return &AwsELBDuplicateNameRule{
+		resourceType:  "aws_elb",
+		attributeName: "name",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsELBDuplicateNameRule) Name() string {
+	return "aws_elb_duplicate_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsELBDuplicateNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsELBDuplicateNameRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsELBDuplicateNameRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsELBDuplicateNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether the load balancer name of a new `aws_elb` is already taken in the account. Resources recorded in the state are not reported. It is run only in deep check mode, and only when the state is read, since resources already applied cannot be told apart from new ones without it.",
+		Rationale:   "The load balancer name must be unique, so a new resource with a taken one fails only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_elb" "example" {
+  name = "existing-elb"
+}
+`,
+		Compliant: `resource "aws_elb" "example" {
+  name = "new-elb"
+}
+`,
+		Fix: "Use another load balancer name, or import the existing resource into the state by `terraform import` if it should be managed by this configuration.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsELBDuplicateNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check checks whether the load balancer name is already taken by a resource that isn't in the state
+func (r *AwsELBDuplicateNameRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkNewResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
+		var name string
+		err := runner.EvaluateExpr(attribute.Expr, &name)
+
+		return runner.EnsureNoError(err, func() error {
+			log.Printf("[DEBUG] Invoking DescribeLoadBalancers: %s", runner.Redact(name))
+			_, err := runner.AwsClientAt(attribute.Expr.Range()).ELB.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
+				LoadBalancerNames: aws.StringSlice([]string{name}),
+			})
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == elb.ErrCodeAccessPointNotFoundException {
+					return nil
+				}
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeLoadBalancers",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf("\"%s\" already exists.", name),
+				attribute.Expr.Range(),
+				runner.NewEvidence("DescribeLoadBalancers", name),
+			)
+			return nil
+		})
+	})
+}
@@ -0,0 +1,153 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsELBInvalidListenerRule checks whether listeners of classic load balancers are coherent
+type AwsELBInvalidListenerRule struct {
+	resourceType string
+	blockType    string
+}
+
+// NewAwsELBInvalidListenerRule returns new rule with default attributes
+func NewAwsELBInvalidListenerRule() *AwsELBInvalidListenerRule {
+	return &AwsELBInvalidListenerRule{
+		resourceType: "aws_elb",
+		blockType:    "listener",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsELBInvalidListenerRule) Name() string {
+	return "aws_elb_invalid_listener"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsELBInvalidListenerRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsELBInvalidListenerRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsELBInvalidListenerRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsELBInvalidListenerRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `listener` blocks of `aws_elb` use ports in the range 1-65535, and do not mix HTTP/HTTPS with TCP/SSL between `lb_protocol` and `instance_protocol`.",
+		Rationale:   "Classic Load Balancers reject such listeners, but Terraform does not validate them, so they fail only when running `terraform apply`.",
+		Violating: `resource "aws_elb" "web" {
+  listener {
+    lb_port           = 443
+    lb_protocol       = "https"
+    instance_port     = 8080
+    instance_protocol = "tcp"
+  }
+}
+`,
+		Compliant: `resource "aws_elb" "web" {
+  listener {
+    lb_port           = 443
+    lb_protocol       = "https"
+    instance_port     = 8080
+    instance_protocol = "http"
+  }
+}
+`,
+		Fix: "Use ports between 1 and 65535, and use HTTP or HTTPS for both protocols, or TCP or SSL for both protocols.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsELBInvalidListenerRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check checks listener ports are within 1-65535 and front-end/back-end protocols are the same layer
+// See https://docs.aws.amazon.com/elasticloadbalancing/latest/classic/elb-listener-config.html
+func (r *AwsELBInvalidListenerRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkResourceBlocks(r.resourceType, r.blockType, func(block *hcl.Block) error {
+		content, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{
+				{Name: "lb_port"},
+				{Name: "lb_protocol"},
+				{Name: "instance_port"},
+				{Name: "instance_protocol"},
+			},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, name := range []string{"lb_port", "instance_port"} {
+			attribute, exists := content.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			err := runner.WithExpressionContext(attribute.Expr, func() error {
+				var port int
+				err := runner.EvaluateExpr(attribute.Expr, &port)
+
+				return runner.EnsureNoError(err, func() error {
+					if port < 1 || port > 65535 {
+						runner.EmitIssue(r, fmt.Sprintf("%d is out of the port range (1-65535).", port), attribute.Expr.Range())
+					}
+					return nil
+				})
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		lbAttr, lbExists := content.Attributes["lb_protocol"]
+		instanceAttr, instanceExists := content.Attributes["instance_protocol"]
+		if !lbExists || !instanceExists {
+			return nil
+		}
+
+		var lbProtocol, instanceProtocol string
+		if err := runner.EvaluateExpr(lbAttr.Expr, &lbProtocol); err != nil {
+			return runner.EnsureNoError(err, func() error { return nil })
+		}
+		if err := runner.EvaluateExpr(instanceAttr.Expr, &instanceProtocol); err != nil {
+			return runner.EnsureNoError(err, func() error { return nil })
+		}
+
+		if isApplicationLayerProtocol(lbProtocol) != isApplicationLayerProtocol(instanceProtocol) {
+			return runner.WithExpressionContext(instanceAttr.Expr, func() error {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("instance_protocol \"%s\" cannot be used with lb_protocol \"%s\". HTTP/HTTPS and TCP/SSL cannot be mixed.", instanceProtocol, lbProtocol),
+					instanceAttr.Expr.Range(),
+				)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func isApplicationLayerProtocol(protocol string) bool {
+	switch strings.ToUpper(protocol) {
+	case "HTTP", "HTTPS":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,88 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsELBInvalidListener(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "mixed protocols",
+			Content: `
+resource "aws_elb" "main" {
+  listener {
+    lb_port           = 443
+    lb_protocol       = "https"
+    instance_port     = 8080
+    instance_protocol = "tcp"
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsELBInvalidListenerRule(),
+					Message: "instance_protocol \"tcp\" cannot be used with lb_protocol \"https\". HTTP/HTTPS and TCP/SSL cannot be mixed.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 25},
+						End:      hcl.Pos{Line: 7, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "out of range",
+			Content: `
+resource "aws_elb" "main" {
+  listener {
+    lb_port           = 0
+    lb_protocol       = "tcp"
+    instance_port     = 8080
+    instance_protocol = "ssl"
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsELBInvalidListenerRule(),
+					Message: "0 is out of the port range (1-65535).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 25},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_elb" "main" {
+  listener {
+    lb_port           = 443
+    lb_protocol       = "HTTPS"
+    instance_port     = 80
+    instance_protocol = "HTTP"
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsELBInvalidListenerRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
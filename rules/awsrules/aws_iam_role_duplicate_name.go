@@ -0,0 +1,106 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsIAMRoleDuplicateNameRule checks whether the IAM role name of a new "aws_iam_role" already exists
+type AwsIAMRoleDuplicateNameRule struct {
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsIAMRoleDuplicateNameRule returns new rule with default attributes
+func NewAwsIAMRoleDuplicateNameRule() *AwsIAMRoleDuplicateNameRule {
+	return &AwsIAMRoleDuplicateNameRule{
+		resourceType:  "aws_iam_role",
+		attributeName: "name",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsIAMRoleDuplicateNameRule) Name() string {
+	return "aws_iam_role_duplicate_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsIAMRoleDuplicateNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsIAMRoleDuplicateNameRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsIAMRoleDuplicateNameRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsIAMRoleDuplicateNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether the IAM role name of a new `aws_iam_role` is already taken in the account. Resources recorded in the state are not reported. It is run only in deep check mode, and only when the state is read, since resources already applied cannot be told apart from new ones without it.",
+		Rationale:   "The IAM role name must be unique, so a new resource with a taken one fails only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_iam_role" "example" {
+  name = "existing-role"
+}
+`,
+		Compliant: `resource "aws_iam_role" "example" {
+  name = "new-role"
+}
+`,
+		Fix: "Use another IAM role name, or import the existing resource into the state by `terraform import` if it should be managed by this configuration.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsIAMRoleDuplicateNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check checks whether the IAM role name is already taken by a resource that isn't in the state
+func (r *AwsIAMRoleDuplicateNameRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkNewResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
+		var name string
+		err := runner.EvaluateExpr(attribute.Expr, &name)
+
+		return runner.EnsureNoError(err, func() error {
+			log.Printf("[DEBUG] Invoking GetRole: %s", runner.Redact(name))
+			_, err := runner.AwsClientAt(attribute.Expr.Range()).IAM.GetRole(&iam.GetRoleInput{
+				RoleName: aws.String(name),
+			})
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok && aerr.Code() == iam.ErrCodeNoSuchEntityException {
+					return nil
+				}
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking GetRole",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf("\"%s\" already exists.", name),
+				attribute.Expr.Range(),
+				runner.NewEvidence("GetRole", name),
+			)
+			return nil
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package awsrules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsIAMRoleDuplicateName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Response error
+		Expected tflint.Issues
+	}{
+		{
+			Name: "role already exists",
+			Content: `
+resource "aws_iam_role" "role" {
+	name = "app"
+}`,
+			Response: nil,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsIAMRoleDuplicateNameRule(),
+					Message: "\"app\" already exists.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 9},
+						End:      hcl.Pos{Line: 3, Column: 14},
+					},
+				},
+			},
+		},
+		{
+			Name: "role not found",
+			Content: `
+resource "aws_iam_role" "role" {
+	name = "app"
+}`,
+			Response: awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil),
+			Expected: tflint.Issues{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := NewAwsIAMRoleDuplicateNameRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": tc.Content}, tflint.EmptyConfig(), states.NewState())
+
+		mock := client.NewMockIAMAPI(ctrl)
+		mock.EXPECT().GetRole(&iam.GetRoleInput{
+			RoleName: aws.String("app"),
+		}).Return(&iam.GetRoleOutput{}, tc.Response)
+		runner.AwsClient.IAM = mock
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_AwsIAMRoleDuplicateName_error(t *testing.T) {
+	content := `
+resource "aws_iam_role" "role" {
+	name = "app"
+}`
+	runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": content}, tflint.EmptyConfig(), states.NewState())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := client.NewMockIAMAPI(ctrl)
+	mock.EXPECT().GetRole(&iam.GetRoleInput{
+		RoleName: aws.String("app"),
+	}).Return(nil, errors.New("MissingRegion: could not find region configuration"))
+	runner.AwsClient.IAM = mock
+
+	rule := NewAwsIAMRoleDuplicateNameRule()
+	err := rule.Check(runner)
+
+	tflint.AssertAppError(t, tflint.Error{
+		Code:    tflint.ExternalAPIError,
+		Level:   tflint.ErrorLevel,
+		Message: "An error occurred while invoking GetRole",
+		Cause:   errors.New("MissingRegion: could not find region configuration"),
+	}, err)
+}
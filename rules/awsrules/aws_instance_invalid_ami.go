@@ -44,7 +44,31 @@ func (r *AwsInstanceInvalidAMIRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsInstanceInvalidAMIRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsInstanceInvalidAMIRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `ami` of `aws_instance` is an AMI available to the account in the region of the provider. It is run only in deep check mode.",
+		Rationale:   "An AMI ID of another region, a deregistered AMI or a private AMI of another account is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_instance" "web" {
+  ami           = "ami-1234abcd"
+  instance_type = "t3.micro"
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  ami           = data.aws_ami.ubuntu.id
+  instance_type = "t3.micro"
+}
+`,
+		Fix: "Use an AMI ID of the region of the provider, or look it up with the `aws_ami` data source so that it always matches the region.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsInstanceInvalidAMIRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks whether "aws_instance" has invalid AMI ID
@@ -57,8 +81,8 @@ func (r *AwsInstanceInvalidAMIRule) Check(runner *tflint.Runner) error {
 
 		return runner.EnsureNoError(err, func() error {
 			if !r.amiIDs[ami] {
-				log.Printf("[DEBUG] Fetch AMI images: %s", ami)
-				resp, err := runner.AwsClient.EC2.DescribeImages(&ec2.DescribeImagesInput{
+				log.Printf("[DEBUG] Fetch AMI images: %s", runner.Redact(ami))
+				resp, err := runner.AwsClientAt(attribute.Expr.Range()).EC2.DescribeImages(&ec2.DescribeImagesInput{
 					ImageIds: aws.StringSlice([]string{ami}),
 				})
 				if err != nil {
@@ -69,10 +93,11 @@ func (r *AwsInstanceInvalidAMIRule) Check(runner *tflint.Runner) error {
 						case "InvalidAMIID.NotFound":
 							fallthrough
 						case "InvalidAMIID.Unavailable":
-							runner.EmitIssue(
+							runner.EmitIssueWithEvidence(
 								r,
 								fmt.Sprintf("\"%s\" is invalid AMI ID.", ami),
 								attribute.Expr.Range(),
+								runner.NewEvidence("DescribeImages", ami),
 							)
 							return nil
 						}
@@ -92,10 +117,11 @@ func (r *AwsInstanceInvalidAMIRule) Check(runner *tflint.Runner) error {
 						r.amiIDs[*image.ImageId] = true
 					}
 				} else {
-					runner.EmitIssue(
+					runner.EmitIssueWithEvidence(
 						r,
 						fmt.Sprintf("\"%s\" is invalid AMI ID.", ami),
 						attribute.Expr.Range(),
+						runner.NewEvidence("DescribeImages", ami),
 					)
 				}
 			}
@@ -60,6 +60,19 @@ func NewAwsInstancePreviousTypeRule() *AwsInstancePreviousTypeRule {
 	}
 }
 
+// AddPreviousInstanceTypes adds instance types which became previous generation after the release
+func (r *AwsInstancePreviousTypeRule) AddPreviousInstanceTypes(types []string) {
+	for _, instanceType := range types {
+		r.previousInstanceTypes[instanceType] = true
+	}
+}
+
+// SetPreviousInstanceTypes replaces the previous generation instance types with the passed types
+func (r *AwsInstancePreviousTypeRule) SetPreviousInstanceTypes(types []string) {
+	r.previousInstanceTypes = map[string]bool{}
+	r.AddPreviousInstanceTypes(types)
+}
+
 // Name returns the rule name
 func (r *AwsInstancePreviousTypeRule) Name() string {
 	return "aws_instance_previous_type"
@@ -80,6 +93,28 @@ func (r *AwsInstancePreviousTypeRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsInstancePreviousTypeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows using previous generation EC2 instance types.",
+		Rationale:   "Previous generation instance types are inferior to current generation in terms of performance and fee. Unless there is a special reason, you should avoid them.",
+		Violating: `resource "aws_instance" "web" {
+  instance_type = "t1.micro"
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Fix: "Select a current generation instance type according to the upgrade paths in the EC2 documentation.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsInstancePreviousTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether the resource's `instance_type` is included in the list of previous generation instance type
 func (r *AwsInstancePreviousTypeRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
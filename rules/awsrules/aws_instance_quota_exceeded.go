@@ -0,0 +1,247 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsInstanceQuotaExceededRule checks whether new instances exceed the vCPU quotas of running On-Demand instances per instance family
+type AwsInstanceQuotaExceededRule struct {
+	resourceType  string
+	attributeName string
+	serviceCode   string
+}
+
+// instanceFamilyQuota is a quota of running On-Demand instances shared by instance families
+type instanceFamilyQuota struct {
+	code string
+	name string
+}
+
+// instanceFamilyQuotas maps instance families to their quotas
+// Families not listed here, such as high memory instances, have their own quotas per instance type and are not checked.
+var instanceFamilyQuotas = map[string]instanceFamilyQuota{
+	"a":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"c":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"d":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"h":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"i":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"m":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"r":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"t":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"z":   {code: "L-1216C47A", name: "Standard (A, C, D, H, I, M, R, T, Z)"},
+	"f":   {code: "L-74FC7D96", name: "F"},
+	"g":   {code: "L-DB2E81BA", name: "G"},
+	"p":   {code: "L-417A185B", name: "P"},
+	"x":   {code: "L-7295265B", name: "X"},
+	"inf": {code: "L-1945791B", name: "Inf"},
+}
+
+// NewAwsInstanceQuotaExceededRule returns new rule with default attributes
+func NewAwsInstanceQuotaExceededRule() *AwsInstanceQuotaExceededRule {
+	return &AwsInstanceQuotaExceededRule{
+		resourceType:  "aws_instance",
+		attributeName: "instance_type",
+		serviceCode:   "ec2",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsInstanceQuotaExceededRule) Name() string {
+	return "aws_instance_quota_exceeded"
+}
+
+// Enabled returns whether the rule is enabled by default
+// This rule is disabled by default because it requires the permission for Service Quotas
+func (r *AwsInstanceQuotaExceededRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsInstanceQuotaExceededRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsInstanceQuotaExceededRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsInstanceQuotaExceededRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether new `aws_instance` resources exceed the vCPU quota of running On-Demand instances per instance family, such as \"Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances\" quota of the account, by comparing existing and new resources with the quota from Service Quotas. Instances created by `count` and `for_each` are counted in the region of the provider of each resource. It is run only in deep check mode when the state is read, and requires the `servicequotas:GetServiceQuota` and `servicequotas:GetAWSDefaultServiceQuota` permissions.",
+		Rationale:   "Resources beyond the quota fail only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_instance" "web" {
+  count         = 100
+  instance_type = "m5.4xlarge"
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  count         = 2
+  instance_type = "m5.large"
+}
+`,
+		Fix: "Stop unused instances, use fewer or smaller instances, or request a quota increase in Service Quotas.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsInstanceQuotaExceededRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check compares vCPUs of running and new instances with the quota of their instance family
+// Quotas of On-Demand instances are measured in vCPUs, and some families share the same quota.
+// Instances whose type, `count` or `for_each` cannot be evaluated are ignored.
+func (r *AwsInstanceQuotaExceededRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	resources, err := lookupNewResourceInstances(runner, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	// Quotas and usages are per account and region, so they are looked up with the client of each resource
+	vcpus := map[*client.AwsClient]map[string]int{}
+	usages := map[*client.AwsClient]map[string]int{}
+	quotas := map[*client.AwsClient]map[string]int{}
+	requested := map[*client.AwsClient]map[string]int{}
+	for _, resource := range resources {
+		instanceType, err := r.instanceType(runner, resource.resource)
+		if err != nil {
+			return err
+		}
+		if instanceType == "" || resource.count == 0 {
+			continue
+		}
+		quota, ok := instanceFamilyQuotas[instanceFamily(instanceType)]
+		if !ok {
+			log.Printf("[DEBUG] `%s` has no quota of its instance family", runner.Redact(instanceType))
+			continue
+		}
+
+		awsClient := resource.client
+		if _, exists := vcpus[awsClient]; !exists {
+			log.Print("[DEBUG] invoking DescribeInstanceTypes")
+			sizes, err := awsClient.DescribeInstanceTypeVCPUs()
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeInstanceTypes",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			log.Print("[DEBUG] invoking DescribeInstances")
+			running, err := awsClient.DescribeRunningInstanceVCPUs()
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeInstances",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			vcpus[awsClient] = sizes
+			usages[awsClient] = map[string]int{}
+			for runningType, count := range running {
+				if runningQuota, ok := instanceFamilyQuotas[instanceFamily(runningType)]; ok {
+					usages[awsClient][runningQuota.code] += count
+				}
+			}
+			quotas[awsClient] = map[string]int{}
+			requested[awsClient] = map[string]int{}
+		}
+
+		size, ok := vcpus[awsClient][instanceType]
+		if !ok {
+			log.Printf("[DEBUG] `%s` is not found in the region", runner.Redact(instanceType))
+			continue
+		}
+
+		limit, exists := quotas[awsClient][quota.code]
+		if !exists {
+			log.Print("[DEBUG] invoking GetServiceQuota")
+			limit, err = awsClient.GetServiceQuota(r.serviceCode, quota.code)
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking GetServiceQuota",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+			quotas[awsClient][quota.code] = limit
+		}
+
+		requested[awsClient][quota.code] += size * resource.count
+		if usages[awsClient][quota.code]+requested[awsClient][quota.code] > limit {
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf(
+					"Creating this instance exceeds the quota of %d vCPUs for running On-Demand %s instances. %d vCPUs are already running.",
+					limit,
+					quota.name,
+					usages[awsClient][quota.code],
+				),
+				resource.resource.DeclRange,
+				runner.NewEvidence("GetServiceQuota", r.serviceCode, quota.code),
+			)
+		}
+	}
+
+	return nil
+}
+
+// instanceType evaluates the instance type of the resource
+// It returns an empty string if it cannot be evaluated.
+func (r *AwsInstanceQuotaExceededRule) instanceType(runner *tflint.Runner, resource *configs.Resource) (string, error) {
+	body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{
+				Name: r.attributeName,
+			},
+		},
+	})
+	if diags.HasErrors() {
+		return "", diags
+	}
+	attribute, exists := body.Attributes[r.attributeName]
+	if !exists {
+		return "", nil
+	}
+
+	var ret string
+	var instanceType string
+	err := runner.EvaluateExpr(attribute.Expr, &instanceType)
+	err = runner.EnsureNoError(err, func() error {
+		ret = instanceType
+		return nil
+	})
+	return ret, err
+}
+
+// instanceFamily returns the letters before the generation of the instance type, such as `m` of `m5.large` and `inf` of `inf1.xlarge`
+func instanceFamily(instanceType string) string {
+	family := strings.ToLower(strings.SplitN(instanceType, ".", 2)[0])
+	if i := strings.IndexFunc(family, unicode.IsDigit); i >= 0 {
+		return family[:i]
+	}
+	return family
+}
@@ -0,0 +1,125 @@
+package awsrules
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsInstanceQuotaExceeded(t *testing.T) {
+	content := `
+resource "aws_instance" "web" {
+  count         = 2
+  instance_type = "m5.large"
+}
+
+resource "aws_instance" "worker" {
+  instance_type = "c5.xlarge"
+}
+
+resource "aws_instance" "gpu" {
+  instance_type = "p3.2xlarge"
+}
+
+resource "aws_instance" "memory" {
+  instance_type = "u-6tb1.metal"
+}`
+	runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": content}, tflint.EmptyConfig(), states.NewState())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec2mock := client.NewMockEC2API(ctrl)
+	ec2mock.EXPECT().DescribeInstanceTypesPages(&ec2.DescribeInstanceTypesInput{}, gomock.Any()).DoAndReturn(
+		func(input *ec2.DescribeInstanceTypesInput, fn func(*ec2.DescribeInstanceTypesOutput, bool) bool) error {
+			fn(&ec2.DescribeInstanceTypesOutput{
+				InstanceTypes: []*ec2.InstanceTypeInfo{
+					{InstanceType: aws.String("m5.large"), VCpuInfo: &ec2.VCpuInfo{DefaultVCpus: aws.Int64(2)}},
+					{InstanceType: aws.String("c5.xlarge"), VCpuInfo: &ec2.VCpuInfo{DefaultVCpus: aws.Int64(4)}},
+					{InstanceType: aws.String("p3.2xlarge"), VCpuInfo: &ec2.VCpuInfo{DefaultVCpus: aws.Int64(8)}},
+				},
+			}, true)
+			return nil
+		},
+	)
+	ec2mock.EXPECT().DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: []*string{aws.String("running")},
+			},
+		},
+	}, gomock.Any()).DoAndReturn(
+		func(input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+			fn(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{
+					{
+						Instances: []*ec2.Instance{
+							{
+								InstanceType: aws.String("t3.xlarge"),
+								CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(2), ThreadsPerCore: aws.Int64(2)},
+							},
+						},
+					},
+				},
+			}, true)
+			return nil
+		},
+	)
+	runner.AwsClient.EC2 = ec2mock
+
+	quotaMock := client.NewMockServiceQuotasAPI(ctrl)
+	quotaMock.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String("ec2"),
+		QuotaCode:   aws.String("L-1216C47A"),
+	}).Return(&servicequotas.GetServiceQuotaOutput{
+		Quota: &servicequotas.ServiceQuota{Value: aws.Float64(10)},
+	}, nil)
+	quotaMock.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String("ec2"),
+		QuotaCode:   aws.String("L-417A185B"),
+	}).Return(&servicequotas.GetServiceQuotaOutput{
+		Quota: &servicequotas.ServiceQuota{Value: aws.Float64(8)},
+	}, nil)
+	runner.AwsClient.ServiceQuotas = quotaMock
+
+	rule := NewAwsInstanceQuotaExceededRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// 4 running vCPUs + 2 * 2 vCPUs of web fit into the standard quota, but 4 vCPUs of worker do not
+	expected := tflint.Issues{
+		{
+			Rule:    NewAwsInstanceQuotaExceededRule(),
+			Message: "Creating this instance exceeds the quota of 10 vCPUs for running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances. 4 vCPUs are already running.",
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 7, Column: 1},
+				End:      hcl.Pos{Line: 7, Column: 33},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+}
+
+func Test_instanceFamily(t *testing.T) {
+	cases := map[string]string{
+		"m5.large":     "m",
+		"inf1.xlarge":  "inf",
+		"x2gd.medium":  "x",
+		"u-6tb1.metal": "u-",
+	}
+	for instanceType, expected := range cases {
+		if got := instanceFamily(instanceType); got != expected {
+			t.Fatalf("`%s`: expected family is `%s`, but got `%s`", instanceType, expected, got)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsInvalidCidrBlockRule checks whether CIDR blocks are valid
+type AwsInvalidCidrBlockRule struct {
+	attributes map[string][]string
+	blocks     map[string][]string
+}
+
+// NewAwsInvalidCidrBlockRule returns new rule with default attributes
+func NewAwsInvalidCidrBlockRule() *AwsInvalidCidrBlockRule {
+	return &AwsInvalidCidrBlockRule{
+		attributes: map[string][]string{
+			"aws_default_subnet":                  {"cidr_block"},
+			"aws_security_group_rule":             {"cidr_blocks", "ipv6_cidr_blocks"},
+			"aws_subnet":                          {"cidr_block", "ipv6_cidr_block"},
+			"aws_vpc":                             {"cidr_block"},
+			"aws_vpc_ipv4_cidr_block_association": {"cidr_block"},
+		},
+		blocks: map[string][]string{
+			"aws_security_group": {"ingress", "egress"},
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsInvalidCidrBlockRule) Name() string {
+	return "aws_invalid_cidr_block"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsInvalidCidrBlockRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsInvalidCidrBlockRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsInvalidCidrBlockRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsInvalidCidrBlockRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether CIDR blocks of VPCs, subnets and security groups can be parsed and have no host bits, such as `10.0.1.0/16`.",
+		Rationale:   "AWS rejects malformed CIDR blocks only when running `terraform apply`. A CIDR block with host bits is usually a typo of the prefix length or the address.",
+		Violating: `resource "aws_subnet" "private" {
+  cidr_block = "10.0.1.0/16"
+}
+`,
+		Compliant: `resource "aws_subnet" "private" {
+  cidr_block = "10.0.1.0/24"
+}
+`,
+		Fix: "Fix the address or the prefix length so that the host bits are zero. The issue message suggests the network address of the CIDR block.",
+	}
+}
+
+// Check checks whether CIDR blocks can be parsed and have no host bits
+func (r *AwsInvalidCidrBlockRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for resourceType, attributes := range r.attributes {
+		for _, attributeName := range attributes {
+			err := runner.WalkResourceAttributes(resourceType, attributeName, func(attribute *hcl.Attribute) error {
+				return eachCidrBlock(runner, attribute, func(cidr string, expr hcl.Expression) {
+					r.checkCidrBlock(runner, cidr, expr)
+				})
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for resourceType, blocks := range r.blocks {
+		for _, blockType := range blocks {
+			err := walkSecurityGroupCidrBlocks(runner, resourceType, blockType, func(cidr string, expr hcl.Expression) {
+				r.checkCidrBlock(runner, cidr, expr)
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsInvalidCidrBlockRule) checkCidrBlock(runner *tflint.Runner, cidr string, expr hcl.Expression) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		runner.EmitIssue(r, fmt.Sprintf("\"%s\" is an invalid CIDR block.", cidr), expr.Range())
+		return
+	}
+
+	if !ip.Equal(network.IP) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is an invalid CIDR block. Did you mean \"%s\"?", cidr, network.String()),
+			expr.Range(),
+		)
+	}
+}
+
+// eachCidrBlock evaluates the attribute as a CIDR block or a list of CIDR blocks, and passes each of them to the function
+func eachCidrBlock(runner *tflint.Runner, attribute *hcl.Attribute, proc func(cidr string, expr hcl.Expression)) error {
+	if strings.HasSuffix(attribute.Name, "_blocks") {
+		return runner.EachStringSliceExprs(attribute.Expr, proc)
+	}
+
+	var cidr string
+	err := runner.EvaluateExpr(attribute.Expr, &cidr)
+	return runner.EnsureNoError(err, func() error {
+		proc(cidr, attribute.Expr)
+		return nil
+	})
+}
+
+// walkSecurityGroupCidrBlocks walks CIDR blocks of inline rules of security groups
+func walkSecurityGroupCidrBlocks(runner *tflint.Runner, resourceType, blockType string, proc func(cidr string, expr hcl.Expression)) error {
+	return runner.WalkResourceBlocks(resourceType, blockType, func(block *hcl.Block) error {
+		content, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{
+				{Name: "cidr_blocks"},
+				{Name: "ipv6_cidr_blocks"},
+			},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, attribute := range content.Attributes {
+			err := runner.WithExpressionContext(attribute.Expr, func() error {
+				return runner.EachStringSliceExprs(attribute.Expr, proc)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
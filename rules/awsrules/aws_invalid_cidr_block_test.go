@@ -0,0 +1,83 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsInvalidCidrBlock(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "invalid syntax",
+			Content: `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/33"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsInvalidCidrBlockRule(),
+					Message: "\"10.0.0.0/33\" is an invalid CIDR block.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 16},
+						End:      hcl.Pos{Line: 3, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "host bits are set",
+			Content: `
+resource "aws_security_group" "main" {
+  ingress {
+    from_port   = 443
+    to_port     = 443
+    protocol    = "tcp"
+    cidr_blocks = ["10.0.0.0/16", "10.1.2.0/16"]
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsInvalidCidrBlockRule(),
+					Message: "\"10.1.2.0/16\" is an invalid CIDR block. Did you mean \"10.1.0.0/16\"?",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 35},
+						End:      hcl.Pos{Line: 7, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_subnet" "main" {
+  cidr_block      = "10.0.1.0/24"
+  ipv6_cidr_block = "2600:1f18:abcd:1200::/64"
+}
+
+resource "aws_security_group_rule" "main" {
+  cidr_blocks = ["0.0.0.0/0"]
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsInvalidCidrBlockRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -44,7 +44,31 @@ func (r *AwsLaunchConfigurationInvalidImageIDRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsLaunchConfigurationInvalidImageIDRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsLaunchConfigurationInvalidImageIDRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `image_id` of `aws_launch_configuration` is an AMI available to the account in the region of the provider. It is run only in deep check mode.",
+		Rationale:   "An AMI ID of another region, a deregistered AMI or a private AMI of another account is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_launch_configuration" "web" {
+  image_id      = "ami-1234abcd"
+  instance_type = "t3.micro"
+}
+`,
+		Compliant: `resource "aws_launch_configuration" "web" {
+  image_id      = data.aws_ami.ubuntu.id
+  instance_type = "t3.micro"
+}
+`,
+		Fix: "Use an AMI ID of the region of the provider, or look it up with the `aws_ami` data source so that it always matches the region.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsLaunchConfigurationInvalidImageIDRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks whether "aws_instance" has invalid AMI ID
@@ -57,8 +81,8 @@ func (r *AwsLaunchConfigurationInvalidImageIDRule) Check(runner *tflint.Runner)
 
 		return runner.EnsureNoError(err, func() error {
 			if !r.amiIDs[ami] {
-				log.Printf("[DEBUG] Fetch AMI images: %s", ami)
-				resp, err := runner.AwsClient.EC2.DescribeImages(&ec2.DescribeImagesInput{
+				log.Printf("[DEBUG] Fetch AMI images: %s", runner.Redact(ami))
+				resp, err := runner.AwsClientAt(attribute.Expr.Range()).EC2.DescribeImages(&ec2.DescribeImagesInput{
 					ImageIds: aws.StringSlice([]string{ami}),
 				})
 				if err != nil {
@@ -69,10 +93,11 @@ func (r *AwsLaunchConfigurationInvalidImageIDRule) Check(runner *tflint.Runner)
 						case "InvalidAMIID.NotFound":
 							fallthrough
 						case "InvalidAMIID.Unavailable":
-							runner.EmitIssue(
+							runner.EmitIssueWithEvidence(
 								r,
 								fmt.Sprintf("\"%s\" is invalid image ID.", ami),
 								attribute.Expr.Range(),
+								runner.NewEvidence("DescribeImages", ami),
 							)
 							return nil
 						}
@@ -92,10 +117,11 @@ func (r *AwsLaunchConfigurationInvalidImageIDRule) Check(runner *tflint.Runner)
 						r.amiIDs[*image.ImageId] = true
 					}
 				} else {
-					runner.EmitIssue(
+					runner.EmitIssueWithEvidence(
 						r,
 						fmt.Sprintf("\"%s\" is invalid image ID.", ami),
 						attribute.Expr.Range(),
+						runner.NewEvidence("DescribeImages", ami),
 					)
 				}
 			}
@@ -42,7 +42,29 @@ func (r *AwsMqConfigurationInvalidEngineTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsMqConfigurationInvalidEngineTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsMqConfigurationInvalidEngineTypeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `engine_type` of `aws_mq_configuration` is `ActiveMQ`, which is case-sensitive.",
+		Rationale:   "Terraform does not validate engine types, so an invalid one is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_mq_configuration" "config" {
+  engine_type = "activemq"
+}
+`,
+		Compliant: `resource "aws_mq_configuration" "config" {
+  engine_type = "ActiveMQ"
+}
+`,
+		Fix: "Set `engine_type` to `ActiveMQ`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsMqConfigurationInvalidEngineTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -0,0 +1,110 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsProviderInvalidRegionRule checks whether the region of `aws` provider blocks is valid
+type AwsProviderInvalidRegionRule struct {
+	providerName  string
+	attributeName string
+	regions       []string
+}
+
+// NewAwsProviderInvalidRegionRule returns new rule with default attributes
+func NewAwsProviderInvalidRegionRule() *AwsProviderInvalidRegionRule {
+	return &AwsProviderInvalidRegionRule{
+		providerName:  "aws",
+		attributeName: "region",
+	}
+}
+
+// SetRegions replaces the endpoints data with the passed regions
+func (r *AwsProviderInvalidRegionRule) SetRegions(regions []string) {
+	r.regions = regions
+}
+
+// Name returns the rule name
+func (r *AwsProviderInvalidRegionRule) Name() string {
+	return "aws_provider_invalid_region"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsProviderInvalidRegionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsProviderInvalidRegionRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsProviderInvalidRegionRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsProviderInvalidRegionRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `region` of `aws` provider blocks is a region known to the AWS SDK, or listed in `data_files` of the config.",
+		Rationale:   "The provider fails with an invalid region when running `terraform plan`, and every resource of the provider is affected.",
+		Violating: `provider "aws" {
+  region = "us-east-l"
+}
+`,
+		Compliant: `provider "aws" {
+  region = "us-east-1"
+}
+`,
+		Fix: "Fix the region name. If the region is new and unknown to TFLint, add it to the regions dataset by `data_files`.",
+	}
+}
+
+// Check checks whether the region exists in the endpoints data bundled with the AWS SDK, or the regions in `data_files`
+func (r *AwsProviderInvalidRegionRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for _, provider := range runner.TFConfig.Module.ProviderConfigs {
+		if provider.Name != r.providerName {
+			continue
+		}
+
+		content, _, diags := provider.Config.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{{Name: r.attributeName}},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		attribute, exists := content.Attributes[r.attributeName]
+		if !exists {
+			continue
+		}
+
+		err := runner.WithExpressionContext(attribute.Expr, func() error {
+			var region string
+			err := runner.EvaluateExpr(attribute.Expr, &region)
+
+			return runner.EnsureNoError(err, func() error {
+				if !isKnownRegion(region, r.regions) {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" is an invalid region.", region),
+						attribute.Expr.Range(),
+					)
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsProviderInvalidRegion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "invalid region",
+			Content: `
+provider "aws" {
+  region = "us-east-3"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsProviderInvalidRegionRule(),
+					Message: "\"us-east-3\" is an invalid region.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 3, Column: 23},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid region with alias",
+			Content: `
+provider "aws" {
+  alias  = "gov"
+  region = "us-gov-west-1"
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsProviderInvalidRegionRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
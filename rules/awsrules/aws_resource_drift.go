@@ -0,0 +1,185 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// AwsResourceDriftRule checks whether configured attributes differ from the values recorded in the state
+// It is a rule family. Each rule compares the attributes of a resource type, such as `aws_instance_drift`.
+type AwsResourceDriftRule struct {
+	name         string
+	resourceType string
+	attributes   []string
+
+	// exampleValues are a configured value and a value changed outside Terraform of the first attribute, used in the documentation
+	exampleValues [2]string
+}
+
+type awsResourceDriftRuleConfig struct {
+	IgnoreAttributes []string `hcl:"ignore_attributes,optional"`
+}
+
+// NewAwsInstanceDriftRule returns a drift rule for aws_instance
+func NewAwsInstanceDriftRule() *AwsResourceDriftRule {
+	return &AwsResourceDriftRule{
+		name:          "aws_instance_drift",
+		resourceType:  "aws_instance",
+		attributes:    []string{"ami", "instance_type", "key_name", "ebs_optimized", "monitoring"},
+		exampleValues: [2]string{"ami-12345678", "ami-87654321"},
+	}
+}
+
+// NewAwsDBInstanceDriftRule returns a drift rule for aws_db_instance
+func NewAwsDBInstanceDriftRule() *AwsResourceDriftRule {
+	return &AwsResourceDriftRule{
+		name:          "aws_db_instance_drift",
+		resourceType:  "aws_db_instance",
+		attributes:    []string{"instance_class", "engine_version", "allocated_storage", "multi_az", "backup_retention_period"},
+		exampleValues: [2]string{"db.t3.micro", "db.t3.large"},
+	}
+}
+
+// NewAwsElastiCacheClusterDriftRule returns a drift rule for aws_elasticache_cluster
+func NewAwsElastiCacheClusterDriftRule() *AwsResourceDriftRule {
+	return &AwsResourceDriftRule{
+		name:          "aws_elasticache_cluster_drift",
+		resourceType:  "aws_elasticache_cluster",
+		attributes:    []string{"node_type", "engine_version", "num_cache_nodes"},
+		exampleValues: [2]string{"cache.t3.micro", "cache.t3.medium"},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceDriftRule) Name() string {
+	return r.name
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceDriftRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceDriftRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+// All rules of the family share the same document.
+func (r *AwsResourceDriftRule) Link() string {
+	return tflint.ReferenceLink("aws_resource_drift")
+}
+
+// Doc returns the rule documentation
+// The examples are written for the first attribute compared by the rule.
+func (r *AwsResourceDriftRule) Doc() *tflint.RuleDoc {
+	attribute := r.attributes[0]
+	example := func(value string) string {
+		return fmt.Sprintf("# `%s` is \"%s\" in the state\nresource \"%s\" \"example\" {\n  %s = \"%s\"\n}\n", attribute, r.exampleValues[1], r.resourceType, attribute, value)
+	}
+
+	return &tflint.RuleDoc{
+		Description: fmt.Sprintf("Compares `%s` of `%s` with the values recorded in the state, and warns about differences. Resources with `count` or `for_each` are skipped. It is run only in deep check mode.", strings.Join(r.attributes, "`, `"), r.resourceType),
+		Rationale:   "Attributes changed outside Terraform, such as in the console or by automation, are silently reverted by the next apply, or the configuration no longer describes what is actually running.",
+		Violating:   example(r.exampleValues[0]),
+		Compliant:   example(r.exampleValues[1]),
+		Fix:         "If the change outside Terraform should be kept, update the configuration to the recorded value. Otherwise, run `terraform apply` to revert it. Attributes which are intentionally managed outside Terraform can be excluded by `ignore_attributes`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsResourceDriftRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *AwsResourceDriftRule) DefaultConfig() interface{} {
+	return awsResourceDriftRuleConfig{}
+}
+
+// Check compares configured attributes with the values recorded in the state
+// Resources with `count` or `for_each` are skipped because instances cannot be matched with the configuration statically.
+// Resources not recorded in the state yet and attributes omitted in the configuration are also skipped.
+func (r *AwsResourceDriftRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	state := runner.State()
+	if state == nil {
+		log.Printf("[DEBUG] Skip `%s` rule because the state is not loaded", r.Name())
+		return nil
+	}
+
+	config := r.DefaultConfig().(awsResourceDriftRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	attributes := []string{}
+	for _, name := range r.attributes {
+		if !stringInSlice(name, config.IgnoreAttributes) {
+			attributes = append(attributes, name)
+		}
+	}
+
+	schema := &hcl.BodySchema{}
+	for _, name := range attributes {
+		schema.Attributes = append(schema.Attributes, hcl.AttributeSchema{Name: name})
+	}
+
+	for _, resource := range runner.LookupResourcesByType(r.resourceType) {
+		if resource.Count != nil || resource.ForEach != nil {
+			log.Printf("[DEBUG] Skip `%s` because it has multiple instances", resource.Addr())
+			continue
+		}
+		object := state.Lookup(resource.Addr().String())
+		if object == nil {
+			continue
+		}
+
+		body, _, diags := resource.Config.PartialContent(schema)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		for _, name := range attributes {
+			attribute, exists := body.Attributes[name]
+			if !exists {
+				continue
+			}
+
+			recorded, err := object.Attr(name)
+			if err != nil {
+				log.Printf("[DEBUG] Skip `%s.%s`: %s", resource.Addr(), name, err)
+				continue
+			}
+			recorded, err = convert.Convert(recorded, cty.String)
+			if err != nil || recorded.IsNull() {
+				continue
+			}
+
+			var configured string
+			err = runner.EvaluateExpr(attribute.Expr, &configured)
+			err = runner.EnsureNoError(err, func() error {
+				if configured != recorded.AsString() {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("`%s` is \"%s\" in the state, but \"%s\" is configured. It may have been changed outside Terraform", name, recorded.AsString(), configured),
+						attribute.Expr.Range(),
+					)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
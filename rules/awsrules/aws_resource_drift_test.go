@@ -0,0 +1,117 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsInstanceDrift(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "web",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"ami":"ami-1234","instance_type":"t2.large","monitoring":true,"key_name":null}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+	})
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		State    *states.State
+		Expected tflint.Issues
+	}{
+		{
+			Name: "drifted",
+			Content: `
+resource "aws_instance" "web" {
+  ami           = "ami-1234"
+  instance_type = "t2.micro"
+  monitoring    = false
+  key_name      = "admin"
+}`,
+			State: state,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsInstanceDriftRule(),
+					Message: "`instance_type` is \"t2.large\" in the state, but \"t2.micro\" is configured. It may have been changed outside Terraform",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 19},
+						End:      hcl.Pos{Line: 4, Column: 29},
+					},
+				},
+				{
+					Rule:    NewAwsInstanceDriftRule(),
+					Message: "`monitoring` is \"true\" in the state, but \"false\" is configured. It may have been changed outside Terraform",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 5, Column: 19},
+						End:      hcl.Pos{Line: 5, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "ignored attributes",
+			Content: `
+resource "aws_instance" "web" {
+  ami           = "ami-1234"
+  instance_type = "t2.micro"
+  monitoring    = false
+}`,
+			Config: `
+rule "aws_instance_drift" {
+  enabled           = true
+  ignore_attributes = ["instance_type", "monitoring"]
+}`,
+			State:    state,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "not recorded",
+			Content: `
+resource "aws_instance" "new" {
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "web" {
+  count         = 2
+  instance_type = "t2.micro"
+}`,
+			State:    state,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "state is not loaded",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsInstanceDriftRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config), tc.State)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
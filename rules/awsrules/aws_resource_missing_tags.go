@@ -297,9 +297,43 @@ func (r *AwsResourceMissingTagsRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsResourceMissingTagsRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Requires the tags listed in `tags` of the rule config on all AWS resources which support tags. Tags of `aws_autoscaling_group` are read from `tag` blocks and the `tags` attribute.",
+		Rationale:   "A standardized set of tags is the basis of cost allocation, access control and automation. Resources missing them are easily overlooked.",
+		Config: `rule "aws_resource_missing_tags" {
+  enabled = true
+  tags    = ["Environment"]
+}
+`,
+		Violating: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+  tags = {
+    Name = "web"
+  }
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+  tags = {
+    Name        = "web"
+    Environment = "production"
+  }
+}
+`,
+		Fix: "Add the missing tags to the resource. Exclude resource types which cannot be tagged in your environment by `exclude`.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *AwsResourceMissingTagsRule) DefaultConfig() interface{} {
+	return awsResourceTagsRuleConfig{}
+}
+
 // Check checks resources for missing tags
 func (r *AwsResourceMissingTagsRule) Check(runner *tflint.Runner) error {
-	config := awsResourceTagsRuleConfig{}
+	config := r.DefaultConfig().(awsResourceTagsRuleConfig)
 	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
 		return err
 	}
@@ -0,0 +1,283 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// AwsResourceTagConsistencyRule checks whether tags are propagated and spelled consistently
+type AwsResourceTagConsistencyRule struct {
+	resourceTypes []string
+}
+
+type awsResourceTagConsistencyRuleConfig struct {
+	Tags []string `hcl:"tags,optional"`
+}
+
+// tagKey is a statically determined tag key and its location
+type tagKey struct {
+	name     string
+	location hcl.Range
+}
+
+// NewAwsResourceTagConsistencyRule returns new rule with default attributes
+func NewAwsResourceTagConsistencyRule() *AwsResourceTagConsistencyRule {
+	return &AwsResourceTagConsistencyRule{
+		// Shares the resource types supporting tags with aws_resource_missing_tags
+		resourceTypes: NewAwsResourceMissingTagsRule().resourceTypes,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceTagConsistencyRule) Name() string {
+	return "aws_resource_tag_consistency"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceTagConsistencyRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceTagConsistencyRule) Severity() string {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceTagConsistencyRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *AwsResourceTagConsistencyRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks that tag keys are spelled consistently across AWS resources, that tags set by `default_tags` of the provider are not repeated, and that tags listed in `tags` of the rule config are propagated at launch in autoscaling groups.",
+		Rationale:   "Tag keys are case-sensitive, so `environment` and `Environment` are different tags for cost allocation and access control. Repeated default tags hide which value wins.",
+		Violating: `resource "aws_instance" "web" {
+  tags = {
+    Environment = "production"
+  }
+}
+
+resource "aws_instance" "db" {
+  tags = {
+    environment = "production"
+  }
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  tags = {
+    Environment = "production"
+  }
+}
+
+resource "aws_instance" "db" {
+  tags = {
+    Environment = "production"
+  }
+}
+`,
+		Fix: "Use the spelling of the key used in other resources, remove tags already set by `default_tags`, and set `propagate_at_launch` to true for the listed tags.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *AwsResourceTagConsistencyRule) DefaultConfig() interface{} {
+	return awsResourceTagConsistencyRuleConfig{}
+}
+
+// Check checks propagation of required tags in autoscaling groups, duplication of provider's default_tags,
+// and case-sensitivity drift of tag keys in the module
+func (r *AwsResourceTagConsistencyRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(awsResourceTagConsistencyRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	if err := r.checkPropagateAtLaunch(runner, config); err != nil {
+		return err
+	}
+
+	defaultTags := map[string]bool{}
+	for _, key := range r.defaultTagKeys(runner) {
+		defaultTags[key.name] = true
+	}
+
+	keys := []tagKey{}
+	for _, resourceType := range r.resourceTypes {
+		for _, resource := range runner.LookupResourcesByType(resourceType) {
+			content, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+				Attributes: []hcl.AttributeSchema{{Name: tagsAttributeName}},
+			})
+			if diags.HasErrors() {
+				return diags
+			}
+			attribute, exists := content.Attributes[tagsAttributeName]
+			if !exists {
+				continue
+			}
+
+			for _, key := range staticMapKeys(attribute.Expr) {
+				if defaultTags[key.name] {
+					err := runner.WithExpressionContext(attribute.Expr, func() error {
+						runner.EmitIssue(r, fmt.Sprintf("\"%s\" is already set by default_tags of the provider.", key.name), key.location)
+						return nil
+					})
+					if err != nil {
+						return err
+					}
+				}
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	r.checkCaseDrift(runner, keys)
+
+	return nil
+}
+
+// checkPropagateAtLaunch checks whether required tags in aws_autoscaling_group have `propagate_at_launch = true`
+func (r *AwsResourceTagConsistencyRule) checkPropagateAtLaunch(runner *tflint.Runner, config awsResourceTagConsistencyRuleConfig) error {
+	return runner.WalkResourceBlocks("aws_autoscaling_group", tagBlockName, func(block *hcl.Block) error {
+		content, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{
+				{Name: "key"},
+				{Name: "propagate_at_launch"},
+			},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+		keyAttr, exists := content.Attributes["key"]
+		if !exists {
+			return nil
+		}
+
+		return runner.WithExpressionContext(keyAttr.Expr, func() error {
+			var key string
+			err := runner.EvaluateExpr(keyAttr.Expr, &key)
+
+			return runner.EnsureNoError(err, func() error {
+				if !stringInSlice(key, config.Tags) {
+					return nil
+				}
+
+				propagateAttr, exists := content.Attributes["propagate_at_launch"]
+				if !exists {
+					runner.EmitIssue(r, fmt.Sprintf("\"%s\" tag is not propagated at launch.", key), block.DefRange)
+					return nil
+				}
+
+				var propagate string
+				err := runner.EvaluateExpr(propagateAttr.Expr, &propagate)
+				return runner.EnsureNoError(err, func() error {
+					if propagate != "true" {
+						runner.EmitIssue(r, fmt.Sprintf("\"%s\" tag is not propagated at launch.", key), propagateAttr.Expr.Range())
+					}
+					return nil
+				})
+			})
+		})
+	})
+}
+
+// defaultTagKeys returns keys in `default_tags` of the root module's aws provider blocks
+func (r *AwsResourceTagConsistencyRule) defaultTagKeys(runner *tflint.Runner) []tagKey {
+	keys := []tagKey{}
+
+	for _, provider := range runner.TFConfig.Root.Module.ProviderConfigs {
+		if provider.Name != "aws" || provider.Alias != "" {
+			continue
+		}
+
+		content, _, diags := provider.Config.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{{Type: "default_tags"}},
+		})
+		if diags.HasErrors() {
+			continue
+		}
+		for _, block := range content.Blocks {
+			attributes, diags := block.Body.JustAttributes()
+			if diags.HasErrors() {
+				continue
+			}
+			if attribute, exists := attributes[tagsAttributeName]; exists {
+				keys = append(keys, staticMapKeys(attribute.Expr)...)
+			}
+		}
+	}
+
+	return keys
+}
+
+// checkCaseDrift emits issues on keys whose spelling differs from the most used one only in case
+func (r *AwsResourceTagConsistencyRule) checkCaseDrift(runner *tflint.Runner, keys []tagKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i].location, keys[j].location
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Start.Byte < b.Start.Byte
+	})
+
+	spellings := map[string]map[string]int{}
+	for _, key := range keys {
+		normalized := strings.ToLower(key.name)
+		if spellings[normalized] == nil {
+			spellings[normalized] = map[string]int{}
+		}
+		spellings[normalized][key.name]++
+	}
+
+	canonical := map[string]string{}
+	for normalized, counts := range spellings {
+		names := []string{}
+		for name := range counts {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if counts[names[i]] != counts[names[j]] {
+				return counts[names[i]] > counts[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		canonical[normalized] = names[0]
+	}
+
+	for _, key := range keys {
+		if expected := canonical[strings.ToLower(key.name)]; expected != key.name {
+			runner.EmitIssue(r, fmt.Sprintf("\"%s\" is inconsistent with \"%s\" used in other resources.", key.name, expected), key.location)
+		}
+	}
+}
+
+// staticMapKeys returns keys of a map/object constructor expression which can be determined without evaluation
+func staticMapKeys(expr hcl.Expression) []tagKey {
+	pairs, diags := hcl.ExprMap(expr)
+	if diags.HasErrors() {
+		return []tagKey{}
+	}
+
+	keys := []tagKey{}
+	for _, pair := range pairs {
+		if keyword := hcl.ExprAsKeyword(pair.Key); keyword != "" {
+			keys = append(keys, tagKey{name: keyword, location: pair.Key.Range()})
+			continue
+		}
+		val, diags := pair.Key.Value(nil)
+		if diags.HasErrors() || !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+			continue
+		}
+		keys = append(keys, tagKey{name: val.AsString(), location: pair.Key.Range()})
+	}
+	return keys
+}
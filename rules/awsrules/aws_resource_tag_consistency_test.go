@@ -0,0 +1,188 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsResourceTagConsistency(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "required tag is not propagated at launch",
+			Content: `
+resource "aws_autoscaling_group" "asg" {
+  tag {
+    key                 = "Environment"
+    value               = "production"
+    propagate_at_launch = false
+  }
+  tag {
+    key   = "Owner"
+    value = "platform"
+  }
+  tag {
+    key                 = "Name"
+    value               = "app"
+    propagate_at_launch = false
+  }
+}`,
+			Config: `
+rule "aws_resource_tag_consistency" {
+  enabled = true
+  tags = ["Environment", "Owner"]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsResourceTagConsistencyRule(),
+					Message: "\"Environment\" tag is not propagated at launch.",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 6, Column: 27},
+						End:      hcl.Pos{Line: 6, Column: 32},
+					},
+				},
+				{
+					Rule:    NewAwsResourceTagConsistencyRule(),
+					Message: "\"Owner\" tag is not propagated at launch.",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 8, Column: 3},
+						End:      hcl.Pos{Line: 8, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "required tag is propagated at launch",
+			Content: `
+resource "aws_autoscaling_group" "asg" {
+  tag {
+    key                 = "Environment"
+    value               = "production"
+    propagate_at_launch = true
+  }
+}`,
+			Config: `
+rule "aws_resource_tag_consistency" {
+  enabled = true
+  tags = ["Environment"]
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "tag is duplicated with default_tags",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+
+  default_tags {
+    tags = {
+      Owner = "platform"
+    }
+  }
+}
+
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+  tags = {
+    Name  = "web"
+    Owner = "platform"
+  }
+}`,
+			Config: `
+rule "aws_resource_tag_consistency" {
+  enabled = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsResourceTagConsistencyRule(),
+					Message: "\"Owner\" is already set by default_tags of the provider.",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 16, Column: 5},
+						End:      hcl.Pos{Line: 16, Column: 10},
+					},
+				},
+			},
+		},
+		{
+			Name: "inconsistent tag key case",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+  tags = {
+    Environment = "production"
+  }
+}
+
+resource "aws_instance" "db" {
+  instance_type = "t2.micro"
+  tags = {
+    "environment" = "production"
+  }
+}
+
+resource "aws_s3_bucket" "assets" {
+  tags = {
+    Environment = "production"
+  }
+}`,
+			Config: `
+rule "aws_resource_tag_consistency" {
+  enabled = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsResourceTagConsistencyRule(),
+					Message: "\"environment\" is inconsistent with \"Environment\" used in other resources.",
+					Range: hcl.Range{
+						Filename: "module.tf",
+						Start:    hcl.Pos{Line: 12, Column: 5},
+						End:      hcl.Pos{Line: 12, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "consistent tag keys",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+  tags = {
+    Environment = "production"
+  }
+}
+
+resource "aws_instance" "db" {
+  instance_type = "t2.micro"
+  tags = {
+    Environment = "staging"
+  }
+}`,
+			Config: `
+rule "aws_resource_tag_consistency" {
+  enabled = true
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsResourceTagConsistencyRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"module.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
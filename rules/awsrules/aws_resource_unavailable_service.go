@@ -0,0 +1,194 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsResourceUnavailableServiceRule checks whether the service of each resource is available in the provider's region
+// Availability is based on the endpoints data bundled with the AWS SDK, unless the `services` dataset is overridden by `data_files`
+type AwsResourceUnavailableServiceRule struct {
+	// services maps resource type prefixes to service IDs in the endpoints data
+	// Global services (e.g. IAM) and services sharing another service's endpoints (e.g. Neptune) are not listed
+	services map[string]string
+	// availability maps service IDs to regions where they are available. If nil, the endpoints data is used
+	availability map[string][]string
+}
+
+// NewAwsResourceUnavailableServiceRule returns new rule with default attributes
+func NewAwsResourceUnavailableServiceRule() *AwsResourceUnavailableServiceRule {
+	return &AwsResourceUnavailableServiceRule{
+		services: map[string]string{
+			"aws_appmesh_":           "appmesh",
+			"aws_appsync_":           "appsync",
+			"aws_athena_":            "athena",
+			"aws_backup_":            "backup",
+			"aws_batch_":             "batch",
+			"aws_cloud9_":            "cloud9",
+			"aws_codebuild_":         "codebuild",
+			"aws_codecommit_":        "codecommit",
+			"aws_codedeploy_":        "codedeploy",
+			"aws_codepipeline":       "codepipeline",
+			"aws_cognito_identity_":  "cognito-identity",
+			"aws_cognito_user_pool":  "cognito-idp",
+			"aws_datasync_":          "datasync",
+			"aws_dax_":               "dax",
+			"aws_directory_service_": "ds",
+			"aws_dx_":                "directconnect",
+			"aws_ecs_":               "ecs",
+			"aws_efs_":               "elasticfilesystem",
+			"aws_elasticache_":       "elasticache",
+			"aws_elasticsearch_":     "es",
+			"aws_fsx_":               "fsx",
+			"aws_gamelift_":          "gamelift",
+			"aws_glue_":              "glue",
+			"aws_guardduty_":         "guardduty",
+			"aws_iot_":               "iot",
+			"aws_kinesis_analytics_": "kinesisanalytics",
+			"aws_lambda_":            "lambda",
+			"aws_lightsail_":         "lightsail",
+			"aws_mq_":                "mq",
+			"aws_msk_":               "kafka",
+			"aws_qldb_":              "qldb",
+			"aws_redshift_":          "redshift",
+			"aws_sagemaker_":         "api.sagemaker",
+			"aws_securityhub_":       "securityhub",
+			"aws_transfer_":          "transfer",
+			"aws_workspaces_":        "workspaces",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *AwsResourceUnavailableServiceRule) Name() string {
+	return "aws_resource_unavailable_service"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsResourceUnavailableServiceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsResourceUnavailableServiceRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsResourceUnavailableServiceRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsResourceUnavailableServiceRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether resources use services which are not available in the region of their provider, according to the endpoints data of the AWS SDK or the `services` dataset of `data_files`.",
+		Rationale:   "Resources of unavailable services fail only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `provider "aws" {
+  region = "ap-northeast-3"
+}
+
+resource "aws_appsync_graphql_api" "api" {
+  name                = "api"
+  authentication_type = "API_KEY"
+}
+`,
+		Compliant: `provider "aws" {
+  region = "ap-northeast-1"
+}
+
+resource "aws_appsync_graphql_api" "api" {
+  name                = "api"
+  authentication_type = "API_KEY"
+}
+`,
+		Fix: "Create the resource in a region where the service is available, for example with a provider alias, or use another service.",
+	}
+}
+
+// SetServices replaces the endpoints data with the dataset declared in `data_files`
+// Services not listed in the dataset are not checked.
+func (r *AwsResourceUnavailableServiceRule) SetServices(services map[string][]string) {
+	r.availability = services
+}
+
+// Check checks whether resources request services which are not available in the region of their provider
+func (r *AwsResourceUnavailableServiceRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	// Services of the endpoints data are looked up once per region. nil means the region is unknown
+	partitions := map[string]map[string]endpoints.Service{}
+
+	for _, resource := range runner.TFConfig.Module.ManagedResources {
+		serviceID := r.lookupService(resource.Type)
+		if serviceID == "" {
+			continue
+		}
+		region := runner.AwsRegionAt(resource.DeclRange)
+		if region == "" {
+			log.Printf("[DEBUG] The region of `%s` cannot be determined. Skipped", resource.Addr())
+			continue
+		}
+
+		available, known := r.isAvailable(serviceID, region, partitions, runner)
+		if known && !available {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is not available in the %s region.", resource.Type, region),
+				resource.DeclRange,
+			)
+		}
+	}
+
+	return nil
+}
+
+// isAvailable returns whether the service is available in the region
+// The second return value is false if the availability is not known, such as for unknown regions.
+func (r *AwsResourceUnavailableServiceRule) isAvailable(serviceID string, region string, partitions map[string]map[string]endpoints.Service, runner *tflint.Runner) (bool, bool) {
+	if r.availability != nil {
+		regions, ok := r.availability[serviceID]
+		if !ok {
+			return false, false
+		}
+		for _, available := range regions {
+			if available == region {
+				return true, true
+			}
+		}
+		return false, true
+	}
+
+	services, ok := partitions[region]
+	if !ok {
+		if partition, found := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); found {
+			services = partition.Services()
+		} else {
+			log.Printf("[DEBUG] `%s` is an unknown region. Skipped", runner.Redact(region))
+		}
+		partitions[region] = services
+	}
+	if services == nil {
+		return false, false
+	}
+	service, ok := services[serviceID]
+	if !ok {
+		return false, false
+	}
+	_, available := service.Regions()[region]
+	return available, true
+}
+
+func (r *AwsResourceUnavailableServiceRule) lookupService(resourceType string) string {
+	matched := ""
+	for prefix := range r.services {
+		if strings.HasPrefix(resourceType, prefix) && len(prefix) > len(matched) {
+			matched = prefix
+		}
+	}
+	return r.services[matched]
+}
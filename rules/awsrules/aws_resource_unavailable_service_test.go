@@ -0,0 +1,162 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsResourceUnavailableService(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Region   string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name:   "service is not available",
+			Region: "sa-east-1",
+			Content: `
+resource "aws_qldb_ledger" "ledger" {
+  name = "ledger"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsResourceUnavailableServiceRule(),
+					Message: "\"aws_qldb_ledger\" is not available in the sa-east-1 region.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name:   "service is available",
+			Region: "us-east-1",
+			Content: `
+resource "aws_qldb_ledger" "ledger" {
+  name = "ledger"
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name:   "region from provider block",
+			Region: "",
+			Content: `
+provider "aws" {
+  region = "sa-east-1"
+}
+
+resource "aws_qldb_ledger" "ledger" {
+  name = "ledger"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsResourceUnavailableServiceRule(),
+					Message: "\"aws_qldb_ledger\" is not available in the sa-east-1 region.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name:   "alias provider in another region",
+			Region: "",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "aws" {
+  alias  = "sa"
+  region = "sa-east-1"
+}
+
+resource "aws_qldb_ledger" "us" {
+  name = "us"
+}
+
+resource "aws_qldb_ledger" "sa" {
+  provider = aws.sa
+  name     = "sa"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsResourceUnavailableServiceRule(),
+					Message: "\"aws_qldb_ledger\" is not available in the sa-east-1 region.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 15, Column: 1},
+						End:      hcl.Pos{Line: 15, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name:   "unknown service",
+			Region: "sa-east-1",
+			Content: `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsResourceUnavailableServiceRule()
+
+	for _, tc := range cases {
+		config := tflint.EmptyConfig()
+		config.AwsCredentials = client.AwsCredentials{Region: tc.Region}
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"resource.tf": tc.Content}, config)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_AwsResourceUnavailableService_services(t *testing.T) {
+	content := `
+provider "aws" {
+  region = "us-gov-west-1"
+}
+
+resource "aws_qldb_ledger" "ledger" {
+  name = "ledger"
+}
+
+resource "aws_lambda_function" "function" {
+  function_name = "function"
+}`
+
+	rule := NewAwsResourceUnavailableServiceRule()
+	rule.SetServices(map[string][]string{"qldb": {"us-gov-east-1"}})
+
+	runner := tflint.TestRunner(t, map[string]string{"resource.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := tflint.Issues{
+		{
+			Rule:    rule,
+			Message: "\"aws_qldb_ledger\" is not available in the us-gov-west-1 region.",
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 6, Column: 1},
+				End:      hcl.Pos{Line: 6, Column: 36},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+}
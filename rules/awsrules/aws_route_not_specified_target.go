@@ -39,6 +39,31 @@ func (r *AwsRouteNotSpecifiedTargetRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsRouteNotSpecifiedTargetRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `aws_route` has a routing target, such as `gateway_id` or `nat_gateway_id`.",
+		Rationale:   "A route without any target is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_route" "internet" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+}
+`,
+		Compliant: `resource "aws_route" "internet" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = "igw-1234abcd"
+}
+`,
+		Fix: "Set exactly one of `egress_only_gateway_id`, `gateway_id`, `instance_id`, `nat_gateway_id`, `network_interface_id`, `transit_gateway_id` and `vpc_peering_connection_id`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRouteNotSpecifiedTargetRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether `gateway_id`, `egress_only_gateway_id`, `nat_gateway_id`, `instance_id`
 // `vpc_peering_connection_id` or `network_interface_id` is defined in a resource
 func (r *AwsRouteNotSpecifiedTargetRule) Check(runner *tflint.Runner) error {
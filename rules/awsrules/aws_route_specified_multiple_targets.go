@@ -39,6 +39,33 @@ func (r *AwsRouteSpecifiedMultipleTargetsRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *AwsRouteSpecifiedMultipleTargetsRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `aws_route` has more than one routing target, such as both `gateway_id` and `nat_gateway_id`.",
+		Rationale:   "A route can have only one target. Multiple targets are rejected only when running `terraform apply`.",
+		Violating: `resource "aws_route" "internet" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = "igw-1234abcd"
+  nat_gateway_id         = "nat-1234abcd"
+}
+`,
+		Compliant: `resource "aws_route" "internet" {
+  route_table_id         = "rtb-1234abcd"
+  destination_cidr_block = "0.0.0.0/0"
+  gateway_id             = "igw-1234abcd"
+}
+`,
+		Fix: "Remove all targets except the intended one. Create another route for another destination if both are needed.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRouteSpecifiedMultipleTargetsRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
 // Check checks whether a resource defines `gateway_id`, `egress_only_gateway_id`, `nat_gateway_id`
 // `instance_id`, `vpc_peering_connection_id` or `network_interface_id` at the same time
 func (r *AwsRouteSpecifiedMultipleTargetsRule) Check(runner *tflint.Runner) error {
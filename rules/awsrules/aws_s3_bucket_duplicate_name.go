@@ -0,0 +1,117 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsS3BucketDuplicateNameRule checks whether the S3 bucket name of a new "aws_s3_bucket" already exists
+type AwsS3BucketDuplicateNameRule struct {
+	resourceType  string
+	attributeName string
+}
+
+// NewAwsS3BucketDuplicateNameRule returns new rule with default attributes
+func NewAwsS3BucketDuplicateNameRule() *AwsS3BucketDuplicateNameRule {
+	return &AwsS3BucketDuplicateNameRule{
+		resourceType:  "aws_s3_bucket",
+		attributeName: "bucket",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsS3BucketDuplicateNameRule) Name() string {
+	return "aws_s3_bucket_duplicate_name"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsS3BucketDuplicateNameRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsS3BucketDuplicateNameRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsS3BucketDuplicateNameRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsS3BucketDuplicateNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether the bucket name of a new `aws_s3_bucket` is already taken in the account or by another account, since S3 bucket names are globally unique. Resources recorded in the state are not reported. It is run only in deep check mode, and only when the state is read, since resources already applied cannot be told apart from new ones without it.",
+		Rationale:   "The bucket name must be unique, so a new resource with a taken one fails only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_s3_bucket" "example" {
+  bucket = "existing-bucket"
+}
+`,
+		Compliant: `resource "aws_s3_bucket" "example" {
+  bucket = "new-bucket-2e7f1c"
+}
+`,
+		Fix: "Use another bucket name, or import the existing resource into the state by `terraform import` if it should be managed by this configuration.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsS3BucketDuplicateNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check checks whether the S3 bucket name is already taken by a resource that isn't in the state
+func (r *AwsS3BucketDuplicateNameRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkNewResourceAttributes(r.resourceType, r.attributeName, func(attribute *hcl.Attribute) error {
+		var name string
+		err := runner.EvaluateExpr(attribute.Expr, &name)
+
+		return runner.EnsureNoError(err, func() error {
+			log.Printf("[DEBUG] Invoking HeadBucket: %s", runner.Redact(name))
+			_, err := runner.AwsClientAt(attribute.Expr.Range()).S3.HeadBucket(&s3.HeadBucketInput{
+				Bucket: aws.String(name),
+			})
+			if err != nil {
+				if aerr, ok := err.(awserr.Error); ok {
+					switch aerr.Code() {
+					case "NotFound", s3.ErrCodeNoSuchBucket:
+						return nil
+					case "Forbidden":
+						runner.EmitIssueWithEvidence(
+							r,
+							fmt.Sprintf("\"%s\" is already owned by another account. S3 bucket names must be globally unique.", name),
+							attribute.Expr.Range(),
+							runner.NewEvidence("HeadBucket", name),
+						)
+						return nil
+					}
+				}
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking HeadBucket",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf("\"%s\" already exists.", name),
+				attribute.Expr.Range(),
+				runner.NewEvidence("HeadBucket", name),
+			)
+			return nil
+		})
+	})
+}
@@ -0,0 +1,155 @@
+package awsrules
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsS3BucketDuplicateName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Response error
+		Expected tflint.Issues
+	}{
+		{
+			Name: "bucket already exists",
+			Content: `
+resource "aws_s3_bucket" "bucket" {
+	bucket = "my-bucket"
+}`,
+			Response: nil,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsS3BucketDuplicateNameRule(),
+					Message: "\"my-bucket\" already exists.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "bucket is owned by another account",
+			Content: `
+resource "aws_s3_bucket" "bucket" {
+	bucket = "my-bucket"
+}`,
+			Response: awserr.New("Forbidden", "Forbidden", nil),
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsS3BucketDuplicateNameRule(),
+					Message: "\"my-bucket\" is already owned by another account. S3 bucket names must be globally unique.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "bucket not found",
+			Content: `
+resource "aws_s3_bucket" "bucket" {
+	bucket = "my-bucket"
+}`,
+			Response: awserr.New("NotFound", "Not Found", nil),
+			Expected: tflint.Issues{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rule := NewAwsS3BucketDuplicateNameRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": tc.Content}, tflint.EmptyConfig(), states.NewState())
+
+		mock := client.NewMockS3API(ctrl)
+		mock.EXPECT().HeadBucket(&s3.HeadBucketInput{
+			Bucket: aws.String("my-bucket"),
+		}).Return(&s3.HeadBucketOutput{}, tc.Response)
+		runner.AwsClient.S3 = mock
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_AwsS3BucketDuplicateName_noState(t *testing.T) {
+	content := `
+resource "aws_s3_bucket" "bucket" {
+	bucket = "my-bucket"
+}`
+	runner := tflint.TestRunner(t, map[string]string{"resource.tf": content})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Without the state, the bucket may be already applied, so HeadBucket is never invoked
+	runner.AwsClient.S3 = client.NewMockS3API(ctrl)
+
+	rule := NewAwsS3BucketDuplicateNameRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	tflint.AssertIssues(t, tflint.Issues{}, runner.Issues)
+}
+
+func Test_AwsS3BucketDuplicateName_redactLog(t *testing.T) {
+	content := `
+variable "bucket" {
+  default   = "secret-bucket"
+  sensitive = true
+}
+
+resource "aws_s3_bucket" "bucket" {
+	bucket = var.bucket
+}`
+	runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": content}, tflint.EmptyConfig(), states.NewState())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := client.NewMockS3API(ctrl)
+	mock.EXPECT().HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String("secret-bucket"),
+	}).Return(&s3.HeadBucketOutput{}, awserr.New("NotFound", "Not Found", nil))
+	runner.AwsClient.S3 = mock
+
+	logs := new(bytes.Buffer)
+	log.SetOutput(logs)
+	defer log.SetOutput(os.Stderr)
+
+	rule := NewAwsS3BucketDuplicateNameRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	if strings.Contains(logs.String(), "secret-bucket") {
+		t.Fatalf("Expected the bucket name is redacted, but got `%s`", logs.String())
+	}
+	if !strings.Contains(logs.String(), "Invoking HeadBucket: (sensitive)") {
+		t.Fatalf("Expected HeadBucket is logged, but got `%s`", logs.String())
+	}
+}
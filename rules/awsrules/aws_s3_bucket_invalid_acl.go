@@ -50,7 +50,29 @@ func (r *AwsS3BucketInvalidACLRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsS3BucketInvalidACLRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsS3BucketInvalidACLRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `acl` of `aws_s3_bucket` is one of the canned ACLs, such as `private` and `public-read`.",
+		Rationale:   "Terraform does not validate canned ACLs, so a typo is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_s3_bucket" "logs" {
+  acl = "public"
+}
+`,
+		Compliant: `resource "aws_s3_bucket" "logs" {
+  acl = "private"
+}
+`,
+		Fix: "Use one of `private`, `public-read`, `public-read-write`, `aws-exec-read`, `authenticated-read`, `log-delivery-write`, `bucket-owner-read` and `bucket-owner-full-control`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsS3BucketInvalidACLRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
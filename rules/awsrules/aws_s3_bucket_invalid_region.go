@@ -43,10 +43,17 @@ func NewAwsS3BucketInvalidRegionRule() *AwsS3BucketInvalidRegionRule {
 			"cn-northwest-1",
 			"eu-central-1",
 			"me-south-1",
+			"us-gov-west-1",
+			"us-gov-east-1",
 		},
 	}
 }
 
+// SetRegions replaces the enum with the passed regions
+func (r *AwsS3BucketInvalidRegionRule) SetRegions(regions []string) {
+	r.enum = regions
+}
+
 // Name returns the rule name
 func (r *AwsS3BucketInvalidRegionRule) Name() string {
 	return "aws_s3_bucket_invalid_region"
@@ -64,7 +71,29 @@ func (r *AwsS3BucketInvalidRegionRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsS3BucketInvalidRegionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsS3BucketInvalidRegionRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `region` of `aws_s3_bucket` is a region known to the AWS SDK, or listed in `data_files` of the config.",
+		Rationale:   "Terraform does not validate the region, so a typo is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_s3_bucket" "logs" {
+  region = "us-east-l"
+}
+`,
+		Compliant: `resource "aws_s3_bucket" "logs" {
+  region = "us-east-1"
+}
+`,
+		Fix: "Fix the region name. If the region is new and unknown to TFLint, add it to the regions dataset by `data_files`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsS3BucketInvalidRegionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -0,0 +1,156 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsSecurityGroupInvalidPortRangeRule checks whether port ranges of security group rules are coherent
+type AwsSecurityGroupInvalidPortRangeRule struct{}
+
+// NewAwsSecurityGroupInvalidPortRangeRule returns new rule with default attributes
+func NewAwsSecurityGroupInvalidPortRangeRule() *AwsSecurityGroupInvalidPortRangeRule {
+	return &AwsSecurityGroupInvalidPortRangeRule{}
+}
+
+// Name returns the rule name
+func (r *AwsSecurityGroupInvalidPortRangeRule) Name() string {
+	return "aws_security_group_invalid_port_range"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSecurityGroupInvalidPortRangeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSecurityGroupInvalidPortRangeRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSecurityGroupInvalidPortRangeRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSecurityGroupInvalidPortRangeRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether port ranges of security group rules are valid: ports are between 0 and 65535, `from_port` is not greater than `to_port`, and all protocols (`-1`) are not combined with specific ports.",
+		Rationale:   "AWS rejects such rules only when running `terraform apply`, and all protocols with specific ports silently opens all ports in some versions of the provider.",
+		Violating: `resource "aws_security_group_rule" "https" {
+  type        = "ingress"
+  from_port   = 443
+  to_port     = 80
+  protocol    = "tcp"
+  cidr_blocks = ["10.0.0.0/16"]
+}
+`,
+		Compliant: `resource "aws_security_group_rule" "https" {
+  type        = "ingress"
+  from_port   = 80
+  to_port     = 443
+  protocol    = "tcp"
+  cidr_blocks = ["10.0.0.0/16"]
+}
+`,
+		Fix: "Swap `from_port` and `to_port` if they are reversed, and set both ports to 0 when the protocol is `-1` or `all`.",
+	}
+}
+
+// Check checks `from_port`, `to_port`, and `protocol` in inline rules and `aws_security_group_rule`
+func (r *AwsSecurityGroupInvalidPortRangeRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for _, blockType := range []string{"ingress", "egress"} {
+		err := runner.WalkResourceBlocks("aws_security_group", blockType, func(block *hcl.Block) error {
+			return r.checkPortRange(runner, block.Body)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, resource := range runner.LookupResourcesByType("aws_security_group_rule") {
+		if err := r.checkPortRange(runner, resource.Config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsSecurityGroupInvalidPortRangeRule) checkPortRange(runner *tflint.Runner, body hcl.Body) error {
+	content, _, diags := body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "from_port"},
+			{Name: "to_port"},
+			{Name: "protocol"},
+		},
+	})
+	if diags.HasErrors() {
+		return diags
+	}
+
+	fromAttr, fromExists := content.Attributes["from_port"]
+	toAttr, toExists := content.Attributes["to_port"]
+	protocolAttr, protocolExists := content.Attributes["protocol"]
+	if !fromExists || !toExists || !protocolExists {
+		return nil
+	}
+
+	var fromPort, toPort int
+	var protocol string
+	for _, eval := range []struct {
+		expr hcl.Expression
+		ret  interface{}
+	}{
+		{expr: fromAttr.Expr, ret: &fromPort},
+		{expr: toAttr.Expr, ret: &toPort},
+		{expr: protocolAttr.Expr, ret: &protocol},
+	} {
+		if err := runner.EvaluateExpr(eval.expr, eval.ret); err != nil {
+			// Skip rules which cannot be evaluated
+			return runner.EnsureNoError(err, func() error { return nil })
+		}
+	}
+
+	return runner.WithExpressionContext(fromAttr.Expr, func() error {
+		switch strings.ToLower(protocol) {
+		case "-1", "all":
+			if fromPort != 0 || toPort != 0 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("All protocols (\"%s\") cannot be combined with specific ports. Set from_port and to_port to 0.", protocol),
+					protocolAttr.Expr.Range(),
+				)
+			}
+		case "icmp", "1", "icmpv6", "58":
+			// from_port and to_port mean ICMP type and code, so skip port checks
+		default:
+			for _, port := range []struct {
+				value int
+				expr  hcl.Expression
+			}{
+				{value: fromPort, expr: fromAttr.Expr},
+				{value: toPort, expr: toAttr.Expr},
+			} {
+				if port.value < 0 || port.value > 65535 {
+					runner.EmitIssue(r, fmt.Sprintf("%d is out of the port range (0-65535).", port.value), port.expr.Range())
+				}
+			}
+			if fromPort > toPort {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("from_port (%d) is greater than to_port (%d).", fromPort, toPort),
+					fromAttr.Expr.Range(),
+				)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,111 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsSecurityGroupInvalidPortRange(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "from_port is greater than to_port",
+			Content: `
+resource "aws_security_group" "main" {
+  ingress {
+    from_port = 8080
+    to_port   = 80
+    protocol  = "tcp"
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSecurityGroupInvalidPortRangeRule(),
+					Message: "from_port (8080) is greater than to_port (80).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "out of range",
+			Content: `
+resource "aws_security_group_rule" "main" {
+  from_port = 0
+  to_port   = 70000
+  protocol  = "udp"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSecurityGroupInvalidPortRangeRule(),
+					Message: "70000 is out of the port range (0-65535).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 4, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "all protocols with ports",
+			Content: `
+resource "aws_security_group" "main" {
+  egress {
+    from_port = 443
+    to_port   = 443
+    protocol  = "-1"
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSecurityGroupInvalidPortRangeRule(),
+					Message: "All protocols (\"-1\") cannot be combined with specific ports. Set from_port and to_port to 0.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 6, Column: 17},
+						End:      hcl.Pos{Line: 6, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid",
+			Content: `
+resource "aws_security_group" "main" {
+  ingress {
+    from_port = 8
+    to_port   = -1
+    protocol  = "icmp"
+  }
+
+  egress {
+    from_port = 0
+    to_port   = 0
+    protocol  = "-1"
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsSecurityGroupInvalidPortRangeRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
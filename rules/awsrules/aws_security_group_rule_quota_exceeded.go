@@ -0,0 +1,178 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsSecurityGroupRuleQuotaExceededRule checks whether inline rules exceed the "Inbound or outbound rules per security group" quota
+type AwsSecurityGroupRuleQuotaExceededRule struct {
+	resourceType string
+	serviceCode  string
+	quotaCode    string
+	quota        int
+	quotaFetched bool
+}
+
+// NewAwsSecurityGroupRuleQuotaExceededRule returns new rule with default attributes
+func NewAwsSecurityGroupRuleQuotaExceededRule() *AwsSecurityGroupRuleQuotaExceededRule {
+	return &AwsSecurityGroupRuleQuotaExceededRule{
+		resourceType: "aws_security_group",
+		serviceCode:  "vpc",
+		quotaCode:    "L-0EA8095F",
+		quota:        0,
+		quotaFetched: false,
+	}
+}
+
+// Name returns the rule name
+func (r *AwsSecurityGroupRuleQuotaExceededRule) Name() string {
+	return "aws_security_group_rule_quota_exceeded"
+}
+
+// Enabled returns whether the rule is enabled by default
+// This rule is disabled by default because it requires the permission for Service Quotas
+func (r *AwsSecurityGroupRuleQuotaExceededRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsSecurityGroupRuleQuotaExceededRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSecurityGroupRuleQuotaExceededRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSecurityGroupRuleQuotaExceededRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether inline `ingress` and `egress` rules of `aws_security_group` exceed the \"Inbound or outbound rules per security group\" quota. Each CIDR block and source security group counts as a separate rule, like AWS does. It is run only in deep check mode, and requires the `servicequotas:GetServiceQuota` permission.",
+		Rationale:   "Rules beyond the quota fail only when running `terraform apply`, after the security group has been created.",
+		Violating: `resource "aws_security_group" "web" {
+  ingress {
+    from_port   = 443
+    to_port     = 443
+    protocol    = "tcp"
+    cidr_blocks = [for i in range(100) : "10.${i}.0.0/16"]
+  }
+}
+`,
+		Compliant: `resource "aws_security_group" "web" {
+  ingress {
+    from_port   = 443
+    to_port     = 443
+    protocol    = "tcp"
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+`,
+		Fix: "Aggregate CIDR blocks into larger ones, use prefix lists or source security groups, split rules into multiple security groups, or request a quota increase in Service Quotas.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSecurityGroupRuleQuotaExceededRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check counts inline ingress/egress rules of each security group and compares them with the quota
+// Each CIDR block and source security group in a statically defined list counts as a separate rule, like AWS does
+func (r *AwsSecurityGroupRuleQuotaExceededRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for _, resource := range runner.LookupResourcesByType(r.resourceType) {
+		body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{
+				{Type: "ingress"},
+				{Type: "egress"},
+			},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		counts := map[string]int{}
+		for _, block := range body.Blocks {
+			count, err := countSecurityGroupRules(block)
+			if err != nil {
+				return err
+			}
+			counts[block.Type] += count
+		}
+
+		for _, direction := range []string{"ingress", "egress"} {
+			if counts[direction] == 0 {
+				continue
+			}
+
+			if !r.quotaFetched {
+				log.Print("[DEBUG] invoking GetServiceQuota")
+				var err error
+				r.quota, err = runner.AwsClient.GetServiceQuota(r.serviceCode, r.quotaCode)
+				if err != nil {
+					err := &tflint.Error{
+						Code:    tflint.ExternalAPIError,
+						Level:   tflint.ErrorLevel,
+						Message: "An error occurred while invoking GetServiceQuota",
+						Cause:   err,
+					}
+					log.Printf("[ERROR] %s", err)
+					return err
+				}
+				r.quotaFetched = true
+			}
+
+			if counts[direction] > r.quota {
+				runner.EmitIssueWithEvidence(
+					r,
+					fmt.Sprintf("This security group has %d %s rules, which exceeds the quota of %d rules per security group.", counts[direction], direction, r.quota),
+					resource.DeclRange,
+					runner.NewEvidence("GetServiceQuota", r.serviceCode, r.quotaCode),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func countSecurityGroupRules(block *hcl.Block) (int, error) {
+	content, _, diags := block.Body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "cidr_blocks"},
+			{Name: "ipv6_cidr_blocks"},
+			{Name: "prefix_list_ids"},
+			{Name: "security_groups"},
+			{Name: "self"},
+		},
+	})
+	if diags.HasErrors() {
+		return 0, diags
+	}
+
+	count := 0
+	for _, attribute := range content.Attributes {
+		if attribute.Name == "self" {
+			count++
+			continue
+		}
+		exprs, diags := hcl.ExprList(attribute.Expr)
+		if diags.HasErrors() {
+			// If it's not a static list, count it as a single rule
+			count++
+			continue
+		}
+		count += len(exprs)
+	}
+
+	if count == 0 {
+		return 1, nil
+	}
+	return count, nil
+}
@@ -0,0 +1,94 @@
+package awsrules
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsSecurityGroupRuleQuotaExceeded(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "ingress rules exceed the quota",
+			Content: `
+resource "aws_security_group" "web" {
+  ingress {
+    from_port   = 443
+    to_port     = 443
+    protocol    = "tcp"
+    cidr_blocks = ["10.0.0.0/16", "10.1.0.0/16"]
+  }
+
+  ingress {
+    from_port       = 80
+    to_port         = 80
+    protocol        = "tcp"
+    security_groups = ["sg-12345678"]
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSecurityGroupRuleQuotaExceededRule(),
+					Message: "This security group has 3 ingress rules, which exceeds the quota of 2 rules per security group.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "within the quota",
+			Content: `
+resource "aws_security_group" "web" {
+  ingress {
+    from_port   = 443
+    to_port     = 443
+    protocol    = "tcp"
+    cidr_blocks = ["10.0.0.0/16"]
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		mock := client.NewMockServiceQuotasAPI(ctrl)
+		mock.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String("vpc"),
+			QuotaCode:   aws.String("L-0EA8095F"),
+		}).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{Value: aws.Float64(2)},
+		}, nil)
+		runner.AwsClient.ServiceQuotas = mock
+
+		rule := NewAwsSecurityGroupRuleQuotaExceededRule()
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
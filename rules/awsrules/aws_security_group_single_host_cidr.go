@@ -0,0 +1,104 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsSecurityGroupSingleHostCidrRule checks whether security group rules allow a single network address with /32
+type AwsSecurityGroupSingleHostCidrRule struct{}
+
+// NewAwsSecurityGroupSingleHostCidrRule returns new rule with default attributes
+func NewAwsSecurityGroupSingleHostCidrRule() *AwsSecurityGroupSingleHostCidrRule {
+	return &AwsSecurityGroupSingleHostCidrRule{}
+}
+
+// Name returns the rule name
+func (r *AwsSecurityGroupSingleHostCidrRule) Name() string {
+	return "aws_security_group_single_host_cidr"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSecurityGroupSingleHostCidrRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSecurityGroupSingleHostCidrRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsSecurityGroupSingleHostCidrRule) Link() string {
+	return tflint.ReferenceIndexLink("best-practices")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSecurityGroupSingleHostCidrRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Warns about IPv4 CIDR blocks of security group rules which allow only a single host whose address ends with `.0`, such as `10.0.1.0/32`.",
+		Rationale:   "A `/32` CIDR block ending with `.0` is usually a network address with a wrong prefix length, so the rule allows far fewer hosts than intended.",
+		Violating: `resource "aws_security_group_rule" "ssh" {
+  type        = "ingress"
+  from_port   = 22
+  to_port     = 22
+  protocol    = "tcp"
+  cidr_blocks = ["10.0.1.0/32"]
+}
+`,
+		Compliant: `resource "aws_security_group_rule" "ssh" {
+  type        = "ingress"
+  from_port   = 22
+  to_port     = 22
+  protocol    = "tcp"
+  cidr_blocks = ["10.0.1.0/24"]
+}
+`,
+		Fix: "Fix the prefix length to allow the intended range. If the single host is intended, use its actual address.",
+	}
+}
+
+// Check checks whether /32 is used for an address ending with 0 like "10.0.0.0/32"
+// Such an address is usually a network address, so a range was probably intended
+func (r *AwsSecurityGroupSingleHostCidrRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	err := runner.WalkResourceAttributes("aws_security_group_rule", "cidr_blocks", func(attribute *hcl.Attribute) error {
+		return eachCidrBlock(runner, attribute, func(cidr string, expr hcl.Expression) {
+			r.checkCidrBlock(runner, cidr, expr)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, blockType := range []string{"ingress", "egress"} {
+		err := walkSecurityGroupCidrBlocks(runner, "aws_security_group", blockType, func(cidr string, expr hcl.Expression) {
+			r.checkCidrBlock(runner, cidr, expr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *AwsSecurityGroupSingleHostCidrRule) checkCidrBlock(runner *tflint.Runner, cidr string, expr hcl.Expression) {
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() == nil {
+		return
+	}
+
+	if ones, _ := network.Mask.Size(); ones == 32 && ip.To4()[3] == 0 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" allows only a single host. Did you intend a range?", cidr),
+			expr.Range(),
+		)
+	}
+}
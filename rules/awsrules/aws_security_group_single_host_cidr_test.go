@@ -0,0 +1,57 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsSecurityGroupSingleHostCidr(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "network address with /32",
+			Content: `
+resource "aws_security_group_rule" "main" {
+  cidr_blocks = ["10.0.0.0/32", "192.168.1.10/32"]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSecurityGroupSingleHostCidrRule(),
+					Message: "\"10.0.0.0/32\" allows only a single host. Did you intend a range?",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 18},
+						End:      hcl.Pos{Line: 3, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "inline rules",
+			Content: `
+resource "aws_security_group" "main" {
+  egress {
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsSecurityGroupSingleHostCidrRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
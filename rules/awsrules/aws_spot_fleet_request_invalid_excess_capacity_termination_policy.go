@@ -44,7 +44,29 @@ func (r *AwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule) Severity
 
 // Link returns the rule reference link
 func (r *AwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether `excess_capacity_termination_policy` of `aws_spot_fleet_request` is `Default` or `NoTermination`.",
+		Rationale:   "Terraform does not validate the policy, so an invalid value is rejected only when running `terraform apply`.",
+		Violating: `resource "aws_spot_fleet_request" "fleet" {
+  excess_capacity_termination_policy = "Terminate"
+}
+`,
+		Compliant: `resource "aws_spot_fleet_request" "fleet" {
+  excess_capacity_termination_policy = "Default"
+}
+`,
+		Fix: "Set `excess_capacity_termination_policy` to `Default` or `NoTermination`.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
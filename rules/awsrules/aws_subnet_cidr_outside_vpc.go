@@ -0,0 +1,234 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsSubnetCidrOutsideVpcRule checks whether subnet CIDR blocks are contained in the CIDR blocks of their VPC
+type AwsSubnetCidrOutsideVpcRule struct{}
+
+// NewAwsSubnetCidrOutsideVpcRule returns new rule with default attributes
+func NewAwsSubnetCidrOutsideVpcRule() *AwsSubnetCidrOutsideVpcRule {
+	return &AwsSubnetCidrOutsideVpcRule{}
+}
+
+// Name returns the rule name
+func (r *AwsSubnetCidrOutsideVpcRule) Name() string {
+	return "aws_subnet_cidr_outside_vpc"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSubnetCidrOutsideVpcRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSubnetCidrOutsideVpcRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSubnetCidrOutsideVpcRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSubnetCidrOutsideVpcRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether CIDR blocks of subnets are contained in the CIDR blocks of the VPC in the same module, including secondary CIDR blocks associated by `aws_vpc_ipv4_cidr_block_association`.",
+		Rationale:   "AWS rejects subnets outside the VPC only when running `terraform apply`, after the VPC has been created.",
+		Violating: `resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "private" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.1.0/24"
+}
+`,
+		Compliant: `resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "private" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.1.0/24"
+}
+`,
+		Fix: "Use a CIDR block within the VPC, or associate a secondary CIDR block containing it with the VPC.",
+	}
+}
+
+// Check checks whether subnets referring to a VPC in the same module are within the VPC's CIDR blocks
+// The VPC's CIDR blocks include secondary CIDR blocks associated by `aws_vpc_ipv4_cidr_block_association`
+func (r *AwsSubnetCidrOutsideVpcRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	vpcs, err := lookupVpcCidrBlocks(runner)
+	if err != nil {
+		return err
+	}
+	subnets, err := lookupSubnetCidrBlocks(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range subnets {
+		vpcCidrs, ok := vpcs[subnet.vpc]
+		if !ok || len(vpcCidrs) == 0 {
+			continue
+		}
+
+		contained := false
+		for _, vpcCidr := range vpcCidrs {
+			if containsNetwork(vpcCidr, subnet.cidr) {
+				contained = true
+			}
+		}
+		if !contained {
+			err := runner.WithExpressionContext(subnet.expr, func() error {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not contained in the CIDR blocks of aws_vpc.%s.", subnet.cidr, subnet.vpc),
+					subnet.expr.Range(),
+				)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type subnetCidrBlock struct {
+	resource *configs.Resource
+	vpc      string
+	cidr     *net.IPNet
+	expr     hcl.Expression
+}
+
+// lookupVpcCidrBlocks returns IPv4 CIDR blocks of each VPC indexed by resource name
+func lookupVpcCidrBlocks(runner *tflint.Runner) (map[string][]*net.IPNet, error) {
+	ret := map[string][]*net.IPNet{}
+
+	for _, resource := range runner.LookupResourcesByType("aws_vpc") {
+		cidr, _, err := evalCidrBlockAttribute(runner, resource)
+		if err != nil {
+			return ret, err
+		}
+		ret[resource.Name] = []*net.IPNet{}
+		if cidr != nil {
+			ret[resource.Name] = append(ret[resource.Name], cidr)
+		}
+	}
+
+	for _, resource := range runner.LookupResourcesByType("aws_vpc_ipv4_cidr_block_association") {
+		vpc, ok := referencedResourceName(resource, "vpc_id", "aws_vpc")
+		if !ok {
+			continue
+		}
+		cidr, _, err := evalCidrBlockAttribute(runner, resource)
+		if err != nil {
+			return ret, err
+		}
+		if cidr != nil {
+			ret[vpc] = append(ret[vpc], cidr)
+		}
+	}
+
+	return ret, nil
+}
+
+// lookupSubnetCidrBlocks returns subnets whose CIDR block and VPC are known, in order of declaration
+func lookupSubnetCidrBlocks(runner *tflint.Runner) ([]*subnetCidrBlock, error) {
+	ret := []*subnetCidrBlock{}
+
+	for _, resource := range runner.LookupResourcesByType("aws_subnet") {
+		vpc, ok := referencedResourceName(resource, "vpc_id", "aws_vpc")
+		if !ok {
+			continue
+		}
+		cidr, expr, err := evalCidrBlockAttribute(runner, resource)
+		if err != nil {
+			return ret, err
+		}
+		if cidr == nil {
+			continue
+		}
+		ret = append(ret, &subnetCidrBlock{resource: resource, vpc: vpc, cidr: cidr, expr: expr})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].resource.DeclRange.Filename != ret[j].resource.DeclRange.Filename {
+			return ret[i].resource.DeclRange.Filename < ret[j].resource.DeclRange.Filename
+		}
+		return ret[i].resource.DeclRange.Start.Byte < ret[j].resource.DeclRange.Start.Byte
+	})
+
+	return ret, nil
+}
+
+// evalCidrBlockAttribute evaluates `cidr_block` of the resource
+// It returns nil if the attribute is not found, unevaluable, or invalid. Invalid CIDR blocks are reported by aws_invalid_cidr_block
+func evalCidrBlockAttribute(runner *tflint.Runner, resource *configs.Resource) (*net.IPNet, hcl.Expression, error) {
+	content, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "cidr_block"}},
+	})
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+	attribute, exists := content.Attributes["cidr_block"]
+	if !exists {
+		return nil, nil, nil
+	}
+
+	var cidr string
+	err := runner.EvaluateExpr(attribute.Expr, &cidr)
+
+	var ret *net.IPNet
+	err = runner.EnsureNoError(err, func() error {
+		_, ret, _ = net.ParseCIDR(cidr)
+		return nil
+	})
+	return ret, attribute.Expr, err
+}
+
+// referencedResourceName returns the name of the resource referenced by the attribute (e.g. `vpc_id = aws_vpc.main.id`)
+func referencedResourceName(resource *configs.Resource, attributeName string, resourceType string) (string, bool) {
+	content, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: attributeName}},
+	})
+	if diags.HasErrors() {
+		return "", false
+	}
+	attribute, exists := content.Attributes[attributeName]
+	if !exists {
+		return "", false
+	}
+
+	traversal, diags := hcl.AbsTraversalForExpr(attribute.Expr)
+	if diags.HasErrors() || traversal.RootName() != resourceType || len(traversal) < 2 {
+		return "", false
+	}
+	if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+		return attr.Name, true
+	}
+	return "", false
+}
+
+// containsNetwork returns whether the inner network is completely contained in the outer network
+func containsNetwork(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && outerOnes <= innerOnes
+}
@@ -0,0 +1,81 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsSubnetCidrOutsideVpc(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "outside of the VPC",
+			Content: `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "main" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.0.0/24"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSubnetCidrOutsideVpcRule(),
+					Message: "\"10.1.0.0/24\" is not contained in the CIDR blocks of aws_vpc.main.",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 16},
+						End:      hcl.Pos{Line: 8, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "secondary CIDR block",
+			Content: `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc_ipv4_cidr_block_association" "secondary" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "main" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.1.0.0/24"
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "VPC is not in the module",
+			Content: `
+resource "aws_subnet" "main" {
+  vpc_id     = var.vpc_id
+  cidr_block = "10.1.0.0/24"
+}
+
+variable "vpc_id" {}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsSubnetCidrOutsideVpcRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,102 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsSubnetOverlappingCidrRule checks whether subnets in the same VPC have overlapping CIDR blocks
+type AwsSubnetOverlappingCidrRule struct{}
+
+// NewAwsSubnetOverlappingCidrRule returns new rule with default attributes
+func NewAwsSubnetOverlappingCidrRule() *AwsSubnetOverlappingCidrRule {
+	return &AwsSubnetOverlappingCidrRule{}
+}
+
+// Name returns the rule name
+func (r *AwsSubnetOverlappingCidrRule) Name() string {
+	return "aws_subnet_overlapping_cidr"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *AwsSubnetOverlappingCidrRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *AwsSubnetOverlappingCidrRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *AwsSubnetOverlappingCidrRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSubnetOverlappingCidrRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether CIDR blocks of subnets in the same VPC overlap. The issue is reported on the subnet declared later.",
+		Rationale:   "AWS rejects overlapping subnets only when running `terraform apply`, possibly after other subnets have been created.",
+		Violating: `resource "aws_subnet" "a" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.0.0/23"
+}
+
+resource "aws_subnet" "b" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.1.0/24"
+}
+`,
+		Compliant: `resource "aws_subnet" "a" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "aws_subnet" "b" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.1.0/24"
+}
+`,
+		Fix: "Split the address space of the VPC so that each subnet has its own range.",
+	}
+}
+
+// Check compares CIDR blocks of subnets referring to the same VPC in pairs
+// An issue is emitted on the subnet declared later
+func (r *AwsSubnetOverlappingCidrRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	subnets, err := lookupSubnetCidrBlocks(runner)
+	if err != nil {
+		return err
+	}
+
+	for i, subnet := range subnets {
+		for _, other := range subnets[:i] {
+			if subnet.vpc != other.vpc {
+				continue
+			}
+			if !subnet.cidr.Contains(other.cidr.IP) && !other.cidr.Contains(subnet.cidr.IP) {
+				continue
+			}
+
+			err := runner.WithExpressionContext(subnet.expr, func() error {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" overlaps with aws_subnet.%s (%s).", subnet.cidr, other.resource.Name, other.cidr),
+					subnet.expr.Range(),
+				)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	return nil
+}
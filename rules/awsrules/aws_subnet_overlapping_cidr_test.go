@@ -0,0 +1,72 @@
+package awsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsSubnetOverlappingCidr(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "overlapping",
+			Content: `
+resource "aws_subnet" "a" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.0.0/23"
+}
+
+resource "aws_subnet" "b" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.1.0/24"
+}
+
+resource "aws_subnet" "c" {
+  vpc_id     = aws_vpc.other.id
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewAwsSubnetOverlappingCidrRule(),
+					Message: "\"10.0.1.0/24\" overlaps with aws_subnet.a (10.0.0.0/23).",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 16},
+						End:      hcl.Pos{Line: 9, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "not overlapping",
+			Content: `
+resource "aws_subnet" "a" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.0.0/24"
+}
+
+resource "aws_subnet" "b" {
+  vpc_id     = aws_vpc.main.id
+  cidr_block = "10.0.1.0/24"
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewAwsSubnetOverlappingCidrRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred in test \"%s\": %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,129 @@
+package awsrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// AwsVpcQuotaExceededRule checks whether new VPCs exceed the "VPCs per Region" quota
+type AwsVpcQuotaExceededRule struct {
+	resourceType string
+	serviceCode  string
+	quotaCode    string
+}
+
+// NewAwsVpcQuotaExceededRule returns new rule with default attributes
+func NewAwsVpcQuotaExceededRule() *AwsVpcQuotaExceededRule {
+	return &AwsVpcQuotaExceededRule{
+		resourceType: "aws_vpc",
+		serviceCode:  "vpc",
+		quotaCode:    "L-F678F1CE",
+	}
+}
+
+// Name returns the rule name
+func (r *AwsVpcQuotaExceededRule) Name() string {
+	return "aws_vpc_quota_exceeded"
+}
+
+// Enabled returns whether the rule is enabled by default
+// This rule is disabled by default because it requires the permission for Service Quotas
+func (r *AwsVpcQuotaExceededRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *AwsVpcQuotaExceededRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *AwsVpcQuotaExceededRule) Link() string {
+	return tflint.ReferenceIndexLink("possible-errors")
+}
+
+// Doc returns the rule documentation
+func (r *AwsVpcQuotaExceededRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks whether new `aws_vpc` resources exceed the \"VPCs per Region\" quota of the account, by comparing existing and new resources with the quota from Service Quotas. Instances created by `count` and `for_each` are counted in the region of the provider of each resource. It is run only in deep check mode when the state is read, and requires the `servicequotas:GetServiceQuota` and `servicequotas:GetAWSDefaultServiceQuota` permissions.",
+		Rationale:   "Resources beyond the quota fail only when running `terraform apply`, possibly after other resources have been created.",
+		Violating: `resource "aws_vpc" "main" {
+  count      = 10
+  cidr_block = "10.${count.index}.0.0/16"
+}
+`,
+		Compliant: `resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+`,
+		Fix: "Delete unused VPCs, reduce the number of new ones, or request a quota increase in Service Quotas.",
+	}
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsVpcQuotaExceededRule) ResourceTypes() []string {
+	return []string{r.resourceType}
+}
+
+// Check compares the number of existing and new VPCs with the quota
+// Instances of resources with `count` or `for_each` are counted, and VPCs are compared per region of their providers.
+func (r *AwsVpcQuotaExceededRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	resources, err := lookupNewResourceInstances(runner, r.resourceType)
+	if err != nil {
+		return err
+	}
+
+	// Quotas and usages are per account and region, so they are looked up with the client of each resource
+	quotas := map[*client.AwsClient]int{}
+	usages := map[*client.AwsClient]int{}
+	requested := map[*client.AwsClient]int{}
+	for _, resource := range resources {
+		if _, exists := quotas[resource.client]; !exists {
+			log.Print("[DEBUG] invoking GetServiceQuota")
+			quota, err := resource.client.GetServiceQuota(r.serviceCode, r.quotaCode)
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking GetServiceQuota",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			log.Print("[DEBUG] invoking DescribeVpcs")
+			vpcs, err := resource.client.DescribeVpcs()
+			if err != nil {
+				err := &tflint.Error{
+					Code:    tflint.ExternalAPIError,
+					Level:   tflint.ErrorLevel,
+					Message: "An error occurred while invoking DescribeVpcs",
+					Cause:   err,
+				}
+				log.Printf("[ERROR] %s", err)
+				return err
+			}
+
+			quotas[resource.client] = quota
+			usages[resource.client] = len(vpcs)
+		}
+
+		requested[resource.client] += resource.count
+		if resource.count > 0 && usages[resource.client]+requested[resource.client] > quotas[resource.client] {
+			runner.EmitIssueWithEvidence(
+				r,
+				fmt.Sprintf("Creating this VPC exceeds the quota of %d VPCs per region. %d VPCs already exist.", quotas[resource.client], usages[resource.client]),
+				resource.resource.DeclRange,
+				runner.NewEvidence("GetServiceQuota", r.serviceCode, r.quotaCode),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package awsrules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsVpcQuotaExceeded(t *testing.T) {
+	content := `
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "sub" {
+  cidr_block = "10.1.0.0/16"
+}`
+	runner := tflint.TestRunnerWithState(t, map[string]string{"resource.tf": content}, tflint.EmptyConfig(), states.NewState())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	quotaMock := client.NewMockServiceQuotasAPI(ctrl)
+	quotaMock.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String("vpc"),
+		QuotaCode:   aws.String("L-F678F1CE"),
+	}).Return(nil, awserr.New(servicequotas.ErrCodeNoSuchResourceException, "not found", nil))
+	quotaMock.EXPECT().GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String("vpc"),
+		QuotaCode:   aws.String("L-F678F1CE"),
+	}).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+		Quota: &servicequotas.ServiceQuota{Value: aws.Float64(5)},
+	}, nil)
+	runner.AwsClient.ServiceQuotas = quotaMock
+
+	ec2mock := client.NewMockEC2API(ctrl)
+	ec2mock.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{}).Return(&ec2.DescribeVpcsOutput{
+		Vpcs: []*ec2.Vpc{
+			{VpcId: aws.String("vpc-1")},
+			{VpcId: aws.String("vpc-2")},
+			{VpcId: aws.String("vpc-3")},
+			{VpcId: aws.String("vpc-4")},
+		},
+	}, nil)
+	runner.AwsClient.EC2 = ec2mock
+
+	rule := NewAwsVpcQuotaExceededRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := tflint.Issues{
+		{
+			Rule:    NewAwsVpcQuotaExceededRule(),
+			Message: "Creating this VPC exceeds the quota of 5 VPCs per region. 4 VPCs already exist.",
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 6, Column: 1},
+				End:      hcl.Pos{Line: 6, Column: 25},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+}
+
+func Test_AwsVpcQuotaExceeded_instances(t *testing.T) {
+	content := `
+provider "aws" {
+  alias  = "east"
+  region = "us-east-1"
+}
+
+resource "aws_vpc" "main" {
+  count      = 2
+  cidr_block = "10.${count.index}.0.0/16"
+}
+
+resource "aws_vpc" "sub" {
+  for_each   = toset(["10.2.0.0/16", "10.3.0.0/16"])
+  cidr_block = each.value
+}
+
+resource "aws_vpc" "east" {
+  provider   = aws.east
+  cidr_block = "10.0.0.0/16"
+}`
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newClient := func(quota float64, vpcs int) *client.AwsClient {
+		quotaMock := client.NewMockServiceQuotasAPI(ctrl)
+		quotaMock.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String("vpc"),
+			QuotaCode:   aws.String("L-F678F1CE"),
+		}).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{Value: aws.Float64(quota)},
+		}, nil)
+
+		output := &ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{}}
+		for i := 0; i < vpcs; i++ {
+			output.Vpcs = append(output.Vpcs, &ec2.Vpc{VpcId: aws.String(fmt.Sprintf("vpc-%d", i))})
+		}
+		ec2mock := client.NewMockEC2API(ctrl)
+		ec2mock.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{}).Return(output, nil)
+
+		return &client.AwsClient{ServiceQuotas: quotaMock, EC2: ec2mock}
+	}
+
+	runner := tflint.TestRunnerWithAwsClients(
+		t,
+		map[string]string{"resource.tf": content},
+		states.NewState(),
+		map[string]*client.AwsClient{"east": newClient(5, 5)},
+	)
+	defaultClient := newClient(5, 2)
+	runner.AwsClient.ServiceQuotas = defaultClient.ServiceQuotas
+	runner.AwsClient.EC2 = defaultClient.EC2
+
+	rule := NewAwsVpcQuotaExceededRule()
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// 2 existing VPCs + 2 VPCs of main fit into the default region, but 2 VPCs of sub do not.
+	// The region of the alias provider already has 5 VPCs.
+	expected := tflint.Issues{
+		{
+			Rule:    NewAwsVpcQuotaExceededRule(),
+			Message: "Creating this VPC exceeds the quota of 5 VPCs per region. 2 VPCs already exist.",
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 12, Column: 1},
+				End:      hcl.Pos{Line: 12, Column: 25},
+			},
+		},
+		{
+			Rule:    NewAwsVpcQuotaExceededRule(),
+			Message: "Creating this VPC exceeds the quota of 5 VPCs per region. 5 VPCs already exist.",
+			Range: hcl.Range{
+				Filename: "resource.tf",
+				Start:    hcl.Pos{Line: 17, Column: 1},
+				End:      hcl.Pos{Line: 17, Column: 26},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+}
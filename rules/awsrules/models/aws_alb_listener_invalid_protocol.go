@@ -50,7 +50,21 @@ func (r *AwsALBListenerInvalidProtocolRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsALBListenerInvalidProtocolRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsALBListenerInvalidProtocolRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsALBListenerInvalidProtocolRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
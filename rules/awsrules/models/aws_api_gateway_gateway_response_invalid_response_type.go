@@ -64,7 +64,21 @@ func (r *AwsAPIGatewayGatewayResponseInvalidResponseTypeRule) Severity() string
 
 // Link returns the rule reference link
 func (r *AwsAPIGatewayGatewayResponseInvalidResponseTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAPIGatewayGatewayResponseInvalidResponseTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAPIGatewayGatewayResponseInvalidResponseTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -49,7 +49,21 @@ func (r *AwsAPIGatewayIntegrationInvalidTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsAPIGatewayIntegrationInvalidTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAPIGatewayIntegrationInvalidTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAPIGatewayIntegrationInvalidTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
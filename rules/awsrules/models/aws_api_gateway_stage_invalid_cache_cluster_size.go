@@ -52,7 +52,21 @@ func (r *AwsAPIGatewayStageInvalidCacheClusterSizeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsAPIGatewayStageInvalidCacheClusterSizeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAPIGatewayStageInvalidCacheClusterSizeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAPIGatewayStageInvalidCacheClusterSizeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -54,7 +54,21 @@ func (r *AwsAppautoscalingPolicyInvalidServiceNamespaceRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsAppautoscalingPolicyInvalidServiceNamespaceRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAppautoscalingPolicyInvalidServiceNamespaceRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAppautoscalingPolicyInvalidServiceNamespaceRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -57,7 +57,21 @@ func (r *AwsAppautoscalingScheduledActionInvalidScalableDimensionRule) Severity(
 
 // Link returns the rule reference link
 func (r *AwsAppautoscalingScheduledActionInvalidScalableDimensionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAppautoscalingScheduledActionInvalidScalableDimensionRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAppautoscalingScheduledActionInvalidScalableDimensionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
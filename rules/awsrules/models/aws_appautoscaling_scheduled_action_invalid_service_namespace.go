@@ -54,7 +54,21 @@ func (r *AwsAppautoscalingScheduledActionInvalidServiceNamespaceRule) Severity()
 
 // Link returns the rule reference link
 func (r *AwsAppautoscalingScheduledActionInvalidServiceNamespaceRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAppautoscalingScheduledActionInvalidServiceNamespaceRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAppautoscalingScheduledActionInvalidServiceNamespaceRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
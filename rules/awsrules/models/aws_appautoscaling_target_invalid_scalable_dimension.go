@@ -57,7 +57,21 @@ func (r *AwsAppautoscalingTargetInvalidScalableDimensionRule) Severity() string
 
 // Link returns the rule reference link
 func (r *AwsAppautoscalingTargetInvalidScalableDimensionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAppautoscalingTargetInvalidScalableDimensionRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAppautoscalingTargetInvalidScalableDimensionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
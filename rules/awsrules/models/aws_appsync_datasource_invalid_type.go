@@ -50,7 +50,21 @@ func (r *AwsAppsyncDatasourceInvalidTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsAppsyncDatasourceInvalidTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsAppsyncDatasourceInvalidTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsAppsyncDatasourceInvalidTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
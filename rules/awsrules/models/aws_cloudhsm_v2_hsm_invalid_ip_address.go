@@ -44,7 +44,21 @@ func (r *AwsCloudhsmV2HsmInvalidIPAddressRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsCloudhsmV2HsmInvalidIPAddressRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCloudhsmV2HsmInvalidIPAddressRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCloudhsmV2HsmInvalidIPAddressRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
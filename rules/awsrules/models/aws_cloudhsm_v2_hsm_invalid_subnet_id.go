@@ -44,7 +44,21 @@ func (r *AwsCloudhsmV2HsmInvalidSubnetIDRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsCloudhsmV2HsmInvalidSubnetIDRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCloudhsmV2HsmInvalidSubnetIDRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCloudhsmV2HsmInvalidSubnetIDRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
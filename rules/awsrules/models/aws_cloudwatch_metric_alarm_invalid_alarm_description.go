@@ -42,7 +42,21 @@ func (r *AwsCloudwatchMetricAlarmInvalidAlarmDescriptionRule) Severity() string
 
 // Link returns the rule reference link
 func (r *AwsCloudwatchMetricAlarmInvalidAlarmDescriptionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCloudwatchMetricAlarmInvalidAlarmDescriptionRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCloudwatchMetricAlarmInvalidAlarmDescriptionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -51,7 +51,21 @@ func (r *AwsCloudwatchMetricAlarmInvalidComparisonOperatorRule) Severity() strin
 
 // Link returns the rule reference link
 func (r *AwsCloudwatchMetricAlarmInvalidComparisonOperatorRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCloudwatchMetricAlarmInvalidComparisonOperatorRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCloudwatchMetricAlarmInvalidComparisonOperatorRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
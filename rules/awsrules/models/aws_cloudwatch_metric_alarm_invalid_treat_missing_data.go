@@ -44,7 +44,22 @@ func (r *AwsCloudwatchMetricAlarmInvalidTreatMissingDataRule) Severity() string
 
 // Link returns the rule reference link
 func (r *AwsCloudwatchMetricAlarmInvalidTreatMissingDataRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCloudwatchMetricAlarmInvalidTreatMissingDataRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCloudwatchMetricAlarmInvalidTreatMissingDataRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
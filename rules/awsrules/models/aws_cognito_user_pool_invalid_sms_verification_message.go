@@ -48,7 +48,23 @@ func (r *AwsCognitoUserPoolInvalidSmsVerificationMessageRule) Severity() string
 
 // Link returns the rule reference link
 func (r *AwsCognitoUserPoolInvalidSmsVerificationMessageRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsCognitoUserPoolInvalidSmsVerificationMessageRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsCognitoUserPoolInvalidSmsVerificationMessageRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
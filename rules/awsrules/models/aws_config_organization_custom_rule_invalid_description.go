@@ -42,7 +42,21 @@ func (r *AwsConfigOrganizationCustomRuleInvalidDescriptionRule) Severity() strin
 
 // Link returns the rule reference link
 func (r *AwsConfigOrganizationCustomRuleInvalidDescriptionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsConfigOrganizationCustomRuleInvalidDescriptionRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsConfigOrganizationCustomRuleInvalidDescriptionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
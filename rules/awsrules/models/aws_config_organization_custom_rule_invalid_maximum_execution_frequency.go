@@ -49,7 +49,21 @@ func (r *AwsConfigOrganizationCustomRuleInvalidMaximumExecutionFrequencyRule) Se
 
 // Link returns the rule reference link
 func (r *AwsConfigOrganizationCustomRuleInvalidMaximumExecutionFrequencyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsConfigOrganizationCustomRuleInvalidMaximumExecutionFrequencyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsConfigOrganizationCustomRuleInvalidMaximumExecutionFrequencyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
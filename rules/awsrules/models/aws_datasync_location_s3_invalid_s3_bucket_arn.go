@@ -46,7 +46,22 @@ func (r *AwsDatasyncLocationS3InvalidS3BucketArnRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsDatasyncLocationS3InvalidS3BucketArnRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDatasyncLocationS3InvalidS3BucketArnRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDatasyncLocationS3InvalidS3BucketArnRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -44,7 +44,21 @@ func (r *AwsDirectoryServiceConditionalForwarderInvalidRemoteDomainNameRule) Sev
 
 // Link returns the rule reference link
 func (r *AwsDirectoryServiceConditionalForwarderInvalidRemoteDomainNameRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDirectoryServiceConditionalForwarderInvalidRemoteDomainNameRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDirectoryServiceConditionalForwarderInvalidRemoteDomainNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
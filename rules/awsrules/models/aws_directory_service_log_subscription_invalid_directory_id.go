@@ -44,7 +44,21 @@ func (r *AwsDirectoryServiceLogSubscriptionInvalidDirectoryIDRule) Severity() st
 
 // Link returns the rule reference link
 func (r *AwsDirectoryServiceLogSubscriptionInvalidDirectoryIDRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDirectoryServiceLogSubscriptionInvalidDirectoryIDRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDirectoryServiceLogSubscriptionInvalidDirectoryIDRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
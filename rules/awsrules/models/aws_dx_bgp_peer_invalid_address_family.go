@@ -46,7 +46,21 @@ func (r *AwsDxBgpPeerInvalidAddressFamilyRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsDxBgpPeerInvalidAddressFamilyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDxBgpPeerInvalidAddressFamilyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDxBgpPeerInvalidAddressFamilyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -48,7 +48,21 @@ func (r *AwsDynamoDBTableInvalidStreamViewTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsDynamoDBTableInvalidStreamViewTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsDynamoDBTableInvalidStreamViewTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsDynamoDBTableInvalidStreamViewTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -46,7 +46,21 @@ func (r *AwsEc2CapacityReservationInvalidTenancyRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsEc2CapacityReservationInvalidTenancyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsEc2CapacityReservationInvalidTenancyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsEc2CapacityReservationInvalidTenancyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -48,7 +48,21 @@ func (r *AwsEcsTaskDefinitionInvalidNetworkModeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsEcsTaskDefinitionInvalidNetworkModeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsEcsTaskDefinitionInvalidNetworkModeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsEcsTaskDefinitionInvalidNetworkModeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
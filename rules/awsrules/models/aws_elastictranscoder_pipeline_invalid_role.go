@@ -23,7 +23,7 @@ func NewAwsElastictranscoderPipelineInvalidRoleRule() *AwsElastictranscoderPipel
 	return &AwsElastictranscoderPipelineInvalidRoleRule{
 		resourceType:  "aws_elastictranscoder_pipeline",
 		attributeName: "role",
-		pattern:       regexp.MustCompile(`^arn:aws:iam::\w{12}:role/.+$`),
+		pattern:       regexp.MustCompile(`^arn:aws(-cn|-us-gov)?:iam::\w{12}:role/.+$`),
 	}
 }
 
@@ -44,7 +44,21 @@ func (r *AwsElastictranscoderPipelineInvalidRoleRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsElastictranscoderPipelineInvalidRoleRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsElastictranscoderPipelineInvalidRoleRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsElastictranscoderPipelineInvalidRoleRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -59,7 +73,7 @@ func (r *AwsElastictranscoderPipelineInvalidRoleRule) Check(runner *tflint.Runne
 			if !r.pattern.MatchString(val) {
 				runner.EmitIssue(
 					r,
-					fmt.Sprintf(`"%s" does not match valid pattern %s`, truncateLongMessage(val), `^arn:aws:iam::\w{12}:role/.+$`),
+					fmt.Sprintf(`"%s" does not match valid pattern %s`, truncateLongMessage(val), `^arn:aws(-cn|-us-gov)?:iam::\w{12}:role/.+$`),
 					attribute.Expr.Range(),
 				)
 			}
@@ -44,7 +44,21 @@ func (r *AwsElastictranscoderPresetInvalidContainerRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsElastictranscoderPresetInvalidContainerRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsElastictranscoderPresetInvalidContainerRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsElastictranscoderPresetInvalidContainerRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
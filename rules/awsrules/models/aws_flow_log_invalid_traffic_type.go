@@ -47,7 +47,21 @@ func (r *AwsFlowLogInvalidTrafficTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsFlowLogInvalidTrafficTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsFlowLogInvalidTrafficTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsFlowLogInvalidTrafficTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
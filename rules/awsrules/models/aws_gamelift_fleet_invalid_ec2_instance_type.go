@@ -102,7 +102,21 @@ func (r *AwsGameliftFleetInvalidEc2InstanceTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsGameliftFleetInvalidEc2InstanceTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsGameliftFleetInvalidEc2InstanceTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsGameliftFleetInvalidEc2InstanceTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
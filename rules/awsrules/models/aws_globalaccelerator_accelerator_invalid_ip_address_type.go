@@ -45,7 +45,21 @@ func (r *AwsGlobalacceleratorAcceleratorInvalidIPAddressTypeRule) Severity() str
 
 // Link returns the rule reference link
 func (r *AwsGlobalacceleratorAcceleratorInvalidIPAddressTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsGlobalacceleratorAcceleratorInvalidIPAddressTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsGlobalacceleratorAcceleratorInvalidIPAddressTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
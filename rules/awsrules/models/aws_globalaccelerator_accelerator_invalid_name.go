@@ -42,7 +42,21 @@ func (r *AwsGlobalacceleratorAcceleratorInvalidNameRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsGlobalacceleratorAcceleratorInvalidNameRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsGlobalacceleratorAcceleratorInvalidNameRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsGlobalacceleratorAcceleratorInvalidNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
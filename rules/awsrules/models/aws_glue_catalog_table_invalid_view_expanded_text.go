@@ -42,7 +42,21 @@ func (r *AwsGlueCatalogTableInvalidViewExpandedTextRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsGlueCatalogTableInvalidViewExpandedTextRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsGlueCatalogTableInvalidViewExpandedTextRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsGlueCatalogTableInvalidViewExpandedTextRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -54,7 +54,23 @@ func (r *AwsGuarddutyThreatintelsetInvalidFormatRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsGuarddutyThreatintelsetInvalidFormatRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsGuarddutyThreatintelsetInvalidFormatRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsGuarddutyThreatintelsetInvalidFormatRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
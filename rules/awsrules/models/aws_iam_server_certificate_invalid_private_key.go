@@ -47,7 +47,23 @@ func (r *AwsIAMServerCertificateInvalidPrivateKeyRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsIAMServerCertificateInvalidPrivateKeyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsIAMServerCertificateInvalidPrivateKeyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsIAMServerCertificateInvalidPrivateKeyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -44,7 +44,21 @@ func (r *AwsLambdaFunctionInvalidKmsKeyArnRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsLambdaFunctionInvalidKmsKeyArnRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsLambdaFunctionInvalidKmsKeyArnRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsLambdaFunctionInvalidKmsKeyArnRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -315,7 +315,21 @@ func (r *AwsLaunchTemplateInvalidInstanceTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsLaunchTemplateInvalidInstanceTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsLaunchTemplateInvalidInstanceTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsLaunchTemplateInvalidInstanceTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
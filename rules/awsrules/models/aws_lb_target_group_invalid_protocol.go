@@ -50,7 +50,21 @@ func (r *AwsLbTargetGroupInvalidProtocolRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsLbTargetGroupInvalidProtocolRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsLbTargetGroupInvalidProtocolRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsLbTargetGroupInvalidProtocolRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -44,7 +44,21 @@ func (r *AwsLightsailInstanceInvalidKeyPairNameRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsLightsailInstanceInvalidKeyPairNameRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsLightsailInstanceInvalidKeyPairNameRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsLightsailInstanceInvalidKeyPairNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
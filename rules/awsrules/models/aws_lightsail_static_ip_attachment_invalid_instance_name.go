@@ -44,7 +44,21 @@ func (r *AwsLightsailStaticIPAttachmentInvalidInstanceNameRule) Severity() strin
 
 // Link returns the rule reference link
 func (r *AwsLightsailStaticIPAttachmentInvalidInstanceNameRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsLightsailStaticIPAttachmentInvalidInstanceNameRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsLightsailStaticIPAttachmentInvalidInstanceNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
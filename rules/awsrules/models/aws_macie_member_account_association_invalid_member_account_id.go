@@ -44,7 +44,21 @@ func (r *AwsMacieMemberAccountAssociationInvalidMemberAccountIDRule) Severity()
 
 // Link returns the rule reference link
 func (r *AwsMacieMemberAccountAssociationInvalidMemberAccountIDRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsMacieMemberAccountAssociationInvalidMemberAccountIDRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsMacieMemberAccountAssociationInvalidMemberAccountIDRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -47,7 +47,21 @@ func (r *AwsMskClusterInvalidEnhancedMonitoringRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsMskClusterInvalidEnhancedMonitoringRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsMskClusterInvalidEnhancedMonitoringRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsMskClusterInvalidEnhancedMonitoringRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
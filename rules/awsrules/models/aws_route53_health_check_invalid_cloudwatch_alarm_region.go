@@ -69,7 +69,23 @@ func (r *AwsRoute53HealthCheckInvalidCloudwatchAlarmRegionRule) Severity() strin
 
 // Link returns the rule reference link
 func (r *AwsRoute53HealthCheckInvalidCloudwatchAlarmRegionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsRoute53HealthCheckInvalidCloudwatchAlarmRegionRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRoute53HealthCheckInvalidCloudwatchAlarmRegionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
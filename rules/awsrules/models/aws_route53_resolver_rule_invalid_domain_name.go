@@ -44,7 +44,22 @@ func (r *AwsRoute53ResolverRuleInvalidDomainNameRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsRoute53ResolverRuleInvalidDomainNameRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsRoute53ResolverRuleInvalidDomainNameRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRoute53ResolverRuleInvalidDomainNameRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
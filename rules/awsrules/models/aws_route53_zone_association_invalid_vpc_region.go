@@ -68,7 +68,23 @@ func (r *AwsRoute53ZoneAssociationInvalidVpcRegionRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsRoute53ZoneAssociationInvalidVpcRegionRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsRoute53ZoneAssociationInvalidVpcRegionRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		min:           r.min,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRoute53ZoneAssociationInvalidVpcRegionRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
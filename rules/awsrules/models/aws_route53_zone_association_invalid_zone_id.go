@@ -42,7 +42,21 @@ func (r *AwsRoute53ZoneAssociationInvalidZoneIDRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsRoute53ZoneAssociationInvalidZoneIDRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsRoute53ZoneAssociationInvalidZoneIDRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsRoute53ZoneAssociationInvalidZoneIDRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
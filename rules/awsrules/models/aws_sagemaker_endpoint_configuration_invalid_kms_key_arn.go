@@ -46,7 +46,22 @@ func (r *AwsSagemakerEndpointConfigurationInvalidKmsKeyArnRule) Severity() strin
 
 // Link returns the rule reference link
 func (r *AwsSagemakerEndpointConfigurationInvalidKmsKeyArnRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSagemakerEndpointConfigurationInvalidKmsKeyArnRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSagemakerEndpointConfigurationInvalidKmsKeyArnRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -82,7 +82,21 @@ func (r *AwsSagemakerNotebookInstanceInvalidInstanceTypeRule) Severity() string
 
 // Link returns the rule reference link
 func (r *AwsSagemakerNotebookInstanceInvalidInstanceTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSagemakerNotebookInstanceInvalidInstanceTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSagemakerNotebookInstanceInvalidInstanceTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
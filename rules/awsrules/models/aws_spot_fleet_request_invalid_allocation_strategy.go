@@ -47,7 +47,21 @@ func (r *AwsSpotFleetRequestInvalidAllocationStrategyRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsSpotFleetRequestInvalidAllocationStrategyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSpotFleetRequestInvalidAllocationStrategyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSpotFleetRequestInvalidAllocationStrategyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -49,7 +49,21 @@ func (r *AwsSsmAssociationInvalidComplianceSeverityRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsSsmAssociationInvalidComplianceSeverityRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSsmAssociationInvalidComplianceSeverityRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSsmAssociationInvalidComplianceSeverityRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
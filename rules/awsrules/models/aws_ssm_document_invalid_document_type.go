@@ -53,7 +53,21 @@ func (r *AwsSsmDocumentInvalidDocumentTypeRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsSsmDocumentInvalidDocumentTypeRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSsmDocumentInvalidDocumentTypeRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSsmDocumentInvalidDocumentTypeRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
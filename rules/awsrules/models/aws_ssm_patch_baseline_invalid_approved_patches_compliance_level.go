@@ -50,7 +50,21 @@ func (r *AwsSsmPatchBaselineInvalidApprovedPatchesComplianceLevelRule) Severity(
 
 // Link returns the rule reference link
 func (r *AwsSsmPatchBaselineInvalidApprovedPatchesComplianceLevelRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSsmPatchBaselineInvalidApprovedPatchesComplianceLevelRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSsmPatchBaselineInvalidApprovedPatchesComplianceLevelRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -51,7 +51,21 @@ func (r *AwsSsmPatchBaselineInvalidOperatingSystemRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsSsmPatchBaselineInvalidOperatingSystemRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsSsmPatchBaselineInvalidOperatingSystemRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsSsmPatchBaselineInvalidOperatingSystemRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -51,7 +51,21 @@ func (r *AwsStoragegatewayNfsFileShareInvalidObjectACLRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsStoragegatewayNfsFileShareInvalidObjectACLRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsStoragegatewayNfsFileShareInvalidObjectACLRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsStoragegatewayNfsFileShareInvalidObjectACLRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
@@ -46,7 +46,22 @@ func (r *AwsTransferSSHKeyInvalidBodyRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsTransferSSHKeyInvalidBodyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsTransferSSHKeyInvalidBodyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+		pattern:       r.pattern,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsTransferSSHKeyInvalidBodyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
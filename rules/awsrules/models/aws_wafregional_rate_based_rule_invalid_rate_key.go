@@ -45,7 +45,21 @@ func (r *AwsWafregionalRateBasedRuleInvalidRateKeyRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsWafregionalRateBasedRuleInvalidRateKeyRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsWafregionalRateBasedRuleInvalidRateKeyRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		enum:          r.enum,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsWafregionalRateBasedRuleInvalidRateKeyRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
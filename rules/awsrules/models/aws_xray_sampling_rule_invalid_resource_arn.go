@@ -42,7 +42,21 @@ func (r *AwsXraySamplingRuleInvalidResourceArnRule) Severity() string {
 
 // Link returns the rule reference link
 func (r *AwsXraySamplingRuleInvalidResourceArnRule) Link() string {
-	return ""
+	return tflint.ReferenceIndexLink("sdk-based-validations")
+}
+
+// Doc returns the rule documentation
+func (r *AwsXraySamplingRuleInvalidResourceArnRule) Doc() *tflint.RuleDoc {
+	return constraint{
+		resourceType:  r.resourceType,
+		attributeName: r.attributeName,
+		max:           r.max,
+	}.doc()
+}
+
+// ResourceTypes returns the resource types inspected by the rule
+func (r *AwsXraySamplingRuleInvalidResourceArnRule) ResourceTypes() []string {
+	return []string{r.resourceType}
 }
 
 // Check checks the pattern is valid
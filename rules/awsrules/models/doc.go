@@ -0,0 +1,267 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// maxEnumValuesInDoc is the maximum number of allowed values listed in the documentation
+const maxEnumValuesInDoc = 10
+
+// constraint is the restriction on the attribute value declared in the AWS API model
+// Zero values mean that the restriction is not declared.
+type constraint struct {
+	resourceType  string
+	attributeName string
+	max           int
+	min           int
+	pattern       *regexp.Regexp
+	enum          []string
+}
+
+// doc returns the documentation of the rule validating the attribute by the constraint
+// Example values are derived from the constraint, so they are always consistent with the rule.
+func (c constraint) doc() *tflint.RuleDoc {
+	restrictions := c.restrictions()
+
+	return &tflint.RuleDoc{
+		Description: fmt.Sprintf("Checks whether `%s` of `%s` is valid as declared in the AWS API, that is, the value %s.", c.attributeName, c.resourceType, restrictions),
+		Rationale:   "Terraform does not validate the value against the AWS API, so an invalid value is rejected only when running `terraform apply`, possibly after other resources have been changed.",
+		Violating:   c.violatingExample(),
+		Compliant:   c.example(c.validValue()),
+		Fix:         fmt.Sprintf("Set `%s` to a value which %s.", c.attributeName, restrictions),
+	}
+}
+
+// restrictions returns the constraint in English, such as "has at least 3 characters and matches the pattern `^[a-z]+$`"
+func (c constraint) restrictions() string {
+	ret := []string{}
+	if len(c.enum) > 0 {
+		values := []string{}
+		for i, v := range c.enum {
+			if i == maxEnumValuesInDoc {
+				values = append(values, fmt.Sprintf("and %d more", len(c.enum)-i))
+				break
+			}
+			values = append(values, fmt.Sprintf("`%s`", v))
+		}
+		ret = append(ret, "is one of "+strings.Join(values, ", "))
+	}
+	if c.min > 0 {
+		ret = append(ret, fmt.Sprintf("has at least %d characters", c.min))
+	}
+	if c.max > 0 {
+		ret = append(ret, fmt.Sprintf("has at most %d characters", c.max))
+	}
+	if c.pattern != nil {
+		ret = append(ret, fmt.Sprintf("matches the pattern `%s`", c.pattern))
+	}
+	if len(ret) == 1 {
+		return ret[0]
+	}
+	return strings.Join(ret[:len(ret)-1], ", ") + " and " + ret[len(ret)-1]
+}
+
+// violatingExample returns the example with an invalid value
+// Some patterns declared in the AWS API match any strings. In that case, the example only explains that.
+func (c constraint) violatingExample() string {
+	if value, ok := c.invalidValue(); ok {
+		return c.example(value)
+	}
+	return fmt.Sprintf("# Any value of `%s` is valid, because the pattern matches any strings\n", c.attributeName)
+}
+
+func (c constraint) example(value string) string {
+	return fmt.Sprintf("resource \"%s\" \"example\" {\n  %s = %s\n}\n", c.resourceType, c.attributeName, value)
+}
+
+// valid returns whether the value satisfies the constraint
+func (c constraint) valid(val string) bool {
+	if c.max > 0 && len(val) > c.max {
+		return false
+	}
+	if len(val) < c.min {
+		return false
+	}
+	if c.pattern != nil && !c.pattern.MatchString(val) {
+		return false
+	}
+	if len(c.enum) > 0 {
+		for _, item := range c.enum {
+			if item == val {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// validValue returns an expression of a value satisfying the constraint
+// If no such value is found, it returns a reference to a variable, which the rule does not inspect until the value is known.
+func (c constraint) validValue() string {
+	candidates := append([]string{}, c.enum...)
+	if c.pattern != nil {
+		if re, err := syntax.Parse(c.pattern.String(), syntax.Perl); err == nil {
+			if val, ok := matchingString(re.Simplify(), c.min); ok {
+				candidates = append(candidates, val)
+			}
+		}
+	}
+	candidates = append(candidates, strings.Repeat("a", max(c.min, 1)))
+
+	for _, val := range candidates {
+		if c.valid(val) {
+			return quoteString(val)
+		}
+	}
+	return "var." + c.attributeName
+}
+
+// invalidValue returns an expression of a value violating the constraint
+// Too long strings are written as expressions padding zeros, such as `format("%0257d", 0)`.
+func (c constraint) invalidValue() (string, bool) {
+	for _, val := range []string{"invalid", "", "-", "!invalid!"} {
+		if !c.valid(val) {
+			return quoteString(val), true
+		}
+	}
+	if c.max > 0 {
+		return fmt.Sprintf(`format("%%0%dd", 0)`, c.max+1), true
+	}
+	return "", false
+}
+
+// matchingString returns a string which matches the regular expression and is as short as possible
+// If the string is shorter than the passed length, unbounded repetitions are repeated more to extend it.
+func matchingString(re *syntax.Regexp, length int) (string, bool) {
+	shortage := 0
+	str, ok := extendMatchingString(re, &shortage)
+	if !ok || len(str) >= length {
+		return str, ok
+	}
+	shortage = length - len(str)
+	return extendMatchingString(re, &shortage)
+}
+
+// extendMatchingString returns a string matching the regular expression, which is extended by the shortage
+// The shortage is decreased by the number of characters added by repetitions.
+func extendMatchingString(re *syntax.Regexp, shortage *int) (string, bool) {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return "", false
+	case syntax.OpLiteral:
+		return string(re.Rune), true
+	case syntax.OpCharClass:
+		r, ok := classRune(re.Rune)
+		return string(r), ok
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		return "a", true
+	case syntax.OpCapture:
+		return extendMatchingString(re.Sub[0], shortage)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := 0, 1
+		switch re.Op {
+		case syntax.OpStar:
+			max = -1
+		case syntax.OpPlus:
+			min, max = 1, -1
+		case syntax.OpRepeat:
+			min, max = re.Min, re.Max
+		}
+		sub, ok := extendMatchingString(re.Sub[0], new(int))
+		if !ok {
+			return "", min == 0
+		}
+		ret := strings.Repeat(sub, min)
+		for n := min; *shortage > 0 && sub != "" && (max == -1 || n < max); n++ {
+			ret += sub
+			*shortage -= len(sub)
+		}
+		return ret, true
+	case syntax.OpConcat:
+		ret := ""
+		for _, sub := range re.Sub {
+			str, ok := extendMatchingString(sub, shortage)
+			if !ok {
+				return "", false
+			}
+			ret += str
+		}
+		return ret, true
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if str, ok := extendMatchingString(sub, shortage); ok {
+				return str, true
+			}
+		}
+		return "", false
+	default:
+		// Empty matches and anchors
+		return "", true
+	}
+}
+
+// classRune returns a readable rune in the character class
+func classRune(ranges []rune) (rune, bool) {
+	for _, preferred := range []rune{'a', 'A', '0', '-', '_'} {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if ranges[i] <= preferred && preferred <= ranges[i+1] {
+				return preferred, true
+			}
+		}
+	}
+	for i := 0; i+1 < len(ranges); i += 2 {
+		for r := ranges[i]; r <= ranges[i+1] && r < ranges[i]+128; r++ {
+			if unicode.IsPrint(r) && !unicode.IsSpace(r) {
+				return r, true
+			}
+		}
+	}
+	if len(ranges) > 0 {
+		return ranges[0], true
+	}
+	return 0, false
+}
+
+// quoteString returns the string literal in HCL syntax
+func quoteString(str string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range str {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(str[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case !unicode.IsPrint(r) && r > 0xFFFF:
+			fmt.Fprintf(&b, `\U%08X`, r)
+		case !unicode.IsPrint(r):
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+package models
+
+// AddInstanceTypes adds instance types released after the enum was generated
+func (r *AwsInstanceInvalidTypeRule) AddInstanceTypes(types []string) {
+	r.enum = appendMissing(r.enum, types)
+}
+
+// SetInstanceTypes replaces the enum with the passed instance types
+func (r *AwsInstanceInvalidTypeRule) SetInstanceTypes(types []string) {
+	r.enum = types
+}
+
+// AddInstanceTypes adds instance types released after the enum was generated
+func (r *AwsLaunchConfigurationInvalidTypeRule) AddInstanceTypes(types []string) {
+	r.enum = appendMissing(r.enum, types)
+}
+
+// SetInstanceTypes replaces the enum with the passed instance types
+func (r *AwsLaunchConfigurationInvalidTypeRule) SetInstanceTypes(types []string) {
+	r.enum = types
+}
+
+// AddInstanceTypes adds instance types released after the enum was generated
+func (r *AwsLaunchTemplateInvalidInstanceTypeRule) AddInstanceTypes(types []string) {
+	r.enum = appendMissing(r.enum, types)
+}
+
+// SetInstanceTypes replaces the enum with the passed instance types
+func (r *AwsLaunchTemplateInvalidInstanceTypeRule) SetInstanceTypes(types []string) {
+	r.enum = types
+}
+
+func appendMissing(enum []string, items []string) []string {
+	exists := map[string]bool{}
+	for _, item := range enum {
+		exists[item] = true
+	}
+	for _, item := range items {
+		if !exists[item] {
+			enum = append(enum, item)
+			exists[item] = true
+		}
+	}
+	return enum
+}
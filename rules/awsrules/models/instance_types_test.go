@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsInstanceInvalidType_AddInstanceTypes(t *testing.T) {
+	content := `
+resource "aws_instance" "foo" {
+	instance_type = "m99.large"
+}`
+	rule := NewAwsInstanceInvalidTypeRule()
+	rule.AddInstanceTypes([]string{"m99.large", "t2.micro"})
+	rule.AddInstanceTypes([]string{"m99.large"})
+
+	count := 0
+	for _, item := range rule.enum {
+		if item == "m99.large" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Fatalf("Expected the instance type to be added once, but added %d times", count)
+	}
+
+	runner := tflint.TestRunner(t, map[string]string{"resource.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	tflint.AssertIssues(t, tflint.Issues{}, runner.Issues)
+}
@@ -112,7 +112,7 @@ resource "aws_launch_template" "foo" {
 
 		opts := []cmp.Option{
 			cmpopts.IgnoreUnexported(AwsLaunchTemplateInvalidNameRule{}),
-			cmpopts.IgnoreFields(tflint.Issue{}, "Range"),
+			cmpopts.IgnoreFields(tflint.Issue{}, "Range", "Address"),
 		}
 		if !cmp.Equal(tc.Expected, runner.Issues, opts...) {
 			t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(tc.Expected, runner.Issues, opts...))
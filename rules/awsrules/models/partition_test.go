@@ -0,0 +1,25 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_AwsCloud9EnvironmentEc2InvalidOwnerArn_partitions(t *testing.T) {
+	content := `
+resource "aws_cloud9_environment_ec2" "gov" {
+	owner_arn = "arn:aws-us-gov:iam::123456789012:user/David"
+}
+
+resource "aws_cloud9_environment_ec2" "cn" {
+	owner_arn = "arn:aws-cn:iam::123456789012:user/David"
+}`
+	rule := NewAwsCloud9EnvironmentEc2InvalidOwnerArnRule()
+
+	runner := tflint.TestRunner(t, map[string]string{"resource.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	tflint.AssertIssues(t, tflint.Issues{}, runner.Issues)
+}
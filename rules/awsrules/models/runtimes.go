@@ -0,0 +1,6 @@
+package models
+
+// SetRuntimes replaces the enum with the passed runtimes
+func (r *AwsLambdaFunctionInvalidRuntimeRule) SetRuntimes(runtimes []string) {
+	r.enum = runtimes
+}
@@ -0,0 +1,80 @@
+package awsrules
+
+import (
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/client"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// newResourceInstances is instances of a new resource counted against quotas
+type newResourceInstances struct {
+	resource *configs.Resource
+	count    int
+	// client is the client of the provider of the resource. Quotas and usages are per account and region of the client
+	client *client.AwsClient
+}
+
+// lookupNewResourceInstances returns new resources of the type with the numbers of their instances
+// Resources are sorted by position so that issues are emitted on the resources declared later.
+// Resources whose `count` or `for_each` cannot be evaluated are ignored.
+func lookupNewResourceInstances(runner *tflint.Runner, resourceType string) ([]*newResourceInstances, error) {
+	resources := runner.LookupNewResourcesByType(resourceType)
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].DeclRange.Filename != resources[j].DeclRange.Filename {
+			return resources[i].DeclRange.Filename < resources[j].DeclRange.Filename
+		}
+		return resources[i].DeclRange.Start.Byte < resources[j].DeclRange.Start.Byte
+	})
+
+	ret := []*newResourceInstances{}
+	for _, resource := range resources {
+		count, known, err := countResourceInstances(runner, resource)
+		if err != nil {
+			return ret, err
+		}
+		if !known {
+			log.Printf("[DEBUG] The number of instances of `%s` is not known. Ignored", resource.Addr())
+			continue
+		}
+		ret = append(ret, &newResourceInstances{
+			resource: resource,
+			count:    count,
+			client:   runner.AwsClientAt(resource.DeclRange),
+		})
+	}
+	return ret, nil
+}
+
+// countResourceInstances evaluates the number of instances of the resource from `count` or `for_each`
+// It returns false if the number cannot be evaluated, such as when it depends on unknown values.
+func countResourceInstances(runner *tflint.Runner, resource *configs.Resource) (int, bool, error) {
+	switch {
+	case resource.Count != nil:
+		var count int
+		known := false
+		err := runner.EvaluateExpr(resource.Count, &count)
+		err = runner.EnsureNoError(err, func() error {
+			known = true
+			return nil
+		})
+		return count, known, err
+	case resource.ForEach != nil:
+		val, err := runner.EvalExpr(resource.ForEach, nil, cty.DynamicPseudoType)
+		count := 0
+		known := false
+		err = runner.EnsureNoError(err, func() error {
+			if val.CanIterateElements() {
+				count = val.LengthInt()
+				known = true
+			}
+			return nil
+		})
+		return count, known, err
+	default:
+		return 1, true, nil
+	}
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// Explanation is the metadata of a rule printed by `tflint explain`
+// Everything is taken from the rule implementation, so it is always the same as the behavior.
+type Explanation struct {
+	Name     string
+	Severity string
+	Link     string
+	// EnabledByDefault is whether the rule is enabled without any config
+	EnabledByDefault bool
+	// Enabled is whether the rule is enabled with the passed config
+	Enabled bool
+	// DeepCheck is whether the rule is only run in deep check mode
+	DeepCheck bool
+	// ModuleMode is whether the rule is enabled by default in module mode
+	ModuleMode bool
+	Options    []*RuleOption
+	// Doc is the description, rationale, examples and fix guidance of the rule
+	Doc *tflint.RuleDoc
+}
+
+// RuleOption is an attribute of the rule block specific to the rule
+type RuleOption struct {
+	Name     string
+	Type     string
+	Required bool
+	// Default is the default value in HCL syntax. It is empty if the option has no default
+	Default string
+}
+
+// configurableRule is a rule which reads its own options from the rule block
+type configurableRule interface {
+	DefaultConfig() interface{}
+}
+
+// documentedRule is a rule which describes itself
+// All built-in rules implement it. Generated rules describe themselves from their templates.
+type documentedRule interface {
+	Doc() *tflint.RuleDoc
+}
+
+// Explain returns the explanation of the rule
+func Explain(c *tflint.Config, name string) (*Explanation, error) {
+	var rule Rule
+	deepCheck := false
+	for _, r := range DefaultRules() {
+		if r.Name() == name {
+			rule = r
+		}
+	}
+	for _, r := range deepCheckRules() {
+		if r.Name() == name {
+			rule = r
+			deepCheck = true
+		}
+	}
+	if rule == nil {
+		return nil, fmt.Errorf("Rule not found: %s", name)
+	}
+
+	ret := &Explanation{
+		Name:             rule.Name(),
+		EnabledByDefault: rule.Enabled(),
+		Enabled:          isEnabled(c, rule) && (!deepCheck || c.DeepCheck),
+		DeepCheck:        deepCheck,
+		ModuleMode:       moduleModeRules[rule.Name()],
+		Options:          []*RuleOption{},
+	}
+	if r, ok := rule.(tflint.Rule); ok {
+		ret.Severity = r.Severity()
+		ret.Link = r.Link()
+	}
+	if r, ok := rule.(configurableRule); ok {
+		ret.Options = ruleOptions(r.DefaultConfig())
+	}
+	if r, ok := rule.(documentedRule); ok {
+		ret.Doc = r.Doc()
+	}
+	return ret, nil
+}
+
+// ruleOptions returns options declared by `hcl` tags of the rule config struct
+func ruleOptions(config interface{}) []*RuleOption {
+	ret := []*RuleOption{}
+
+	val := reflect.ValueOf(config)
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		tag := field.Tag.Get("hcl")
+		if tag == "" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+
+		option := &RuleOption{
+			Name:     parts[0],
+			Type:     optionType(field.Type),
+			Required: len(parts) == 1,
+		}
+		if value := val.Field(i); !value.IsZero() {
+			src, err := json.Marshal(value.Interface())
+			if err == nil {
+				option.Default = string(src)
+			}
+		}
+		ret = append(ret, option)
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Required && !ret[j].Required
+	})
+	return ret
+}
+
+// optionType returns the type of the option in Terraform's type syntax
+func optionType(ty reflect.Type) string {
+	switch ty.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int64, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		return fmt.Sprintf("list(%s)", optionType(ty.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map(%s)", optionType(ty.Elem()))
+	default:
+		return "any"
+	}
+}
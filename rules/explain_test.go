@@ -0,0 +1,168 @@
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_Explain(t *testing.T) {
+	enabled := tflint.EmptyConfig()
+	enabled.Rules["terraform_module_complexity"] = &tflint.RuleConfig{Name: "terraform_module_complexity", Enabled: true}
+
+	cases := []struct {
+		Name     string
+		Config   *tflint.Config
+		Rule     string
+		Expected *Explanation
+	}{
+		{
+			Name:   "rule with options",
+			Config: enabled,
+			Rule:   "terraform_module_complexity",
+			Expected: &Explanation{
+				Name:     "terraform_module_complexity",
+				Severity: tflint.NOTICE,
+				Link:     tflint.ReferenceLink("terraform_module_complexity"),
+				Enabled:  true,
+				Options: []*RuleOption{
+					{Name: "max_variables", Type: "number", Default: "30"},
+					{Name: "max_outputs", Type: "number", Default: "30"},
+					{Name: "max_resource_lines", Type: "number", Default: "100"},
+					{Name: "max_nesting_depth", Type: "number", Default: "3"},
+				},
+			},
+		},
+		{
+			Name:   "required options",
+			Config: tflint.EmptyConfig(),
+			Rule:   "aws_resource_missing_tags",
+			Expected: &Explanation{
+				Name:     "aws_resource_missing_tags",
+				Severity: tflint.NOTICE,
+				Link:     tflint.ReferenceLink("aws_resource_missing_tags"),
+				Options: []*RuleOption{
+					{Name: "tags", Type: "list(string)", Required: true},
+					{Name: "exclude", Type: "list(string)"},
+				},
+			},
+		},
+		{
+			Name:   "module mode rule",
+			Config: tflint.EmptyConfig(),
+			Rule:   "terraform_documented_outputs",
+			Expected: &Explanation{
+				Name:       "terraform_documented_outputs",
+				Severity:   tflint.NOTICE,
+				Link:       tflint.ReferenceLink("terraform_documented_outputs"),
+				ModuleMode: true,
+				Options:    []*RuleOption{},
+			},
+		},
+		{
+			Name:   "deep check rule",
+			Config: tflint.EmptyConfig(),
+			Rule:   "aws_instance_invalid_ami",
+			Expected: &Explanation{
+				Name:             "aws_instance_invalid_ami",
+				Severity:         tflint.ERROR,
+				Link:             tflint.ReferenceIndexLink("possible-errors"),
+				EnabledByDefault: true,
+				DeepCheck:        true,
+				Options:          []*RuleOption{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		explanation, err := Explain(tc.Config, tc.Rule)
+		if err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+		opt := cmpopts.IgnoreFields(Explanation{}, "Doc")
+		if !cmp.Equal(tc.Expected, explanation, opt) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, explanation, opt))
+		}
+	}
+
+	if _, err := Explain(tflint.EmptyConfig(), "unknown_rule"); err == nil || err.Error() != "Rule not found: unknown_rule" {
+		t.Fatalf("Expected `Rule not found` error, but got %v", err)
+	}
+}
+
+func Test_Explain_documented(t *testing.T) {
+	// Examples of these rules depend on other files, such as provider schemas, modules, the state and the directory layout.
+	// Patterns of some generated rules match any strings, so they have no violating examples.
+	unverifiable := map[string]bool{
+		"terraform_invalid_attribute_types":       true,
+		"terraform_misspelled_names":              true,
+		"terraform_unknown_attributes":            true,
+		"terraform_module_inputs":                 true,
+		"terraform_provider_version_conflicts":    true,
+		"terraform_orphaned_state_resources":      true,
+		"terraform_standard_module_structure":     true,
+		"aws_lambda_function_invalid_kms_key_arn": true,
+		"aws_lambda_permission_invalid_principal": true,
+	}
+
+	dir, err := ioutil.TempDir("", "explain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	deepCheck := map[string]bool{}
+	for _, rule := range deepCheckRules() {
+		deepCheck[rule.Name()] = true
+	}
+
+	for _, rule := range append(DefaultRules(), deepCheckRules()...) {
+		explanation, err := Explain(tflint.EmptyConfig(), rule.Name())
+		if err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", rule.Name(), err)
+		}
+		doc := explanation.Doc
+		if doc == nil || doc.Description == "" || doc.Rationale == "" || doc.Violating == "" || doc.Compliant == "" || doc.Fix == "" {
+			t.Fatalf("Failed `%s` test: the rule is not documented: %#v", rule.Name(), doc)
+		}
+		if explanation.Link == "" {
+			t.Fatalf("Failed `%s` test: the reference link is empty", rule.Name())
+		}
+		if deepCheck[rule.Name()] || unverifiable[rule.Name()] {
+			continue
+		}
+
+		config := tflint.EmptyConfig()
+		if doc.Config != "" {
+			file := filepath.Join(dir, rule.Name()+".hcl")
+			if err := ioutil.WriteFile(file, []byte(doc.Config), 0644); err != nil {
+				t.Fatal(err)
+			}
+			config, err = tflint.LoadConfig(file)
+			if err != nil {
+				t.Fatalf("Failed `%s` test: invalid config: %s", rule.Name(), err)
+			}
+		}
+
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": doc.Violating}, config)
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred in the violating example: %s", rule.Name(), err)
+		}
+		if len(runner.Issues) == 0 {
+			t.Fatalf("Failed `%s` test: the violating example emits no issues:\n%s", rule.Name(), doc.Violating)
+		}
+
+		runner = tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": doc.Compliant}, config)
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred in the compliant example: %s", rule.Name(), err)
+		}
+		if len(runner.Issues) > 0 {
+			t.Fatalf("Failed `%s` test: the compliant example emits issues: %s\n%s", rule.Name(), runner.Issues[0].Message, doc.Compliant)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// ResourceTypeRule is a rule which inspects only resources of the specific types
+type ResourceTypeRule interface {
+	Rule
+	ResourceTypes() []string
+}
+
+// providerPrefixes maps prefixes of rule names to providers of the rule families
+// Rules with other prefixes, such as `terraform_`, are not routed because they inspect configurations regardless of providers.
+var providerPrefixes = map[string]string{
+	"aws_":        "aws",
+	"google_":     "google",
+	"azurerm_":    "azurerm",
+	"kubernetes_": "kubernetes",
+	"random_":     "random",
+	"tls_":        "tls",
+}
+
+// RuleIndex is an index of rules by the resource types they inspect and the providers of their families
+// It is built before the inspection and used to skip rules that have nothing to inspect in a module.
+type RuleIndex struct {
+	types     map[string][]string
+	providers map[string]string
+
+	mu sync.Mutex
+	// used caches providers used in each configuration tree, keyed by the root
+	used map[*configs.Config]map[string]bool
+}
+
+// NewRuleIndex returns an index of the passed rules
+// Rules not implementing ResourceTypeRule are not indexed by types because they may inspect anything in modules.
+func NewRuleIndex(rules []Rule) *RuleIndex {
+	index := &RuleIndex{
+		types:     map[string][]string{},
+		providers: map[string]string{},
+		used:      map[*configs.Config]map[string]bool{},
+	}
+	for _, rule := range rules {
+		if r, ok := rule.(ResourceTypeRule); ok {
+			index.types[rule.Name()] = r.ResourceTypes()
+		}
+		for prefix, provider := range providerPrefixes {
+			if strings.HasPrefix(rule.Name(), prefix) {
+				index.providers[rule.Name()] = provider
+			}
+		}
+	}
+	return index
+}
+
+// Skip returns whether the rule can be skipped for the runner, that is, the module has no resources of the types
+func (i *RuleIndex) Skip(rule Rule, runner *tflint.Runner) bool {
+	types, ok := i.types[rule.Name()]
+	if !ok {
+		return false
+	}
+	for _, resourceType := range types {
+		if len(runner.LookupResourcesByType(resourceType)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SkipProvider returns whether the rule can be skipped because the provider of its family never appears in the configuration
+// Unlike Skip, it looks up the whole configuration tree, so it is safe to skip rules regardless of what they inspect.
+func (i *RuleIndex) SkipProvider(rule Rule, runner *tflint.Runner) bool {
+	provider, ok := i.providers[rule.Name()]
+	if !ok {
+		return false
+	}
+
+	root := runner.TFConfig.Root
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	used, cached := i.used[root]
+	if !cached {
+		used = usedProviders(root)
+		i.used[root] = used
+	}
+	return !used[provider]
+}
+
+// usedProviders returns providers appearing in resources, data sources, provider blocks and provider requirements of any modules
+// Providers of resources without the `provider` argument are implied by the prefixes of the resource types, as with Terraform.
+func usedProviders(root *configs.Config) map[string]bool {
+	used := map[string]bool{}
+	root.DeepEach(func(c *configs.Config) {
+		if c.Module == nil {
+			return
+		}
+		for _, resources := range []map[string]*configs.Resource{c.Module.ManagedResources, c.Module.DataResources} {
+			for _, resource := range resources {
+				if resource.ProviderConfigRef != nil {
+					used[resource.ProviderConfigRef.Name] = true
+				} else {
+					used[strings.SplitN(resource.Type, "_", 2)[0]] = true
+				}
+			}
+		}
+		for _, provider := range c.Module.ProviderConfigs {
+			used[provider.Name] = true
+		}
+		for name := range c.Module.ProviderRequirements {
+			used[name] = true
+		}
+	})
+	return used
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/terraform-linters/tflint/rules/awsrules"
+	"github.com/terraform-linters/tflint/rules/awsrules/models"
+	"github.com/terraform-linters/tflint/rules/randomrules"
+	"github.com/terraform-linters/tflint/rules/terraformrules"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_RuleIndex_Skip(t *testing.T) {
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+}`})
+
+	cases := []struct {
+		Name     string
+		Rule     Rule
+		Expected bool
+	}{
+		{
+			Name:     "resource found",
+			Rule:     models.NewAwsInstanceInvalidTypeRule(),
+			Expected: false,
+		},
+		{
+			Name:     "resource not found",
+			Rule:     awsrules.NewAwsDBInstanceInvalidTypeRule(),
+			Expected: true,
+		},
+		{
+			Name:     "not indexed rule",
+			Rule:     terraformrules.NewTerraformDashInResourceNameRule(),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		index := NewRuleIndex([]Rule{tc.Rule})
+		if skip := index.Skip(tc.Rule, runner); skip != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%t, got=%t", tc.Name, tc.Expected, skip)
+		}
+	}
+}
+
+func Test_RuleIndex_SkipProvider(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Rule     Rule
+		Expected bool
+	}{
+		{
+			Name: "resource found",
+			Content: `
+resource "aws_instance" "web" {}`,
+			Rule:     awsrules.NewAwsDBInstanceInvalidTypeRule(),
+			Expected: false,
+		},
+		{
+			Name: "data source found",
+			Content: `
+data "aws_ami" "ubuntu" {}`,
+			Rule:     awsrules.NewAwsDBInstanceInvalidTypeRule(),
+			Expected: false,
+		},
+		{
+			Name: "provider block found",
+			Content: `
+provider "aws" {
+  region = "us-east-1"
+}`,
+			Rule:     awsrules.NewAwsDBInstanceInvalidTypeRule(),
+			Expected: false,
+		},
+		{
+			Name: "provider not found",
+			Content: `
+resource "google_compute_instance" "web" {}`,
+			Rule:     awsrules.NewAwsDBInstanceInvalidTypeRule(),
+			Expected: true,
+		},
+		{
+			Name: "other provider found",
+			Content: `
+resource "random_password" "db" {
+  length = 32
+}`,
+			Rule:     randomrules.NewRandomPasswordLengthRule(),
+			Expected: false,
+		},
+		{
+			Name: "other provider not found",
+			Content: `
+resource "aws_instance" "web" {}`,
+			Rule:     randomrules.NewRandomPasswordLengthRule(),
+			Expected: true,
+		},
+		{
+			Name: "not routed rule",
+			Content: `
+resource "google_compute_instance" "web" {}`,
+			Rule:     terraformrules.NewTerraformDashInResourceNameRule(),
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"main.tf": tc.Content})
+		index := NewRuleIndex([]Rule{tc.Rule})
+		if skip := index.SkipProvider(tc.Rule, runner); skip != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%t, got=%t", tc.Name, tc.Expected, skip)
+		}
+	}
+}
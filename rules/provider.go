@@ -5,7 +5,9 @@ import (
 	"log"
 
 	"github.com/terraform-linters/tflint/rules/awsrules"
+	"github.com/terraform-linters/tflint/rules/randomrules"
 	"github.com/terraform-linters/tflint/rules/terraformrules"
+	"github.com/terraform-linters/tflint/rules/tlsrules"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
@@ -16,39 +18,106 @@ type Rule interface {
 	Check(runner *tflint.Runner) error
 }
 
-// DefaultRules is rules by default
-var DefaultRules = append(manualDefaultRules, modelRules...)
-var deepCheckRules = append(manualDeepCheckRules, apiRules...)
-
-var manualDefaultRules = []Rule{
-	awsrules.NewAwsDBInstanceDefaultParameterGroupRule(),
-	awsrules.NewAwsDBInstanceInvalidTypeRule(),
-	awsrules.NewAwsDBInstancePreviousTypeRule(),
-	awsrules.NewAwsElastiCacheClusterDefaultParameterGroupRule(),
-	awsrules.NewAwsElastiCacheClusterInvalidTypeRule(),
-	awsrules.NewAwsElastiCacheClusterPreviousTypeRule(),
-	awsrules.NewAwsInstancePreviousTypeRule(),
-	awsrules.NewAwsMqBrokerInvalidEngineTypeRule(),
-	awsrules.NewAwsMqConfigurationInvalidEngineTypeRule(),
-	awsrules.NewAwsRouteNotSpecifiedTargetRule(),
-	awsrules.NewAwsRouteSpecifiedMultipleTargetsRule(),
-	awsrules.NewAwsS3BucketInvalidACLRule(),
-	awsrules.NewAwsS3BucketInvalidRegionRule(),
-	awsrules.NewAwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule(),
-	awsrules.NewAwsResourceMissingTagsRule(),
-	terraformrules.NewTerraformDashInResourceNameRule(),
-	terraformrules.NewTerraformDashInOutputNameRule(),
-	terraformrules.NewTerraformDashInModuleNameRule(),
-	terraformrules.NewTerraformDashInDataSourceNameRule(),
-	terraformrules.NewTerraformDeprecatedInterpolationRule(),
-	terraformrules.NewTerraformDocumentedOutputsRule(),
-	terraformrules.NewTerraformDocumentedVariablesRule(),
-	terraformrules.NewTerraformModulePinnedSourceRule(),
-}
-
-var manualDeepCheckRules = []Rule{
-	awsrules.NewAwsInstanceInvalidAMIRule(),
-	awsrules.NewAwsLaunchConfigurationInvalidImageIDRule(),
+// DefaultRules returns new instances of rules by default
+// Rules hold datasets which are extended or overridden according to the config, such as instance types,
+// so each call returns fresh instances so that the datasets of a config never leak into other callers.
+var DefaultRules = func() []Rule {
+	return append(manualDefaultRules(), modelRules()...)
+}
+
+// deepCheckRules returns new instances of rules added in deep check mode
+var deepCheckRules = func() []Rule {
+	return append(manualDeepCheckRules(), apiRules()...)
+}
+
+func manualDefaultRules() []Rule {
+	return []Rule{
+		awsrules.NewAwsAvailabilityZoneInvalidNameRule(),
+		awsrules.NewAwsDBInstanceDefaultParameterGroupRule(),
+		awsrules.NewAwsDBInstanceInvalidTypeRule(),
+		awsrules.NewAwsDBInstancePreviousTypeRule(),
+		awsrules.NewAwsELBInvalidListenerRule(),
+		awsrules.NewAwsElastiCacheClusterDefaultParameterGroupRule(),
+		awsrules.NewAwsElastiCacheClusterInvalidTypeRule(),
+		awsrules.NewAwsElastiCacheClusterPreviousTypeRule(),
+		awsrules.NewAwsInstancePreviousTypeRule(),
+		awsrules.NewAwsInvalidCidrBlockRule(),
+		awsrules.NewAwsMqBrokerInvalidEngineTypeRule(),
+		awsrules.NewAwsMqConfigurationInvalidEngineTypeRule(),
+		awsrules.NewAwsProviderInvalidRegionRule(),
+		awsrules.NewAwsRouteNotSpecifiedTargetRule(),
+		awsrules.NewAwsRouteSpecifiedMultipleTargetsRule(),
+		awsrules.NewAwsS3BucketInvalidACLRule(),
+		awsrules.NewAwsS3BucketInvalidRegionRule(),
+		awsrules.NewAwsSecurityGroupInvalidPortRangeRule(),
+		awsrules.NewAwsSecurityGroupSingleHostCidrRule(),
+		awsrules.NewAwsSpotFleetRequestInvalidExcessCapacityTerminationPolicyRule(),
+		awsrules.NewAwsSubnetCidrOutsideVpcRule(),
+		awsrules.NewAwsSubnetOverlappingCidrRule(),
+		awsrules.NewAwsResourceMissingTagsRule(),
+		awsrules.NewAwsResourceTagConsistencyRule(),
+		awsrules.NewAwsResourceUnavailableServiceRule(),
+		randomrules.NewRandomIDByteLengthRule(),
+		randomrules.NewRandomPasswordKeepersRule(),
+		randomrules.NewRandomPasswordLengthRule(),
+		terraformrules.NewTerraformDashInResourceNameRule(),
+		terraformrules.NewTerraformDashInOutputNameRule(),
+		terraformrules.NewTerraformDashInModuleNameRule(),
+		terraformrules.NewTerraformDashInDataSourceNameRule(),
+		terraformrules.NewTerraformDeadConfigurationRule(),
+		terraformrules.NewTerraformDependsOnRule(),
+		terraformrules.NewTerraformDeprecatedInterpolationRule(),
+		terraformrules.NewTerraformDocumentedOutputsRule(),
+		terraformrules.NewTerraformDocumentedVariablesRule(),
+		terraformrules.NewTerraformExternalDataSourceRule(),
+		terraformrules.NewTerraformFileHeaderRule(),
+		terraformrules.NewTerraformInvalidAttributeTypesRule(),
+		terraformrules.NewTerraformMapKeyCoverageRule(),
+		terraformrules.NewTerraformMisspelledNamesRule(),
+		terraformrules.NewTerraformModuleComplexityRule(),
+		terraformrules.NewTerraformModuleInputsRule(),
+		terraformrules.NewTerraformModulePinnedSourceRule(),
+		terraformrules.NewTerraformMovedAndImportBlocksRule(),
+		terraformrules.NewTerraformNullResourceRule(),
+		terraformrules.NewTerraformOrphanedStateResourcesRule(),
+		terraformrules.NewTerraformProviderVersionConflictsRule(),
+		terraformrules.NewTerraformStandardModuleStructureRule(),
+		terraformrules.NewTerraformTimeSleepRule(),
+		terraformrules.NewTerraformTypedVariablesRule(),
+		terraformrules.NewTerraformUnknownAttributesRule(),
+		terraformrules.NewTerraformVariableValidationRule(),
+		tlsrules.NewTLSPrivateKeyExposureRule(),
+		tlsrules.NewTLSPrivateKeyRSABitsRule(),
+		tlsrules.NewTLSSelfSignedCertValidityRule(),
+	}
+}
+
+func manualDeepCheckRules() []Rule {
+	return []Rule{
+		awsrules.NewAwsCloudWatchLogGroupDuplicateNameRule(),
+		awsrules.NewAwsDBInstanceDuplicateIdentifierRule(),
+		awsrules.NewAwsDBInstanceDriftRule(),
+		awsrules.NewAwsEipQuotaExceededRule(),
+		awsrules.NewAwsELBDuplicateNameRule(),
+		awsrules.NewAwsElastiCacheClusterDriftRule(),
+		awsrules.NewAwsIAMRoleDuplicateNameRule(),
+		awsrules.NewAwsInstanceDriftRule(),
+		awsrules.NewAwsInstanceInvalidAMIRule(),
+		awsrules.NewAwsInstanceQuotaExceededRule(),
+		awsrules.NewAwsLaunchConfigurationInvalidImageIDRule(),
+		awsrules.NewAwsS3BucketDuplicateNameRule(),
+		awsrules.NewAwsSecurityGroupRuleQuotaExceededRule(),
+		awsrules.NewAwsVpcQuotaExceededRule(),
+	}
+}
+
+// moduleModeRules are rules enabled by default in module mode
+// They enforce conventions of reusable modules published to the registry.
+var moduleModeRules = map[string]bool{
+	"terraform_documented_outputs":        true,
+	"terraform_documented_variables":      true,
+	"terraform_standard_module_structure": true,
+	"terraform_typed_variables":           true,
 }
 
 // CheckRuleNames returns map of rules indexed by name
@@ -56,7 +125,7 @@ func CheckRuleNames(ruleNames []string) error {
 	log.Print("[INFO] Checking rules")
 
 	rulesMap := map[string]Rule{}
-	for _, rule := range append(DefaultRules, deepCheckRules...) {
+	for _, rule := range append(DefaultRules(), deepCheckRules()...) {
 		rulesMap[rule.Name()] = rule
 	}
 
@@ -75,35 +144,158 @@ func CheckRuleNames(ruleNames []string) error {
 	return nil
 }
 
+// instanceTypeRule is a rule validating instance types with a list which can be extended by `--update-data`
+// or overridden by `data_files`
+type instanceTypeRule interface {
+	AddInstanceTypes(types []string)
+	SetInstanceTypes(types []string)
+}
+
+// previousInstanceTypeRule is a rule reporting previous generation instance types which can be extended by
+// `--update-data` or overridden by `data_files`
+type previousInstanceTypeRule interface {
+	AddPreviousInstanceTypes(types []string)
+	SetPreviousInstanceTypes(types []string)
+}
+
+// runtimeRule is a rule validating runtimes with a list which can be overridden by `data_files`
+type runtimeRule interface {
+	SetRuntimes(runtimes []string)
+}
+
+// regionRule is a rule validating regions with a list which can be overridden by `data_files`
+type regionRule interface {
+	SetRegions(regions []string)
+}
+
+// serviceRule is a rule validating service availability with a dataset which can be overridden by `data_files`
+type serviceRule interface {
+	SetServices(services map[string][]string)
+}
+
+// applyInstanceTypes extends built-in lists of instance types with the data updated by `--update-data`
+// Types are only added, so types removed from the API are still accepted.
+func applyInstanceTypes(allRules []Rule, data *tflint.InstanceTypes) {
+	for _, rule := range allRules {
+		if r, ok := rule.(instanceTypeRule); ok {
+			r.AddInstanceTypes(data.Current)
+			r.AddInstanceTypes(data.Previous)
+		}
+		if r, ok := rule.(previousInstanceTypeRule); ok {
+			r.AddPreviousInstanceTypes(data.Previous)
+		}
+	}
+}
+
+// overrideDatasets replaces datasets embedded in rules with files declared in `data_files`
+// Files are already validated when loading the config, so errors are only logged.
+func overrideDatasets(allRules []Rule, dataFiles map[string]string) {
+	for name, path := range dataFiles {
+		log.Printf("[INFO] Override `%s` dataset with %s", name, path)
+
+		switch name {
+		case tflint.DataInstanceTypes:
+			data, err := tflint.LoadInstanceTypesFile(path)
+			if err != nil {
+				log.Printf("[WARN] Failed to load `%s` dataset: %s", name, err)
+				continue
+			}
+			for _, rule := range allRules {
+				if r, ok := rule.(instanceTypeRule); ok {
+					r.SetInstanceTypes(append(append([]string{}, data.Current...), data.Previous...))
+				}
+				if r, ok := rule.(previousInstanceTypeRule); ok {
+					r.SetPreviousInstanceTypes(data.Previous)
+				}
+			}
+		case tflint.DataRuntimes, tflint.DataRegions:
+			data, err := tflint.LoadDataList(path)
+			if err != nil {
+				log.Printf("[WARN] Failed to load `%s` dataset: %s", name, err)
+				continue
+			}
+			for _, rule := range allRules {
+				if r, ok := rule.(runtimeRule); ok && name == tflint.DataRuntimes {
+					r.SetRuntimes(data)
+				}
+				if r, ok := rule.(regionRule); ok && name == tflint.DataRegions {
+					r.SetRegions(data)
+				}
+			}
+		case tflint.DataServices:
+			data, err := tflint.LoadDataMap(path)
+			if err != nil {
+				log.Printf("[WARN] Failed to load `%s` dataset: %s", name, err)
+				continue
+			}
+			for _, rule := range allRules {
+				if r, ok := rule.(serviceRule); ok {
+					r.SetServices(data)
+				}
+			}
+		}
+	}
+}
+
 // NewRules returns rules according to configuration
 func NewRules(c *tflint.Config) []Rule {
 	log.Print("[INFO] Prepare rules")
 
 	ret := []Rule{}
-	allRules := []Rule{}
+	allRules := DefaultRules()
 
 	if c.DeepCheck {
 		log.Printf("[DEBUG] Deep check mode is enabled. Add deep check rules")
-		allRules = append(DefaultRules, deepCheckRules...)
-	} else {
-		allRules = DefaultRules
+		allRules = append(allRules, deepCheckRules()...)
+	}
+
+	if _, overridden := c.DataFiles[tflint.DataInstanceTypes]; !overridden {
+		data, err := tflint.LoadInstanceTypes()
+		if err != nil {
+			log.Printf("[WARN] Failed to load data of instance types: %s", err)
+		} else if data != nil {
+			log.Printf("[DEBUG] Data of instance types updated at %s found", data.UpdatedAt)
+			applyInstanceTypes(allRules, data)
+		}
 	}
+	overrideDatasets(allRules, c.DataFiles)
 
 	for _, rule := range allRules {
-		enabled := rule.Enabled()
-		if r := c.Rules[rule.Name()]; r != nil {
-			if r.Enabled {
-				log.Printf("[DEBUG] `%s` is enabled", rule.Name())
-			} else {
-				log.Printf("[DEBUG] `%s` is disabled", rule.Name())
-			}
-			enabled = r.Enabled
+		if isEnabled(c, rule) {
+			ret = append(ret, rule)
 		}
+	}
+	log.Printf("[INFO]   %d rules enabled", len(ret))
+	return ret
+}
+
+// NewAuditRules returns deep check rules according to configuration
+// Rules inspecting only templates are excluded because the audit checks the state and the live account.
+func NewAuditRules(c *tflint.Config) []Rule {
+	log.Print("[INFO] Prepare audit rules")
 
-		if enabled {
+	ret := []Rule{}
+	for _, rule := range deepCheckRules() {
+		if isEnabled(c, rule) {
 			ret = append(ret, rule)
 		}
 	}
 	log.Printf("[INFO]   %d rules enabled", len(ret))
 	return ret
 }
+
+func isEnabled(c *tflint.Config, rule Rule) bool {
+	enabled := rule.Enabled()
+	if c.ModuleMode && moduleModeRules[rule.Name()] {
+		enabled = true
+	}
+	if r := c.Rules[rule.Name()]; r != nil {
+		if r.Enabled {
+			log.Printf("[DEBUG] `%s` is enabled", rule.Name())
+		} else {
+			log.Printf("[DEBUG] `%s` is disabled", rule.Name())
+		}
+		enabled = r.Enabled
+	}
+	return enabled
+}
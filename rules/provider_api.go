@@ -4,29 +4,40 @@ package rules
 
 import awsapirules "github.com/terraform-linters/tflint/rules/awsrules/api"
 
-var apiRules = []Rule{
-	awsapirules.NewAwsALBInvalidSecurityGroupRule(),
-	awsapirules.NewAwsALBInvalidSubnetRule(),
-	awsapirules.NewAwsDBInstanceInvalidDBSubnetGroupRule(),
-	awsapirules.NewAwsDBInstanceInvalidOptionGroupRule(),
-	awsapirules.NewAwsDBInstanceInvalidParameterGroupRule(),
-	awsapirules.NewAwsDBInstanceInvalidVpcSecurityGroupRule(),
-	awsapirules.NewAwsELBInvalidInstanceRule(),
-	awsapirules.NewAwsELBInvalidSecurityGroupRule(),
-	awsapirules.NewAwsELBInvalidSubnetRule(),
-	awsapirules.NewAwsElastiCacheClusterInvalidParameterGroupRule(),
-	awsapirules.NewAwsElastiCacheClusterInvalidSecurityGroupRule(),
-	awsapirules.NewAwsElastiCacheClusterInvalidSubnetGroupRule(),
-	awsapirules.NewAwsInstanceInvalidIAMProfileRule(),
-	awsapirules.NewAwsInstanceInvalidKeyNameRule(),
-	awsapirules.NewAwsInstanceInvalidSubnetRule(),
-	awsapirules.NewAwsInstanceInvalidVpcSecurityGroupRule(),
-	awsapirules.NewAwsLaunchConfigurationInvalidIAMProfileRule(),
-	awsapirules.NewAwsRouteInvalidEgressOnlyGatewayRule(),
-	awsapirules.NewAwsRouteInvalidGatewayRule(),
-	awsapirules.NewAwsRouteInvalidInstanceRule(),
-	awsapirules.NewAwsRouteInvalidNatGatewayRule(),
-	awsapirules.NewAwsRouteInvalidNetworkInterfaceRule(),
-	awsapirules.NewAwsRouteInvalidRouteTableRule(),
-	awsapirules.NewAwsRouteInvalidVpcPeeringConnectionRule(),
+func apiRules() []Rule {
+	return []Rule{
+		awsapirules.NewAwsALBInvalidSecurityGroupRule(),
+		awsapirules.NewAwsALBInvalidSubnetRule(),
+		awsapirules.NewAwsDBInstanceInvalidDBSubnetGroupRule(),
+		awsapirules.NewAwsDBInstanceInvalidOptionGroupRule(),
+		awsapirules.NewAwsDBInstanceInvalidParameterGroupRule(),
+		awsapirules.NewAwsDBInstanceInvalidVpcSecurityGroupRule(),
+		awsapirules.NewAwsELBInvalidInstanceRule(),
+		awsapirules.NewAwsELBInvalidSecurityGroupRule(),
+		awsapirules.NewAwsELBInvalidSubnetRule(),
+		awsapirules.NewAwsEbsVolumeInvalidAvailabilityZoneRule(),
+		awsapirules.NewAwsEipAssociationInvalidAllocationRule(),
+		awsapirules.NewAwsEipAssociationInvalidNetworkInterfaceRule(),
+		awsapirules.NewAwsEipInvalidNetworkInterfaceRule(),
+		awsapirules.NewAwsElastiCacheClusterInvalidParameterGroupRule(),
+		awsapirules.NewAwsElastiCacheClusterInvalidSecurityGroupRule(),
+		awsapirules.NewAwsElastiCacheClusterInvalidSubnetGroupRule(),
+		awsapirules.NewAwsInstanceInvalidAvailabilityZoneRule(),
+		awsapirules.NewAwsInstanceInvalidIAMProfileRule(),
+		awsapirules.NewAwsInstanceInvalidKeyNameRule(),
+		awsapirules.NewAwsInstanceInvalidSubnetRule(),
+		awsapirules.NewAwsInstanceInvalidVpcSecurityGroupRule(),
+		awsapirules.NewAwsInstanceUnavailableTypeRule(),
+		awsapirules.NewAwsLaunchConfigurationInvalidIAMProfileRule(),
+		awsapirules.NewAwsNatGatewayInvalidAllocationRule(),
+		awsapirules.NewAwsNetworkInterfaceAttachmentInvalidNetworkInterfaceRule(),
+		awsapirules.NewAwsRouteInvalidEgressOnlyGatewayRule(),
+		awsapirules.NewAwsRouteInvalidGatewayRule(),
+		awsapirules.NewAwsRouteInvalidInstanceRule(),
+		awsapirules.NewAwsRouteInvalidNatGatewayRule(),
+		awsapirules.NewAwsRouteInvalidNetworkInterfaceRule(),
+		awsapirules.NewAwsRouteInvalidRouteTableRule(),
+		awsapirules.NewAwsRouteInvalidVpcPeeringConnectionRule(),
+		awsapirules.NewAwsSubnetInvalidAvailabilityZoneRule(),
+	}
 }
@@ -4,747 +4,749 @@ package rules
 
 import awsmodelrules "github.com/terraform-linters/tflint/rules/awsrules/models"
 
-var modelRules = []Rule{
-	awsmodelrules.NewAwsAcmCertificateInvalidCertificateBodyRule(),
-	awsmodelrules.NewAwsAcmCertificateInvalidCertificateChainRule(),
-	awsmodelrules.NewAwsAcmCertificateInvalidPrivateKeyRule(),
-	awsmodelrules.NewAwsAcmpcaCertificateAuthorityInvalidTypeRule(),
-	awsmodelrules.NewAwsALBInvalidIPAddressTypeRule(),
-	awsmodelrules.NewAwsALBInvalidLoadBalancerTypeRule(),
-	awsmodelrules.NewAwsALBListenerInvalidProtocolRule(),
-	awsmodelrules.NewAwsALBTargetGroupInvalidProtocolRule(),
-	awsmodelrules.NewAwsALBTargetGroupInvalidTargetTypeRule(),
-	awsmodelrules.NewAwsAMIInvalidArchitectureRule(),
-	awsmodelrules.NewAwsAPIGatewayAuthorizerInvalidTypeRule(),
-	awsmodelrules.NewAwsAPIGatewayGatewayResponseInvalidResponseTypeRule(),
-	awsmodelrules.NewAwsAPIGatewayGatewayResponseInvalidStatusCodeRule(),
-	awsmodelrules.NewAwsAPIGatewayIntegrationInvalidConnectionTypeRule(),
-	awsmodelrules.NewAwsAPIGatewayIntegrationInvalidContentHandlingRule(),
-	awsmodelrules.NewAwsAPIGatewayIntegrationInvalidTypeRule(),
-	awsmodelrules.NewAwsAPIGatewayIntegrationResponseInvalidContentHandlingRule(),
-	awsmodelrules.NewAwsAPIGatewayIntegrationResponseInvalidStatusCodeRule(),
-	awsmodelrules.NewAwsAPIGatewayMethodResponseInvalidStatusCodeRule(),
-	awsmodelrules.NewAwsAPIGatewayRestAPIInvalidAPIKeySourceRule(),
-	awsmodelrules.NewAwsAPIGatewayStageInvalidCacheClusterSizeRule(),
-	awsmodelrules.NewAwsAppautoscalingPolicyInvalidPolicyTypeRule(),
-	awsmodelrules.NewAwsAppautoscalingPolicyInvalidScalableDimensionRule(),
-	awsmodelrules.NewAwsAppautoscalingPolicyInvalidServiceNamespaceRule(),
-	awsmodelrules.NewAwsAppautoscalingScheduledActionInvalidScalableDimensionRule(),
-	awsmodelrules.NewAwsAppautoscalingScheduledActionInvalidServiceNamespaceRule(),
-	awsmodelrules.NewAwsAppautoscalingTargetInvalidScalableDimensionRule(),
-	awsmodelrules.NewAwsAppautoscalingTargetInvalidServiceNamespaceRule(),
-	awsmodelrules.NewAwsAppmeshMeshInvalidNameRule(),
-	awsmodelrules.NewAwsAppmeshRouteInvalidMeshNameRule(),
-	awsmodelrules.NewAwsAppmeshRouteInvalidNameRule(),
-	awsmodelrules.NewAwsAppmeshRouteInvalidVirtualRouterNameRule(),
-	awsmodelrules.NewAwsAppmeshVirtualNodeInvalidMeshNameRule(),
-	awsmodelrules.NewAwsAppmeshVirtualNodeInvalidNameRule(),
-	awsmodelrules.NewAwsAppmeshVirtualRouterInvalidMeshNameRule(),
-	awsmodelrules.NewAwsAppmeshVirtualRouterInvalidNameRule(),
-	awsmodelrules.NewAwsAppmeshVirtualServiceInvalidMeshNameRule(),
-	awsmodelrules.NewAwsAppmeshVirtualServiceInvalidNameRule(),
-	awsmodelrules.NewAwsAppsyncDatasourceInvalidNameRule(),
-	awsmodelrules.NewAwsAppsyncDatasourceInvalidTypeRule(),
-	awsmodelrules.NewAwsAppsyncFunctionInvalidDataSourceRule(),
-	awsmodelrules.NewAwsAppsyncFunctionInvalidNameRule(),
-	awsmodelrules.NewAwsAppsyncFunctionInvalidRequestMappingTemplateRule(),
-	awsmodelrules.NewAwsAppsyncFunctionInvalidResponseMappingTemplateRule(),
-	awsmodelrules.NewAwsAppsyncGraphqlAPIInvalidAuthenticationTypeRule(),
-	awsmodelrules.NewAwsAppsyncResolverInvalidDataSourceRule(),
-	awsmodelrules.NewAwsAppsyncResolverInvalidFieldRule(),
-	awsmodelrules.NewAwsAppsyncResolverInvalidRequestTemplateRule(),
-	awsmodelrules.NewAwsAppsyncResolverInvalidResponseTemplateRule(),
-	awsmodelrules.NewAwsAppsyncResolverInvalidTypeRule(),
-	awsmodelrules.NewAwsAthenaDatabaseInvalidNameRule(),
-	awsmodelrules.NewAwsAthenaNamedQueryInvalidDatabaseRule(),
-	awsmodelrules.NewAwsAthenaNamedQueryInvalidDescriptionRule(),
-	awsmodelrules.NewAwsAthenaNamedQueryInvalidNameRule(),
-	awsmodelrules.NewAwsAthenaNamedQueryInvalidQueryRule(),
-	awsmodelrules.NewAwsAthenaWorkgroupInvalidDescriptionRule(),
-	awsmodelrules.NewAwsAthenaWorkgroupInvalidNameRule(),
-	awsmodelrules.NewAwsAthenaWorkgroupInvalidStateRule(),
-	awsmodelrules.NewAwsBackupSelectionInvalidNameRule(),
-	awsmodelrules.NewAwsBackupVaultInvalidNameRule(),
-	awsmodelrules.NewAwsBatchComputeEnvironmentInvalidStateRule(),
-	awsmodelrules.NewAwsBatchComputeEnvironmentInvalidTypeRule(),
-	awsmodelrules.NewAwsBatchJobDefinitionInvalidTypeRule(),
-	awsmodelrules.NewAwsBatchJobQueueInvalidStateRule(),
-	awsmodelrules.NewAwsBudgetsBudgetInvalidAccountIDRule(),
-	awsmodelrules.NewAwsBudgetsBudgetInvalidBudgetTypeRule(),
-	awsmodelrules.NewAwsBudgetsBudgetInvalidNameRule(),
-	awsmodelrules.NewAwsBudgetsBudgetInvalidTimeUnitRule(),
-	awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidDescriptionRule(),
-	awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidInstanceTypeRule(),
-	awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidNameRule(),
-	awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidOwnerArnRule(),
-	awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidSubnetIDRule(),
-	awsmodelrules.NewAwsCloudformationStackInvalidIAMRoleArnRule(),
-	awsmodelrules.NewAwsCloudformationStackInvalidOnFailureRule(),
-	awsmodelrules.NewAwsCloudformationStackInvalidPolicyBodyRule(),
-	awsmodelrules.NewAwsCloudformationStackInvalidPolicyURLRule(),
-	awsmodelrules.NewAwsCloudformationStackInvalidTemplateURLRule(),
-	awsmodelrules.NewAwsCloudformationStackSetInstanceInvalidAccountIDRule(),
-	awsmodelrules.NewAwsCloudformationStackSetInvalidAdministrationRoleArnRule(),
-	awsmodelrules.NewAwsCloudformationStackSetInvalidDescriptionRule(),
-	awsmodelrules.NewAwsCloudformationStackSetInvalidExecutionRoleNameRule(),
-	awsmodelrules.NewAwsCloudformationStackSetInvalidTemplateURLRule(),
-	awsmodelrules.NewAwsCloudfrontDistributionInvalidHTTPVersionRule(),
-	awsmodelrules.NewAwsCloudfrontDistributionInvalidPriceClassRule(),
-	awsmodelrules.NewAwsCloudhsmV2ClusterInvalidHsmTypeRule(),
-	awsmodelrules.NewAwsCloudhsmV2ClusterInvalidSourceBackupIdentifierRule(),
-	awsmodelrules.NewAwsCloudhsmV2HsmInvalidAvailabilityZoneRule(),
-	awsmodelrules.NewAwsCloudhsmV2HsmInvalidClusterIDRule(),
-	awsmodelrules.NewAwsCloudhsmV2HsmInvalidIPAddressRule(),
-	awsmodelrules.NewAwsCloudhsmV2HsmInvalidSubnetIDRule(),
-	awsmodelrules.NewAwsCloudwatchEventPermissionInvalidActionRule(),
-	awsmodelrules.NewAwsCloudwatchEventPermissionInvalidPrincipalRule(),
-	awsmodelrules.NewAwsCloudwatchEventPermissionInvalidStatementIDRule(),
-	awsmodelrules.NewAwsCloudwatchEventRuleInvalidDescriptionRule(),
-	awsmodelrules.NewAwsCloudwatchEventRuleInvalidNameRule(),
-	awsmodelrules.NewAwsCloudwatchEventRuleInvalidRoleArnRule(),
-	awsmodelrules.NewAwsCloudwatchEventRuleInvalidScheduleExpressionRule(),
-	awsmodelrules.NewAwsCloudwatchEventTargetInvalidArnRule(),
-	awsmodelrules.NewAwsCloudwatchEventTargetInvalidInputRule(),
-	awsmodelrules.NewAwsCloudwatchEventTargetInvalidInputPathRule(),
-	awsmodelrules.NewAwsCloudwatchEventTargetInvalidRoleArnRule(),
-	awsmodelrules.NewAwsCloudwatchEventTargetInvalidRuleRule(),
-	awsmodelrules.NewAwsCloudwatchEventTargetInvalidTargetIDRule(),
-	awsmodelrules.NewAwsCloudwatchLogDestinationInvalidNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogDestinationPolicyInvalidDestinationNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogGroupInvalidKmsKeyIDRule(),
-	awsmodelrules.NewAwsCloudwatchLogGroupInvalidNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogMetricFilterInvalidLogGroupNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogMetricFilterInvalidNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogMetricFilterInvalidPatternRule(),
-	awsmodelrules.NewAwsCloudwatchLogResourcePolicyInvalidPolicyDocumentRule(),
-	awsmodelrules.NewAwsCloudwatchLogStreamInvalidLogGroupNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogStreamInvalidNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidDistributionRule(),
-	awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidFilterPatternRule(),
-	awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidLogGroupNameRule(),
-	awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidNameRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidAlarmDescriptionRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidAlarmNameRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidComparisonOperatorRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidEvaluateLowSampleCountPercentilesRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidExtendedStatisticRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidMetricNameRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidNamespaceRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidStatisticRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidTreatMissingDataRule(),
-	awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidUnitRule(),
-	awsmodelrules.NewAwsCodebuildProjectInvalidDescriptionRule(),
-	awsmodelrules.NewAwsCodebuildSourceCredentialInvalidAuthTypeRule(),
-	awsmodelrules.NewAwsCodebuildSourceCredentialInvalidServerTypeRule(),
-	awsmodelrules.NewAwsCodecommitRepositoryInvalidDefaultBranchRule(),
-	awsmodelrules.NewAwsCodecommitRepositoryInvalidDescriptionRule(),
-	awsmodelrules.NewAwsCodecommitRepositoryInvalidRepositoryNameRule(),
-	awsmodelrules.NewAwsCodecommitTriggerInvalidRepositoryNameRule(),
-	awsmodelrules.NewAwsCodedeployAppInvalidComputePlatformRule(),
-	awsmodelrules.NewAwsCodedeployAppInvalidNameRule(),
-	awsmodelrules.NewAwsCodedeployDeploymentConfigInvalidComputePlatformRule(),
-	awsmodelrules.NewAwsCodedeployDeploymentConfigInvalidDeploymentConfigNameRule(),
-	awsmodelrules.NewAwsCodedeployDeploymentGroupInvalidAppNameRule(),
-	awsmodelrules.NewAwsCodedeployDeploymentGroupInvalidDeploymentConfigNameRule(),
-	awsmodelrules.NewAwsCodedeployDeploymentGroupInvalidDeploymentGroupNameRule(),
-	awsmodelrules.NewAwsCodepipelineInvalidNameRule(),
-	awsmodelrules.NewAwsCodepipelineInvalidRoleArnRule(),
-	awsmodelrules.NewAwsCodepipelineWebhookInvalidAuthenticationRule(),
-	awsmodelrules.NewAwsCodepipelineWebhookInvalidNameRule(),
-	awsmodelrules.NewAwsCodepipelineWebhookInvalidTargetActionRule(),
-	awsmodelrules.NewAwsCodepipelineWebhookInvalidTargetPipelineRule(),
-	awsmodelrules.NewAwsCognitoIdentityPoolInvalidDeveloperProviderNameRule(),
-	awsmodelrules.NewAwsCognitoIdentityPoolInvalidIdentityPoolNameRule(),
-	awsmodelrules.NewAwsCognitoIdentityPoolRolesAttachmentInvalidIdentityPoolIDRule(),
-	awsmodelrules.NewAwsCognitoIdentityProviderInvalidProviderNameRule(),
-	awsmodelrules.NewAwsCognitoIdentityProviderInvalidProviderTypeRule(),
-	awsmodelrules.NewAwsCognitoIdentityProviderInvalidUserPoolIDRule(),
-	awsmodelrules.NewAwsCognitoResourceServerInvalidIdentifierRule(),
-	awsmodelrules.NewAwsCognitoResourceServerInvalidNameRule(),
-	awsmodelrules.NewAwsCognitoUserGroupInvalidDescriptionRule(),
-	awsmodelrules.NewAwsCognitoUserGroupInvalidNameRule(),
-	awsmodelrules.NewAwsCognitoUserGroupInvalidRoleArnRule(),
-	awsmodelrules.NewAwsCognitoUserGroupInvalidUserPoolIDRule(),
-	awsmodelrules.NewAwsCognitoUserPoolClientInvalidDefaultRedirectURIRule(),
-	awsmodelrules.NewAwsCognitoUserPoolClientInvalidNameRule(),
-	awsmodelrules.NewAwsCognitoUserPoolClientInvalidUserPoolIDRule(),
-	awsmodelrules.NewAwsCognitoUserPoolDomainInvalidCertificateArnRule(),
-	awsmodelrules.NewAwsCognitoUserPoolDomainInvalidDomainRule(),
-	awsmodelrules.NewAwsCognitoUserPoolDomainInvalidUserPoolIDRule(),
-	awsmodelrules.NewAwsCognitoUserPoolInvalidEmailVerificationMessageRule(),
-	awsmodelrules.NewAwsCognitoUserPoolInvalidEmailVerificationSubjectRule(),
-	awsmodelrules.NewAwsCognitoUserPoolInvalidMfaConfigurationRule(),
-	awsmodelrules.NewAwsCognitoUserPoolInvalidNameRule(),
-	awsmodelrules.NewAwsCognitoUserPoolInvalidSmsAuthenticationMessageRule(),
-	awsmodelrules.NewAwsCognitoUserPoolInvalidSmsVerificationMessageRule(),
-	awsmodelrules.NewAwsConfigAggregateAuthorizationInvalidAccountIDRule(),
-	awsmodelrules.NewAwsConfigAggregateAuthorizationInvalidRegionRule(),
-	awsmodelrules.NewAwsConfigConfigRuleInvalidDescriptionRule(),
-	awsmodelrules.NewAwsConfigConfigRuleInvalidInputParametersRule(),
-	awsmodelrules.NewAwsConfigConfigRuleInvalidMaximumExecutionFrequencyRule(),
-	awsmodelrules.NewAwsConfigConfigRuleInvalidNameRule(),
-	awsmodelrules.NewAwsConfigConfigurationAggregatorInvalidNameRule(),
-	awsmodelrules.NewAwsConfigConfigurationRecorderInvalidNameRule(),
-	awsmodelrules.NewAwsConfigConfigurationRecorderStatusInvalidNameRule(),
-	awsmodelrules.NewAwsConfigDeliveryChannelInvalidNameRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidDescriptionRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidInputParametersRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidLambdaFunctionArnRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidMaximumExecutionFrequencyRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidNameRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidResourceIDScopeRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidTagKeyScopeRule(),
-	awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidTagValueScopeRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidDescriptionRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidInputParametersRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidMaximumExecutionFrequencyRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidNameRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidResourceIDScopeRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidRuleIdentifierRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidTagKeyScopeRule(),
-	awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidTagValueScopeRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidCompressionRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidFormatRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidReportNameRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidS3BucketRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidS3PrefixRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidS3RegionRule(),
-	awsmodelrules.NewAwsCurReportDefinitionInvalidTimeUnitRule(),
-	awsmodelrules.NewAwsCustomerGatewayInvalidTypeRule(),
-	awsmodelrules.NewAwsDatasyncAgentInvalidActivationKeyRule(),
-	awsmodelrules.NewAwsDatasyncAgentInvalidNameRule(),
-	awsmodelrules.NewAwsDatasyncLocationEfsInvalidEfsFileSystemArnRule(),
-	awsmodelrules.NewAwsDatasyncLocationEfsInvalidSubdirectoryRule(),
-	awsmodelrules.NewAwsDatasyncLocationNfsInvalidServerHostnameRule(),
-	awsmodelrules.NewAwsDatasyncLocationNfsInvalidSubdirectoryRule(),
-	awsmodelrules.NewAwsDatasyncLocationS3InvalidS3BucketArnRule(),
-	awsmodelrules.NewAwsDatasyncLocationS3InvalidSubdirectoryRule(),
-	awsmodelrules.NewAwsDatasyncTaskInvalidCloudwatchLogGroupArnRule(),
-	awsmodelrules.NewAwsDatasyncTaskInvalidDestinationLocationArnRule(),
-	awsmodelrules.NewAwsDatasyncTaskInvalidNameRule(),
-	awsmodelrules.NewAwsDatasyncTaskInvalidSourceLocationArnRule(),
-	awsmodelrules.NewAwsDevicefarmProjectInvalidNameRule(),
-	awsmodelrules.NewAwsDirectoryServiceConditionalForwarderInvalidDirectoryIDRule(),
-	awsmodelrules.NewAwsDirectoryServiceConditionalForwarderInvalidRemoteDomainNameRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidDescriptionRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidEditionRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidNameRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidPasswordRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidShortNameRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidSizeRule(),
-	awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidTypeRule(),
-	awsmodelrules.NewAwsDirectoryServiceLogSubscriptionInvalidDirectoryIDRule(),
-	awsmodelrules.NewAwsDirectoryServiceLogSubscriptionInvalidLogGroupNameRule(),
-	awsmodelrules.NewAwsDlmLifecyclePolicyInvalidDescriptionRule(),
-	awsmodelrules.NewAwsDlmLifecyclePolicyInvalidExecutionRoleArnRule(),
-	awsmodelrules.NewAwsDlmLifecyclePolicyInvalidStateRule(),
-	awsmodelrules.NewAwsDmsEndpointInvalidEndpointTypeRule(),
-	awsmodelrules.NewAwsDmsEndpointInvalidSslModeRule(),
-	awsmodelrules.NewAwsDmsReplicationTaskInvalidMigrationTypeRule(),
-	awsmodelrules.NewAwsDxBgpPeerInvalidAddressFamilyRule(),
-	awsmodelrules.NewAwsDxHostedPrivateVirtualInterfaceInvalidAddressFamilyRule(),
-	awsmodelrules.NewAwsDxHostedPublicVirtualInterfaceInvalidAddressFamilyRule(),
-	awsmodelrules.NewAwsDxPrivateVirtualInterfaceInvalidAddressFamilyRule(),
-	awsmodelrules.NewAwsDxPublicVirtualInterfaceInvalidAddressFamilyRule(),
-	awsmodelrules.NewAwsDynamoDBGlobalTableInvalidNameRule(),
-	awsmodelrules.NewAwsDynamoDBTableInvalidBillingModeRule(),
-	awsmodelrules.NewAwsDynamoDBTableInvalidHashKeyRule(),
-	awsmodelrules.NewAwsDynamoDBTableInvalidNameRule(),
-	awsmodelrules.NewAwsDynamoDBTableInvalidRangeKeyRule(),
-	awsmodelrules.NewAwsDynamoDBTableInvalidStreamViewTypeRule(),
-	awsmodelrules.NewAwsDynamoDBTableItemInvalidHashKeyRule(),
-	awsmodelrules.NewAwsDynamoDBTableItemInvalidRangeKeyRule(),
-	awsmodelrules.NewAwsDynamoDBTableItemInvalidTableNameRule(),
-	awsmodelrules.NewAwsEbsVolumeInvalidTypeRule(),
-	awsmodelrules.NewAwsEc2CapacityReservationInvalidEndDateTypeRule(),
-	awsmodelrules.NewAwsEc2CapacityReservationInvalidInstanceMatchCriteriaRule(),
-	awsmodelrules.NewAwsEc2CapacityReservationInvalidInstancePlatformRule(),
-	awsmodelrules.NewAwsEc2CapacityReservationInvalidTenancyRule(),
-	awsmodelrules.NewAwsEc2ClientVpnEndpointInvalidTransportProtocolRule(),
-	awsmodelrules.NewAwsEc2FleetInvalidExcessCapacityTerminationPolicyRule(),
-	awsmodelrules.NewAwsEc2FleetInvalidTypeRule(),
-	awsmodelrules.NewAwsEc2TransitGatewayInvalidAutoAcceptSharedAttachmentsRule(),
-	awsmodelrules.NewAwsEc2TransitGatewayInvalidDefaultRouteTableAssociationRule(),
-	awsmodelrules.NewAwsEc2TransitGatewayInvalidDefaultRouteTablePropagationRule(),
-	awsmodelrules.NewAwsEc2TransitGatewayInvalidDNSSupportRule(),
-	awsmodelrules.NewAwsEc2TransitGatewayVpcAttachmentInvalidDNSSupportRule(),
-	awsmodelrules.NewAwsEc2TransitGatewayVpcAttachmentInvalidIpv6SupportRule(),
-	awsmodelrules.NewAwsEcrLifecyclePolicyInvalidPolicyRule(),
-	awsmodelrules.NewAwsEcrLifecyclePolicyInvalidRepositoryRule(),
-	awsmodelrules.NewAwsEcrRepositoryInvalidNameRule(),
-	awsmodelrules.NewAwsEcrRepositoryPolicyInvalidPolicyRule(),
-	awsmodelrules.NewAwsEcrRepositoryPolicyInvalidRepositoryRule(),
-	awsmodelrules.NewAwsEcsServiceInvalidLaunchTypeRule(),
-	awsmodelrules.NewAwsEcsServiceInvalidPropagateTagsRule(),
-	awsmodelrules.NewAwsEcsServiceInvalidSchedulingStrategyRule(),
-	awsmodelrules.NewAwsEcsTaskDefinitionInvalidIpcModeRule(),
-	awsmodelrules.NewAwsEcsTaskDefinitionInvalidNetworkModeRule(),
-	awsmodelrules.NewAwsEcsTaskDefinitionInvalidPidModeRule(),
-	awsmodelrules.NewAwsEfsFileSystemInvalidCreationTokenRule(),
-	awsmodelrules.NewAwsEfsFileSystemInvalidKmsKeyIDRule(),
-	awsmodelrules.NewAwsEfsFileSystemInvalidPerformanceModeRule(),
-	awsmodelrules.NewAwsEfsFileSystemInvalidThroughputModeRule(),
-	awsmodelrules.NewAwsEksClusterInvalidNameRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationInvalidDescriptionRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationInvalidNameRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidApplicationRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidBucketRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidDescriptionRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidKeyRule(),
-	awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidNameRule(),
-	awsmodelrules.NewAwsElasticBeanstalkConfigurationTemplateInvalidApplicationRule(),
-	awsmodelrules.NewAwsElasticBeanstalkConfigurationTemplateInvalidDescriptionRule(),
-	awsmodelrules.NewAwsElasticBeanstalkConfigurationTemplateInvalidNameRule(),
-	awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidApplicationRule(),
-	awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidCnamePrefixRule(),
-	awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidDescriptionRule(),
-	awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidNameRule(),
-	awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidTemplateNameRule(),
-	awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidVersionLabelRule(),
-	awsmodelrules.NewAwsElastiCacheClusterInvalidAzModeRule(),
-	awsmodelrules.NewAwsElasticsearchDomainInvalidDomainNameRule(),
-	awsmodelrules.NewAwsElasticsearchDomainPolicyInvalidDomainNameRule(),
-	awsmodelrules.NewAwsElastictranscoderPipelineInvalidAwsKmsKeyArnRule(),
-	awsmodelrules.NewAwsElastictranscoderPipelineInvalidInputBucketRule(),
-	awsmodelrules.NewAwsElastictranscoderPipelineInvalidNameRule(),
-	awsmodelrules.NewAwsElastictranscoderPipelineInvalidOutputBucketRule(),
-	awsmodelrules.NewAwsElastictranscoderPipelineInvalidRoleRule(),
-	awsmodelrules.NewAwsElastictranscoderPresetInvalidContainerRule(),
-	awsmodelrules.NewAwsElastictranscoderPresetInvalidDescriptionRule(),
-	awsmodelrules.NewAwsElastictranscoderPresetInvalidNameRule(),
-	awsmodelrules.NewAwsEmrClusterInvalidScaleDownBehaviorRule(),
-	awsmodelrules.NewAwsFlowLogInvalidLogDestinationTypeRule(),
-	awsmodelrules.NewAwsFlowLogInvalidTrafficTypeRule(),
-	awsmodelrules.NewAwsFmsAdminAccountInvalidAccountIDRule(),
-	awsmodelrules.NewAwsFsxLustreFileSystemInvalidExportPathRule(),
-	awsmodelrules.NewAwsFsxLustreFileSystemInvalidImportPathRule(),
-	awsmodelrules.NewAwsFsxLustreFileSystemInvalidWeeklyMaintenanceStartTimeRule(),
-	awsmodelrules.NewAwsFsxWindowsFileSystemInvalidActiveDirectoryIDRule(),
-	awsmodelrules.NewAwsFsxWindowsFileSystemInvalidDailyAutomaticBackupStartTimeRule(),
-	awsmodelrules.NewAwsFsxWindowsFileSystemInvalidWeeklyMaintenanceStartTimeRule(),
-	awsmodelrules.NewAwsGameliftAliasInvalidDescriptionRule(),
-	awsmodelrules.NewAwsGameliftAliasInvalidNameRule(),
-	awsmodelrules.NewAwsGameliftBuildInvalidNameRule(),
-	awsmodelrules.NewAwsGameliftBuildInvalidOperatingSystemRule(),
-	awsmodelrules.NewAwsGameliftBuildInvalidVersionRule(),
-	awsmodelrules.NewAwsGameliftFleetInvalidBuildIDRule(),
-	awsmodelrules.NewAwsGameliftFleetInvalidDescriptionRule(),
-	awsmodelrules.NewAwsGameliftFleetInvalidEc2InstanceTypeRule(),
-	awsmodelrules.NewAwsGameliftFleetInvalidNameRule(),
-	awsmodelrules.NewAwsGameliftFleetInvalidNewGameSessionProtectionPolicyRule(),
-	awsmodelrules.NewAwsGameliftGameSessionQueueInvalidNameRule(),
-	awsmodelrules.NewAwsGlobalacceleratorAcceleratorInvalidIPAddressTypeRule(),
-	awsmodelrules.NewAwsGlobalacceleratorAcceleratorInvalidNameRule(),
-	awsmodelrules.NewAwsGlobalacceleratorEndpointGroupInvalidHealthCheckPathRule(),
-	awsmodelrules.NewAwsGlobalacceleratorEndpointGroupInvalidHealthCheckProtocolRule(),
-	awsmodelrules.NewAwsGlobalacceleratorEndpointGroupInvalidListenerArnRule(),
-	awsmodelrules.NewAwsGlobalacceleratorListenerInvalidAcceleratorArnRule(),
-	awsmodelrules.NewAwsGlobalacceleratorListenerInvalidClientAffinityRule(),
-	awsmodelrules.NewAwsGlobalacceleratorListenerInvalidProtocolRule(),
-	awsmodelrules.NewAwsGlueCatalogTableInvalidTableTypeRule(),
-	awsmodelrules.NewAwsGlueCatalogTableInvalidViewExpandedTextRule(),
-	awsmodelrules.NewAwsGlueCatalogTableInvalidViewOriginalTextRule(),
-	awsmodelrules.NewAwsGlueConnectionInvalidConnectionTypeRule(),
-	awsmodelrules.NewAwsGlueCrawlerInvalidSecurityConfigurationRule(),
-	awsmodelrules.NewAwsGlueCrawlerInvalidTablePrefixRule(),
-	awsmodelrules.NewAwsGlueTriggerInvalidTypeRule(),
-	awsmodelrules.NewAwsGuarddutyDetectorInvalidFindingPublishingFrequencyRule(),
-	awsmodelrules.NewAwsGuarddutyInviteAccepterInvalidDetectorIDRule(),
-	awsmodelrules.NewAwsGuarddutyIpsetInvalidDetectorIDRule(),
-	awsmodelrules.NewAwsGuarddutyIpsetInvalidFormatRule(),
-	awsmodelrules.NewAwsGuarddutyIpsetInvalidLocationRule(),
-	awsmodelrules.NewAwsGuarddutyIpsetInvalidNameRule(),
-	awsmodelrules.NewAwsGuarddutyMemberInvalidDetectorIDRule(),
-	awsmodelrules.NewAwsGuarddutyMemberInvalidEmailRule(),
-	awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidDetectorIDRule(),
-	awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidFormatRule(),
-	awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidLocationRule(),
-	awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidNameRule(),
-	awsmodelrules.NewAwsIAMAccessKeyInvalidStatusRule(),
-	awsmodelrules.NewAwsIAMAccessKeyInvalidUserRule(),
-	awsmodelrules.NewAwsIAMGroupInvalidNameRule(),
-	awsmodelrules.NewAwsIAMGroupInvalidPathRule(),
-	awsmodelrules.NewAwsIAMGroupMembershipInvalidGroupRule(),
-	awsmodelrules.NewAwsIAMGroupPolicyAttachmentInvalidGroupRule(),
-	awsmodelrules.NewAwsIAMGroupPolicyAttachmentInvalidPolicyArnRule(),
-	awsmodelrules.NewAwsIAMGroupPolicyInvalidGroupRule(),
-	awsmodelrules.NewAwsIAMGroupPolicyInvalidNameRule(),
-	awsmodelrules.NewAwsIAMGroupPolicyInvalidPolicyRule(),
-	awsmodelrules.NewAwsIAMInstanceProfileInvalidNameRule(),
-	awsmodelrules.NewAwsIAMInstanceProfileInvalidPathRule(),
-	awsmodelrules.NewAwsIAMInstanceProfileInvalidRoleRule(),
-	awsmodelrules.NewAwsIAMOpenidConnectProviderInvalidURLRule(),
-	awsmodelrules.NewAwsIAMPolicyAttachmentInvalidPolicyArnRule(),
-	awsmodelrules.NewAwsIAMPolicyInvalidDescriptionRule(),
-	awsmodelrules.NewAwsIAMPolicyInvalidNameRule(),
-	awsmodelrules.NewAwsIAMPolicyInvalidPathRule(),
-	awsmodelrules.NewAwsIAMPolicyInvalidPolicyRule(),
-	awsmodelrules.NewAwsIAMRoleInvalidAssumeRolePolicyRule(),
-	awsmodelrules.NewAwsIAMRoleInvalidDescriptionRule(),
-	awsmodelrules.NewAwsIAMRoleInvalidNameRule(),
-	awsmodelrules.NewAwsIAMRoleInvalidPathRule(),
-	awsmodelrules.NewAwsIAMRoleInvalidPermissionsBoundaryRule(),
-	awsmodelrules.NewAwsIAMRolePolicyAttachmentInvalidPolicyArnRule(),
-	awsmodelrules.NewAwsIAMRolePolicyAttachmentInvalidRoleRule(),
-	awsmodelrules.NewAwsIAMRolePolicyInvalidNameRule(),
-	awsmodelrules.NewAwsIAMRolePolicyInvalidPolicyRule(),
-	awsmodelrules.NewAwsIAMRolePolicyInvalidRoleRule(),
-	awsmodelrules.NewAwsIAMSamlProviderInvalidNameRule(),
-	awsmodelrules.NewAwsIAMSamlProviderInvalidSamlMetadataDocumentRule(),
-	awsmodelrules.NewAwsIAMServerCertificateInvalidCertificateBodyRule(),
-	awsmodelrules.NewAwsIAMServerCertificateInvalidCertificateChainRule(),
-	awsmodelrules.NewAwsIAMServerCertificateInvalidNameRule(),
-	awsmodelrules.NewAwsIAMServerCertificateInvalidPathRule(),
-	awsmodelrules.NewAwsIAMServerCertificateInvalidPrivateKeyRule(),
-	awsmodelrules.NewAwsIAMServiceLinkedRoleInvalidAwsServiceNameRule(),
-	awsmodelrules.NewAwsIAMServiceLinkedRoleInvalidCustomSuffixRule(),
-	awsmodelrules.NewAwsIAMServiceLinkedRoleInvalidDescriptionRule(),
-	awsmodelrules.NewAwsIAMUserGroupMembershipInvalidUserRule(),
-	awsmodelrules.NewAwsIAMUserInvalidNameRule(),
-	awsmodelrules.NewAwsIAMUserInvalidPathRule(),
-	awsmodelrules.NewAwsIAMUserInvalidPermissionsBoundaryRule(),
-	awsmodelrules.NewAwsIAMUserLoginProfileInvalidUserRule(),
-	awsmodelrules.NewAwsIAMUserPolicyAttachmentInvalidPolicyArnRule(),
-	awsmodelrules.NewAwsIAMUserPolicyAttachmentInvalidUserRule(),
-	awsmodelrules.NewAwsIAMUserPolicyInvalidNameRule(),
-	awsmodelrules.NewAwsIAMUserPolicyInvalidPolicyRule(),
-	awsmodelrules.NewAwsIAMUserPolicyInvalidUserRule(),
-	awsmodelrules.NewAwsIAMUserSSHKeyInvalidEncodingRule(),
-	awsmodelrules.NewAwsIAMUserSSHKeyInvalidPublicKeyRule(),
-	awsmodelrules.NewAwsIAMUserSSHKeyInvalidStatusRule(),
-	awsmodelrules.NewAwsIAMUserSSHKeyInvalidUsernameRule(),
-	awsmodelrules.NewAwsInspectorAssessmentTargetInvalidNameRule(),
-	awsmodelrules.NewAwsInspectorAssessmentTargetInvalidResourceGroupArnRule(),
-	awsmodelrules.NewAwsInspectorAssessmentTemplateInvalidNameRule(),
-	awsmodelrules.NewAwsInspectorAssessmentTemplateInvalidTargetArnRule(),
-	awsmodelrules.NewAwsInstanceInvalidInstanceInitiatedShutdownBehaviorRule(),
-	awsmodelrules.NewAwsInstanceInvalidTenancyRule(),
-	awsmodelrules.NewAwsInstanceInvalidTypeRule(),
-	awsmodelrules.NewAwsIotPolicyAttachmentInvalidPolicyRule(),
-	awsmodelrules.NewAwsIotPolicyInvalidNameRule(),
-	awsmodelrules.NewAwsIotRoleAliasInvalidAliasRule(),
-	awsmodelrules.NewAwsIotRoleAliasInvalidRoleArnRule(),
-	awsmodelrules.NewAwsIotThingInvalidNameRule(),
-	awsmodelrules.NewAwsIotThingInvalidThingTypeNameRule(),
-	awsmodelrules.NewAwsIotThingPrincipalAttachmentInvalidThingRule(),
-	awsmodelrules.NewAwsIotThingTypeInvalidNameRule(),
-	awsmodelrules.NewAwsIotTopicRuleInvalidNameRule(),
-	awsmodelrules.NewAwsKinesisAnalyticsApplicationInvalidCodeRule(),
-	awsmodelrules.NewAwsKinesisAnalyticsApplicationInvalidDescriptionRule(),
-	awsmodelrules.NewAwsKinesisAnalyticsApplicationInvalidNameRule(),
-	awsmodelrules.NewAwsKinesisFirehoseDeliveryStreamInvalidNameRule(),
-	awsmodelrules.NewAwsKinesisStreamInvalidEncryptionTypeRule(),
-	awsmodelrules.NewAwsKinesisStreamInvalidKmsKeyIDRule(),
-	awsmodelrules.NewAwsKinesisStreamInvalidNameRule(),
-	awsmodelrules.NewAwsKmsAliasInvalidNameRule(),
-	awsmodelrules.NewAwsKmsAliasInvalidTargetKeyIDRule(),
-	awsmodelrules.NewAwsKmsCiphertextInvalidKeyIDRule(),
-	awsmodelrules.NewAwsKmsExternalKeyInvalidDescriptionRule(),
-	awsmodelrules.NewAwsKmsExternalKeyInvalidPolicyRule(),
-	awsmodelrules.NewAwsKmsGrantInvalidGranteePrincipalRule(),
-	awsmodelrules.NewAwsKmsGrantInvalidKeyIDRule(),
-	awsmodelrules.NewAwsKmsGrantInvalidNameRule(),
-	awsmodelrules.NewAwsKmsGrantInvalidRetiringPrincipalRule(),
-	awsmodelrules.NewAwsKmsKeyInvalidDescriptionRule(),
-	awsmodelrules.NewAwsKmsKeyInvalidKeyUsageRule(),
-	awsmodelrules.NewAwsKmsKeyInvalidPolicyRule(),
-	awsmodelrules.NewAwsLambdaAliasInvalidDescriptionRule(),
-	awsmodelrules.NewAwsLambdaAliasInvalidFunctionNameRule(),
-	awsmodelrules.NewAwsLambdaAliasInvalidFunctionVersionRule(),
-	awsmodelrules.NewAwsLambdaEventSourceMappingInvalidEventSourceArnRule(),
-	awsmodelrules.NewAwsLambdaEventSourceMappingInvalidFunctionNameRule(),
-	awsmodelrules.NewAwsLambdaEventSourceMappingInvalidStartingPositionRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidDescriptionRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidFunctionNameRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidHandlerRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidKmsKeyArnRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidRoleRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidRuntimeRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidS3KeyRule(),
-	awsmodelrules.NewAwsLambdaFunctionInvalidS3ObjectVersionRule(),
-	awsmodelrules.NewAwsLambdaLayerVersionInvalidDescriptionRule(),
-	awsmodelrules.NewAwsLambdaLayerVersionInvalidLayerNameRule(),
-	awsmodelrules.NewAwsLambdaLayerVersionInvalidLicenseInfoRule(),
-	awsmodelrules.NewAwsLambdaLayerVersionInvalidS3KeyRule(),
-	awsmodelrules.NewAwsLambdaLayerVersionInvalidS3ObjectVersionRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidActionRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidEventSourceTokenRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidFunctionNameRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidPrincipalRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidQualifierRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidSourceAccountRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidSourceArnRule(),
-	awsmodelrules.NewAwsLambdaPermissionInvalidStatementIDRule(),
-	awsmodelrules.NewAwsLaunchConfigurationInvalidSpotPriceRule(),
-	awsmodelrules.NewAwsLaunchConfigurationInvalidTypeRule(),
-	awsmodelrules.NewAwsLaunchTemplateInvalidDescriptionRule(),
-	awsmodelrules.NewAwsLaunchTemplateInvalidInstanceInitiatedShutdownBehaviorRule(),
-	awsmodelrules.NewAwsLaunchTemplateInvalidInstanceTypeRule(),
-	awsmodelrules.NewAwsLaunchTemplateInvalidNameRule(),
-	awsmodelrules.NewAwsLbInvalidIPAddressTypeRule(),
-	awsmodelrules.NewAwsLbInvalidLoadBalancerTypeRule(),
-	awsmodelrules.NewAwsLbListenerInvalidProtocolRule(),
-	awsmodelrules.NewAwsLbTargetGroupInvalidProtocolRule(),
-	awsmodelrules.NewAwsLbTargetGroupInvalidTargetTypeRule(),
-	awsmodelrules.NewAwsLicensemanagerLicenseConfigurationInvalidLicenseCountingTypeRule(),
-	awsmodelrules.NewAwsLightsailInstanceInvalidBlueprintIDRule(),
-	awsmodelrules.NewAwsLightsailInstanceInvalidBundleIDRule(),
-	awsmodelrules.NewAwsLightsailInstanceInvalidKeyPairNameRule(),
-	awsmodelrules.NewAwsLightsailKeyPairInvalidNameRule(),
-	awsmodelrules.NewAwsLightsailStaticIPAttachmentInvalidInstanceNameRule(),
-	awsmodelrules.NewAwsLightsailStaticIPAttachmentInvalidStaticIPNameRule(),
-	awsmodelrules.NewAwsLightsailStaticIPInvalidNameRule(),
-	awsmodelrules.NewAwsMacieMemberAccountAssociationInvalidMemberAccountIDRule(),
-	awsmodelrules.NewAwsMacieS3BucketAssociationInvalidBucketNameRule(),
-	awsmodelrules.NewAwsMacieS3BucketAssociationInvalidMemberAccountIDRule(),
-	awsmodelrules.NewAwsMacieS3BucketAssociationInvalidPrefixRule(),
-	awsmodelrules.NewAwsMediaStoreContainerInvalidNameRule(),
-	awsmodelrules.NewAwsMediaStoreContainerPolicyInvalidContainerNameRule(),
-	awsmodelrules.NewAwsMqBrokerInvalidDeploymentModeRule(),
-	awsmodelrules.NewAwsMskClusterInvalidClusterNameRule(),
-	awsmodelrules.NewAwsMskClusterInvalidEnhancedMonitoringRule(),
-	awsmodelrules.NewAwsMskClusterInvalidKafkaVersionRule(),
-	awsmodelrules.NewAwsNetworkACLRuleInvalidRuleActionRule(),
-	awsmodelrules.NewAwsOpsworksApplicationInvalidTypeRule(),
-	awsmodelrules.NewAwsOpsworksInstanceInvalidArchitectureRule(),
-	awsmodelrules.NewAwsOpsworksInstanceInvalidAutoScalingTypeRule(),
-	awsmodelrules.NewAwsOpsworksInstanceInvalidRootDeviceTypeRule(),
-	awsmodelrules.NewAwsOpsworksStackInvalidDefaultRootDeviceTypeRule(),
-	awsmodelrules.NewAwsOrganizationsAccountInvalidEmailRule(),
-	awsmodelrules.NewAwsOrganizationsAccountInvalidIAMUserAccessToBillingRule(),
-	awsmodelrules.NewAwsOrganizationsAccountInvalidNameRule(),
-	awsmodelrules.NewAwsOrganizationsAccountInvalidParentIDRule(),
-	awsmodelrules.NewAwsOrganizationsAccountInvalidRoleNameRule(),
-	awsmodelrules.NewAwsOrganizationsOrganizationInvalidFeatureSetRule(),
-	awsmodelrules.NewAwsOrganizationsOrganizationalUnitInvalidNameRule(),
-	awsmodelrules.NewAwsOrganizationsOrganizationalUnitInvalidParentIDRule(),
-	awsmodelrules.NewAwsOrganizationsPolicyAttachmentInvalidPolicyIDRule(),
-	awsmodelrules.NewAwsOrganizationsPolicyAttachmentInvalidTargetIDRule(),
-	awsmodelrules.NewAwsOrganizationsPolicyInvalidContentRule(),
-	awsmodelrules.NewAwsOrganizationsPolicyInvalidDescriptionRule(),
-	awsmodelrules.NewAwsOrganizationsPolicyInvalidNameRule(),
-	awsmodelrules.NewAwsOrganizationsPolicyInvalidTypeRule(),
-	awsmodelrules.NewAwsPlacementGroupInvalidStrategyRule(),
-	awsmodelrules.NewAwsQuicksightGroupInvalidAwsAccountIDRule(),
-	awsmodelrules.NewAwsQuicksightGroupInvalidDescriptionRule(),
-	awsmodelrules.NewAwsQuicksightGroupInvalidGroupNameRule(),
-	awsmodelrules.NewAwsQuicksightGroupInvalidNamespaceRule(),
-	awsmodelrules.NewAwsResourcegroupsGroupInvalidDescriptionRule(),
-	awsmodelrules.NewAwsResourcegroupsGroupInvalidNameRule(),
-	awsmodelrules.NewAwsRoute53DelegationSetInvalidReferenceNameRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidCloudwatchAlarmNameRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidCloudwatchAlarmRegionRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidFqdnRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidInsufficientDataHealthStatusRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidIPAddressRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidReferenceNameRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidResourcePathRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidSearchStringRule(),
-	awsmodelrules.NewAwsRoute53HealthCheckInvalidTypeRule(),
-	awsmodelrules.NewAwsRoute53QueryLogInvalidZoneIDRule(),
-	awsmodelrules.NewAwsRoute53RecordInvalidHealthCheckIDRule(),
-	awsmodelrules.NewAwsRoute53RecordInvalidNameRule(),
-	awsmodelrules.NewAwsRoute53RecordInvalidSetIdentifierRule(),
-	awsmodelrules.NewAwsRoute53RecordInvalidTypeRule(),
-	awsmodelrules.NewAwsRoute53RecordInvalidZoneIDRule(),
-	awsmodelrules.NewAwsRoute53ResolverEndpointInvalidDirectionRule(),
-	awsmodelrules.NewAwsRoute53ResolverRuleAssociationInvalidResolverRuleIDRule(),
-	awsmodelrules.NewAwsRoute53ResolverRuleAssociationInvalidVpcIDRule(),
-	awsmodelrules.NewAwsRoute53ResolverRuleInvalidDomainNameRule(),
-	awsmodelrules.NewAwsRoute53ResolverRuleInvalidResolverEndpointIDRule(),
-	awsmodelrules.NewAwsRoute53ResolverRuleInvalidRuleTypeRule(),
-	awsmodelrules.NewAwsRoute53ZoneAssociationInvalidVpcIDRule(),
-	awsmodelrules.NewAwsRoute53ZoneAssociationInvalidVpcRegionRule(),
-	awsmodelrules.NewAwsRoute53ZoneAssociationInvalidZoneIDRule(),
-	awsmodelrules.NewAwsRoute53ZoneInvalidCommentRule(),
-	awsmodelrules.NewAwsRoute53ZoneInvalidDelegationSetIDRule(),
-	awsmodelrules.NewAwsRoute53ZoneInvalidNameRule(),
-	awsmodelrules.NewAwsS3BucketInvalidAccelerationStatusRule(),
-	awsmodelrules.NewAwsS3BucketInvalidRequestPayerRule(),
-	awsmodelrules.NewAwsS3BucketInventoryInvalidIncludedObjectVersionsRule(),
-	awsmodelrules.NewAwsS3BucketObjectInvalidACLRule(),
-	awsmodelrules.NewAwsS3BucketObjectInvalidServerSideEncryptionRule(),
-	awsmodelrules.NewAwsS3BucketObjectInvalidStorageClassRule(),
-	awsmodelrules.NewAwsSagemakerEndpointConfigurationInvalidKmsKeyArnRule(),
-	awsmodelrules.NewAwsSagemakerEndpointConfigurationInvalidNameRule(),
-	awsmodelrules.NewAwsSagemakerEndpointInvalidEndpointConfigNameRule(),
-	awsmodelrules.NewAwsSagemakerEndpointInvalidNameRule(),
-	awsmodelrules.NewAwsSagemakerModelInvalidExecutionRoleArnRule(),
-	awsmodelrules.NewAwsSagemakerModelInvalidNameRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidInstanceTypeRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidKmsKeyIDRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidLifecycleConfigNameRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidNameRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidRoleArnRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidSubnetIDRule(),
-	awsmodelrules.NewAwsSagemakerNotebookInstanceLifecycleConfigurationInvalidNameRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretInvalidKmsKeyIDRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretInvalidNameRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretInvalidPolicyRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretInvalidRotationLambdaArnRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretVersionInvalidSecretIDRule(),
-	awsmodelrules.NewAwsSecretsmanagerSecretVersionInvalidSecretStringRule(),
-	awsmodelrules.NewAwsSecurityhubProductSubscriptionInvalidProductArnRule(),
-	awsmodelrules.NewAwsSecurityhubStandardsSubscriptionInvalidStandardsArnRule(),
-	awsmodelrules.NewAwsServiceDiscoveryHTTPNamespaceInvalidDescriptionRule(),
-	awsmodelrules.NewAwsServiceDiscoveryHTTPNamespaceInvalidNameRule(),
-	awsmodelrules.NewAwsServiceDiscoveryPrivateDNSNamespaceInvalidDescriptionRule(),
-	awsmodelrules.NewAwsServiceDiscoveryPrivateDNSNamespaceInvalidNameRule(),
-	awsmodelrules.NewAwsServiceDiscoveryPrivateDNSNamespaceInvalidVpcRule(),
-	awsmodelrules.NewAwsServiceDiscoveryPublicDNSNamespaceInvalidDescriptionRule(),
-	awsmodelrules.NewAwsServiceDiscoveryPublicDNSNamespaceInvalidNameRule(),
-	awsmodelrules.NewAwsServiceDiscoveryServiceInvalidDescriptionRule(),
-	awsmodelrules.NewAwsServicecatalogPortfolioInvalidDescriptionRule(),
-	awsmodelrules.NewAwsServicecatalogPortfolioInvalidNameRule(),
-	awsmodelrules.NewAwsServicecatalogPortfolioInvalidProviderNameRule(),
-	awsmodelrules.NewAwsServicequotasServiceQuotaInvalidQuotaCodeRule(),
-	awsmodelrules.NewAwsServicequotasServiceQuotaInvalidServiceCodeRule(),
-	awsmodelrules.NewAwsSesDomainMailFromInvalidBehaviorOnMxFailureRule(),
-	awsmodelrules.NewAwsSesIdentityNotificationTopicInvalidNotificationTypeRule(),
-	awsmodelrules.NewAwsSesIdentityPolicyInvalidNameRule(),
-	awsmodelrules.NewAwsSesReceiptFilterInvalidPolicyRule(),
-	awsmodelrules.NewAwsSesReceiptRuleInvalidTLSPolicyRule(),
-	awsmodelrules.NewAwsSfnActivityInvalidNameRule(),
-	awsmodelrules.NewAwsSfnStateMachineInvalidDefinitionRule(),
-	awsmodelrules.NewAwsSfnStateMachineInvalidNameRule(),
-	awsmodelrules.NewAwsSfnStateMachineInvalidRoleArnRule(),
-	awsmodelrules.NewAwsShieldProtectionInvalidNameRule(),
-	awsmodelrules.NewAwsShieldProtectionInvalidResourceArnRule(),
-	awsmodelrules.NewAwsSpotFleetRequestInvalidAllocationStrategyRule(),
-	awsmodelrules.NewAwsSpotFleetRequestInvalidFleetTypeRule(),
-	awsmodelrules.NewAwsSpotFleetRequestInvalidInstanceInterruptionBehaviourRule(),
-	awsmodelrules.NewAwsSpotInstanceRequestInvalidInstanceInterruptionBehaviourRule(),
-	awsmodelrules.NewAwsSsmActivationInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSsmActivationInvalidIAMRoleRule(),
-	awsmodelrules.NewAwsSsmActivationInvalidNameRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidAssociationNameRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidComplianceSeverityRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidDocumentVersionRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidInstanceIDRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidMaxConcurrencyRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidMaxErrorsRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidNameRule(),
-	awsmodelrules.NewAwsSsmAssociationInvalidScheduleExpressionRule(),
-	awsmodelrules.NewAwsSsmDocumentInvalidDocumentFormatRule(),
-	awsmodelrules.NewAwsSsmDocumentInvalidDocumentTypeRule(),
-	awsmodelrules.NewAwsSsmDocumentInvalidNameRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowInvalidNameRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowInvalidScheduleRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidNameRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidOwnerInformationRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidResourceTypeRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidWindowIDRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidMaxConcurrencyRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidMaxErrorsRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidNameRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidTaskArnRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidTaskTypeRule(),
-	awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidWindowIDRule(),
-	awsmodelrules.NewAwsSsmParameterInvalidAllowedPatternRule(),
-	awsmodelrules.NewAwsSsmParameterInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSsmParameterInvalidKeyIDRule(),
-	awsmodelrules.NewAwsSsmParameterInvalidNameRule(),
-	awsmodelrules.NewAwsSsmParameterInvalidTierRule(),
-	awsmodelrules.NewAwsSsmParameterInvalidTypeRule(),
-	awsmodelrules.NewAwsSsmPatchBaselineInvalidApprovedPatchesComplianceLevelRule(),
-	awsmodelrules.NewAwsSsmPatchBaselineInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSsmPatchBaselineInvalidNameRule(),
-	awsmodelrules.NewAwsSsmPatchBaselineInvalidOperatingSystemRule(),
-	awsmodelrules.NewAwsSsmPatchGroupInvalidBaselineIDRule(),
-	awsmodelrules.NewAwsSsmPatchGroupInvalidPatchGroupRule(),
-	awsmodelrules.NewAwsSsmResourceDataSyncInvalidNameRule(),
-	awsmodelrules.NewAwsStoragegatewayCacheInvalidDiskIDRule(),
-	awsmodelrules.NewAwsStoragegatewayCacheInvalidGatewayArnRule(),
-	awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidGatewayArnRule(),
-	awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidNetworkInterfaceIDRule(),
-	awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidSnapshotIDRule(),
-	awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidSourceVolumeArnRule(),
-	awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidTargetNameRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidActivationKeyRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidGatewayNameRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidGatewayTimezoneRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidGatewayTypeRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidMediumChangerTypeRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidSmbGuestPasswordRule(),
-	awsmodelrules.NewAwsStoragegatewayGatewayInvalidTapeDriveTypeRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidDefaultStorageClassRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidGatewayArnRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidKmsKeyArnRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidLocationArnRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidObjectACLRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidRoleArnRule(),
-	awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidSquashRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidAuthenticationRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidDefaultStorageClassRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidGatewayArnRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidKmsKeyArnRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidLocationArnRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidObjectACLRule(),
-	awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidRoleArnRule(),
-	awsmodelrules.NewAwsStoragegatewayUploadBufferInvalidDiskIDRule(),
-	awsmodelrules.NewAwsStoragegatewayUploadBufferInvalidGatewayArnRule(),
-	awsmodelrules.NewAwsStoragegatewayWorkingStorageInvalidDiskIDRule(),
-	awsmodelrules.NewAwsStoragegatewayWorkingStorageInvalidGatewayArnRule(),
-	awsmodelrules.NewAwsSwfDomainInvalidDescriptionRule(),
-	awsmodelrules.NewAwsSwfDomainInvalidNameRule(),
-	awsmodelrules.NewAwsSwfDomainInvalidWorkflowExecutionRetentionPeriodInDaysRule(),
-	awsmodelrules.NewAwsTransferServerInvalidEndpointTypeRule(),
-	awsmodelrules.NewAwsTransferServerInvalidIdentityProviderTypeRule(),
-	awsmodelrules.NewAwsTransferServerInvalidInvocationRoleRule(),
-	awsmodelrules.NewAwsTransferServerInvalidLoggingRoleRule(),
-	awsmodelrules.NewAwsTransferServerInvalidURLRule(),
-	awsmodelrules.NewAwsTransferSSHKeyInvalidBodyRule(),
-	awsmodelrules.NewAwsTransferSSHKeyInvalidServerIDRule(),
-	awsmodelrules.NewAwsTransferSSHKeyInvalidUserNameRule(),
-	awsmodelrules.NewAwsTransferUserInvalidHomeDirectoryRule(),
-	awsmodelrules.NewAwsTransferUserInvalidPolicyRule(),
-	awsmodelrules.NewAwsTransferUserInvalidRoleRule(),
-	awsmodelrules.NewAwsTransferUserInvalidServerIDRule(),
-	awsmodelrules.NewAwsTransferUserInvalidUserNameRule(),
-	awsmodelrules.NewAwsVpcEndpointInvalidVpcEndpointTypeRule(),
-	awsmodelrules.NewAwsVpcInvalidInstanceTenancyRule(),
-	awsmodelrules.NewAwsWafByteMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafGeoMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafIpsetInvalidNameRule(),
-	awsmodelrules.NewAwsWafRateBasedRuleInvalidNameRule(),
-	awsmodelrules.NewAwsWafRateBasedRuleInvalidRateKeyRule(),
-	awsmodelrules.NewAwsWafRegexMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafRegexPatternSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafRuleGroupInvalidNameRule(),
-	awsmodelrules.NewAwsWafRuleInvalidNameRule(),
-	awsmodelrules.NewAwsWafSizeConstraintSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafSQLInjectionMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafWebACLInvalidNameRule(),
-	awsmodelrules.NewAwsWafXSSMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalByteMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalGeoMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalIpsetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalRateBasedRuleInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalRateBasedRuleInvalidRateKeyRule(),
-	awsmodelrules.NewAwsWafregionalRegexMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalRegexPatternSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalRuleGroupInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalRuleInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalSizeConstraintSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalSQLInjectionMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalWebACLAssociationInvalidResourceArnRule(),
-	awsmodelrules.NewAwsWafregionalWebACLAssociationInvalidWebACLIDRule(),
-	awsmodelrules.NewAwsWafregionalWebACLInvalidNameRule(),
-	awsmodelrules.NewAwsWafregionalXSSMatchSetInvalidNameRule(),
-	awsmodelrules.NewAwsWorklinkFleetInvalidDeviceCaCertificateRule(),
-	awsmodelrules.NewAwsWorklinkFleetInvalidDisplayNameRule(),
-	awsmodelrules.NewAwsWorklinkFleetInvalidNameRule(),
-	awsmodelrules.NewAwsWorklinkWebsiteCertificateAuthorityAssociationInvalidCertificateRule(),
-	awsmodelrules.NewAwsWorklinkWebsiteCertificateAuthorityAssociationInvalidDisplayNameRule(),
-	awsmodelrules.NewAwsWorklinkWebsiteCertificateAuthorityAssociationInvalidFleetArnRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidHostRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidHTTPMethodRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidResourceArnRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidRuleNameRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidServiceNameRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidServiceTypeRule(),
-	awsmodelrules.NewAwsXraySamplingRuleInvalidURLPathRule(),
+func modelRules() []Rule {
+	return []Rule{
+		awsmodelrules.NewAwsAcmCertificateInvalidCertificateBodyRule(),
+		awsmodelrules.NewAwsAcmCertificateInvalidCertificateChainRule(),
+		awsmodelrules.NewAwsAcmCertificateInvalidPrivateKeyRule(),
+		awsmodelrules.NewAwsAcmpcaCertificateAuthorityInvalidTypeRule(),
+		awsmodelrules.NewAwsALBInvalidIPAddressTypeRule(),
+		awsmodelrules.NewAwsALBInvalidLoadBalancerTypeRule(),
+		awsmodelrules.NewAwsALBListenerInvalidProtocolRule(),
+		awsmodelrules.NewAwsALBTargetGroupInvalidProtocolRule(),
+		awsmodelrules.NewAwsALBTargetGroupInvalidTargetTypeRule(),
+		awsmodelrules.NewAwsAMIInvalidArchitectureRule(),
+		awsmodelrules.NewAwsAPIGatewayAuthorizerInvalidTypeRule(),
+		awsmodelrules.NewAwsAPIGatewayGatewayResponseInvalidResponseTypeRule(),
+		awsmodelrules.NewAwsAPIGatewayGatewayResponseInvalidStatusCodeRule(),
+		awsmodelrules.NewAwsAPIGatewayIntegrationInvalidConnectionTypeRule(),
+		awsmodelrules.NewAwsAPIGatewayIntegrationInvalidContentHandlingRule(),
+		awsmodelrules.NewAwsAPIGatewayIntegrationInvalidTypeRule(),
+		awsmodelrules.NewAwsAPIGatewayIntegrationResponseInvalidContentHandlingRule(),
+		awsmodelrules.NewAwsAPIGatewayIntegrationResponseInvalidStatusCodeRule(),
+		awsmodelrules.NewAwsAPIGatewayMethodResponseInvalidStatusCodeRule(),
+		awsmodelrules.NewAwsAPIGatewayRestAPIInvalidAPIKeySourceRule(),
+		awsmodelrules.NewAwsAPIGatewayStageInvalidCacheClusterSizeRule(),
+		awsmodelrules.NewAwsAppautoscalingPolicyInvalidPolicyTypeRule(),
+		awsmodelrules.NewAwsAppautoscalingPolicyInvalidScalableDimensionRule(),
+		awsmodelrules.NewAwsAppautoscalingPolicyInvalidServiceNamespaceRule(),
+		awsmodelrules.NewAwsAppautoscalingScheduledActionInvalidScalableDimensionRule(),
+		awsmodelrules.NewAwsAppautoscalingScheduledActionInvalidServiceNamespaceRule(),
+		awsmodelrules.NewAwsAppautoscalingTargetInvalidScalableDimensionRule(),
+		awsmodelrules.NewAwsAppautoscalingTargetInvalidServiceNamespaceRule(),
+		awsmodelrules.NewAwsAppmeshMeshInvalidNameRule(),
+		awsmodelrules.NewAwsAppmeshRouteInvalidMeshNameRule(),
+		awsmodelrules.NewAwsAppmeshRouteInvalidNameRule(),
+		awsmodelrules.NewAwsAppmeshRouteInvalidVirtualRouterNameRule(),
+		awsmodelrules.NewAwsAppmeshVirtualNodeInvalidMeshNameRule(),
+		awsmodelrules.NewAwsAppmeshVirtualNodeInvalidNameRule(),
+		awsmodelrules.NewAwsAppmeshVirtualRouterInvalidMeshNameRule(),
+		awsmodelrules.NewAwsAppmeshVirtualRouterInvalidNameRule(),
+		awsmodelrules.NewAwsAppmeshVirtualServiceInvalidMeshNameRule(),
+		awsmodelrules.NewAwsAppmeshVirtualServiceInvalidNameRule(),
+		awsmodelrules.NewAwsAppsyncDatasourceInvalidNameRule(),
+		awsmodelrules.NewAwsAppsyncDatasourceInvalidTypeRule(),
+		awsmodelrules.NewAwsAppsyncFunctionInvalidDataSourceRule(),
+		awsmodelrules.NewAwsAppsyncFunctionInvalidNameRule(),
+		awsmodelrules.NewAwsAppsyncFunctionInvalidRequestMappingTemplateRule(),
+		awsmodelrules.NewAwsAppsyncFunctionInvalidResponseMappingTemplateRule(),
+		awsmodelrules.NewAwsAppsyncGraphqlAPIInvalidAuthenticationTypeRule(),
+		awsmodelrules.NewAwsAppsyncResolverInvalidDataSourceRule(),
+		awsmodelrules.NewAwsAppsyncResolverInvalidFieldRule(),
+		awsmodelrules.NewAwsAppsyncResolverInvalidRequestTemplateRule(),
+		awsmodelrules.NewAwsAppsyncResolverInvalidResponseTemplateRule(),
+		awsmodelrules.NewAwsAppsyncResolverInvalidTypeRule(),
+		awsmodelrules.NewAwsAthenaDatabaseInvalidNameRule(),
+		awsmodelrules.NewAwsAthenaNamedQueryInvalidDatabaseRule(),
+		awsmodelrules.NewAwsAthenaNamedQueryInvalidDescriptionRule(),
+		awsmodelrules.NewAwsAthenaNamedQueryInvalidNameRule(),
+		awsmodelrules.NewAwsAthenaNamedQueryInvalidQueryRule(),
+		awsmodelrules.NewAwsAthenaWorkgroupInvalidDescriptionRule(),
+		awsmodelrules.NewAwsAthenaWorkgroupInvalidNameRule(),
+		awsmodelrules.NewAwsAthenaWorkgroupInvalidStateRule(),
+		awsmodelrules.NewAwsBackupSelectionInvalidNameRule(),
+		awsmodelrules.NewAwsBackupVaultInvalidNameRule(),
+		awsmodelrules.NewAwsBatchComputeEnvironmentInvalidStateRule(),
+		awsmodelrules.NewAwsBatchComputeEnvironmentInvalidTypeRule(),
+		awsmodelrules.NewAwsBatchJobDefinitionInvalidTypeRule(),
+		awsmodelrules.NewAwsBatchJobQueueInvalidStateRule(),
+		awsmodelrules.NewAwsBudgetsBudgetInvalidAccountIDRule(),
+		awsmodelrules.NewAwsBudgetsBudgetInvalidBudgetTypeRule(),
+		awsmodelrules.NewAwsBudgetsBudgetInvalidNameRule(),
+		awsmodelrules.NewAwsBudgetsBudgetInvalidTimeUnitRule(),
+		awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidDescriptionRule(),
+		awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidInstanceTypeRule(),
+		awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidNameRule(),
+		awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidOwnerArnRule(),
+		awsmodelrules.NewAwsCloud9EnvironmentEc2InvalidSubnetIDRule(),
+		awsmodelrules.NewAwsCloudformationStackInvalidIAMRoleArnRule(),
+		awsmodelrules.NewAwsCloudformationStackInvalidOnFailureRule(),
+		awsmodelrules.NewAwsCloudformationStackInvalidPolicyBodyRule(),
+		awsmodelrules.NewAwsCloudformationStackInvalidPolicyURLRule(),
+		awsmodelrules.NewAwsCloudformationStackInvalidTemplateURLRule(),
+		awsmodelrules.NewAwsCloudformationStackSetInstanceInvalidAccountIDRule(),
+		awsmodelrules.NewAwsCloudformationStackSetInvalidAdministrationRoleArnRule(),
+		awsmodelrules.NewAwsCloudformationStackSetInvalidDescriptionRule(),
+		awsmodelrules.NewAwsCloudformationStackSetInvalidExecutionRoleNameRule(),
+		awsmodelrules.NewAwsCloudformationStackSetInvalidTemplateURLRule(),
+		awsmodelrules.NewAwsCloudfrontDistributionInvalidHTTPVersionRule(),
+		awsmodelrules.NewAwsCloudfrontDistributionInvalidPriceClassRule(),
+		awsmodelrules.NewAwsCloudhsmV2ClusterInvalidHsmTypeRule(),
+		awsmodelrules.NewAwsCloudhsmV2ClusterInvalidSourceBackupIdentifierRule(),
+		awsmodelrules.NewAwsCloudhsmV2HsmInvalidAvailabilityZoneRule(),
+		awsmodelrules.NewAwsCloudhsmV2HsmInvalidClusterIDRule(),
+		awsmodelrules.NewAwsCloudhsmV2HsmInvalidIPAddressRule(),
+		awsmodelrules.NewAwsCloudhsmV2HsmInvalidSubnetIDRule(),
+		awsmodelrules.NewAwsCloudwatchEventPermissionInvalidActionRule(),
+		awsmodelrules.NewAwsCloudwatchEventPermissionInvalidPrincipalRule(),
+		awsmodelrules.NewAwsCloudwatchEventPermissionInvalidStatementIDRule(),
+		awsmodelrules.NewAwsCloudwatchEventRuleInvalidDescriptionRule(),
+		awsmodelrules.NewAwsCloudwatchEventRuleInvalidNameRule(),
+		awsmodelrules.NewAwsCloudwatchEventRuleInvalidRoleArnRule(),
+		awsmodelrules.NewAwsCloudwatchEventRuleInvalidScheduleExpressionRule(),
+		awsmodelrules.NewAwsCloudwatchEventTargetInvalidArnRule(),
+		awsmodelrules.NewAwsCloudwatchEventTargetInvalidInputRule(),
+		awsmodelrules.NewAwsCloudwatchEventTargetInvalidInputPathRule(),
+		awsmodelrules.NewAwsCloudwatchEventTargetInvalidRoleArnRule(),
+		awsmodelrules.NewAwsCloudwatchEventTargetInvalidRuleRule(),
+		awsmodelrules.NewAwsCloudwatchEventTargetInvalidTargetIDRule(),
+		awsmodelrules.NewAwsCloudwatchLogDestinationInvalidNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogDestinationPolicyInvalidDestinationNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogGroupInvalidKmsKeyIDRule(),
+		awsmodelrules.NewAwsCloudwatchLogGroupInvalidNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogMetricFilterInvalidLogGroupNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogMetricFilterInvalidNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogMetricFilterInvalidPatternRule(),
+		awsmodelrules.NewAwsCloudwatchLogResourcePolicyInvalidPolicyDocumentRule(),
+		awsmodelrules.NewAwsCloudwatchLogStreamInvalidLogGroupNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogStreamInvalidNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidDistributionRule(),
+		awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidFilterPatternRule(),
+		awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidLogGroupNameRule(),
+		awsmodelrules.NewAwsCloudwatchLogSubscriptionFilterInvalidNameRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidAlarmDescriptionRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidAlarmNameRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidComparisonOperatorRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidEvaluateLowSampleCountPercentilesRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidExtendedStatisticRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidMetricNameRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidNamespaceRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidStatisticRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidTreatMissingDataRule(),
+		awsmodelrules.NewAwsCloudwatchMetricAlarmInvalidUnitRule(),
+		awsmodelrules.NewAwsCodebuildProjectInvalidDescriptionRule(),
+		awsmodelrules.NewAwsCodebuildSourceCredentialInvalidAuthTypeRule(),
+		awsmodelrules.NewAwsCodebuildSourceCredentialInvalidServerTypeRule(),
+		awsmodelrules.NewAwsCodecommitRepositoryInvalidDefaultBranchRule(),
+		awsmodelrules.NewAwsCodecommitRepositoryInvalidDescriptionRule(),
+		awsmodelrules.NewAwsCodecommitRepositoryInvalidRepositoryNameRule(),
+		awsmodelrules.NewAwsCodecommitTriggerInvalidRepositoryNameRule(),
+		awsmodelrules.NewAwsCodedeployAppInvalidComputePlatformRule(),
+		awsmodelrules.NewAwsCodedeployAppInvalidNameRule(),
+		awsmodelrules.NewAwsCodedeployDeploymentConfigInvalidComputePlatformRule(),
+		awsmodelrules.NewAwsCodedeployDeploymentConfigInvalidDeploymentConfigNameRule(),
+		awsmodelrules.NewAwsCodedeployDeploymentGroupInvalidAppNameRule(),
+		awsmodelrules.NewAwsCodedeployDeploymentGroupInvalidDeploymentConfigNameRule(),
+		awsmodelrules.NewAwsCodedeployDeploymentGroupInvalidDeploymentGroupNameRule(),
+		awsmodelrules.NewAwsCodepipelineInvalidNameRule(),
+		awsmodelrules.NewAwsCodepipelineInvalidRoleArnRule(),
+		awsmodelrules.NewAwsCodepipelineWebhookInvalidAuthenticationRule(),
+		awsmodelrules.NewAwsCodepipelineWebhookInvalidNameRule(),
+		awsmodelrules.NewAwsCodepipelineWebhookInvalidTargetActionRule(),
+		awsmodelrules.NewAwsCodepipelineWebhookInvalidTargetPipelineRule(),
+		awsmodelrules.NewAwsCognitoIdentityPoolInvalidDeveloperProviderNameRule(),
+		awsmodelrules.NewAwsCognitoIdentityPoolInvalidIdentityPoolNameRule(),
+		awsmodelrules.NewAwsCognitoIdentityPoolRolesAttachmentInvalidIdentityPoolIDRule(),
+		awsmodelrules.NewAwsCognitoIdentityProviderInvalidProviderNameRule(),
+		awsmodelrules.NewAwsCognitoIdentityProviderInvalidProviderTypeRule(),
+		awsmodelrules.NewAwsCognitoIdentityProviderInvalidUserPoolIDRule(),
+		awsmodelrules.NewAwsCognitoResourceServerInvalidIdentifierRule(),
+		awsmodelrules.NewAwsCognitoResourceServerInvalidNameRule(),
+		awsmodelrules.NewAwsCognitoUserGroupInvalidDescriptionRule(),
+		awsmodelrules.NewAwsCognitoUserGroupInvalidNameRule(),
+		awsmodelrules.NewAwsCognitoUserGroupInvalidRoleArnRule(),
+		awsmodelrules.NewAwsCognitoUserGroupInvalidUserPoolIDRule(),
+		awsmodelrules.NewAwsCognitoUserPoolClientInvalidDefaultRedirectURIRule(),
+		awsmodelrules.NewAwsCognitoUserPoolClientInvalidNameRule(),
+		awsmodelrules.NewAwsCognitoUserPoolClientInvalidUserPoolIDRule(),
+		awsmodelrules.NewAwsCognitoUserPoolDomainInvalidCertificateArnRule(),
+		awsmodelrules.NewAwsCognitoUserPoolDomainInvalidDomainRule(),
+		awsmodelrules.NewAwsCognitoUserPoolDomainInvalidUserPoolIDRule(),
+		awsmodelrules.NewAwsCognitoUserPoolInvalidEmailVerificationMessageRule(),
+		awsmodelrules.NewAwsCognitoUserPoolInvalidEmailVerificationSubjectRule(),
+		awsmodelrules.NewAwsCognitoUserPoolInvalidMfaConfigurationRule(),
+		awsmodelrules.NewAwsCognitoUserPoolInvalidNameRule(),
+		awsmodelrules.NewAwsCognitoUserPoolInvalidSmsAuthenticationMessageRule(),
+		awsmodelrules.NewAwsCognitoUserPoolInvalidSmsVerificationMessageRule(),
+		awsmodelrules.NewAwsConfigAggregateAuthorizationInvalidAccountIDRule(),
+		awsmodelrules.NewAwsConfigAggregateAuthorizationInvalidRegionRule(),
+		awsmodelrules.NewAwsConfigConfigRuleInvalidDescriptionRule(),
+		awsmodelrules.NewAwsConfigConfigRuleInvalidInputParametersRule(),
+		awsmodelrules.NewAwsConfigConfigRuleInvalidMaximumExecutionFrequencyRule(),
+		awsmodelrules.NewAwsConfigConfigRuleInvalidNameRule(),
+		awsmodelrules.NewAwsConfigConfigurationAggregatorInvalidNameRule(),
+		awsmodelrules.NewAwsConfigConfigurationRecorderInvalidNameRule(),
+		awsmodelrules.NewAwsConfigConfigurationRecorderStatusInvalidNameRule(),
+		awsmodelrules.NewAwsConfigDeliveryChannelInvalidNameRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidDescriptionRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidInputParametersRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidLambdaFunctionArnRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidMaximumExecutionFrequencyRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidNameRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidResourceIDScopeRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidTagKeyScopeRule(),
+		awsmodelrules.NewAwsConfigOrganizationCustomRuleInvalidTagValueScopeRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidDescriptionRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidInputParametersRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidMaximumExecutionFrequencyRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidNameRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidResourceIDScopeRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidRuleIdentifierRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidTagKeyScopeRule(),
+		awsmodelrules.NewAwsConfigOrganizationManagedRuleInvalidTagValueScopeRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidCompressionRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidFormatRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidReportNameRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidS3BucketRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidS3PrefixRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidS3RegionRule(),
+		awsmodelrules.NewAwsCurReportDefinitionInvalidTimeUnitRule(),
+		awsmodelrules.NewAwsCustomerGatewayInvalidTypeRule(),
+		awsmodelrules.NewAwsDatasyncAgentInvalidActivationKeyRule(),
+		awsmodelrules.NewAwsDatasyncAgentInvalidNameRule(),
+		awsmodelrules.NewAwsDatasyncLocationEfsInvalidEfsFileSystemArnRule(),
+		awsmodelrules.NewAwsDatasyncLocationEfsInvalidSubdirectoryRule(),
+		awsmodelrules.NewAwsDatasyncLocationNfsInvalidServerHostnameRule(),
+		awsmodelrules.NewAwsDatasyncLocationNfsInvalidSubdirectoryRule(),
+		awsmodelrules.NewAwsDatasyncLocationS3InvalidS3BucketArnRule(),
+		awsmodelrules.NewAwsDatasyncLocationS3InvalidSubdirectoryRule(),
+		awsmodelrules.NewAwsDatasyncTaskInvalidCloudwatchLogGroupArnRule(),
+		awsmodelrules.NewAwsDatasyncTaskInvalidDestinationLocationArnRule(),
+		awsmodelrules.NewAwsDatasyncTaskInvalidNameRule(),
+		awsmodelrules.NewAwsDatasyncTaskInvalidSourceLocationArnRule(),
+		awsmodelrules.NewAwsDevicefarmProjectInvalidNameRule(),
+		awsmodelrules.NewAwsDirectoryServiceConditionalForwarderInvalidDirectoryIDRule(),
+		awsmodelrules.NewAwsDirectoryServiceConditionalForwarderInvalidRemoteDomainNameRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidDescriptionRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidEditionRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidNameRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidPasswordRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidShortNameRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidSizeRule(),
+		awsmodelrules.NewAwsDirectoryServiceDirectoryInvalidTypeRule(),
+		awsmodelrules.NewAwsDirectoryServiceLogSubscriptionInvalidDirectoryIDRule(),
+		awsmodelrules.NewAwsDirectoryServiceLogSubscriptionInvalidLogGroupNameRule(),
+		awsmodelrules.NewAwsDlmLifecyclePolicyInvalidDescriptionRule(),
+		awsmodelrules.NewAwsDlmLifecyclePolicyInvalidExecutionRoleArnRule(),
+		awsmodelrules.NewAwsDlmLifecyclePolicyInvalidStateRule(),
+		awsmodelrules.NewAwsDmsEndpointInvalidEndpointTypeRule(),
+		awsmodelrules.NewAwsDmsEndpointInvalidSslModeRule(),
+		awsmodelrules.NewAwsDmsReplicationTaskInvalidMigrationTypeRule(),
+		awsmodelrules.NewAwsDxBgpPeerInvalidAddressFamilyRule(),
+		awsmodelrules.NewAwsDxHostedPrivateVirtualInterfaceInvalidAddressFamilyRule(),
+		awsmodelrules.NewAwsDxHostedPublicVirtualInterfaceInvalidAddressFamilyRule(),
+		awsmodelrules.NewAwsDxPrivateVirtualInterfaceInvalidAddressFamilyRule(),
+		awsmodelrules.NewAwsDxPublicVirtualInterfaceInvalidAddressFamilyRule(),
+		awsmodelrules.NewAwsDynamoDBGlobalTableInvalidNameRule(),
+		awsmodelrules.NewAwsDynamoDBTableInvalidBillingModeRule(),
+		awsmodelrules.NewAwsDynamoDBTableInvalidHashKeyRule(),
+		awsmodelrules.NewAwsDynamoDBTableInvalidNameRule(),
+		awsmodelrules.NewAwsDynamoDBTableInvalidRangeKeyRule(),
+		awsmodelrules.NewAwsDynamoDBTableInvalidStreamViewTypeRule(),
+		awsmodelrules.NewAwsDynamoDBTableItemInvalidHashKeyRule(),
+		awsmodelrules.NewAwsDynamoDBTableItemInvalidRangeKeyRule(),
+		awsmodelrules.NewAwsDynamoDBTableItemInvalidTableNameRule(),
+		awsmodelrules.NewAwsEbsVolumeInvalidTypeRule(),
+		awsmodelrules.NewAwsEc2CapacityReservationInvalidEndDateTypeRule(),
+		awsmodelrules.NewAwsEc2CapacityReservationInvalidInstanceMatchCriteriaRule(),
+		awsmodelrules.NewAwsEc2CapacityReservationInvalidInstancePlatformRule(),
+		awsmodelrules.NewAwsEc2CapacityReservationInvalidTenancyRule(),
+		awsmodelrules.NewAwsEc2ClientVpnEndpointInvalidTransportProtocolRule(),
+		awsmodelrules.NewAwsEc2FleetInvalidExcessCapacityTerminationPolicyRule(),
+		awsmodelrules.NewAwsEc2FleetInvalidTypeRule(),
+		awsmodelrules.NewAwsEc2TransitGatewayInvalidAutoAcceptSharedAttachmentsRule(),
+		awsmodelrules.NewAwsEc2TransitGatewayInvalidDefaultRouteTableAssociationRule(),
+		awsmodelrules.NewAwsEc2TransitGatewayInvalidDefaultRouteTablePropagationRule(),
+		awsmodelrules.NewAwsEc2TransitGatewayInvalidDNSSupportRule(),
+		awsmodelrules.NewAwsEc2TransitGatewayVpcAttachmentInvalidDNSSupportRule(),
+		awsmodelrules.NewAwsEc2TransitGatewayVpcAttachmentInvalidIpv6SupportRule(),
+		awsmodelrules.NewAwsEcrLifecyclePolicyInvalidPolicyRule(),
+		awsmodelrules.NewAwsEcrLifecyclePolicyInvalidRepositoryRule(),
+		awsmodelrules.NewAwsEcrRepositoryInvalidNameRule(),
+		awsmodelrules.NewAwsEcrRepositoryPolicyInvalidPolicyRule(),
+		awsmodelrules.NewAwsEcrRepositoryPolicyInvalidRepositoryRule(),
+		awsmodelrules.NewAwsEcsServiceInvalidLaunchTypeRule(),
+		awsmodelrules.NewAwsEcsServiceInvalidPropagateTagsRule(),
+		awsmodelrules.NewAwsEcsServiceInvalidSchedulingStrategyRule(),
+		awsmodelrules.NewAwsEcsTaskDefinitionInvalidIpcModeRule(),
+		awsmodelrules.NewAwsEcsTaskDefinitionInvalidNetworkModeRule(),
+		awsmodelrules.NewAwsEcsTaskDefinitionInvalidPidModeRule(),
+		awsmodelrules.NewAwsEfsFileSystemInvalidCreationTokenRule(),
+		awsmodelrules.NewAwsEfsFileSystemInvalidKmsKeyIDRule(),
+		awsmodelrules.NewAwsEfsFileSystemInvalidPerformanceModeRule(),
+		awsmodelrules.NewAwsEfsFileSystemInvalidThroughputModeRule(),
+		awsmodelrules.NewAwsEksClusterInvalidNameRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationInvalidDescriptionRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationInvalidNameRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidApplicationRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidBucketRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidDescriptionRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidKeyRule(),
+		awsmodelrules.NewAwsElasticBeanstalkApplicationVersionInvalidNameRule(),
+		awsmodelrules.NewAwsElasticBeanstalkConfigurationTemplateInvalidApplicationRule(),
+		awsmodelrules.NewAwsElasticBeanstalkConfigurationTemplateInvalidDescriptionRule(),
+		awsmodelrules.NewAwsElasticBeanstalkConfigurationTemplateInvalidNameRule(),
+		awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidApplicationRule(),
+		awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidCnamePrefixRule(),
+		awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidDescriptionRule(),
+		awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidNameRule(),
+		awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidTemplateNameRule(),
+		awsmodelrules.NewAwsElasticBeanstalkEnvironmentInvalidVersionLabelRule(),
+		awsmodelrules.NewAwsElastiCacheClusterInvalidAzModeRule(),
+		awsmodelrules.NewAwsElasticsearchDomainInvalidDomainNameRule(),
+		awsmodelrules.NewAwsElasticsearchDomainPolicyInvalidDomainNameRule(),
+		awsmodelrules.NewAwsElastictranscoderPipelineInvalidAwsKmsKeyArnRule(),
+		awsmodelrules.NewAwsElastictranscoderPipelineInvalidInputBucketRule(),
+		awsmodelrules.NewAwsElastictranscoderPipelineInvalidNameRule(),
+		awsmodelrules.NewAwsElastictranscoderPipelineInvalidOutputBucketRule(),
+		awsmodelrules.NewAwsElastictranscoderPipelineInvalidRoleRule(),
+		awsmodelrules.NewAwsElastictranscoderPresetInvalidContainerRule(),
+		awsmodelrules.NewAwsElastictranscoderPresetInvalidDescriptionRule(),
+		awsmodelrules.NewAwsElastictranscoderPresetInvalidNameRule(),
+		awsmodelrules.NewAwsEmrClusterInvalidScaleDownBehaviorRule(),
+		awsmodelrules.NewAwsFlowLogInvalidLogDestinationTypeRule(),
+		awsmodelrules.NewAwsFlowLogInvalidTrafficTypeRule(),
+		awsmodelrules.NewAwsFmsAdminAccountInvalidAccountIDRule(),
+		awsmodelrules.NewAwsFsxLustreFileSystemInvalidExportPathRule(),
+		awsmodelrules.NewAwsFsxLustreFileSystemInvalidImportPathRule(),
+		awsmodelrules.NewAwsFsxLustreFileSystemInvalidWeeklyMaintenanceStartTimeRule(),
+		awsmodelrules.NewAwsFsxWindowsFileSystemInvalidActiveDirectoryIDRule(),
+		awsmodelrules.NewAwsFsxWindowsFileSystemInvalidDailyAutomaticBackupStartTimeRule(),
+		awsmodelrules.NewAwsFsxWindowsFileSystemInvalidWeeklyMaintenanceStartTimeRule(),
+		awsmodelrules.NewAwsGameliftAliasInvalidDescriptionRule(),
+		awsmodelrules.NewAwsGameliftAliasInvalidNameRule(),
+		awsmodelrules.NewAwsGameliftBuildInvalidNameRule(),
+		awsmodelrules.NewAwsGameliftBuildInvalidOperatingSystemRule(),
+		awsmodelrules.NewAwsGameliftBuildInvalidVersionRule(),
+		awsmodelrules.NewAwsGameliftFleetInvalidBuildIDRule(),
+		awsmodelrules.NewAwsGameliftFleetInvalidDescriptionRule(),
+		awsmodelrules.NewAwsGameliftFleetInvalidEc2InstanceTypeRule(),
+		awsmodelrules.NewAwsGameliftFleetInvalidNameRule(),
+		awsmodelrules.NewAwsGameliftFleetInvalidNewGameSessionProtectionPolicyRule(),
+		awsmodelrules.NewAwsGameliftGameSessionQueueInvalidNameRule(),
+		awsmodelrules.NewAwsGlobalacceleratorAcceleratorInvalidIPAddressTypeRule(),
+		awsmodelrules.NewAwsGlobalacceleratorAcceleratorInvalidNameRule(),
+		awsmodelrules.NewAwsGlobalacceleratorEndpointGroupInvalidHealthCheckPathRule(),
+		awsmodelrules.NewAwsGlobalacceleratorEndpointGroupInvalidHealthCheckProtocolRule(),
+		awsmodelrules.NewAwsGlobalacceleratorEndpointGroupInvalidListenerArnRule(),
+		awsmodelrules.NewAwsGlobalacceleratorListenerInvalidAcceleratorArnRule(),
+		awsmodelrules.NewAwsGlobalacceleratorListenerInvalidClientAffinityRule(),
+		awsmodelrules.NewAwsGlobalacceleratorListenerInvalidProtocolRule(),
+		awsmodelrules.NewAwsGlueCatalogTableInvalidTableTypeRule(),
+		awsmodelrules.NewAwsGlueCatalogTableInvalidViewExpandedTextRule(),
+		awsmodelrules.NewAwsGlueCatalogTableInvalidViewOriginalTextRule(),
+		awsmodelrules.NewAwsGlueConnectionInvalidConnectionTypeRule(),
+		awsmodelrules.NewAwsGlueCrawlerInvalidSecurityConfigurationRule(),
+		awsmodelrules.NewAwsGlueCrawlerInvalidTablePrefixRule(),
+		awsmodelrules.NewAwsGlueTriggerInvalidTypeRule(),
+		awsmodelrules.NewAwsGuarddutyDetectorInvalidFindingPublishingFrequencyRule(),
+		awsmodelrules.NewAwsGuarddutyInviteAccepterInvalidDetectorIDRule(),
+		awsmodelrules.NewAwsGuarddutyIpsetInvalidDetectorIDRule(),
+		awsmodelrules.NewAwsGuarddutyIpsetInvalidFormatRule(),
+		awsmodelrules.NewAwsGuarddutyIpsetInvalidLocationRule(),
+		awsmodelrules.NewAwsGuarddutyIpsetInvalidNameRule(),
+		awsmodelrules.NewAwsGuarddutyMemberInvalidDetectorIDRule(),
+		awsmodelrules.NewAwsGuarddutyMemberInvalidEmailRule(),
+		awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidDetectorIDRule(),
+		awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidFormatRule(),
+		awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidLocationRule(),
+		awsmodelrules.NewAwsGuarddutyThreatintelsetInvalidNameRule(),
+		awsmodelrules.NewAwsIAMAccessKeyInvalidStatusRule(),
+		awsmodelrules.NewAwsIAMAccessKeyInvalidUserRule(),
+		awsmodelrules.NewAwsIAMGroupInvalidNameRule(),
+		awsmodelrules.NewAwsIAMGroupInvalidPathRule(),
+		awsmodelrules.NewAwsIAMGroupMembershipInvalidGroupRule(),
+		awsmodelrules.NewAwsIAMGroupPolicyAttachmentInvalidGroupRule(),
+		awsmodelrules.NewAwsIAMGroupPolicyAttachmentInvalidPolicyArnRule(),
+		awsmodelrules.NewAwsIAMGroupPolicyInvalidGroupRule(),
+		awsmodelrules.NewAwsIAMGroupPolicyInvalidNameRule(),
+		awsmodelrules.NewAwsIAMGroupPolicyInvalidPolicyRule(),
+		awsmodelrules.NewAwsIAMInstanceProfileInvalidNameRule(),
+		awsmodelrules.NewAwsIAMInstanceProfileInvalidPathRule(),
+		awsmodelrules.NewAwsIAMInstanceProfileInvalidRoleRule(),
+		awsmodelrules.NewAwsIAMOpenidConnectProviderInvalidURLRule(),
+		awsmodelrules.NewAwsIAMPolicyAttachmentInvalidPolicyArnRule(),
+		awsmodelrules.NewAwsIAMPolicyInvalidDescriptionRule(),
+		awsmodelrules.NewAwsIAMPolicyInvalidNameRule(),
+		awsmodelrules.NewAwsIAMPolicyInvalidPathRule(),
+		awsmodelrules.NewAwsIAMPolicyInvalidPolicyRule(),
+		awsmodelrules.NewAwsIAMRoleInvalidAssumeRolePolicyRule(),
+		awsmodelrules.NewAwsIAMRoleInvalidDescriptionRule(),
+		awsmodelrules.NewAwsIAMRoleInvalidNameRule(),
+		awsmodelrules.NewAwsIAMRoleInvalidPathRule(),
+		awsmodelrules.NewAwsIAMRoleInvalidPermissionsBoundaryRule(),
+		awsmodelrules.NewAwsIAMRolePolicyAttachmentInvalidPolicyArnRule(),
+		awsmodelrules.NewAwsIAMRolePolicyAttachmentInvalidRoleRule(),
+		awsmodelrules.NewAwsIAMRolePolicyInvalidNameRule(),
+		awsmodelrules.NewAwsIAMRolePolicyInvalidPolicyRule(),
+		awsmodelrules.NewAwsIAMRolePolicyInvalidRoleRule(),
+		awsmodelrules.NewAwsIAMSamlProviderInvalidNameRule(),
+		awsmodelrules.NewAwsIAMSamlProviderInvalidSamlMetadataDocumentRule(),
+		awsmodelrules.NewAwsIAMServerCertificateInvalidCertificateBodyRule(),
+		awsmodelrules.NewAwsIAMServerCertificateInvalidCertificateChainRule(),
+		awsmodelrules.NewAwsIAMServerCertificateInvalidNameRule(),
+		awsmodelrules.NewAwsIAMServerCertificateInvalidPathRule(),
+		awsmodelrules.NewAwsIAMServerCertificateInvalidPrivateKeyRule(),
+		awsmodelrules.NewAwsIAMServiceLinkedRoleInvalidAwsServiceNameRule(),
+		awsmodelrules.NewAwsIAMServiceLinkedRoleInvalidCustomSuffixRule(),
+		awsmodelrules.NewAwsIAMServiceLinkedRoleInvalidDescriptionRule(),
+		awsmodelrules.NewAwsIAMUserGroupMembershipInvalidUserRule(),
+		awsmodelrules.NewAwsIAMUserInvalidNameRule(),
+		awsmodelrules.NewAwsIAMUserInvalidPathRule(),
+		awsmodelrules.NewAwsIAMUserInvalidPermissionsBoundaryRule(),
+		awsmodelrules.NewAwsIAMUserLoginProfileInvalidUserRule(),
+		awsmodelrules.NewAwsIAMUserPolicyAttachmentInvalidPolicyArnRule(),
+		awsmodelrules.NewAwsIAMUserPolicyAttachmentInvalidUserRule(),
+		awsmodelrules.NewAwsIAMUserPolicyInvalidNameRule(),
+		awsmodelrules.NewAwsIAMUserPolicyInvalidPolicyRule(),
+		awsmodelrules.NewAwsIAMUserPolicyInvalidUserRule(),
+		awsmodelrules.NewAwsIAMUserSSHKeyInvalidEncodingRule(),
+		awsmodelrules.NewAwsIAMUserSSHKeyInvalidPublicKeyRule(),
+		awsmodelrules.NewAwsIAMUserSSHKeyInvalidStatusRule(),
+		awsmodelrules.NewAwsIAMUserSSHKeyInvalidUsernameRule(),
+		awsmodelrules.NewAwsInspectorAssessmentTargetInvalidNameRule(),
+		awsmodelrules.NewAwsInspectorAssessmentTargetInvalidResourceGroupArnRule(),
+		awsmodelrules.NewAwsInspectorAssessmentTemplateInvalidNameRule(),
+		awsmodelrules.NewAwsInspectorAssessmentTemplateInvalidTargetArnRule(),
+		awsmodelrules.NewAwsInstanceInvalidInstanceInitiatedShutdownBehaviorRule(),
+		awsmodelrules.NewAwsInstanceInvalidTenancyRule(),
+		awsmodelrules.NewAwsInstanceInvalidTypeRule(),
+		awsmodelrules.NewAwsIotPolicyAttachmentInvalidPolicyRule(),
+		awsmodelrules.NewAwsIotPolicyInvalidNameRule(),
+		awsmodelrules.NewAwsIotRoleAliasInvalidAliasRule(),
+		awsmodelrules.NewAwsIotRoleAliasInvalidRoleArnRule(),
+		awsmodelrules.NewAwsIotThingInvalidNameRule(),
+		awsmodelrules.NewAwsIotThingInvalidThingTypeNameRule(),
+		awsmodelrules.NewAwsIotThingPrincipalAttachmentInvalidThingRule(),
+		awsmodelrules.NewAwsIotThingTypeInvalidNameRule(),
+		awsmodelrules.NewAwsIotTopicRuleInvalidNameRule(),
+		awsmodelrules.NewAwsKinesisAnalyticsApplicationInvalidCodeRule(),
+		awsmodelrules.NewAwsKinesisAnalyticsApplicationInvalidDescriptionRule(),
+		awsmodelrules.NewAwsKinesisAnalyticsApplicationInvalidNameRule(),
+		awsmodelrules.NewAwsKinesisFirehoseDeliveryStreamInvalidNameRule(),
+		awsmodelrules.NewAwsKinesisStreamInvalidEncryptionTypeRule(),
+		awsmodelrules.NewAwsKinesisStreamInvalidKmsKeyIDRule(),
+		awsmodelrules.NewAwsKinesisStreamInvalidNameRule(),
+		awsmodelrules.NewAwsKmsAliasInvalidNameRule(),
+		awsmodelrules.NewAwsKmsAliasInvalidTargetKeyIDRule(),
+		awsmodelrules.NewAwsKmsCiphertextInvalidKeyIDRule(),
+		awsmodelrules.NewAwsKmsExternalKeyInvalidDescriptionRule(),
+		awsmodelrules.NewAwsKmsExternalKeyInvalidPolicyRule(),
+		awsmodelrules.NewAwsKmsGrantInvalidGranteePrincipalRule(),
+		awsmodelrules.NewAwsKmsGrantInvalidKeyIDRule(),
+		awsmodelrules.NewAwsKmsGrantInvalidNameRule(),
+		awsmodelrules.NewAwsKmsGrantInvalidRetiringPrincipalRule(),
+		awsmodelrules.NewAwsKmsKeyInvalidDescriptionRule(),
+		awsmodelrules.NewAwsKmsKeyInvalidKeyUsageRule(),
+		awsmodelrules.NewAwsKmsKeyInvalidPolicyRule(),
+		awsmodelrules.NewAwsLambdaAliasInvalidDescriptionRule(),
+		awsmodelrules.NewAwsLambdaAliasInvalidFunctionNameRule(),
+		awsmodelrules.NewAwsLambdaAliasInvalidFunctionVersionRule(),
+		awsmodelrules.NewAwsLambdaEventSourceMappingInvalidEventSourceArnRule(),
+		awsmodelrules.NewAwsLambdaEventSourceMappingInvalidFunctionNameRule(),
+		awsmodelrules.NewAwsLambdaEventSourceMappingInvalidStartingPositionRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidDescriptionRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidFunctionNameRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidHandlerRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidKmsKeyArnRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidRoleRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidRuntimeRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidS3KeyRule(),
+		awsmodelrules.NewAwsLambdaFunctionInvalidS3ObjectVersionRule(),
+		awsmodelrules.NewAwsLambdaLayerVersionInvalidDescriptionRule(),
+		awsmodelrules.NewAwsLambdaLayerVersionInvalidLayerNameRule(),
+		awsmodelrules.NewAwsLambdaLayerVersionInvalidLicenseInfoRule(),
+		awsmodelrules.NewAwsLambdaLayerVersionInvalidS3KeyRule(),
+		awsmodelrules.NewAwsLambdaLayerVersionInvalidS3ObjectVersionRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidActionRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidEventSourceTokenRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidFunctionNameRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidPrincipalRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidQualifierRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidSourceAccountRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidSourceArnRule(),
+		awsmodelrules.NewAwsLambdaPermissionInvalidStatementIDRule(),
+		awsmodelrules.NewAwsLaunchConfigurationInvalidSpotPriceRule(),
+		awsmodelrules.NewAwsLaunchConfigurationInvalidTypeRule(),
+		awsmodelrules.NewAwsLaunchTemplateInvalidDescriptionRule(),
+		awsmodelrules.NewAwsLaunchTemplateInvalidInstanceInitiatedShutdownBehaviorRule(),
+		awsmodelrules.NewAwsLaunchTemplateInvalidInstanceTypeRule(),
+		awsmodelrules.NewAwsLaunchTemplateInvalidNameRule(),
+		awsmodelrules.NewAwsLbInvalidIPAddressTypeRule(),
+		awsmodelrules.NewAwsLbInvalidLoadBalancerTypeRule(),
+		awsmodelrules.NewAwsLbListenerInvalidProtocolRule(),
+		awsmodelrules.NewAwsLbTargetGroupInvalidProtocolRule(),
+		awsmodelrules.NewAwsLbTargetGroupInvalidTargetTypeRule(),
+		awsmodelrules.NewAwsLicensemanagerLicenseConfigurationInvalidLicenseCountingTypeRule(),
+		awsmodelrules.NewAwsLightsailInstanceInvalidBlueprintIDRule(),
+		awsmodelrules.NewAwsLightsailInstanceInvalidBundleIDRule(),
+		awsmodelrules.NewAwsLightsailInstanceInvalidKeyPairNameRule(),
+		awsmodelrules.NewAwsLightsailKeyPairInvalidNameRule(),
+		awsmodelrules.NewAwsLightsailStaticIPAttachmentInvalidInstanceNameRule(),
+		awsmodelrules.NewAwsLightsailStaticIPAttachmentInvalidStaticIPNameRule(),
+		awsmodelrules.NewAwsLightsailStaticIPInvalidNameRule(),
+		awsmodelrules.NewAwsMacieMemberAccountAssociationInvalidMemberAccountIDRule(),
+		awsmodelrules.NewAwsMacieS3BucketAssociationInvalidBucketNameRule(),
+		awsmodelrules.NewAwsMacieS3BucketAssociationInvalidMemberAccountIDRule(),
+		awsmodelrules.NewAwsMacieS3BucketAssociationInvalidPrefixRule(),
+		awsmodelrules.NewAwsMediaStoreContainerInvalidNameRule(),
+		awsmodelrules.NewAwsMediaStoreContainerPolicyInvalidContainerNameRule(),
+		awsmodelrules.NewAwsMqBrokerInvalidDeploymentModeRule(),
+		awsmodelrules.NewAwsMskClusterInvalidClusterNameRule(),
+		awsmodelrules.NewAwsMskClusterInvalidEnhancedMonitoringRule(),
+		awsmodelrules.NewAwsMskClusterInvalidKafkaVersionRule(),
+		awsmodelrules.NewAwsNetworkACLRuleInvalidRuleActionRule(),
+		awsmodelrules.NewAwsOpsworksApplicationInvalidTypeRule(),
+		awsmodelrules.NewAwsOpsworksInstanceInvalidArchitectureRule(),
+		awsmodelrules.NewAwsOpsworksInstanceInvalidAutoScalingTypeRule(),
+		awsmodelrules.NewAwsOpsworksInstanceInvalidRootDeviceTypeRule(),
+		awsmodelrules.NewAwsOpsworksStackInvalidDefaultRootDeviceTypeRule(),
+		awsmodelrules.NewAwsOrganizationsAccountInvalidEmailRule(),
+		awsmodelrules.NewAwsOrganizationsAccountInvalidIAMUserAccessToBillingRule(),
+		awsmodelrules.NewAwsOrganizationsAccountInvalidNameRule(),
+		awsmodelrules.NewAwsOrganizationsAccountInvalidParentIDRule(),
+		awsmodelrules.NewAwsOrganizationsAccountInvalidRoleNameRule(),
+		awsmodelrules.NewAwsOrganizationsOrganizationInvalidFeatureSetRule(),
+		awsmodelrules.NewAwsOrganizationsOrganizationalUnitInvalidNameRule(),
+		awsmodelrules.NewAwsOrganizationsOrganizationalUnitInvalidParentIDRule(),
+		awsmodelrules.NewAwsOrganizationsPolicyAttachmentInvalidPolicyIDRule(),
+		awsmodelrules.NewAwsOrganizationsPolicyAttachmentInvalidTargetIDRule(),
+		awsmodelrules.NewAwsOrganizationsPolicyInvalidContentRule(),
+		awsmodelrules.NewAwsOrganizationsPolicyInvalidDescriptionRule(),
+		awsmodelrules.NewAwsOrganizationsPolicyInvalidNameRule(),
+		awsmodelrules.NewAwsOrganizationsPolicyInvalidTypeRule(),
+		awsmodelrules.NewAwsPlacementGroupInvalidStrategyRule(),
+		awsmodelrules.NewAwsQuicksightGroupInvalidAwsAccountIDRule(),
+		awsmodelrules.NewAwsQuicksightGroupInvalidDescriptionRule(),
+		awsmodelrules.NewAwsQuicksightGroupInvalidGroupNameRule(),
+		awsmodelrules.NewAwsQuicksightGroupInvalidNamespaceRule(),
+		awsmodelrules.NewAwsResourcegroupsGroupInvalidDescriptionRule(),
+		awsmodelrules.NewAwsResourcegroupsGroupInvalidNameRule(),
+		awsmodelrules.NewAwsRoute53DelegationSetInvalidReferenceNameRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidCloudwatchAlarmNameRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidCloudwatchAlarmRegionRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidFqdnRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidInsufficientDataHealthStatusRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidIPAddressRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidReferenceNameRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidResourcePathRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidSearchStringRule(),
+		awsmodelrules.NewAwsRoute53HealthCheckInvalidTypeRule(),
+		awsmodelrules.NewAwsRoute53QueryLogInvalidZoneIDRule(),
+		awsmodelrules.NewAwsRoute53RecordInvalidHealthCheckIDRule(),
+		awsmodelrules.NewAwsRoute53RecordInvalidNameRule(),
+		awsmodelrules.NewAwsRoute53RecordInvalidSetIdentifierRule(),
+		awsmodelrules.NewAwsRoute53RecordInvalidTypeRule(),
+		awsmodelrules.NewAwsRoute53RecordInvalidZoneIDRule(),
+		awsmodelrules.NewAwsRoute53ResolverEndpointInvalidDirectionRule(),
+		awsmodelrules.NewAwsRoute53ResolverRuleAssociationInvalidResolverRuleIDRule(),
+		awsmodelrules.NewAwsRoute53ResolverRuleAssociationInvalidVpcIDRule(),
+		awsmodelrules.NewAwsRoute53ResolverRuleInvalidDomainNameRule(),
+		awsmodelrules.NewAwsRoute53ResolverRuleInvalidResolverEndpointIDRule(),
+		awsmodelrules.NewAwsRoute53ResolverRuleInvalidRuleTypeRule(),
+		awsmodelrules.NewAwsRoute53ZoneAssociationInvalidVpcIDRule(),
+		awsmodelrules.NewAwsRoute53ZoneAssociationInvalidVpcRegionRule(),
+		awsmodelrules.NewAwsRoute53ZoneAssociationInvalidZoneIDRule(),
+		awsmodelrules.NewAwsRoute53ZoneInvalidCommentRule(),
+		awsmodelrules.NewAwsRoute53ZoneInvalidDelegationSetIDRule(),
+		awsmodelrules.NewAwsRoute53ZoneInvalidNameRule(),
+		awsmodelrules.NewAwsS3BucketInvalidAccelerationStatusRule(),
+		awsmodelrules.NewAwsS3BucketInvalidRequestPayerRule(),
+		awsmodelrules.NewAwsS3BucketInventoryInvalidIncludedObjectVersionsRule(),
+		awsmodelrules.NewAwsS3BucketObjectInvalidACLRule(),
+		awsmodelrules.NewAwsS3BucketObjectInvalidServerSideEncryptionRule(),
+		awsmodelrules.NewAwsS3BucketObjectInvalidStorageClassRule(),
+		awsmodelrules.NewAwsSagemakerEndpointConfigurationInvalidKmsKeyArnRule(),
+		awsmodelrules.NewAwsSagemakerEndpointConfigurationInvalidNameRule(),
+		awsmodelrules.NewAwsSagemakerEndpointInvalidEndpointConfigNameRule(),
+		awsmodelrules.NewAwsSagemakerEndpointInvalidNameRule(),
+		awsmodelrules.NewAwsSagemakerModelInvalidExecutionRoleArnRule(),
+		awsmodelrules.NewAwsSagemakerModelInvalidNameRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidInstanceTypeRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidKmsKeyIDRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidLifecycleConfigNameRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidNameRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidRoleArnRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceInvalidSubnetIDRule(),
+		awsmodelrules.NewAwsSagemakerNotebookInstanceLifecycleConfigurationInvalidNameRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretInvalidKmsKeyIDRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretInvalidNameRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretInvalidPolicyRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretInvalidRotationLambdaArnRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretVersionInvalidSecretIDRule(),
+		awsmodelrules.NewAwsSecretsmanagerSecretVersionInvalidSecretStringRule(),
+		awsmodelrules.NewAwsSecurityhubProductSubscriptionInvalidProductArnRule(),
+		awsmodelrules.NewAwsSecurityhubStandardsSubscriptionInvalidStandardsArnRule(),
+		awsmodelrules.NewAwsServiceDiscoveryHTTPNamespaceInvalidDescriptionRule(),
+		awsmodelrules.NewAwsServiceDiscoveryHTTPNamespaceInvalidNameRule(),
+		awsmodelrules.NewAwsServiceDiscoveryPrivateDNSNamespaceInvalidDescriptionRule(),
+		awsmodelrules.NewAwsServiceDiscoveryPrivateDNSNamespaceInvalidNameRule(),
+		awsmodelrules.NewAwsServiceDiscoveryPrivateDNSNamespaceInvalidVpcRule(),
+		awsmodelrules.NewAwsServiceDiscoveryPublicDNSNamespaceInvalidDescriptionRule(),
+		awsmodelrules.NewAwsServiceDiscoveryPublicDNSNamespaceInvalidNameRule(),
+		awsmodelrules.NewAwsServiceDiscoveryServiceInvalidDescriptionRule(),
+		awsmodelrules.NewAwsServicecatalogPortfolioInvalidDescriptionRule(),
+		awsmodelrules.NewAwsServicecatalogPortfolioInvalidNameRule(),
+		awsmodelrules.NewAwsServicecatalogPortfolioInvalidProviderNameRule(),
+		awsmodelrules.NewAwsServicequotasServiceQuotaInvalidQuotaCodeRule(),
+		awsmodelrules.NewAwsServicequotasServiceQuotaInvalidServiceCodeRule(),
+		awsmodelrules.NewAwsSesDomainMailFromInvalidBehaviorOnMxFailureRule(),
+		awsmodelrules.NewAwsSesIdentityNotificationTopicInvalidNotificationTypeRule(),
+		awsmodelrules.NewAwsSesIdentityPolicyInvalidNameRule(),
+		awsmodelrules.NewAwsSesReceiptFilterInvalidPolicyRule(),
+		awsmodelrules.NewAwsSesReceiptRuleInvalidTLSPolicyRule(),
+		awsmodelrules.NewAwsSfnActivityInvalidNameRule(),
+		awsmodelrules.NewAwsSfnStateMachineInvalidDefinitionRule(),
+		awsmodelrules.NewAwsSfnStateMachineInvalidNameRule(),
+		awsmodelrules.NewAwsSfnStateMachineInvalidRoleArnRule(),
+		awsmodelrules.NewAwsShieldProtectionInvalidNameRule(),
+		awsmodelrules.NewAwsShieldProtectionInvalidResourceArnRule(),
+		awsmodelrules.NewAwsSpotFleetRequestInvalidAllocationStrategyRule(),
+		awsmodelrules.NewAwsSpotFleetRequestInvalidFleetTypeRule(),
+		awsmodelrules.NewAwsSpotFleetRequestInvalidInstanceInterruptionBehaviourRule(),
+		awsmodelrules.NewAwsSpotInstanceRequestInvalidInstanceInterruptionBehaviourRule(),
+		awsmodelrules.NewAwsSsmActivationInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSsmActivationInvalidIAMRoleRule(),
+		awsmodelrules.NewAwsSsmActivationInvalidNameRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidAssociationNameRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidComplianceSeverityRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidDocumentVersionRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidInstanceIDRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidMaxConcurrencyRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidMaxErrorsRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidNameRule(),
+		awsmodelrules.NewAwsSsmAssociationInvalidScheduleExpressionRule(),
+		awsmodelrules.NewAwsSsmDocumentInvalidDocumentFormatRule(),
+		awsmodelrules.NewAwsSsmDocumentInvalidDocumentTypeRule(),
+		awsmodelrules.NewAwsSsmDocumentInvalidNameRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowInvalidNameRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowInvalidScheduleRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidNameRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidOwnerInformationRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidResourceTypeRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTargetInvalidWindowIDRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidMaxConcurrencyRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidMaxErrorsRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidNameRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidTaskArnRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidTaskTypeRule(),
+		awsmodelrules.NewAwsSsmMaintenanceWindowTaskInvalidWindowIDRule(),
+		awsmodelrules.NewAwsSsmParameterInvalidAllowedPatternRule(),
+		awsmodelrules.NewAwsSsmParameterInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSsmParameterInvalidKeyIDRule(),
+		awsmodelrules.NewAwsSsmParameterInvalidNameRule(),
+		awsmodelrules.NewAwsSsmParameterInvalidTierRule(),
+		awsmodelrules.NewAwsSsmParameterInvalidTypeRule(),
+		awsmodelrules.NewAwsSsmPatchBaselineInvalidApprovedPatchesComplianceLevelRule(),
+		awsmodelrules.NewAwsSsmPatchBaselineInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSsmPatchBaselineInvalidNameRule(),
+		awsmodelrules.NewAwsSsmPatchBaselineInvalidOperatingSystemRule(),
+		awsmodelrules.NewAwsSsmPatchGroupInvalidBaselineIDRule(),
+		awsmodelrules.NewAwsSsmPatchGroupInvalidPatchGroupRule(),
+		awsmodelrules.NewAwsSsmResourceDataSyncInvalidNameRule(),
+		awsmodelrules.NewAwsStoragegatewayCacheInvalidDiskIDRule(),
+		awsmodelrules.NewAwsStoragegatewayCacheInvalidGatewayArnRule(),
+		awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidGatewayArnRule(),
+		awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidNetworkInterfaceIDRule(),
+		awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidSnapshotIDRule(),
+		awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidSourceVolumeArnRule(),
+		awsmodelrules.NewAwsStoragegatewayCachedIscsiVolumeInvalidTargetNameRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidActivationKeyRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidGatewayNameRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidGatewayTimezoneRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidGatewayTypeRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidMediumChangerTypeRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidSmbGuestPasswordRule(),
+		awsmodelrules.NewAwsStoragegatewayGatewayInvalidTapeDriveTypeRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidDefaultStorageClassRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidGatewayArnRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidKmsKeyArnRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidLocationArnRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidObjectACLRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidRoleArnRule(),
+		awsmodelrules.NewAwsStoragegatewayNfsFileShareInvalidSquashRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidAuthenticationRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidDefaultStorageClassRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidGatewayArnRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidKmsKeyArnRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidLocationArnRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidObjectACLRule(),
+		awsmodelrules.NewAwsStoragegatewaySmbFileShareInvalidRoleArnRule(),
+		awsmodelrules.NewAwsStoragegatewayUploadBufferInvalidDiskIDRule(),
+		awsmodelrules.NewAwsStoragegatewayUploadBufferInvalidGatewayArnRule(),
+		awsmodelrules.NewAwsStoragegatewayWorkingStorageInvalidDiskIDRule(),
+		awsmodelrules.NewAwsStoragegatewayWorkingStorageInvalidGatewayArnRule(),
+		awsmodelrules.NewAwsSwfDomainInvalidDescriptionRule(),
+		awsmodelrules.NewAwsSwfDomainInvalidNameRule(),
+		awsmodelrules.NewAwsSwfDomainInvalidWorkflowExecutionRetentionPeriodInDaysRule(),
+		awsmodelrules.NewAwsTransferServerInvalidEndpointTypeRule(),
+		awsmodelrules.NewAwsTransferServerInvalidIdentityProviderTypeRule(),
+		awsmodelrules.NewAwsTransferServerInvalidInvocationRoleRule(),
+		awsmodelrules.NewAwsTransferServerInvalidLoggingRoleRule(),
+		awsmodelrules.NewAwsTransferServerInvalidURLRule(),
+		awsmodelrules.NewAwsTransferSSHKeyInvalidBodyRule(),
+		awsmodelrules.NewAwsTransferSSHKeyInvalidServerIDRule(),
+		awsmodelrules.NewAwsTransferSSHKeyInvalidUserNameRule(),
+		awsmodelrules.NewAwsTransferUserInvalidHomeDirectoryRule(),
+		awsmodelrules.NewAwsTransferUserInvalidPolicyRule(),
+		awsmodelrules.NewAwsTransferUserInvalidRoleRule(),
+		awsmodelrules.NewAwsTransferUserInvalidServerIDRule(),
+		awsmodelrules.NewAwsTransferUserInvalidUserNameRule(),
+		awsmodelrules.NewAwsVpcEndpointInvalidVpcEndpointTypeRule(),
+		awsmodelrules.NewAwsVpcInvalidInstanceTenancyRule(),
+		awsmodelrules.NewAwsWafByteMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafGeoMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafIpsetInvalidNameRule(),
+		awsmodelrules.NewAwsWafRateBasedRuleInvalidNameRule(),
+		awsmodelrules.NewAwsWafRateBasedRuleInvalidRateKeyRule(),
+		awsmodelrules.NewAwsWafRegexMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafRegexPatternSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafRuleGroupInvalidNameRule(),
+		awsmodelrules.NewAwsWafRuleInvalidNameRule(),
+		awsmodelrules.NewAwsWafSizeConstraintSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafSQLInjectionMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafWebACLInvalidNameRule(),
+		awsmodelrules.NewAwsWafXSSMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalByteMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalGeoMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalIpsetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalRateBasedRuleInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalRateBasedRuleInvalidRateKeyRule(),
+		awsmodelrules.NewAwsWafregionalRegexMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalRegexPatternSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalRuleGroupInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalRuleInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalSizeConstraintSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalSQLInjectionMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalWebACLAssociationInvalidResourceArnRule(),
+		awsmodelrules.NewAwsWafregionalWebACLAssociationInvalidWebACLIDRule(),
+		awsmodelrules.NewAwsWafregionalWebACLInvalidNameRule(),
+		awsmodelrules.NewAwsWafregionalXSSMatchSetInvalidNameRule(),
+		awsmodelrules.NewAwsWorklinkFleetInvalidDeviceCaCertificateRule(),
+		awsmodelrules.NewAwsWorklinkFleetInvalidDisplayNameRule(),
+		awsmodelrules.NewAwsWorklinkFleetInvalidNameRule(),
+		awsmodelrules.NewAwsWorklinkWebsiteCertificateAuthorityAssociationInvalidCertificateRule(),
+		awsmodelrules.NewAwsWorklinkWebsiteCertificateAuthorityAssociationInvalidDisplayNameRule(),
+		awsmodelrules.NewAwsWorklinkWebsiteCertificateAuthorityAssociationInvalidFleetArnRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidHostRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidHTTPMethodRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidResourceArnRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidRuleNameRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidServiceNameRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidServiceTypeRule(),
+		awsmodelrules.NewAwsXraySamplingRuleInvalidURLPathRule(),
+	}
 }
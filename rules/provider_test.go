@@ -2,22 +2,31 @@ package rules
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint/rules/awsrules"
+	"github.com/terraform-linters/tflint/rules/awsrules/models"
 	"github.com/terraform-linters/tflint/rules/terraformrules"
 	"github.com/terraform-linters/tflint/tflint"
 )
 
 func Test_CheckRuleNames(t *testing.T) {
 	// Mock rules in test
-	DefaultRules = []Rule{
-		awsrules.NewAwsRouteNotSpecifiedTargetRule(),
-		terraformrules.NewTerraformDashInResourceNameRule(),
+	DefaultRules = func() []Rule {
+		return []Rule{
+			awsrules.NewAwsRouteNotSpecifiedTargetRule(),
+			terraformrules.NewTerraformDashInResourceNameRule(),
+		}
 	}
-	deepCheckRules = []Rule{
-		awsrules.NewAwsInstanceInvalidAMIRule(),
+	deepCheckRules = func() []Rule {
+		return []Rule{
+			awsrules.NewAwsInstanceInvalidAMIRule(),
+		}
 	}
 
 	cases := []struct {
@@ -50,14 +59,19 @@ func Test_CheckRuleNames(t *testing.T) {
 
 func Test_NewRules(t *testing.T) {
 	// Mock rules in test
-	DefaultRules = []Rule{
-		awsrules.NewAwsRouteNotSpecifiedTargetRule(),
-		terraformrules.NewTerraformDashInResourceNameRule(),
-		terraformrules.NewTerraformDashInDataSourceNameRule(),
-		terraformrules.NewTerraformDashInModuleNameRule(),
+	DefaultRules = func() []Rule {
+		return []Rule{
+			awsrules.NewAwsRouteNotSpecifiedTargetRule(),
+			terraformrules.NewTerraformDashInResourceNameRule(),
+			terraformrules.NewTerraformDashInDataSourceNameRule(),
+			terraformrules.NewTerraformDashInModuleNameRule(),
+			terraformrules.NewTerraformTypedVariablesRule(),
+		}
 	}
-	deepCheckRules = []Rule{
-		awsrules.NewAwsInstanceInvalidAMIRule(),
+	deepCheckRules = func() []Rule {
+		return []Rule{
+			awsrules.NewAwsInstanceInvalidAMIRule(),
+		}
 	}
 
 	cases := []struct {
@@ -82,6 +96,30 @@ func Test_NewRules(t *testing.T) {
 				awsrules.NewAwsInstanceInvalidAMIRule(),
 			},
 		},
+		{
+			Name: "module mode",
+			Config: &tflint.Config{
+				ModuleMode: true,
+			},
+			Expected: []Rule{
+				awsrules.NewAwsRouteNotSpecifiedTargetRule(),
+				terraformrules.NewTerraformTypedVariablesRule(),
+			},
+		},
+		{
+			Name: "disabled in module mode",
+			Config: &tflint.Config{
+				ModuleMode: true,
+				Rules: map[string]*tflint.RuleConfig{
+					"terraform_typed_variables": {
+						Enabled: false,
+					},
+				},
+			},
+			Expected: []Rule{
+				awsrules.NewAwsRouteNotSpecifiedTargetRule(),
+			},
+		},
 		{
 			Name: "enabled = false",
 			Config: &tflint.Config{
@@ -144,3 +182,220 @@ func Test_NewRules(t *testing.T) {
 		}
 	}
 }
+
+func Test_NewAuditRules(t *testing.T) {
+	// Mock rules in test
+	DefaultRules = func() []Rule {
+		return []Rule{
+			awsrules.NewAwsRouteNotSpecifiedTargetRule(),
+		}
+	}
+	deepCheckRules = func() []Rule {
+		return []Rule{
+			awsrules.NewAwsInstanceInvalidAMIRule(),
+			awsrules.NewAwsVpcQuotaExceededRule(),
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Config   *tflint.Config
+		Expected []Rule
+	}{
+		{
+			Name:   "default",
+			Config: tflint.EmptyConfig(),
+			Expected: []Rule{
+				awsrules.NewAwsInstanceInvalidAMIRule(),
+			},
+		},
+		{
+			Name: "enabled = true",
+			Config: &tflint.Config{
+				Rules: map[string]*tflint.RuleConfig{
+					"aws_vpc_quota_exceeded": {
+						Enabled: true,
+					},
+				},
+			},
+			Expected: []Rule{
+				awsrules.NewAwsInstanceInvalidAMIRule(),
+				awsrules.NewAwsVpcQuotaExceededRule(),
+			},
+		},
+		{
+			Name: "enabled = false",
+			Config: &tflint.Config{
+				Rules: map[string]*tflint.RuleConfig{
+					"aws_instance_invalid_ami": {
+						Enabled: false,
+					},
+				},
+			},
+			Expected: []Rule{},
+		},
+	}
+
+	for _, tc := range cases {
+		ret := NewAuditRules(tc.Config)
+		if !reflect.DeepEqual(tc.Expected, ret) {
+			t.Fatalf("Failed `%s` test: expected rules are `%#v`, but got `%#v`", tc.Name, tc.Expected, ret)
+		}
+	}
+}
+
+func Test_applyInstanceTypes(t *testing.T) {
+	content := `
+resource "aws_instance" "web" {
+	instance_type = "t9.micro"
+}`
+	invalidTypeRule := models.NewAwsInstanceInvalidTypeRule()
+	previousTypeRule := awsrules.NewAwsInstancePreviousTypeRule()
+
+	applyInstanceTypes([]Rule{invalidTypeRule, previousTypeRule}, &tflint.InstanceTypes{
+		Current:  []string{"m9.large"},
+		Previous: []string{"t9.micro"},
+	})
+
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": content})
+	if err := invalidTypeRule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if err := previousTypeRule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := tflint.Issues{
+		{
+			Rule:    previousTypeRule,
+			Message: "\"t9.micro\" is previous generation instance type.",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 3, Column: 18},
+				End:      hcl.Pos{Line: 3, Column: 28},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+}
+
+func Test_overrideDatasets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	regionsFile := filepath.Join(dir, "regions.json")
+	if err := ioutil.WriteFile(regionsFile, []byte(`["us-gov-west-1"]`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	content := `
+provider "aws" {
+	region = "us-gov-west-1"
+}
+
+provider "aws" {
+	alias  = "east"
+	region = "us-east-1"
+}`
+	rule := awsrules.NewAwsProviderInvalidRegionRule()
+
+	overrideDatasets([]Rule{rule}, map[string]string{"regions": regionsFile})
+
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := tflint.Issues{
+		{
+			Rule:    rule,
+			Message: "\"us-east-1\" is an invalid region.",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 8, Column: 11},
+				End:      hcl.Pos{Line: 8, Column: 22},
+			},
+		},
+	}
+	tflint.AssertIssues(t, expected, runner.Issues)
+}
+
+func Test_NewRules_freshInstances(t *testing.T) {
+	// Mock rules in test
+	DefaultRules = func() []Rule {
+		return []Rule{models.NewAwsInstanceInvalidTypeRule()}
+	}
+
+	dir, err := ioutil.TempDir("", "tflint-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	original := tflint.InstanceTypesFile
+	defer func() { tflint.InstanceTypesFile = original }()
+	tflint.InstanceTypesFile = filepath.Join(dir, "aws_instance_types.json")
+	if err := ioutil.WriteFile(tflint.InstanceTypesFile, []byte(`{"current": ["m9.large"], "previous": []}`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	updated := NewRules(tflint.EmptyConfig())
+
+	// Instance types added to rules of the previous call must not be accepted by rules of other calls
+	tflint.InstanceTypesFile = filepath.Join(dir, "not_found.json")
+	rule := NewRules(tflint.EmptyConfig())[0]
+	if rule == updated[0] {
+		t.Fatal("Expected a new instance of the rule, but got the same instance")
+	}
+
+	content := `
+resource "aws_instance" "web" {
+	instance_type = "m9.large"
+}`
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if len(runner.Issues) != 1 {
+		t.Fatalf("Expected an issue about `m9.large`, but got %#v", runner.Issues)
+	}
+}
+
+func Test_NewRules_overriddenDatasets(t *testing.T) {
+	// Mock rules in test
+	DefaultRules = func() []Rule {
+		return []Rule{awsrules.NewAwsProviderInvalidRegionRule()}
+	}
+
+	dir, err := ioutil.TempDir("", "tflint-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	regionsFile := filepath.Join(dir, "regions.json")
+	if err := ioutil.WriteFile(regionsFile, []byte(`["us-gov-west-1"]`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	config := tflint.EmptyConfig()
+	config.DataFiles = map[string]string{"regions": regionsFile}
+	overridden := NewRules(config)
+
+	// The dataset overridden by the previous config must not be applied to rules of other configs
+	rule := NewRules(tflint.EmptyConfig())[0]
+	if rule == overridden[0] {
+		t.Fatal("Expected a new instance of the rule, but got the same instance")
+	}
+
+	content := `
+provider "aws" {
+	region = "us-east-1"
+}`
+	runner := tflint.TestRunner(t, map[string]string{"main.tf": content})
+	if err := rule.Check(runner); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	tflint.AssertIssues(t, tflint.Issues{}, runner.Issues)
+}
@@ -0,0 +1,92 @@
+package randomrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// RandomIDByteLengthRule checks whether random_id resources have enough bytes to avoid collisions
+type RandomIDByteLengthRule struct{}
+
+type randomIDByteLengthRuleConfig struct {
+	MinByteLength int `hcl:"min_byte_length,optional"`
+}
+
+// NewRandomIDByteLengthRule returns a new rule
+func NewRandomIDByteLengthRule() *RandomIDByteLengthRule {
+	return &RandomIDByteLengthRule{}
+}
+
+// Name returns the rule name
+func (r *RandomIDByteLengthRule) Name() string {
+	return "random_id_byte_length"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *RandomIDByteLengthRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RandomIDByteLengthRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *RandomIDByteLengthRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *RandomIDByteLengthRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `random_id` resources whose `byte_length` is less than `min_byte_length`.",
+		Rationale:   "`random_id` is often used to make names unique, such as S3 bucket names. By the birthday problem, IDs of 4 bytes are likely to collide after tens of thousands of them, and 2 bytes after only a few hundred.",
+		Violating: `resource "random_id" "suffix" {
+  byte_length = 2
+}
+`,
+		Compliant: `resource "random_id" "suffix" {
+  byte_length = 8
+}
+`,
+		Fix: "Increase `byte_length`. If the ID only needs to be unique among a few resources, lower `min_byte_length`.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *RandomIDByteLengthRule) DefaultConfig() interface{} {
+	return randomIDByteLengthRuleConfig{
+		MinByteLength: 8,
+	}
+}
+
+// Check checks whether `byte_length` of random_id resources is less than the minimum
+// IDs of 4 bytes are likely to collide after tens of thousands of them by the birthday problem.
+func (r *RandomIDByteLengthRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(randomIDByteLengthRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	return runner.WalkResourceAttributes("random_id", "byte_length", func(attribute *hcl.Attribute) error {
+		var byteLength int
+		err := runner.EvaluateExpr(attribute.Expr, &byteLength)
+
+		return runner.EnsureNoError(err, func() error {
+			if byteLength < config.MinByteLength {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The byte length is %d. IDs shorter than %d bytes can collide", byteLength, config.MinByteLength),
+					attribute.Expr.Range(),
+				)
+			}
+			return nil
+		})
+	})
+}
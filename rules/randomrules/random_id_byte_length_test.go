@@ -0,0 +1,69 @@
+package randomrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_RandomIDByteLengthRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "short id",
+			Content: `
+resource "random_id" "suffix" {
+  byte_length = 4
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewRandomIDByteLengthRule(),
+					Message: "The byte length is 4. IDs shorter than 8 bytes can collide",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 18},
+					},
+				},
+			},
+		},
+		{
+			Name: "long id",
+			Content: `
+resource "random_id" "suffix" {
+  byte_length = 8
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "min_byte_length",
+			Content: `
+resource "random_id" "suffix" {
+  byte_length = 4
+}`,
+			Config: `
+rule "random_id_byte_length" {
+  enabled         = true
+  min_byte_length = 4
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewRandomIDByteLengthRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,85 @@
+package randomrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// RandomPasswordKeepersRule checks whether random_password resources declare keepers
+type RandomPasswordKeepersRule struct{}
+
+// NewRandomPasswordKeepersRule returns a new rule
+func NewRandomPasswordKeepersRule() *RandomPasswordKeepersRule {
+	return &RandomPasswordKeepersRule{}
+}
+
+// Name returns the rule name
+func (r *RandomPasswordKeepersRule) Name() string {
+	return "random_password_keepers"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *RandomPasswordKeepersRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *RandomPasswordKeepersRule) Severity() string {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *RandomPasswordKeepersRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *RandomPasswordKeepersRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Requires `keepers` in `random_password` resources, which declare the values whose changes regenerate the password.",
+		Rationale:   "A password without keepers is only regenerated when its own arguments change or it is tainted. Rotation then depends on changes unrelated to what the password protects, and readers cannot tell when a new password will be generated.",
+		Violating: `resource "random_password" "db" {
+  length = 32
+}
+`,
+		Compliant: `resource "random_password" "db" {
+  length = 32
+  keepers = {
+    instance = var.db_identifier
+  }
+}
+`,
+		Fix: "Declare the values whose changes should regenerate the password in `keepers`, such as the identifier of the database.",
+	}
+}
+
+// Check checks whether random_password resources have the `keepers` attribute
+func (r *RandomPasswordKeepersRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for _, resource := range runner.LookupResourcesByType("random_password") {
+		body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{
+				{
+					Name: "keepers",
+				},
+			},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		if _, exists := body.Attributes["keepers"]; !exists {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` has no keepers. Declare the values whose changes should regenerate the password", resource.Addr()),
+				resource.DeclRange,
+			)
+		}
+	}
+
+	return nil
+}
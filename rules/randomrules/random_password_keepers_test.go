@@ -0,0 +1,66 @@
+package randomrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_RandomPasswordKeepersRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "no keepers",
+			Content: `
+resource "random_password" "db" {
+  length = 32
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewRandomPasswordKeepersRule(),
+					Message: "`random_password.db` has no keepers. Declare the values whose changes should regenerate the password",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "keepers",
+			Content: `
+resource "random_password" "db" {
+  length = 32
+  keepers = {
+    instance = "db-1"
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "other random resources",
+			Content: `
+resource "random_string" "suffix" {
+  length = 8
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewRandomPasswordKeepersRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,91 @@
+package randomrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// RandomPasswordLengthRule checks whether random_password resources are long enough
+type RandomPasswordLengthRule struct{}
+
+type randomPasswordLengthRuleConfig struct {
+	MinLength int `hcl:"min_length,optional"`
+}
+
+// NewRandomPasswordLengthRule returns a new rule
+func NewRandomPasswordLengthRule() *RandomPasswordLengthRule {
+	return &RandomPasswordLengthRule{}
+}
+
+// Name returns the rule name
+func (r *RandomPasswordLengthRule) Name() string {
+	return "random_password_length"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *RandomPasswordLengthRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *RandomPasswordLengthRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *RandomPasswordLengthRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *RandomPasswordLengthRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `random_password` resources whose `length` is less than `min_length`.",
+		Rationale:   "Short passwords can be brute-forced. Lengths are often copied from examples, or lowered to satisfy a system once and never raised again.",
+		Violating: `resource "random_password" "db" {
+  length = 8
+}
+`,
+		Compliant: `resource "random_password" "db" {
+  length = 32
+}
+`,
+		Fix: "Increase `length`. If the system accepting the password limits the length, lower `min_length` or ignore the issue with an annotation.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *RandomPasswordLengthRule) DefaultConfig() interface{} {
+	return randomPasswordLengthRuleConfig{
+		MinLength: 16,
+	}
+}
+
+// Check checks whether `length` of random_password resources is less than the minimum
+func (r *RandomPasswordLengthRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(randomPasswordLengthRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	return runner.WalkResourceAttributes("random_password", "length", func(attribute *hcl.Attribute) error {
+		var length int
+		err := runner.EvaluateExpr(attribute.Expr, &length)
+
+		return runner.EnsureNoError(err, func() error {
+			if length < config.MinLength {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The password length is %d. It should be at least %d", length, config.MinLength),
+					attribute.Expr.Range(),
+				)
+			}
+			return nil
+		})
+	})
+}
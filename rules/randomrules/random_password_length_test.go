@@ -0,0 +1,134 @@
+package randomrules
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_RandomPasswordLengthRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "short password",
+			Content: `
+resource "random_password" "db" {
+  length = 8
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewRandomPasswordLengthRule(),
+					Message: "The password length is 8. It should be at least 16",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 3, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			Name: "long password",
+			Content: `
+resource "random_password" "db" {
+  length = 32
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "variable",
+			Content: `
+variable "length" {
+  default = 12
+}
+
+resource "random_password" "db" {
+  length = var.length
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewRandomPasswordLengthRule(),
+					Message: "The password length is 12. It should be at least 16",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 12},
+						End:      hcl.Pos{Line: 7, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown",
+			Content: `
+variable "length" {}
+
+resource "random_password" "db" {
+  length = var.length
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "min_length",
+			Content: `
+resource "random_password" "db" {
+  length = 24
+}`,
+			Config: `
+rule "random_password_length" {
+  enabled    = true
+  min_length = 32
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewRandomPasswordLengthRule(),
+					Message: "The password length is 24. It should be at least 32",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 12},
+						End:      hcl.Pos{Line: 3, Column: 14},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewRandomPasswordLengthRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func loadConfigfromTempFile(t *testing.T, content string) *tflint.Config {
+	if content == "" {
+		return tflint.EmptyConfig()
+	}
+
+	tmpfile, err := ioutil.TempFile("", "random_password_length")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	if _, err := tmpfile.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	config, err := tflint.LoadConfig(tmpfile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
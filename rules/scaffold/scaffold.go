@@ -0,0 +1,133 @@
+package scaffold
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/serenize/snaker"
+)
+
+var ruleNamePattern = regexp.MustCompile(`^(aws|terraform)(_[a-z0-9]+)+$`)
+
+// Rule is metadata of a rule passed to templates
+type Rule struct {
+	RuleName   string
+	RuleNameCC string
+	Package    string
+}
+
+// NewRule returns metadata of the rule from the rule name
+// The name must start with `aws_` or `terraform_`, which determines the package of the rule.
+func NewRule(name string) (*Rule, error) {
+	if !ruleNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("`%s` is invalid rule name. It must start with `aws_` or `terraform_` and be in snake case", name)
+	}
+
+	return &Rule{
+		RuleName:   name,
+		RuleNameCC: ToCamel(name),
+		Package:    strings.SplitN(name, "_", 2)[0] + "rules",
+	}, nil
+}
+
+// Generate writes a skeleton of the rule, its test and documentation under the root of the TFLint repository,
+// and registers the rule with the provider. Templates are read from the rules directory of the repository.
+// Written files are printed to w.
+func Generate(root string, rule *Rule, w io.Writer) error {
+	files := []struct {
+		path string
+		tmpl string
+	}{
+		{path: filepath.Join("rules", rule.Package, rule.RuleName+".go"), tmpl: filepath.Join("rules", "rule.go.tmpl")},
+		{path: filepath.Join("rules", rule.Package, rule.RuleName+"_test.go"), tmpl: filepath.Join("rules", "rule_test.go.tmpl")},
+		{path: filepath.Join("docs", "rules", rule.RuleName+".md"), tmpl: filepath.Join("rules", "rule.md.tmpl")},
+	}
+
+	providerPath := filepath.Join("rules", "provider.go")
+	provider, err := ioutil.ReadFile(filepath.Join(root, providerPath))
+	if err != nil {
+		return fmt.Errorf("`%s` is not found. Please run in the root of the TFLint repository", providerPath)
+	}
+	for _, file := range files {
+		if _, err := os.Stat(filepath.Join(root, file.path)); !os.IsNotExist(err) {
+			return fmt.Errorf("`%s` already exists", file.path)
+		}
+	}
+
+	provider, err = register(provider, rule)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := GenerateFile(filepath.Join(root, file.path), filepath.Join(root, file.tmpl), rule); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Create: %s\n", file.path)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, providerPath), provider, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Update: %s\n", providerPath)
+
+	return nil
+}
+
+// GenerateFile generates a new file from the passed template and metadata
+func GenerateFile(fileName string, tmplName string, meta interface{}) error {
+	tmpl, err := template.ParseFiles(tmplName)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, meta); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, buf.Bytes(), 0644)
+}
+
+// ToCamel converts a string to CamelCase
+func ToCamel(str string) string {
+	exceptions := map[string]string{
+		"ami":         "AMI",
+		"db":          "DB",
+		"alb":         "ALB",
+		"elb":         "ELB",
+		"elasticache": "ElastiCache",
+		"iam":         "IAM",
+	}
+	for pattern, conv := range exceptions {
+		str = strings.Replace(str, "_"+pattern+"_", "_"+conv+"_", -1)
+		str = strings.Replace(str, pattern+"_", conv+"_", -1)
+		str = strings.Replace(str, "_"+pattern, "_"+conv, -1)
+	}
+	return snaker.SnakeToCamel(str)
+}
+
+// register appends the constructor of the rule to the manual default rules in the provider
+func register(provider []byte, rule *Rule) ([]byte, error) {
+	start := bytes.Index(provider, []byte("func manualDefaultRules() []Rule {\n\treturn []Rule{\n"))
+	if start < 0 {
+		return nil, errors.New("`manualDefaultRules` is not found in the provider")
+	}
+	end := bytes.Index(provider[start:], []byte("\n\t}\n}\n"))
+	if end < 0 {
+		return nil, errors.New("`manualDefaultRules` is not found in the provider")
+	}
+	end += start + 1
+
+	line := fmt.Sprintf("\t\t%s.New%sRule(),\n", rule.Package, rule.RuleNameCC)
+
+	ret := append([]byte{}, provider[:end]...)
+	ret = append(ret, line...)
+	return append(ret, provider[end:]...), nil
+}
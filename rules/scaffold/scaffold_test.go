@@ -0,0 +1,56 @@
+package scaffold
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_NewRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		RuleName string
+		Expected *Rule
+		Error    string
+	}{
+		{
+			Name:     "aws rule",
+			RuleName: "aws_db_instance_invalid_type",
+			Expected: &Rule{
+				RuleName:   "aws_db_instance_invalid_type",
+				RuleNameCC: "AwsDBInstanceInvalidType",
+				Package:    "awsrules",
+			},
+		},
+		{
+			Name:     "terraform rule",
+			RuleName: "terraform_example",
+			Expected: &Rule{
+				RuleName:   "terraform_example",
+				RuleNameCC: "TerraformExample",
+				Package:    "terraformrules",
+			},
+		},
+		{
+			Name:     "invalid name",
+			RuleName: "google_compute",
+			Error:    "`google_compute` is invalid rule name. It must start with `aws_` or `terraform_` and be in snake case",
+		},
+	}
+
+	for _, tc := range cases {
+		rule, err := NewRule(tc.RuleName)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if !cmp.Equal(tc.Expected, rule) {
+			t.Fatalf("Failed `%s` test: %s", tc.Name, cmp.Diff(tc.Expected, rule))
+		}
+	}
+}
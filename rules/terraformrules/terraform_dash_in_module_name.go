@@ -36,6 +36,23 @@ func (r *TerraformDashInModuleNameRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformDashInModuleNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows dashes (-) in `module` names.",
+		Rationale:   "Naming conventions are optional, but consistent names are easier to read and search. Terraform's naming best practices use underscores to separate words in names.",
+		Violating: `module "dash-name" {
+  source = "./module"
+}
+`,
+		Compliant: `module "no_dash_name" {
+  source = "./module"
+}
+`,
+		Fix: "Use underscores (_) instead of dashes (-).",
+	}
+}
+
 // Check checks whether resources have any dashes in the name
 func (r *TerraformDashInModuleNameRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
@@ -36,6 +36,23 @@ func (r *TerraformDashInOutputNameRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformDashInOutputNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows dashes (-) in `output` names.",
+		Rationale:   "Naming conventions are optional, but consistent names are easier to read and search. Terraform's naming best practices use underscores to separate words in names.",
+		Violating: `output "dash-name" {
+  value = "foo"
+}
+`,
+		Compliant: `output "no_dash_name" {
+  value = "foo"
+}
+`,
+		Fix: "Use underscores (_) instead of dashes (-).",
+	}
+}
+
 // Check checks whether outputs have any dashes in the name
 func (r *TerraformDashInOutputNameRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
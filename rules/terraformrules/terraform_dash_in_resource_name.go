@@ -36,6 +36,21 @@ func (r *TerraformDashInResourceNameRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformDashInResourceNameRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows dashes (-) in `resource` names.",
+		Rationale:   "Naming conventions are optional, but consistent names are easier to read and search. Terraform's naming best practices use underscores to separate words in names.",
+		Violating: `resource "aws_eip" "dash-name" {
+}
+`,
+		Compliant: `resource "aws_eip" "no_dash_name" {
+}
+`,
+		Fix: "Use underscores (_) instead of dashes (-).",
+	}
+}
+
 // Check checks whether resources have any dashes in the name
 func (r *TerraformDashInResourceNameRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
@@ -0,0 +1,156 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TerraformDeadConfigurationRule checks whether there are resources that are never created and conditionals that are never true
+type TerraformDeadConfigurationRule struct{}
+
+// NewTerraformDeadConfigurationRule returns a new rule
+func NewTerraformDeadConfigurationRule() *TerraformDeadConfigurationRule {
+	return &TerraformDeadConfigurationRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformDeadConfigurationRule) Name() string {
+	return "terraform_dead_configuration"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformDeadConfigurationRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformDeadConfigurationRule) Severity() string {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *TerraformDeadConfigurationRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformDeadConfigurationRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows resources that are never created because `count` is always 0, and conditional expressions whose conditions are always true or false.",
+		Rationale:   "Dead configuration confuses readers because it looks like it takes effect. Resources with `count = 0` are often left behind after disabling them temporarily, and conditionals are often left behind after a feature flag is no longer used.",
+		Violating: `resource "aws_instance" "old" {
+  count = 0
+}
+`,
+		Compliant: `variable "enabled" {
+  type = bool
+}
+
+resource "aws_instance" "web" {
+  count = var.enabled ? 1 : 0
+}
+`,
+		Fix: "Remove the dead configuration. If a feature flag is intentionally disabled by default, disable this rule or ignore the issue with an annotation.",
+	}
+}
+
+// Check checks whether count of resources is literally 0, and conditions in resources are false with the current values of variables
+// Conditions are evaluated with default values of variables unless they are overwritten by values files or the CLI.
+func (r *TerraformDeadConfigurationRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	resources := []*configs.Resource{}
+	for _, resource := range runner.TFConfig.Module.ManagedResources {
+		resources = append(resources, resource)
+	}
+	for _, resource := range runner.TFConfig.Module.DataResources {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].DeclRange.Start.Byte < resources[j].DeclRange.Start.Byte
+	})
+
+	for _, resource := range resources {
+		if resource.Count != nil {
+			if len(resource.Count.Variables()) == 0 && r.isZero(runner, resource.Count) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` is never created because count is always 0", resource.Addr().String()),
+					resource.Count.Range(),
+				)
+			} else {
+				r.checkConditionals(runner, resource.Count)
+			}
+		}
+
+		r.checkBody(runner, resource.Config)
+	}
+
+	return nil
+}
+
+func (r *TerraformDeadConfigurationRule) checkBody(runner *tflint.Runner, body hcl.Body) {
+	nativeBody, ok := body.(*hclsyntax.Body)
+	if !ok {
+		return
+	}
+
+	names := []string{}
+	for name := range nativeBody.Attributes {
+		// count is a meta-argument checked separately
+		if name != "count" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		r.checkConditionals(runner, nativeBody.Attributes[name].Expr)
+	}
+	for _, block := range nativeBody.Blocks {
+		r.checkBody(runner, block.Body)
+	}
+}
+
+func (r *TerraformDeadConfigurationRule) checkConditionals(runner *tflint.Runner, expr hcl.Expression) {
+	nativeExpr, ok := expr.(hclsyntax.Expression)
+	if !ok {
+		return
+	}
+
+	hclsyntax.VisitAll(nativeExpr, func(node hclsyntax.Node) hcl.Diagnostics {
+		conditional, ok := node.(*hclsyntax.ConditionalExpr)
+		if !ok {
+			return nil
+		}
+
+		val, err := runner.EvalExpr(conditional.Condition, nil, cty.Bool)
+		if err != nil {
+			// Conditions with unknown values, such as variables without defaults, can be true
+			return nil
+		}
+		if val.False() {
+			runner.EmitIssue(
+				r,
+				"The condition is never true, so the true result is never used",
+				conditional.Condition.Range(),
+			)
+		}
+		return nil
+	})
+}
+
+func (r *TerraformDeadConfigurationRule) isZero(runner *tflint.Runner, expr hcl.Expression) bool {
+	val, err := runner.EvalExpr(expr, nil, cty.Number)
+	if err != nil {
+		return false
+	}
+	return val.Equals(cty.Zero).True()
+}
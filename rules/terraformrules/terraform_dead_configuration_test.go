@@ -0,0 +1,134 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformDeadConfigurationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "count is literally 0",
+			Content: `
+resource "aws_instance" "web" {
+  count = 0
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDeadConfigurationRule(),
+					Message: "`aws_instance.web` is never created because count is always 0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 12},
+					},
+				},
+			},
+		},
+		{
+			Name: "count with constant conditional",
+			Content: `
+data "aws_ami" "web" {
+  count = false ? 1 : 0
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDeadConfigurationRule(),
+					Message: "`data.aws_ami.web` is never created because count is always 0",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 11},
+						End:      hcl.Pos{Line: 3, Column: 24},
+					},
+				},
+			},
+		},
+		{
+			Name: "feature flag disabled by default",
+			Content: `
+variable "enabled" {
+  default = false
+}
+
+resource "aws_instance" "web" {
+  count = var.enabled ? 1 : 0
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDeadConfigurationRule(),
+					Message: "The condition is never true, so the true result is never used",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 7, Column: 11},
+						End:      hcl.Pos{Line: 7, Column: 22},
+					},
+				},
+			},
+		},
+		{
+			Name: "conditional in nested block",
+			Content: `
+variable "env" {
+  default = "dev"
+}
+
+resource "aws_instance" "web" {
+  ebs_block_device {
+    volume_size = var.env == "prod" ? 100 : 10
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDeadConfigurationRule(),
+					Message: "The condition is never true, so the true result is never used",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 8, Column: 19},
+						End:      hcl.Pos{Line: 8, Column: 36},
+					},
+				},
+			},
+		},
+		{
+			Name: "variables without defaults",
+			Content: `
+variable "enabled" {}
+
+resource "aws_instance" "web" {
+  count         = var.enabled ? 1 : 0
+  instance_type = var.enabled ? "t2.micro" : "t2.nano"
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "count from a variable",
+			Content: `
+variable "instance_count" {
+  default = 0
+}
+
+resource "aws_instance" "web" {
+  count = var.instance_count
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformDeadConfigurationRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
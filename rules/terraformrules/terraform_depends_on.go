@@ -0,0 +1,131 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformDependsOnRule checks whether entries of depends_on refer to existing objects and are not redundant
+type TerraformDependsOnRule struct{}
+
+// NewTerraformDependsOnRule returns a new rule
+func NewTerraformDependsOnRule() *TerraformDependsOnRule {
+	return &TerraformDependsOnRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformDependsOnRule) Name() string {
+	return "terraform_depends_on"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformDependsOnRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformDependsOnRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformDependsOnRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformDependsOnRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `depends_on` entries that refer to undeclared objects, and entries that are redundant because the dependency is already implied by references.",
+		Rationale:   "Terraform fails when `depends_on` refers to an object which is not declared, for example, after the object is renamed or removed. Redundant entries make it hard to find dependencies that really need `depends_on`, which should be the exception.",
+		Violating: `resource "aws_vpc" "main" {}
+
+resource "aws_subnet" "main" {
+  vpc_id     = aws_vpc.main.id
+  depends_on = [aws_vpc.main]
+}
+`,
+		Compliant: `resource "aws_vpc" "main" {}
+
+resource "aws_subnet" "main" {
+  vpc_id = aws_vpc.main.id
+}
+`,
+		Fix: "Remove entries that are not declared or redundant, or fix the address.",
+	}
+}
+
+type dependsOnEntries struct {
+	from      string
+	dependsOn []hcl.Traversal
+}
+
+// Check checks depends_on of resources, data sources and outputs
+// An entry is redundant if the object already depends on it through references in expressions, including references via
+// local values and other resources.
+func (r *TerraformDependsOnRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	entries := []dependsOnEntries{}
+	for _, resource := range runner.TFConfig.Module.ManagedResources {
+		entries = append(entries, dependsOnEntries{from: resource.Addr().String(), dependsOn: resource.DependsOn})
+	}
+	for _, resource := range runner.TFConfig.Module.DataResources {
+		entries = append(entries, dependsOnEntries{from: resource.Addr().String(), dependsOn: resource.DependsOn})
+	}
+	for name, output := range runner.TFConfig.Module.Outputs {
+		entries = append(entries, dependsOnEntries{from: "output." + name, dependsOn: output.DependsOn})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].from < entries[j].from
+	})
+
+	index := runner.ReferenceIndex()
+
+	for _, entry := range entries {
+		for _, traversal := range entry.dependsOn {
+			ref, diags := addrs.ParseRef(traversal)
+			if diags.HasErrors() {
+				// Terraform reports invalid references
+				continue
+			}
+
+			var subject string
+			switch s := ref.Subject.(type) {
+			case addrs.Resource:
+				subject = s.String()
+			case addrs.ResourceInstance:
+				subject = s.ContainingResource().String()
+			case addrs.ModuleCallInstance:
+				subject = s.Call.String()
+			case addrs.ModuleCallOutput:
+				subject = s.Call.Call.String()
+			default:
+				continue
+			}
+
+			if _, exists := index.Definitions[subject]; !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` in depends_on is not declared", subject),
+					ref.SourceRange.ToHCL(),
+				)
+				continue
+			}
+			if index.DependsOn(entry.from, subject) {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` in depends_on is redundant because `%s` already depends on it through references", subject, entry.from),
+					ref.SourceRange.ToHCL(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
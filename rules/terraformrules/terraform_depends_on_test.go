@@ -0,0 +1,101 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformDependsOnRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "necessary dependency",
+			Content: `
+resource "aws_s3_bucket" "main" {}
+
+resource "aws_instance" "web" {
+  depends_on = [aws_s3_bucket.main]
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "undeclared resource",
+			Content: `
+resource "aws_instance" "web" {
+  depends_on = [aws_s3_bucket.main]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDependsOnRule(),
+					Message: "`aws_s3_bucket.main` in depends_on is not declared",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 3, Column: 17},
+						End:      hcl.Pos{Line: 3, Column: 35},
+					},
+				},
+			},
+		},
+		{
+			Name: "undeclared module in output",
+			Content: `
+output "id" {
+  value      = "id"
+  depends_on = [module.network]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDependsOnRule(),
+					Message: "`module.network` in depends_on is not declared",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 4, Column: 17},
+						End:      hcl.Pos{Line: 4, Column: 31},
+					},
+				},
+			},
+		},
+		{
+			Name: "redundant dependency",
+			Content: `
+resource "aws_vpc" "main" {}
+
+locals {
+  vpc_id = aws_vpc.main.id
+}
+
+resource "aws_subnet" "main" {
+  vpc_id     = local.vpc_id
+  depends_on = [aws_vpc.main]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformDependsOnRule(),
+					Message: "`aws_vpc.main` in depends_on is redundant because `aws_subnet.main` already depends on it through references",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 10, Column: 17},
+						End:      hcl.Pos{Line: 10, Column: 29},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformDependsOnRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"resource.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
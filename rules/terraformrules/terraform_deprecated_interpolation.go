@@ -36,6 +36,23 @@ func (r *TerraformDeprecatedInterpolationRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformDeprecatedInterpolationRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows deprecated 0.11-style interpolation, that is, expressions wrapped only by `\"${...}\"`.",
+		Rationale:   "Terraform v0.12 introduced first-class expressions but continues to support the old interpolation syntax for compatibility. Terraform warns about it and it will become an error in a future major release.",
+		Violating: `resource "aws_instance" "web" {
+  instance_type = "${var.type}"
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = var.type
+}
+`,
+		Fix: "Remove the `\"${` and `}\"` around the expression.",
+	}
+}
+
 // Check emits issues on the deprecated interpolation syntax.
 // This logic is equivalent to the warning logic implemented in Terraform.
 // See https://github.com/hashicorp/terraform/pull/23348
@@ -0,0 +1,174 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformDiscouragedResourceRule checks whether resources or data sources which often hide workarounds are used
+// It is a rule family. Each rule checks a type, such as `terraform_null_resource` for `null_resource`.
+type TerraformDiscouragedResourceRule struct {
+	name         string
+	mode         addrs.ResourceMode
+	resourceType string
+	smell        string
+	// example and alternative are the declaration of the type and its replacement, used in the documentation
+	example     string
+	alternative string
+
+	requireReason bool
+}
+
+type terraformDiscouragedResourceRuleConfig struct {
+	RequireReason bool `hcl:"require_reason,optional"`
+}
+
+// NewTerraformNullResourceRule returns a rule for null_resource
+func NewTerraformNullResourceRule() *TerraformDiscouragedResourceRule {
+	return &TerraformDiscouragedResourceRule{
+		name:         "terraform_null_resource",
+		mode:         addrs.ManagedResourceMode,
+		resourceType: "null_resource",
+		smell:        "often runs shell commands with provisioners or forces ordering with triggers",
+		example: `resource "null_resource" "tags" {
+  provisioner "local-exec" {
+    command = "aws ec2 create-tags --resources ${aws_instance.web.id} --tags Key=Name,Value=web"
+  }
+}
+`,
+		alternative: `resource "aws_instance" "web" {
+  tags = {
+    Name = "web"
+  }
+}
+`,
+	}
+}
+
+// NewTerraformTimeSleepRule returns a rule for time_sleep
+func NewTerraformTimeSleepRule() *TerraformDiscouragedResourceRule {
+	return &TerraformDiscouragedResourceRule{
+		name:         "terraform_time_sleep",
+		mode:         addrs.ManagedResourceMode,
+		resourceType: "time_sleep",
+		smell:        "often waits for eventual consistency instead of depending on the actual condition",
+		example: `resource "time_sleep" "wait" {
+  depends_on      = [aws_iam_role_policy_attachment.web]
+  create_duration = "30s"
+}
+`,
+		alternative: `resource "aws_instance" "web" {
+  iam_instance_profile = aws_iam_instance_profile.web.name
+}
+`,
+	}
+}
+
+// NewTerraformExternalDataSourceRule returns a rule for the external data source
+func NewTerraformExternalDataSourceRule() *TerraformDiscouragedResourceRule {
+	return &TerraformDiscouragedResourceRule{
+		name:         "terraform_external_data_source",
+		mode:         addrs.DataResourceMode,
+		resourceType: "external",
+		smell:        "runs an external program, which is not tracked by Terraform",
+		example: `data "external" "ami" {
+  program = ["./find-ami.sh"]
+}
+`,
+		alternative: `data "aws_ami" "ubuntu" {
+  most_recent = true
+  owners      = ["099720109477"]
+}
+`,
+	}
+}
+
+// Name returns the rule name
+func (r *TerraformDiscouragedResourceRule) Name() string {
+	return r.name
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformDiscouragedResourceRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformDiscouragedResourceRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+// All rules of the family share the same document.
+func (r *TerraformDiscouragedResourceRule) Link() string {
+	return tflint.ReferenceLink("terraform_discouraged_resources")
+}
+
+// Doc returns the rule documentation
+func (r *TerraformDiscouragedResourceRule) Doc() *tflint.RuleDoc {
+	kind := "resources"
+	if r.mode == addrs.DataResourceMode {
+		kind = "data sources"
+	}
+
+	return &tflint.RuleDoc{
+		Description: fmt.Sprintf("Disallows `%s` %s, which %s, unless the reason is explained by an annotation.", r.resourceType, kind, r.smell),
+		Rationale:   "They are sometimes necessary, but they are also easy ways to paper over missing dependencies or provider features. Their behavior is not visible in plans, so reviewers cannot tell whether they are still needed.",
+		Violating:   r.example,
+		Compliant:   r.alternative,
+		Fix:         fmt.Sprintf("Prefer resources, data sources and dependencies that express the actual condition. If it is really necessary, ignore the issue with `# tflint-ignore: %s <reason>`. Annotations without reasons do not ignore the issue unless `require_reason = false`.", r.Name()),
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *TerraformDiscouragedResourceRule) DefaultConfig() interface{} {
+	return terraformDiscouragedResourceRuleConfig{
+		RequireReason: true,
+	}
+}
+
+// IgnoreReasonRequired returns whether issues are only ignored by annotations with reasons
+// It reflects `require_reason` of the rule config while checking, which is true by default.
+func (r *TerraformDiscouragedResourceRule) IgnoreReasonRequired() bool {
+	return r.requireReason
+}
+
+// Check checks whether the resources or data sources of the type are declared
+func (r *TerraformDiscouragedResourceRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(terraformDiscouragedResourceRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	r.requireReason = config.RequireReason
+
+	resources := runner.TFConfig.Module.ManagedResources
+	if r.mode == addrs.DataResourceMode {
+		resources = runner.TFConfig.Module.DataResources
+	}
+	declared := []*configs.Resource{}
+	for _, resource := range resources {
+		if resource.Type == r.resourceType {
+			declared = append(declared, resource)
+		}
+	}
+	sort.Slice(declared, func(i, j int) bool {
+		return declared[i].Name < declared[j].Name
+	})
+
+	for _, resource := range declared {
+		message := fmt.Sprintf("`%s` %s", resource.Addr(), r.smell)
+		if r.requireReason {
+			message += fmt.Sprintf(". If it is necessary, explain why with `tflint-ignore: %s <reason>`", r.Name())
+		}
+		runner.EmitIssue(r, message, resource.DeclRange)
+	}
+
+	return nil
+}
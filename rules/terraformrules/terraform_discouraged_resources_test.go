@@ -0,0 +1,120 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformDiscouragedResourceRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Rule     *TerraformDiscouragedResourceRule
+		Content  string
+		Config   string
+		Expected tflint.Issues
+		// ReasonRequired is whether annotations need reasons to ignore the issues
+		ReasonRequired bool
+	}{
+		{
+			Name: "null_resource",
+			Rule: NewTerraformNullResourceRule(),
+			Content: `
+resource "null_resource" "provision" {
+  triggers = {
+    id = "foo"
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformNullResourceRule(),
+					Message: "`null_resource.provision` often runs shell commands with provisioners or forces ordering with triggers. If it is necessary, explain why with `tflint-ignore: terraform_null_resource <reason>`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 37},
+					},
+				},
+			},
+			ReasonRequired: true,
+		},
+		{
+			Name: "time_sleep",
+			Rule: NewTerraformTimeSleepRule(),
+			Content: `
+resource "time_sleep" "wait" {
+  create_duration = "30s"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformTimeSleepRule(),
+					Message: "`time_sleep.wait` often waits for eventual consistency instead of depending on the actual condition. If it is necessary, explain why with `tflint-ignore: terraform_time_sleep <reason>`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 29},
+					},
+				},
+			},
+			ReasonRequired: true,
+		},
+		{
+			Name: "external data source",
+			Rule: NewTerraformExternalDataSourceRule(),
+			Content: `
+resource "external" "managed" {}
+
+data "external" "script" {
+  program = ["python", "script.py"]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformExternalDataSourceRule(),
+					Message: "`data.external.script` runs an external program, which is not tracked by Terraform. If it is necessary, explain why with `tflint-ignore: terraform_external_data_source <reason>`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 25},
+					},
+				},
+			},
+			ReasonRequired: true,
+		},
+		{
+			Name: "reason is not required",
+			Rule: NewTerraformNullResourceRule(),
+			Content: `
+resource "null_resource" "trigger" {}`,
+			Config: `
+rule "terraform_null_resource" {
+  enabled        = true
+  require_reason = false
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformNullResourceRule(),
+					Message: "`null_resource.trigger` often runs shell commands with provisioners or forces ordering with triggers",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 35},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := tc.Rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+		if tc.Rule.IgnoreReasonRequired() != tc.ReasonRequired {
+			t.Fatalf("Failed `%s` test: expected reason required is %t, but got %t", tc.Name, tc.ReasonRequired, tc.Rule.IgnoreReasonRequired())
+		}
+	}
+}
@@ -35,6 +35,24 @@ func (r *TerraformDocumentedOutputsRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformDocumentedOutputsRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `output` declarations without a non-empty description.",
+		Rationale:   "Outputs are the interface of a module. Descriptions tell users what the values are, and tools such as terraform-docs generate documentation from them.",
+		Violating: `output "id" {
+  value = "foo"
+}
+`,
+		Compliant: `output "id" {
+  value       = "foo"
+  description = "The ID of the instance"
+}
+`,
+		Fix: "Write a description other than an empty string.",
+	}
+}
+
 // Check checks whether outputs have descriptions
 func (r *TerraformDocumentedOutputsRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
@@ -35,6 +35,24 @@ func (r *TerraformDocumentedVariablesRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformDocumentedVariablesRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `variable` declarations without a non-empty description.",
+		Rationale:   "Variables are the interface of a module. Descriptions tell users what to pass, and tools such as terraform-docs generate documentation from them.",
+		Violating: `variable "name" {
+  type = string
+}
+`,
+		Compliant: `variable "name" {
+  type        = string
+  description = "The name of the instance"
+}
+`,
+		Fix: "Write a description other than an empty string.",
+	}
+}
+
 // Check checks whether variables have descriptions
 func (r *TerraformDocumentedVariablesRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
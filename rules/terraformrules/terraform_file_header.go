@@ -0,0 +1,212 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformFileHeaderRule checks whether each file starts with the configured header comment
+type TerraformFileHeaderRule struct {
+	now func() time.Time
+}
+
+type terraformFileHeaderRuleConfig struct {
+	Header  string `hcl:"header"`
+	Company string `hcl:"company,optional"`
+}
+
+const (
+	yearPlaceholder    = "{{year}}"
+	companyPlaceholder = "{{company}}"
+)
+
+// NewTerraformFileHeaderRule returns a new rule
+func NewTerraformFileHeaderRule() *TerraformFileHeaderRule {
+	return &TerraformFileHeaderRule{now: time.Now}
+}
+
+// Name returns the rule name
+func (r *TerraformFileHeaderRule) Name() string {
+	return "terraform_file_header"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformFileHeaderRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformFileHeaderRule) Severity() string {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *TerraformFileHeaderRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformFileHeaderRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Requires the header comment configured by `header` at the top of every `.tf` file. `{{year}}` in the header matches any 4-digit year and `{{company}}` is replaced by `company`. Comment markers and blank lines are ignored when comparing.",
+		Rationale:   "Many organizations require a license or copyright header in every source file. Checking it with TFLint is more reliable than ad hoc shell scripts.",
+		Config: `rule "terraform_file_header" {
+  enabled = true
+  header  = <<EOT
+Copyright {{year}} {{company}}
+SPDX-License-Identifier: Apache-2.0
+EOT
+  company = "Example Inc."
+}
+`,
+		Violating: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Compliant: `# Copyright 2020 Example Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Fix: "Run `tflint --fix` to insert the header at the top of the file as `#` comments, or write it by hand in any comment style.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *TerraformFileHeaderRule) DefaultConfig() interface{} {
+	return terraformFileHeaderRuleConfig{}
+}
+
+// Check checks whether each file in the module starts with the header comment
+func (r *TerraformFileHeaderRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(terraformFileHeaderRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+	if config.Header == "" {
+		return nil
+	}
+
+	pattern, err := r.headerPattern(config)
+	if err != nil {
+		return err
+	}
+
+	for _, filename := range r.moduleFiles(runner) {
+		tokens, err := runner.Tokens(filename)
+		if err != nil {
+			return err
+		}
+
+		if pattern.MatchString(leadingCommentText(tokens)) {
+			continue
+		}
+
+		runner.EmitIssueWithFix(
+			r,
+			"The file does not start with the required header",
+			hcl.Range{
+				Filename: filename,
+				Start:    hcl.InitialPos,
+				End:      hcl.InitialPos,
+			},
+			&tflint.Fix{
+				Range: hcl.Range{
+					Filename: filename,
+					Start:    hcl.InitialPos,
+					End:      hcl.InitialPos,
+				},
+				Text: r.headerComment(config),
+			},
+		)
+	}
+
+	return nil
+}
+
+// headerPattern builds a regexp from the header template
+// The year placeholder accepts any year because files are created in different years.
+func (r *TerraformFileHeaderRule) headerPattern(config terraformFileHeaderRuleConfig) (*regexp.Regexp, error) {
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(config.Header), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	pattern := regexp.QuoteMeta(strings.Join(lines, "\n"))
+	pattern = strings.Replace(pattern, regexp.QuoteMeta(yearPlaceholder), `\d{4}`, -1)
+	pattern = strings.Replace(pattern, regexp.QuoteMeta(companyPlaceholder), regexp.QuoteMeta(config.Company), -1)
+
+	return regexp.Compile(`\A` + pattern + `(\n|\z)`)
+}
+
+// headerComment renders the header template as comments with the current year
+func (r *TerraformFileHeaderRule) headerComment(config terraformFileHeaderRuleConfig) string {
+	header := strings.Replace(strings.TrimSpace(config.Header), yearPlaceholder, fmt.Sprint(r.now().Year()), -1)
+	header = strings.Replace(header, companyPlaceholder, config.Company, -1)
+
+	ret := ""
+	for _, line := range strings.Split(header, "\n") {
+		ret += strings.TrimRight("# "+strings.TrimSpace(line), " ") + "\n"
+	}
+	return ret + "\n"
+}
+
+// moduleFiles returns sorted HCL native syntax files in the module directory
+func (r *TerraformFileHeaderRule) moduleFiles(runner *tflint.Runner) []string {
+	ret := []string{}
+	for filename := range runner.Sources {
+		if strings.HasSuffix(filename, ".tf") && filepath.Dir(filename) == filepath.Clean(runner.TFConfig.Module.SourceDir) {
+			ret = append(ret, filename)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// leadingCommentText returns text of comments at the top of the file without comment markers
+// Blank lines are ignored so that the header can be compared regardless of how comments are separated.
+func leadingCommentText(tokens hclsyntax.Tokens) string {
+	lines := []string{}
+
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenNewline {
+			continue
+		}
+		if token.Type != hclsyntax.TokenComment {
+			break
+		}
+
+		text := string(token.Bytes)
+		switch {
+		case strings.HasPrefix(text, "#"):
+			text = strings.TrimPrefix(text, "#")
+		case strings.HasPrefix(text, "//"):
+			text = strings.TrimPrefix(text, "//")
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		}
+
+		for _, line := range strings.Split(text, "\n") {
+			line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+			if line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
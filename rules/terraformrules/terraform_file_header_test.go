@@ -0,0 +1,126 @@
+package terraformrules
+
+import (
+	"testing"
+	"time"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformFileHeaderRule(t *testing.T) {
+	config := `
+rule "terraform_file_header" {
+  enabled = true
+  header  = <<EOT
+Copyright {{year}} {{company}}
+SPDX-License-Identifier: Apache-2.0
+EOT
+  company = "Example Inc."
+}`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+		Fixed    string
+	}{
+		{
+			Name: "hash comments",
+			Content: `# Copyright 2018 Example Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "aws_instance" "web" {}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "block comment",
+			Content: `/*
+ * Copyright 2019 Example Inc.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+resource "aws_instance" "web" {}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name:    "missing header",
+			Content: `resource "aws_instance" "web" {}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformFileHeaderRule(),
+					Message: "The file does not start with the required header",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+			Fixed: `# Copyright 2020 Example Inc.
+# SPDX-License-Identifier: Apache-2.0
+
+resource "aws_instance" "web" {}`,
+		},
+		{
+			Name: "wrong company",
+			Content: `# Copyright 2018 Other Corp.
+# SPDX-License-Identifier: Apache-2.0
+resource "aws_instance" "web" {}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformFileHeaderRule(),
+					Message: "The file does not start with the required header",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "header after resource",
+			Content: `resource "aws_instance" "web" {}
+# Copyright 2018 Example Inc.
+# SPDX-License-Identifier: Apache-2.0`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformFileHeaderRule(),
+					Message: "The file does not start with the required header",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformFileHeaderRule()
+	rule.now = func() time.Time { return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": tc.Content}, loadConfigfromTempFile(t, config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if tc.Fixed != "" {
+			patched, _ := tflint.ApplyFixes(runner.Sources, runner.Issues)
+			if string(patched["main.tf"]) != tc.Fixed {
+				t.Fatalf("Failed `%s` test: expected fixed content is `%s`, but got `%s`", tc.Name, tc.Fixed, string(patched["main.tf"]))
+			}
+		}
+
+		tflint.AssertIssues(t, tc.Expected, withoutFixes(runner.Issues))
+	}
+}
+
+func withoutFixes(issues tflint.Issues) tflint.Issues {
+	for _, issue := range issues {
+		issue.Fix = nil
+	}
+	return issues
+}
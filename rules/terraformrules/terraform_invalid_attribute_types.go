@@ -0,0 +1,94 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// TerraformInvalidAttributeTypesRule checks whether values of resource arguments match types in provider schemas
+type TerraformInvalidAttributeTypesRule struct{}
+
+// NewTerraformInvalidAttributeTypesRule returns a new rule
+func NewTerraformInvalidAttributeTypesRule() *TerraformInvalidAttributeTypesRule {
+	return &TerraformInvalidAttributeTypesRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformInvalidAttributeTypesRule) Name() string {
+	return "terraform_invalid_attribute_types"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformInvalidAttributeTypesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformInvalidAttributeTypesRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformInvalidAttributeTypesRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformInvalidAttributeTypesRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows values of resource and data source arguments which cannot be converted to the types declared in provider schemas. It requires provider schemas set by `provider_schemas`.",
+		Rationale:   "Terraform converts values to the types declared by providers, and fails to plan if the conversion fails. Unlike rules for specific resources, this rule works for any provider whose schemas are available.",
+		Violating: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+  tags          = ["web"]
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+  tags = {
+    Name = "web"
+  }
+}
+`,
+		Fix: "Pass values of the types the arguments require. Refer to the documentation of the provider for the types.",
+	}
+}
+
+// Check checks whether evaluable values of resource and data source arguments can be converted to the types in schemas
+// Schemas are read from `provider_schemas`, so nothing is reported without it.
+func (r *TerraformInvalidAttributeTypesRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkResourceSchemaBodies(func(resource *configs.Resource, content *hcl.BodyContent, schema *configschema.Block, diags hcl.Diagnostics) error {
+		names := []string{}
+		for name := range content.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			attr := content.Attributes[name]
+			val, err := runner.EvalExpr(attr.Expr, nil, cty.DynamicPseudoType)
+			if err != nil {
+				// Unknown or unevaluable values cannot be checked statically
+				continue
+			}
+			if _, err := convert.Convert(val, schema.Attributes[name].Type); err != nil {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("Invalid value for `%s` of `%s`: %s", name, resource.Type, err),
+					attr.Expr.Range(),
+				)
+			}
+		}
+		return nil
+	})
+}
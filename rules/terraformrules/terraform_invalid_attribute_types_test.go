@@ -0,0 +1,105 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformInvalidAttributeTypesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "valid types",
+			Content: `
+resource "aws_instance" "web" {
+  ami           = "ami-1234"
+  instance_type = "t2.micro"
+  tags          = { Name = "web" }
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_size = "10"
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "invalid type",
+			Content: `
+resource "aws_instance" "web" {
+  ami  = "ami-1234"
+  tags = ["web"]
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformInvalidAttributeTypesRule(),
+					Message: "Invalid value for `tags` of `aws_instance`: map of string required",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 4, Column: 17},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid nested type",
+			Content: `
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_size = "large"
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformInvalidAttributeTypesRule(),
+					Message: "Invalid value for `volume_size` of `aws_instance`: a number is required",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 19},
+						End:      hcl.Pos{Line: 7, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown value",
+			Content: `
+variable "ami" {}
+
+resource "aws_instance" "web" {
+  ami           = var.ami
+  instance_type = aws_instance.other.instance_type
+
+  dynamic "ebs_block_device" {
+    for_each = ["/dev/sdb"]
+    content {
+      device_name = ebs_block_device.value
+      volume_size = ebs_block_device.key
+    }
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformInvalidAttributeTypesRule()
+
+	for _, tc := range cases {
+		runner := testRunnerWithProviderSchemas(t, tc.Content)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
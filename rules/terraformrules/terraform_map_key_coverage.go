@@ -0,0 +1,242 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TerraformMapKeyCoverageRule checks whether maps looked up by variables have keys for all values of the variables
+type TerraformMapKeyCoverageRule struct{}
+
+type terraformMapKeyCoverageRuleConfig struct {
+	// Values are possible values of variables, indexed by variable names
+	Values map[string][]string `hcl:"values,optional"`
+}
+
+// NewTerraformMapKeyCoverageRule returns a new rule
+func NewTerraformMapKeyCoverageRule() *TerraformMapKeyCoverageRule {
+	return &TerraformMapKeyCoverageRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformMapKeyCoverageRule) Name() string {
+	return "terraform_map_key_coverage"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformMapKeyCoverageRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformMapKeyCoverageRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformMapKeyCoverageRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformMapKeyCoverageRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Ensures that maps looked up by variables have keys for all values the variables can take. The values are taken from `values` of the rule config and `contains([...], var.name)` conditions of validation blocks.",
+		Rationale:   "Terraform fails when a key is not found in the map. The failure happens only at runtime with the specific value, for example, when the configuration is applied to a new region for the first time.",
+		Violating: `variable "region" {
+  type = string
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}
+
+variable "amis" {
+  default = {
+    us-east-1 = "ami-12345678"
+  }
+}
+
+resource "aws_instance" "web" {
+  ami = lookup(var.amis, var.region)
+}
+`,
+		Compliant: `variable "region" {
+  type = string
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}
+
+variable "amis" {
+  default = {
+    us-east-1 = "ami-12345678"
+    us-west-2 = "ami-87654321"
+  }
+}
+
+resource "aws_instance" "web" {
+  ami = lookup(var.amis, var.region)
+}
+`,
+		Fix: "Add the missing keys to the map, or pass a default value to `lookup`.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *TerraformMapKeyCoverageRule) DefaultConfig() interface{} {
+	return terraformMapKeyCoverageRuleConfig{}
+}
+
+// Check checks `lookup(map, var.key)` without a default and `map[var.key]`
+// Possible values of the variable are taken from the rule config, or from the domain narrowed by validations of the variable.
+// Lookups are skipped if the possible values are not known or the map cannot be evaluated.
+func (r *TerraformMapKeyCoverageRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(terraformMapKeyCoverageRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	values := map[string][]string{}
+	for name := range runner.TFConfig.Module.Variables {
+		if vals, ok := config.Values[name]; ok {
+			values[name] = vals
+			continue
+		}
+		if domain := runner.VariableDomain(name); domain != nil {
+			values[name] = []string{}
+			for _, v := range domain {
+				if v.Type() == cty.String && !v.IsNull() {
+					values[name] = append(values[name], v.AsString())
+				}
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	nodes := []hclsyntax.Node{}
+	for _, resource := range runner.TFConfig.Module.ManagedResources {
+		nodes = appendSyntaxNode(nodes, resource.Config)
+	}
+	for _, resource := range runner.TFConfig.Module.DataResources {
+		nodes = appendSyntaxNode(nodes, resource.Config)
+	}
+	for _, call := range runner.TFConfig.Module.ModuleCalls {
+		nodes = appendSyntaxNode(nodes, call.Config)
+	}
+	for _, provider := range runner.TFConfig.Module.ProviderConfigs {
+		nodes = appendSyntaxNode(nodes, provider.Config)
+	}
+	for _, local := range runner.TFConfig.Module.Locals {
+		nodes = appendSyntaxNode(nodes, local.Expr)
+	}
+	for _, output := range runner.TFConfig.Module.Outputs {
+		nodes = appendSyntaxNode(nodes, output.Expr)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Range().Filename != nodes[j].Range().Filename {
+			return nodes[i].Range().Filename < nodes[j].Range().Filename
+		}
+		return nodes[i].Range().Start.Byte < nodes[j].Range().Start.Byte
+	})
+
+	for _, node := range nodes {
+		hclsyntax.VisitAll(node, func(n hclsyntax.Node) hcl.Diagnostics {
+			var collection, key hclsyntax.Expression
+			switch expr := n.(type) {
+			case *hclsyntax.FunctionCallExpr:
+				// lookup with a default never fails
+				if expr.Name != "lookup" || len(expr.Args) != 2 {
+					return nil
+				}
+				collection, key = expr.Args[0], expr.Args[1]
+			case *hclsyntax.IndexExpr:
+				collection, key = expr.Collection, expr.Key
+			default:
+				return nil
+			}
+
+			name := variableName(key)
+			if _, ok := values[name]; !ok {
+				return nil
+			}
+
+			val, err := runner.EvalExpr(r.resolveLocal(runner, collection), nil, cty.DynamicPseudoType)
+			if err != nil || !(val.Type().IsMapType() || val.Type().IsObjectType()) {
+				return nil
+			}
+
+			for _, v := range values[name] {
+				if !val.Type().IsObjectType() && val.HasIndex(cty.StringVal(v)).True() {
+					continue
+				}
+				if val.Type().IsObjectType() && val.Type().HasAttribute(v) {
+					continue
+				}
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The map has no key for \"%s\", which `var.%s` can take", v, name),
+					n.Range(),
+				)
+			}
+			return nil
+		})
+	}
+
+	return nil
+}
+
+// resolveLocal returns the expression of the local value if the expression is just a reference to it, like `local.name`
+// Local values cannot be evaluated by the runner, but maps are often defined in them.
+func (r *TerraformMapKeyCoverageRule) resolveLocal(runner *tflint.Runner, expr hcl.Expression) hcl.Expression {
+	traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr)
+	if !ok || len(traversal.Traversal) != 2 || traversal.Traversal.RootName() != "local" {
+		return expr
+	}
+	attr, ok := traversal.Traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return expr
+	}
+	if local, exists := runner.TFConfig.Module.Locals[attr.Name]; exists {
+		return local.Expr
+	}
+	return expr
+}
+
+// variableName returns the name of the variable if the expression is just a reference to it, like `var.name`
+func variableName(expr hcl.Expression) string {
+	traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr)
+	if !ok || len(traversal.Traversal) != 2 || traversal.Traversal.RootName() != "var" {
+		return ""
+	}
+	attr, ok := traversal.Traversal[1].(hcl.TraverseAttr)
+	if !ok {
+		return ""
+	}
+	return attr.Name
+}
+
+// appendSyntaxNode appends the body or the expression if it is in HCL native syntax
+func appendSyntaxNode(nodes []hclsyntax.Node, node interface{}) []hclsyntax.Node {
+	switch n := node.(type) {
+	case *hclsyntax.Body:
+		return append(nodes, n)
+	case hclsyntax.Expression:
+		return append(nodes, n)
+	default:
+		return nodes
+	}
+}
@@ -0,0 +1,172 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformMapKeyCoverageRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "lookup with configured values",
+			Content: `
+variable "region" {}
+
+variable "amis" {
+  default = {
+    us-east-1 = "ami-12345678"
+  }
+}
+
+resource "aws_instance" "web" {
+  ami = lookup(var.amis, var.region)
+}`,
+			Config: `
+rule "terraform_map_key_coverage" {
+  enabled = true
+  values = {
+    region = ["us-east-1", "us-west-2"]
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMapKeyCoverageRule(),
+					Message: "The map has no key for \"us-west-2\", which `var.region` can take",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 11, Column: 9},
+						End:      hcl.Pos{Line: 11, Column: 37},
+					},
+				},
+			},
+		},
+		{
+			Name: "index with configured values",
+			Content: `
+variable "env" {}
+
+locals {
+  instance_types = {
+    dev  = "t2.micro"
+    prod = "m5.large"
+  }
+  instance_type = local.instance_types[var.env]
+}`,
+			Config: `
+rule "terraform_map_key_coverage" {
+  enabled = true
+  values = {
+    env = ["dev", "stg", "prod"]
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMapKeyCoverageRule(),
+					Message: "The map has no key for \"stg\", which `var.env` can take",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 9, Column: 19},
+						End:      hcl.Pos{Line: 9, Column: 48},
+					},
+				},
+			},
+		},
+		{
+			Name: "index with validation",
+			Content: `
+variable "region" {
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}
+
+variable "amis" {
+  default = {
+    us-east-1 = "ami-12345678"
+  }
+}
+
+resource "aws_instance" "web" {
+  ami = var.amis[var.region]
+}`,
+			Config: `
+rule "terraform_map_key_coverage" {
+  enabled = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMapKeyCoverageRule(),
+					Message: "The map has no key for \"us-west-2\", which `var.region` can take",
+					Range: hcl.Range{
+						Filename: "resource.tf",
+						Start:    hcl.Pos{Line: 16, Column: 9},
+						End:      hcl.Pos{Line: 16, Column: 29},
+					},
+				},
+			},
+		},
+		{
+			Name: "lookup with default",
+			Content: `
+variable "region" {}
+
+variable "amis" {
+  default = {
+    us-east-1 = "ami-12345678"
+  }
+}
+
+resource "aws_instance" "web" {
+  ami = lookup(var.amis, var.region, "ami-87654321")
+}`,
+			Config: `
+rule "terraform_map_key_coverage" {
+  enabled = true
+  values = {
+    region = ["us-east-1", "us-west-2"]
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "unknown values",
+			Content: `
+variable "region" {}
+
+variable "amis" {
+  default = {
+    us-east-1 = "ami-12345678"
+  }
+}
+
+resource "aws_instance" "web" {
+  ami = var.amis[var.region]
+}`,
+			Config: `
+rule "terraform_map_key_coverage" {
+  enabled = true
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformMapKeyCoverageRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"resource.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
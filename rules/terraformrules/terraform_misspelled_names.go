@@ -0,0 +1,174 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformMisspelledNamesRule checks whether resource types and their attribute names are misspelled
+type TerraformMisspelledNamesRule struct{}
+
+// maxTypoDistance is the maximum edit distance to regard a name as a misspelling of a known name
+const maxTypoDistance = 2
+
+// NewTerraformMisspelledNamesRule returns a new rule
+func NewTerraformMisspelledNamesRule() *TerraformMisspelledNamesRule {
+	return &TerraformMisspelledNamesRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformMisspelledNamesRule) Name() string {
+	return "terraform_misspelled_names"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformMisspelledNamesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformMisspelledNamesRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformMisspelledNamesRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformMisspelledNamesRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows resource types, data source types and arguments which look like misspellings of the names declared in provider schemas. It requires provider schemas set by `provider_schemas`.",
+		Rationale:   "Terraform fails to find the provider or the resource type of misspelled types when running `terraform init` or `terraform plan`. Since the schema of the type is unknown, TFLint cannot inspect the resource either.",
+		Violating: `resource "aws_intance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Fix: "Fix the names to the suggested ones.",
+	}
+}
+
+// Check checks whether types of resources and data sources are close to known types in provider schemas
+// If the type is misspelled, attribute names are also checked with the schema of the suggested type.
+// Attribute names of known types are checked by `terraform_unknown_attributes`, so they are not reported twice.
+// Schemas are read from `provider_schemas`, so nothing is reported without it.
+func (r *TerraformMisspelledNamesRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	resources := []*configs.Resource{}
+	for _, resource := range runner.TFConfig.Module.ManagedResources {
+		resources = append(resources, resource)
+	}
+	for _, resource := range runner.TFConfig.Module.DataResources {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Addr().String() < resources[j].Addr().String()
+	})
+
+	for _, resource := range resources {
+		schemas := runner.ResourceTypeSchemas(resource.Mode)
+		if schemas == nil || schemas[resource.Type] != nil {
+			continue
+		}
+
+		suggestion := nameSuggestion(resource.Type, schemas)
+		if suggestion == "" {
+			// Types far from any known types may be of providers not included in the schemas
+			log.Printf("[DEBUG] `%s` is not found in schemas, but no similar types are found", resource.Type)
+			continue
+		}
+
+		kind := "resource type"
+		if resource.Mode == addrs.DataResourceMode {
+			kind = "data source"
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`%s` is not a known %s. Did you mean `%s`?", resource.Type, kind, suggestion),
+			resource.TypeRange,
+		)
+
+		err := tflint.WalkSchemaBody(resource, schemas[suggestion], func(resource *configs.Resource, content *hcl.BodyContent, schema *configschema.Block, diags hcl.Diagnostics) error {
+			for _, diag := range diags {
+				// Only misspellings are reported. Other arguments may be valid for the type the author intended
+				if diag.Subject == nil || !strings.Contains(diag.Detail, "Did you mean") {
+					continue
+				}
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("%s of `%s`: %s", diag.Summary, suggestion, diag.Detail),
+					*diag.Subject,
+				)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nameSuggestion returns the known name closest to the given name within the maximum edit distance
+// If several names are equally close, the first one in lexical order is returned.
+func nameSuggestion(given string, known map[string]*configschema.Block) string {
+	names := []string{}
+	for name := range known {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	suggestion := ""
+	min := maxTypoDistance + 1
+	for _, name := range names {
+		if distance := editDistance(given, name); distance < min {
+			suggestion = name
+			min = distance
+		}
+	}
+	return suggestion
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, minInt(curr[j-1]+1, prev[j-1]+cost))
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
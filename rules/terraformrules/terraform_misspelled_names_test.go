@@ -0,0 +1,116 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformMisspelledNamesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "known types",
+			Content: `
+resource "aws_instance" "web" {
+  instance_typ = "t2.micro"
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "misspelled resource type",
+			Content: `
+resource "aws_intance" "web" {
+  ami           = "ami-1234"
+  instence_type = "t2.micro"
+  monitoring    = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMisspelledNamesRule(),
+					Message: "`aws_intance` is not a known resource type. Did you mean `aws_instance`?",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 10},
+						End:      hcl.Pos{Line: 2, Column: 23},
+					},
+				},
+				{
+					Rule:    NewTerraformMisspelledNamesRule(),
+					Message: "Unsupported argument of `aws_instance`: An argument named \"instence_type\" is not expected here. Did you mean \"instance_type\"?",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "misspelled data source",
+			Content: `
+data "aws_amii" "ubuntu" {
+  most_recent = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMisspelledNamesRule(),
+					Message: "`aws_amii` is not a known data source. Did you mean `aws_ami`?",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 6},
+						End:      hcl.Pos{Line: 2, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown provider",
+			Content: `
+resource "google_compute_instance" "web" {
+  machine_type = "n1-standard-1"
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformMisspelledNamesRule()
+
+	for _, tc := range cases {
+		runner := testRunnerWithProviderSchemas(t, tc.Content)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func Test_editDistance(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected int
+	}{
+		{A: "aws_instance", B: "aws_instance", Expected: 0},
+		{A: "aws_intance", B: "aws_instance", Expected: 1},
+		{A: "instence_type", B: "instance_type", Expected: 1},
+		{A: "aws_instanec", B: "aws_instance", Expected: 2},
+		{A: "", B: "abc", Expected: 3},
+	}
+
+	for _, tc := range cases {
+		if got := editDistance(tc.A, tc.B); got != tc.Expected {
+			t.Fatalf("Failed `%s` and `%s`: expected %d, but got %d", tc.A, tc.B, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformModuleComplexityRule checks whether the module and its resources are kept small and simple
+type TerraformModuleComplexityRule struct{}
+
+type terraformModuleComplexityRuleConfig struct {
+	MaxVariables     int `hcl:"max_variables,optional"`
+	MaxOutputs       int `hcl:"max_outputs,optional"`
+	MaxResourceLines int `hcl:"max_resource_lines,optional"`
+	MaxNestingDepth  int `hcl:"max_nesting_depth,optional"`
+}
+
+// NewTerraformModuleComplexityRule returns a new rule
+func NewTerraformModuleComplexityRule() *TerraformModuleComplexityRule {
+	return &TerraformModuleComplexityRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformModuleComplexityRule) Name() string {
+	return "terraform_module_complexity"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformModuleComplexityRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformModuleComplexityRule) Severity() string {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *TerraformModuleComplexityRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformModuleComplexityRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows modules with too many variables or outputs, and resources with too many lines or deeply nested blocks. The thresholds are configured by `max_variables`, `max_outputs`, `max_resource_lines` and `max_nesting_depth`.",
+		Rationale:   "Modules with many inputs and outputs, and long or deeply nested resources are hard to read, review and reuse. These thresholds nudge teams toward smaller and more focused modules.",
+		Violating: `resource "aws_lb_listener" "web" {
+  default_action {
+    forward {
+      target_group {
+        stickiness {
+          enabled = true
+        }
+      }
+    }
+  }
+}
+`,
+		Compliant: `resource "aws_lb_listener" "web" {
+  default_action {
+    forward {
+      target_group {
+        arn = aws_lb_target_group.web.arn
+      }
+    }
+  }
+}
+`,
+		Fix: "Split the module into smaller modules, or move repeated structures into `dynamic` blocks, locals or child modules.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *TerraformModuleComplexityRule) DefaultConfig() interface{} {
+	return terraformModuleComplexityRuleConfig{
+		MaxVariables:     30,
+		MaxOutputs:       30,
+		MaxResourceLines: 100,
+		MaxNestingDepth:  3,
+	}
+}
+
+// Check checks the number of variables and outputs, the number of lines of each resource, and the nesting depth of blocks
+func (r *TerraformModuleComplexityRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(terraformModuleComplexityRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	module := runner.TFConfig.Module
+
+	if config.MaxVariables > 0 && len(module.Variables) > config.MaxVariables {
+		ranges := []hcl.Range{}
+		for _, variable := range module.Variables {
+			ranges = append(ranges, variable.DeclRange)
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("The module has %d variables, exceeding the limit of %d", len(module.Variables), config.MaxVariables),
+			nthRange(ranges, config.MaxVariables),
+		)
+	}
+
+	if config.MaxOutputs > 0 && len(module.Outputs) > config.MaxOutputs {
+		ranges := []hcl.Range{}
+		for _, output := range module.Outputs {
+			ranges = append(ranges, output.DeclRange)
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("The module has %d outputs, exceeding the limit of %d", len(module.Outputs), config.MaxOutputs),
+			nthRange(ranges, config.MaxOutputs),
+		)
+	}
+
+	resources := []*configs.Resource{}
+	for _, resource := range module.ManagedResources {
+		resources = append(resources, resource)
+	}
+	for _, resource := range module.DataResources {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return rangeLess(resources[i].DeclRange, resources[j].DeclRange)
+	})
+
+	for _, resource := range resources {
+		// Lines and nesting depth are only checked in HCL native syntax
+		body, ok := resource.Config.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+
+		lines := body.SrcRange.End.Line - resource.DeclRange.Start.Line + 1
+		if config.MaxResourceLines > 0 && lines > config.MaxResourceLines {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` has %d lines, exceeding the limit of %d", resource.Addr().String(), lines, config.MaxResourceLines),
+				resource.DeclRange,
+			)
+		}
+
+		if config.MaxNestingDepth > 0 {
+			if block := deepBlock(body, 1, config.MaxNestingDepth); block != nil {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("Blocks in `%s` are nested more than %d levels deep", resource.Addr().String(), config.MaxNestingDepth),
+					block.DefRange(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deepBlock returns the first block nested deeper than the max depth
+func deepBlock(body *hclsyntax.Body, depth int, max int) *hclsyntax.Block {
+	for _, block := range body.Blocks {
+		if depth > max {
+			return block
+		}
+		if ret := deepBlock(block.Body, depth+1, max); ret != nil {
+			return ret
+		}
+	}
+	return nil
+}
+
+// nthRange returns the n-th range (0-based) in source order, i.e. the first one exceeding the limit of n
+func nthRange(ranges []hcl.Range, n int) hcl.Range {
+	sort.Slice(ranges, func(i, j int) bool {
+		return rangeLess(ranges[i], ranges[j])
+	})
+	return ranges[n]
+}
+
+func rangeLess(a, b hcl.Range) bool {
+	if a.Filename != b.Filename {
+		return a.Filename < b.Filename
+	}
+	return a.Start.Byte < b.Start.Byte
+}
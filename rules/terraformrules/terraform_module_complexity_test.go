@@ -0,0 +1,143 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformModuleComplexityRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "too many variables and outputs",
+			Content: `
+variable "a" {}
+variable "b" {}
+variable "c" {}
+
+output "a" { value = 1 }
+output "b" { value = 2 }`,
+			Config: `
+rule "terraform_module_complexity" {
+  enabled       = true
+  max_variables = 2
+  max_outputs   = 1
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformModuleComplexityRule(),
+					Message: "The module has 3 variables, exceeding the limit of 2",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 13},
+					},
+				},
+				{
+					Rule:    NewTerraformModuleComplexityRule(),
+					Message: "The module has 2 outputs, exceeding the limit of 1",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 11},
+					},
+				},
+			},
+		},
+		{
+			Name: "long resource",
+			Content: `
+resource "aws_instance" "web" {
+  ami           = "ami-12345678"
+  instance_type = "t2.micro"
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}`,
+			Config: `
+rule "terraform_module_complexity" {
+  enabled            = true
+  max_resource_lines = 3
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformModuleComplexityRule(),
+					Message: "`aws_instance.web` has 4 lines, exceeding the limit of 3",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 30},
+					},
+				},
+			},
+		},
+		{
+			Name: "deeply nested blocks",
+			Content: `
+resource "aws_lb_listener" "web" {
+  default_action {
+    forward {
+      target_group {
+        arn = "arn"
+      }
+    }
+  }
+}`,
+			Config: `
+rule "terraform_module_complexity" {
+  enabled           = true
+  max_nesting_depth = 2
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformModuleComplexityRule(),
+					Message: "Blocks in `aws_lb_listener.web` are nested more than 2 levels deep",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 7},
+						End:      hcl.Pos{Line: 5, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "default limits",
+			Content: `
+variable "a" {}
+
+resource "aws_lb_listener" "web" {
+  default_action {
+    forward {
+      target_group {
+        arn = "arn"
+      }
+    }
+  }
+}`,
+			Config: `
+rule "terraform_module_complexity" {
+  enabled = true
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformModuleComplexityRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
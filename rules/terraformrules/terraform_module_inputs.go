@@ -0,0 +1,130 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// TerraformModuleInputsRule checks whether module calls pass valid inputs to the called modules
+type TerraformModuleInputsRule struct{}
+
+// NewTerraformModuleInputsRule returns a new rule
+func NewTerraformModuleInputsRule() *TerraformModuleInputsRule {
+	return &TerraformModuleInputsRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformModuleInputsRule) Name() string {
+	return "terraform_module_inputs"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformModuleInputsRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformModuleInputsRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformModuleInputsRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformModuleInputsRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Ensures that module calls pass valid inputs to the called modules: required variables are passed, passed arguments are declared in the module, and values can be converted to the types of the variables.",
+		Rationale:   "Terraform reports these errors only when running `terraform init` and `terraform plan` on the calling configuration. They often slip into examples and callers when the interface of a module changes.",
+		Violating:   "# module/variables.tf declares `name` of string and `instance_count` of number\nmodule \"web\" {\n  source = \"./module\"\n\n  instance_type  = \"t3.micro\"\n  instance_count = \"many\"\n}\n",
+		Compliant:   "# module/variables.tf declares `name` of string and `instance_count` of number\nmodule \"web\" {\n  source = \"./module\"\n\n  name           = \"web\"\n  instance_count = 2\n}\n",
+		Fix:         "Pass the variables declared in the module with values of the correct types. Remove arguments which are no longer declared.",
+	}
+}
+
+// Check checks whether arguments of module calls are declared as variables in the module,
+// required variables are passed, and evaluable values can be converted to the types of the variables
+// Only modules loaded as children of the configuration are checked, so module inspection must be enabled.
+func (r *TerraformModuleInputsRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	names := []string{}
+	for name := range runner.TFConfig.Module.ModuleCalls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		call := runner.TFConfig.Module.ModuleCalls[name]
+		child, ok := runner.TFConfig.Children[name]
+		if !ok {
+			log.Printf("[DEBUG] `%s` module is not loaded. Skipped", name)
+			continue
+		}
+
+		attrs, diags := call.Config.JustAttributes()
+		if diags.HasErrors() {
+			return diags
+		}
+
+		attrNames := []string{}
+		for attrName := range attrs {
+			attrNames = append(attrNames, attrName)
+		}
+		sort.Strings(attrNames)
+
+		for _, attrName := range attrNames {
+			attr := attrs[attrName]
+			variable, ok := child.Module.Variables[attrName]
+			if !ok {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` is not a variable of `%s` module", attrName, name),
+					attr.NameRange,
+				)
+				continue
+			}
+
+			val, err := runner.EvalExpr(attr.Expr, nil, cty.DynamicPseudoType)
+			if err != nil {
+				// Unknown or unevaluable values cannot be checked statically
+				continue
+			}
+			if _, err := convert.Convert(val, variable.Type); err != nil {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("Invalid value for `%s` variable of `%s` module: %s", attrName, name, err),
+					attr.Expr.Range(),
+				)
+			}
+		}
+
+		varNames := []string{}
+		for varName := range child.Module.Variables {
+			varNames = append(varNames, varName)
+		}
+		sort.Strings(varNames)
+
+		for _, varName := range varNames {
+			if _, ok := attrs[varName]; ok {
+				continue
+			}
+			if child.Module.Variables[varName].Default == cty.NilVal {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` module requires `%s` variable", name, varName),
+					call.DeclRange,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package terraformrules
+
+import (
+	"os"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformModuleInputsRule(t *testing.T) {
+	module := `
+variable "name" {
+  type = string
+}
+
+variable "instance_count" {
+  type    = number
+  default = 1
+}`
+
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "valid inputs",
+			Content: `
+module "web" {
+  source = "./module"
+
+  name           = "web"
+  instance_count = 2
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "undeclared variable",
+			Content: `
+module "web" {
+  source = "./module"
+
+  name          = "web"
+  instance_type = "t2.micro"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformModuleInputsRule(),
+					Message: "`instance_type` is not a variable of `web` module",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 3},
+						End:      hcl.Pos{Line: 6, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing required variable",
+			Content: `
+module "web" {
+  source = "./module"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformModuleInputsRule(),
+					Message: "`web` module requires `name` variable",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid type",
+			Content: `
+module "web" {
+  source = "./module"
+
+  name           = "web"
+  instance_count = "many"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformModuleInputsRule(),
+					Message: "Invalid value for `instance_count` variable of `web` module: a number is required",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 20},
+						End:      hcl.Pos{Line: 6, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown value",
+			Content: `
+resource "null_resource" "null" {}
+
+module "web" {
+  source = "./module"
+
+  name = null_resource.null.id
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformModuleInputsRule()
+
+	for _, tc := range cases {
+		runner := testRunnerWithLocalModules(t, map[string]string{
+			"main.tf":        tc.Content,
+			"module/main.tf": module,
+		})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func testRunnerWithLocalModules(t *testing.T, files map[string]string) *tflint.Runner {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	for name, src := range files {
+		if err := fs.WriteFile(name, []byte(src), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := tflint.EmptyConfig()
+	config.Module = true
+	loader, err := tflint.NewLoader(config, tflint.WithFS(fs), tflint.WithModuleResolver(tflint.LocalModuleResolver(fs)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := loader.LoadConfig(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner, err := tflint.NewRunnerWithFS(fs, config, map[string]tflint.Annotations{}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return runner
+}
@@ -46,6 +46,28 @@ func (r *TerraformModulePinnedSourceRule) Link() string {
 	return tflint.ReferenceLink(r.Name())
 }
 
+// Doc returns the rule documentation
+func (r *TerraformModulePinnedSourceRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows git and Mercurial repositories as module sources without pinning to a version. With `style = \"semver\"`, the version must be a semantic version such as `v1.2.0`.",
+		Rationale:   "If you do not pin the version to checkout, the dependency may introduce breaking changes without your awareness. Pinning to a non-permanent reference, such as a branch name, is also ambiguous.",
+		Violating: `module "consul" {
+  source = "git://hashicorp.com/consul.git?ref=master"
+}
+`,
+		Compliant: `module "consul" {
+  source = "git://hashicorp.com/consul.git?ref=v1.2.0"
+}
+`,
+		Fix: "Specify a version pin. For git repositories, it should not be `master`. For Mercurial repositories, it should not be `default`.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *TerraformModulePinnedSourceRule) DefaultConfig() interface{} {
+	return terraformModulePinnedSourceRuleConfig{Style: "flexible"}
+}
+
 // ReGitHub matches a module source which is a GitHub repository
 // See https://www.terraform.io/docs/modules/sources.html#github
 var ReGitHub = regexp.MustCompile("(^github.com/(.+)/(.+)$)|(^git@github.com:(.+)/(.+)$)")
@@ -66,7 +88,7 @@ var reSemverRevision = regexp.MustCompile("\\?rev=v?\\d+\\.\\d+\\.\\d+$")
 func (r *TerraformModulePinnedSourceRule) Check(runner *tflint.Runner) error {
 	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
 
-	config := terraformModulePinnedSourceRuleConfig{Style: "flexible"}
+	config := r.DefaultConfig().(terraformModulePinnedSourceRuleConfig)
 	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
 		return err
 	}
@@ -0,0 +1,137 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformMovedAndImportBlocksRule checks whether `moved` and `import` blocks reference real resources
+type TerraformMovedAndImportBlocksRule struct{}
+
+// NewTerraformMovedAndImportBlocksRule returns a new rule
+func NewTerraformMovedAndImportBlocksRule() *TerraformMovedAndImportBlocksRule {
+	return &TerraformMovedAndImportBlocksRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformMovedAndImportBlocksRule) Name() string {
+	return "terraform_moved_and_import_blocks"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformMovedAndImportBlocksRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformMovedAndImportBlocksRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformMovedAndImportBlocksRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformMovedAndImportBlocksRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Validates that `moved` and `import` blocks refer to resources and modules declared in the configuration, that `from` of `moved` blocks no longer exists, and that `id` of `import` blocks is not empty.",
+		Rationale:   "`moved` and `import` blocks refer to objects by their addresses. A typo in these addresses is not noticed until `terraform plan`, and a `moved` block whose `from` still exists in the configuration is rejected by Terraform.",
+		Violating: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+
+moved {
+  from = aws_instance.app
+  to   = aws_instance.server
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+
+moved {
+  from = aws_instance.app
+  to   = aws_instance.web
+}
+`,
+		Fix: "Point `to` at a resource or module declared in the configuration, remove the old resource declared in `from`, and set a non-empty `id` to import blocks.",
+	}
+}
+
+// Check checks `from` and `to` of moved blocks, and `to` and `id` of import blocks
+func (r *TerraformMovedAndImportBlocksRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	moved, imports, err := runner.MovedBlocks()
+	if err != nil {
+		return err
+	}
+	index := runner.ReferenceIndex()
+
+	for _, block := range moved {
+		if block.From == nil || block.To == nil {
+			runner.EmitIssue(r, "`moved` block requires both `from` and `to`", block.DeclRange)
+			continue
+		}
+
+		if from, ok := r.address(runner, block.From); ok {
+			if _, exists := index.Definitions[from]; exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` still exists in the configuration. Remove it, or remove the moved block", from),
+					block.From.Range(),
+				)
+			}
+		}
+		if to, ok := r.address(runner, block.To); ok {
+			if _, exists := index.Definitions[to]; !exists {
+				runner.EmitIssue(r, fmt.Sprintf("`%s` is not found in the configuration", to), block.To.Range())
+			}
+		}
+	}
+
+	for _, block := range imports {
+		if block.To == nil || block.ID == nil {
+			runner.EmitIssue(r, "`import` block requires both `to` and `id`", block.DeclRange)
+			continue
+		}
+
+		if to, ok := r.address(runner, block.To); ok {
+			if strings.HasPrefix(to, "data.") {
+				runner.EmitIssue(r, fmt.Sprintf("`%s` is a data source. Only managed resources can be imported", to), block.To.Range())
+			} else if _, exists := index.Definitions[to]; !exists {
+				runner.EmitIssue(r, fmt.Sprintf("`%s` is not found in the configuration", to), block.To.Range())
+			}
+		}
+
+		var id string
+		err := runner.EvaluateExpr(block.ID, &id)
+		err = runner.EnsureNoError(err, func() error {
+			if id == "" {
+				runner.EmitIssue(r, "`id` must not be empty", block.ID.Range())
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// address returns the normalized address, or emits an issue if the expression is not a valid address
+func (r *TerraformMovedAndImportBlocksRule) address(runner *tflint.Runner, expr hcl.Expression) (string, bool) {
+	address, err := tflint.RefactoringAddress(expr)
+	if err != nil {
+		runner.EmitIssue(r, fmt.Sprintf("Invalid address: %s", err), expr.Range())
+		return "", false
+	}
+	return address, true
+}
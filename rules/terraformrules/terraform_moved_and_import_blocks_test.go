@@ -0,0 +1,180 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformMovedAndImportBlocksRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "valid moved block",
+			Content: `
+resource "aws_instance" "new" {}
+
+module "vpc" {
+  source = "./vpc"
+}
+
+moved {
+  from = aws_instance.old
+  to   = aws_instance.new
+}
+
+moved {
+  from = module.network
+  to   = module.vpc
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "moved to undefined resource",
+			Content: `
+resource "aws_instance" "old" {}
+
+moved {
+  from = aws_instance.old
+  to   = aws_instance.new
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`aws_instance.old` still exists in the configuration. Remove it, or remove the moved block",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 10},
+						End:      hcl.Pos{Line: 5, Column: 26},
+					},
+				},
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`aws_instance.new` is not found in the configuration",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 10},
+						End:      hcl.Pos{Line: 6, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid address",
+			Content: `
+moved {
+  from = var.old
+  to   = aws_instance.new
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "Invalid address: `var.old` is not a resource or module address",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 10},
+						End:      hcl.Pos{Line: 3, Column: 17},
+					},
+				},
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`aws_instance.new` is not found in the configuration",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 10},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing attributes",
+			Content: `
+moved {
+  from = aws_instance.old
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`moved` block requires both `from` and `to`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "valid import block",
+			Content: `
+resource "aws_instance" "web" {}
+
+import {
+  to = aws_instance.web
+  id = "i-1234567890"
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "invalid import block",
+			Content: `
+data "aws_ami" "ubuntu" {}
+
+import {
+  to = aws_instance.web
+  id = ""
+}
+
+import {
+  to = data.aws_ami.ubuntu
+  id = "ami-1234567890"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`aws_instance.web` is not found in the configuration",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 8},
+						End:      hcl.Pos{Line: 5, Column: 24},
+					},
+				},
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`id` must not be empty",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 8},
+						End:      hcl.Pos{Line: 6, Column: 10},
+					},
+				},
+				{
+					Rule:    NewTerraformMovedAndImportBlocksRule(),
+					Message: "`data.aws_ami.ubuntu` is a data source. Only managed resources can be imported",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 10, Column: 8},
+						End:      hcl.Pos{Line: 10, Column: 27},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformMovedAndImportBlocksRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
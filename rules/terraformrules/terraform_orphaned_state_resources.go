@@ -0,0 +1,143 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformOrphanedStateResourcesRule checks whether resources recorded in the state are still declared in the configuration
+type TerraformOrphanedStateResourcesRule struct{}
+
+// NewTerraformOrphanedStateResourcesRule returns a new rule
+func NewTerraformOrphanedStateResourcesRule() *TerraformOrphanedStateResourcesRule {
+	return &TerraformOrphanedStateResourcesRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformOrphanedStateResourcesRule) Name() string {
+	return "terraform_orphaned_state_resources"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformOrphanedStateResourcesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformOrphanedStateResourcesRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformOrphanedStateResourcesRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformOrphanedStateResourcesRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Warns about managed resources recorded in the state but missing from the configuration, which Terraform destroys on the next apply. It is run only when the state is loaded in deep check mode.",
+		Rationale:   "A resource deleted or renamed by mistake, for example while moving it to another file, is only noticed in the plan, which may be overlooked in a long diff.",
+		Violating: `# aws_instance.app is recorded in the state
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Compliant: `# aws_instance.app is recorded in the state
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+
+moved {
+  from = aws_instance.app
+  to   = aws_instance.web
+}
+`,
+		Fix: "Restore the resource if it was removed by mistake. If it was renamed, add a `moved` block. If it should be kept but no longer managed by Terraform, remove it from the state with `terraform state rm`.",
+	}
+}
+
+// Check checks whether managed resources recorded in the state are missing from the configuration
+// The state is loaded only in deep check mode, so nothing is reported without it. Resources moved by `moved` blocks are not orphaned.
+// Resources in child modules are reported at the module call, and those in modules which are not loaded are skipped.
+func (r *TerraformOrphanedStateResourcesRule) Check(runner *tflint.Runner) error {
+	if !runner.TFConfig.Path.IsRoot() {
+		log.Printf("[DEBUG] Skip `%s` rule for child modules", r.Name())
+		return nil
+	}
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	state := runner.State()
+	if state == nil {
+		log.Printf("[DEBUG] Skip `%s` rule because the state is not loaded", r.Name())
+		return nil
+	}
+
+	moved, _, err := runner.MovedBlocks()
+	if err != nil {
+		return err
+	}
+	movedFrom := []string{}
+	for _, block := range moved {
+		if block.From == nil {
+			continue
+		}
+		if from, err := tflint.RefactoringAddress(block.From); err == nil {
+			movedFrom = append(movedFrom, from)
+		}
+	}
+
+	root := runner.TFConfig
+	for _, resource := range state.Resources() {
+		address := resource.String()
+		if r.moved(address, movedFrom) {
+			log.Printf("[DEBUG] `%s` is moved by a moved block", address)
+			continue
+		}
+
+		location := hcl.Range{
+			Filename: filepath.Join(root.Module.SourceDir, "main.tf"),
+			Start:    hcl.InitialPos,
+			End:      hcl.InitialPos,
+		}
+		if len(resource.Module) > 0 {
+			call, exists := root.Module.ModuleCalls[resource.Module[0]]
+			if exists {
+				cfg := root.Descendent(resource.Module)
+				if cfg == nil {
+					log.Printf("[DEBUG] Skip `%s` because the module is not loaded", address)
+					continue
+				}
+				if _, exists := cfg.Module.ManagedResources[resource.Resource.String()]; exists {
+					continue
+				}
+				location = call.DeclRange
+			}
+		} else if _, exists := root.Module.ManagedResources[resource.Resource.String()]; exists {
+			continue
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`%s` is recorded in the state, but not found in the configuration. It will be destroyed on the next apply", address),
+			location,
+		)
+	}
+
+	return nil
+}
+
+// moved returns whether the address or its module is moved by `moved` blocks
+func (r *TerraformOrphanedStateResourcesRule) moved(address string, movedFrom []string) bool {
+	for _, from := range movedFrom {
+		if address == from || strings.HasPrefix(address, from+".") {
+			return true
+		}
+	}
+	return false
+}
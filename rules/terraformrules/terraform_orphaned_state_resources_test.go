@@ -0,0 +1,111 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformOrphanedStateResourcesRule(t *testing.T) {
+	state := states.BuildState(func(s *states.SyncState) {
+		for _, resource := range []struct {
+			Module addrs.ModuleInstance
+			Mode   addrs.ResourceMode
+			Type   string
+			Name   string
+			Key    addrs.InstanceKey
+		}{
+			{Module: addrs.RootModuleInstance, Mode: addrs.ManagedResourceMode, Type: "aws_instance", Name: "web", Key: addrs.IntKey(0)},
+			{Module: addrs.RootModuleInstance, Mode: addrs.ManagedResourceMode, Type: "aws_instance", Name: "web", Key: addrs.IntKey(1)},
+			{Module: addrs.RootModuleInstance, Mode: addrs.ManagedResourceMode, Type: "aws_instance", Name: "db", Key: addrs.NoKey},
+			{Module: addrs.RootModuleInstance, Mode: addrs.DataResourceMode, Type: "aws_ami", Name: "ubuntu", Key: addrs.NoKey},
+			{Module: addrs.RootModuleInstance.Child("network", addrs.NoKey), Mode: addrs.ManagedResourceMode, Type: "aws_vpc", Name: "main", Key: addrs.NoKey},
+		} {
+			s.SetResourceInstanceCurrent(
+				addrs.Resource{Mode: resource.Mode, Type: resource.Type, Name: resource.Name}.Instance(resource.Key).Absolute(resource.Module),
+				&states.ResourceInstanceObjectSrc{Status: states.ObjectReady, AttrsJSON: []byte(`{}`)},
+				addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+			)
+		}
+	})
+
+	cases := []struct {
+		Name     string
+		Content  string
+		State    *states.State
+		Expected tflint.Issues
+	}{
+		{
+			Name: "orphaned resources",
+			Content: `
+resource "aws_instance" "web" {
+  count = 2
+}`,
+			State: state,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformOrphanedStateResourcesRule(),
+					Message: "`aws_instance.db` is recorded in the state, but not found in the configuration. It will be destroyed on the next apply",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+				{
+					Rule:    NewTerraformOrphanedStateResourcesRule(),
+					Message: "`module.network.aws_vpc.main` is recorded in the state, but not found in the configuration. It will be destroyed on the next apply",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "moved resources",
+			Content: `
+resource "aws_instance" "web" {
+  count = 2
+}
+
+resource "aws_instance" "database" {}
+
+moved {
+  from = aws_instance.db
+  to   = aws_instance.database
+}
+
+moved {
+  from = module.network
+  to   = module.vpc
+}`,
+			State:    state,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "state is not loaded",
+			Content: `
+resource "aws_instance" "web" {
+  count = 2
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformOrphanedStateResourcesRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithState(t, map[string]string{"main.tf": tc.Content}, tflint.EmptyConfig(), tc.State)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
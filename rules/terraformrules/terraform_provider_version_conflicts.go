@@ -0,0 +1,186 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	version "github.com/hashicorp/go-version"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformProviderVersionConflictsRule checks whether version constraints of the same provider can be satisfied together
+type TerraformProviderVersionConflictsRule struct{}
+
+// providerVersionConstraint is a version constraint of a provider and where it is declared
+type providerVersionConstraint struct {
+	constraints version.Constraints
+	declRange   hcl.Range
+	root        bool
+}
+
+// NewTerraformProviderVersionConflictsRule returns a new rule
+func NewTerraformProviderVersionConflictsRule() *TerraformProviderVersionConflictsRule {
+	return &TerraformProviderVersionConflictsRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformProviderVersionConflictsRule) Name() string {
+	return "terraform_provider_version_conflicts"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformProviderVersionConflictsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformProviderVersionConflictsRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformProviderVersionConflictsRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformProviderVersionConflictsRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows version constraints of the same provider in the root module and child modules which cannot be satisfied together.",
+		Rationale:   "`terraform init` fails with \"no suitable version is available\" but does not tell which modules require the conflicting versions. It is hard to find them in a large configuration with many modules.",
+		Violating: `# modules/vpc requires aws "~> 3.0"
+terraform {
+  required_providers {
+    aws = "~> 2.0"
+  }
+}
+
+module "vpc" {
+  source = "./modules/vpc"
+}
+`,
+		Compliant: `# modules/vpc requires aws "~> 3.0"
+terraform {
+  required_providers {
+    aws = "~> 3.0"
+  }
+}
+
+module "vpc" {
+  source = "./modules/vpc"
+}
+`,
+		Fix: "Relax the constraints so that a version satisfies all of them, for example by upgrading the module which requires the old version.",
+	}
+}
+
+// Check checks whether constraints in `required_providers` and `version` of provider blocks conflict across the configuration
+// All modules share a provider version, so constraints of the root module and its child modules are collected in the root runner.
+// The issue is reported at the first declaration in the root module, and lists all declarations of the provider.
+func (r *TerraformProviderVersionConflictsRule) Check(runner *tflint.Runner) error {
+	if !runner.TFConfig.Path.IsRoot() {
+		log.Printf("[DEBUG] Skip `%s` rule for child modules", r.Name())
+		return nil
+	}
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	requirements := map[string][]*providerVersionConstraint{}
+	for _, cfg := range runner.TFConfig.AllModules() {
+		add := func(name string, constraint configs.VersionConstraint) {
+			if len(constraint.Required) == 0 {
+				return
+			}
+			requirements[name] = append(requirements[name], &providerVersionConstraint{
+				constraints: constraint.Required,
+				declRange:   constraint.DeclRange,
+				root:        cfg.Path.IsRoot(),
+			})
+		}
+
+		for name, reqs := range cfg.Module.ProviderRequirements {
+			for _, constraint := range reqs.VersionConstraints {
+				add(name, constraint)
+			}
+		}
+		for _, provider := range cfg.Module.ProviderConfigs {
+			add(provider.Name, provider.Version)
+		}
+	}
+
+	names := []string{}
+	for name := range requirements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		declarations := requirements[name]
+		if len(declarations) < 2 || satisfiable(declarations) {
+			continue
+		}
+
+		// Declarations in the root module come first so that the issue can be ignored with annotations in the root module
+		sort.SliceStable(declarations, func(i, j int) bool {
+			if declarations[i].root != declarations[j].root {
+				return declarations[i].root
+			}
+			if declarations[i].declRange.Filename != declarations[j].declRange.Filename {
+				return declarations[i].declRange.Filename < declarations[j].declRange.Filename
+			}
+			return declarations[i].declRange.Start.Line < declarations[j].declRange.Start.Line
+		})
+
+		sites := make([]string, len(declarations))
+		for i, declaration := range declarations {
+			sites[i] = fmt.Sprintf("`%s` (%s:%d)", declaration.constraints, declaration.declRange.Filename, declaration.declRange.Start.Line)
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("No version of `%s` provider satisfies all constraints: %s", name, strings.Join(sites, ", ")),
+			declarations[0].declRange,
+		)
+	}
+
+	return nil
+}
+
+// satisfiable returns whether a version satisfies all the constraints
+// Versions in constraints, their next patch versions and 0.0.0 are tried. If the constraints can be satisfied together,
+// the lowest satisfying version is one of them, because the lower bound is a version in a constraint, inclusive or exclusive,
+// or 0.0.0 if only upper bounds are declared, such as `< 3.0` and `< 2.0`.
+func satisfiable(declarations []*providerVersionConstraint) bool {
+	candidates := []*version.Version{version.Must(version.NewVersion("0.0.0"))}
+	for _, declaration := range declarations {
+		for _, constraint := range declaration.constraints {
+			v, err := version.NewVersion(strings.TrimLeft(constraint.String(), "=!<>~ "))
+			if err != nil {
+				log.Printf("[DEBUG] Failed to parse the version of `%s`: %s", constraint, err)
+				return true
+			}
+			segments := v.Segments()
+			next, err := version.NewVersion(fmt.Sprintf("%d.%d.%d", segments[0], segments[1], segments[2]+1))
+			if err != nil {
+				return true
+			}
+			candidates = append(candidates, v, next)
+		}
+	}
+
+	for _, candidate := range candidates {
+		satisfied := true
+		for _, declaration := range declarations {
+			if !declaration.constraints.Check(candidate) {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformProviderVersionConflictsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Module   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "compatible constraints",
+			Content: `
+terraform {
+  required_providers {
+    aws = ">= 2.0"
+  }
+}
+
+provider "aws" {
+  version = "> 2.0, != 2.1.0"
+}
+
+module "vpc" {
+  source = "./module"
+}`,
+			Module: `
+terraform {
+  required_providers {
+    aws = "~> 2.0"
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "upper bounds only",
+			Content: `
+terraform {
+  required_providers {
+    aws = "< 3.0"
+  }
+}
+
+module "vpc" {
+  source = "./module"
+}`,
+			Module: `
+provider "aws" {
+  version = "< 2.0"
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "conflict with a child module",
+			Content: `
+terraform {
+  required_providers {
+    aws = "~> 2.0"
+  }
+}
+
+module "vpc" {
+  source = "./module"
+}`,
+			Module: `
+provider "aws" {
+  version = ">= 3.0"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformProviderVersionConflictsRule(),
+					Message: "No version of `aws` provider satisfies all constraints: `~> 2.0` (main.tf:4), `>= 3.0` (module/main.tf:3)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "conflict in the root module",
+			Content: `
+terraform {
+  required_providers {
+    aws = ">= 2.0, < 2.5"
+  }
+}
+
+provider "aws" {
+  version = "~> 2.5"
+}
+
+module "vpc" {
+  source = "./module"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformProviderVersionConflictsRule(),
+					Message: "No version of `aws` provider satisfies all constraints: `>= 2.0, < 2.5` (main.tf:4), `~> 2.5` (main.tf:9)",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 5},
+						End:      hcl.Pos{Line: 4, Column: 26},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformProviderVersionConflictsRule()
+
+	for _, tc := range cases {
+		runner := testRunnerWithLocalModules(t, map[string]string{
+			"main.tf":        tc.Content,
+			"module/main.tf": tc.Module,
+		})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
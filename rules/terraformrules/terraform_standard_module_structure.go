@@ -0,0 +1,148 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+const (
+	filenameMain      = "main.tf"
+	filenameVariables = "variables.tf"
+	filenameOutputs   = "outputs.tf"
+	dirnameExamples   = "examples"
+)
+
+// TerraformStandardModuleStructureRule checks whether the module follows the standard module structure
+// See https://www.terraform.io/docs/modules/index.html#standard-module-structure
+type TerraformStandardModuleStructureRule struct{}
+
+// NewTerraformStandardModuleStructureRule returns a new rule
+func NewTerraformStandardModuleStructureRule() *TerraformStandardModuleStructureRule {
+	return &TerraformStandardModuleStructureRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformStandardModuleStructureRule) Name() string {
+	return "terraform_standard_module_structure"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformStandardModuleStructureRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformStandardModuleStructureRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformStandardModuleStructureRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformStandardModuleStructureRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Ensures that the module follows the standard module structure: `main.tf`, `variables.tf` and `outputs.tf` exist, variables are declared in `variables.tf`, and outputs are declared in `outputs.tf`.",
+		Rationale:   "Tooling such as the Terraform Registry and terraform-docs expects the standard module structure. It also makes it easy for users to find the interface of the module.",
+		Violating: `# main.tf
+variable "name" {}
+
+resource "aws_instance" "web" {
+  tags = {
+    Name = var.name
+  }
+}
+`,
+		Compliant: `# variables.tf
+variable "name" {}
+
+# main.tf
+resource "aws_instance" "web" {
+  tags = {
+    Name = var.name
+  }
+}
+
+# outputs.tf (may be empty)
+`,
+		Fix: "Move blocks into the files described above. Files may be empty if the module has no variables or outputs.",
+	}
+}
+
+// Check checks whether the module has the standard files and directories, and variables and outputs are declared in them
+// Only the root module is checked because child modules are not published on their own.
+func (r *TerraformStandardModuleStructureRule) Check(runner *tflint.Runner) error {
+	if !runner.TFConfig.Path.IsRoot() {
+		log.Printf("[DEBUG] Skip `%s` rule for child modules", r.Name())
+		return nil
+	}
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	dir := runner.TFConfig.Module.SourceDir
+
+	for _, filename := range []string{filenameMain, filenameVariables, filenameOutputs} {
+		if !r.exists(runner, filepath.Join(dir, filename)) && !r.exists(runner, filepath.Join(dir, filename+".json")) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("Module should include a %s file", filename),
+				r.fileRange(filepath.Join(dir, filename)),
+			)
+		}
+	}
+
+	if !r.exists(runner, filepath.Join(dir, dirnameExamples)) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("Module should include an %s/ directory with usage examples", dirnameExamples),
+			r.fileRange(filepath.Join(dir, dirnameExamples)),
+		)
+	}
+
+	for _, variable := range runner.TFConfig.Module.Variables {
+		if filename := filepath.Base(variable.DeclRange.Filename); !r.declaredIn(filename, filenameVariables) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` variable should be moved from %s to %s", variable.Name, filename, filenameVariables),
+				variable.DeclRange,
+			)
+		}
+	}
+
+	for _, output := range runner.TFConfig.Module.Outputs {
+		if filename := filepath.Base(output.DeclRange.Filename); !r.declaredIn(filename, filenameOutputs) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` output should be moved from %s to %s", output.Name, filename, filenameOutputs),
+				output.DeclRange,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (r *TerraformStandardModuleStructureRule) exists(runner *tflint.Runner, path string) bool {
+	exists, err := runner.FS().Exists(path)
+	if err != nil {
+		log.Printf("[WARN] Failed to check `%s`: %s", path, err)
+	}
+	return exists
+}
+
+func (r *TerraformStandardModuleStructureRule) declaredIn(filename, expected string) bool {
+	return filename == expected || filename == expected+".json"
+}
+
+func (r *TerraformStandardModuleStructureRule) fileRange(filename string) hcl.Range {
+	return hcl.Range{
+		Filename: filename,
+		Start:    hcl.InitialPos,
+		End:      hcl.InitialPos,
+	}
+}
@@ -0,0 +1,117 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformStandardModuleStructureRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  map[string]string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "standard structure",
+			Content: map[string]string{
+				"main.tf":                `resource "null_resource" "null" {}`,
+				"variables.tf":           `variable "v" {}`,
+				"outputs.tf":             `output "o" { value = null_resource.null.id }`,
+				"examples/basic/main.tf": `module "m" { source = "../.." }`,
+			},
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "missing files",
+			Content: map[string]string{
+				"resources.tf": `resource "null_resource" "null" {}`,
+			},
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformStandardModuleStructureRule(),
+					Message: "Module should include a main.tf file",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+				{
+					Rule:    NewTerraformStandardModuleStructureRule(),
+					Message: "Module should include a variables.tf file",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+				{
+					Rule:    NewTerraformStandardModuleStructureRule(),
+					Message: "Module should include a outputs.tf file",
+					Range: hcl.Range{
+						Filename: "outputs.tf",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+				{
+					Rule:    NewTerraformStandardModuleStructureRule(),
+					Message: "Module should include an examples/ directory with usage examples",
+					Range: hcl.Range{
+						Filename: "examples",
+						Start:    hcl.InitialPos,
+						End:      hcl.InitialPos,
+					},
+				},
+			},
+		},
+		{
+			Name: "misplaced declarations",
+			Content: map[string]string{
+				"main.tf": `
+variable "v" {}
+
+output "o" {
+  value = var.v
+}`,
+				"variables.tf.json": `{}`,
+				"outputs.tf":        ``,
+				"examples/main.tf":  ``,
+			},
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformStandardModuleStructureRule(),
+					Message: "`v` variable should be moved from main.tf to variables.tf",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 13},
+					},
+				},
+				{
+					Rule:    NewTerraformStandardModuleStructureRule(),
+					Message: "`o` output should be moved from main.tf to outputs.tf",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 1},
+						End:      hcl.Pos{Line: 4, Column: 11},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTerraformStandardModuleStructureRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, tc.Content)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
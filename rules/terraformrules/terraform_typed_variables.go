@@ -0,0 +1,74 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/configs"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// TerraformTypedVariablesRule checks whether variables have a type
+type TerraformTypedVariablesRule struct{}
+
+// NewTerraformTypedVariablesRule returns a new rule
+func NewTerraformTypedVariablesRule() *TerraformTypedVariablesRule {
+	return &TerraformTypedVariablesRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformTypedVariablesRule) Name() string {
+	return "terraform_typed_variables"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformTypedVariablesRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformTypedVariablesRule) Severity() string {
+	return tflint.NOTICE
+}
+
+// Link returns the rule reference link
+func (r *TerraformTypedVariablesRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformTypedVariablesRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `variable` declarations without `type`.",
+		Rationale:   "Without `type`, values of any type are accepted. Declaring the type documents the expected value, and Terraform reports a wrong value to callers of the module instead of failing in a resource. `type = any` is allowed because it is an explicit choice.",
+		Violating: `variable "name" {
+  default = "web"
+}
+`,
+		Compliant: `variable "name" {
+  type    = string
+  default = "web"
+}
+`,
+		Fix: "Add a `type` to the variable.",
+	}
+}
+
+// Check checks whether variables have a type
+func (r *TerraformTypedVariablesRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for _, variable := range runner.TFConfig.Module.Variables {
+		// Variables with `type = any` are also dynamic, but they are parsed as HCL because the type is declared explicitly
+		if variable.Type == cty.DynamicPseudoType && variable.ParsingMode == configs.VariableParseLiteral {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` variable has no type", variable.Name),
+				variable.DeclRange,
+			)
+		}
+	}
+
+	return nil
+}
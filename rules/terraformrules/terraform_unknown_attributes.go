@@ -0,0 +1,97 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TerraformUnknownAttributesRule checks whether resources only have arguments and blocks declared in provider schemas
+type TerraformUnknownAttributesRule struct{}
+
+// NewTerraformUnknownAttributesRule returns a new rule
+func NewTerraformUnknownAttributesRule() *TerraformUnknownAttributesRule {
+	return &TerraformUnknownAttributesRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformUnknownAttributesRule) Name() string {
+	return "terraform_unknown_attributes"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformUnknownAttributesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TerraformUnknownAttributesRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TerraformUnknownAttributesRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformUnknownAttributesRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows arguments and blocks which are not declared in provider schemas, and read-only attributes which cannot be set. It requires provider schemas set by `provider_schemas`.",
+		Rationale:   "Terraform rejects these arguments when running `terraform validate` or `terraform plan`. Unlike rules for specific resources, this rule works for any provider whose schemas are available.",
+		Violating: `resource "aws_instance" "web" {
+  instance_typ = "t3.micro"
+}
+`,
+		Compliant: `resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}
+`,
+		Fix: "Fix typos of argument names or remove the arguments. Refer to the documentation of the provider for available arguments.",
+	}
+}
+
+// Check checks whether resources and data sources have unknown arguments and blocks, or set read-only attributes
+// Schemas are read from `provider_schemas`, so nothing is reported without it.
+func (r *TerraformUnknownAttributesRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	return runner.WalkResourceSchemaBodies(func(resource *configs.Resource, content *hcl.BodyContent, schema *configschema.Block, diags hcl.Diagnostics) error {
+		for _, diag := range diags {
+			if diag.Subject == nil {
+				continue
+			}
+			if diag.Summary != "Unsupported argument" && diag.Summary != "Unsupported block type" {
+				continue
+			}
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s of `%s`: %s", diag.Summary, resource.Type, diag.Detail),
+				*diag.Subject,
+			)
+		}
+
+		names := []string{}
+		for name := range content.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			attr := schema.Attributes[name]
+			if attr.Computed && !attr.Optional && !attr.Required {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` is a read-only attribute of `%s`", name, resource.Type),
+					content.Attributes[name].NameRange,
+				)
+			}
+		}
+		return nil
+	})
+}
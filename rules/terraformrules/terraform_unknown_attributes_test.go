@@ -0,0 +1,259 @@
+package terraformrules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+const testProviderSchemas = `{
+  "format_version": "0.1",
+  "provider_schemas": {
+    "aws": {
+      "resource_schemas": {
+        "aws_instance": {
+          "block": {
+            "attributes": {
+              "ami": { "type": "string", "required": true },
+              "instance_type": { "type": "string", "optional": true },
+              "arn": { "type": "string", "computed": true },
+              "tags": { "type": ["map", "string"], "optional": true }
+            },
+            "block_types": {
+              "ebs_block_device": {
+                "nesting_mode": "set",
+                "block": {
+                  "attributes": {
+                    "device_name": { "type": "string", "required": true },
+                    "volume_size": { "type": "number", "optional": true }
+                  }
+                }
+              }
+            }
+          }
+        }
+      },
+      "data_source_schemas": {
+        "aws_ami": {
+          "block": {
+            "attributes": {
+              "most_recent": { "type": "bool", "optional": true }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+func Test_TerraformUnknownAttributesRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "known attributes",
+			Content: `
+resource "aws_instance" "web" {
+  count = 2
+
+  ami           = "ami-1234"
+  instance_type = "t2.micro"
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "unknown attribute",
+			Content: `
+resource "aws_instance" "web" {
+  ami          = "ami-1234"
+  instance_typ = "t2.micro"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "Unsupported argument of `aws_instance`: An argument named \"instance_typ\" is not expected here. Did you mean \"instance_type\"?",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 15},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown nested attribute",
+			Content: `
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    size        = 10
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "Unsupported argument of `aws_instance`: An argument named \"size\" is not expected here.",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 5},
+						End:      hcl.Pos{Line: 7, Column: 9},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown block",
+			Content: `
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+
+  root_device {}
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "Unsupported block type of `aws_instance`: Blocks of type \"root_device\" are not expected here.",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 5, Column: 3},
+						End:      hcl.Pos{Line: 5, Column: 14},
+					},
+				},
+			},
+		},
+		{
+			Name: "dynamic blocks",
+			Content: `
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+
+  dynamic "ebs_block_device" {
+    for_each = ["/dev/sdb"]
+    content {
+      device_name = ebs_block_device.value
+      size        = 10
+    }
+  }
+
+  dynamic "root_device" {
+    for_each = []
+    content {}
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "Unsupported block type of `aws_instance`: Blocks of type \"root_device\" are not expected here.",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 13, Column: 11},
+						End:      hcl.Pos{Line: 13, Column: 24},
+					},
+				},
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "Unsupported argument of `aws_instance`: An argument named \"size\" is not expected here.",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 9, Column: 7},
+						End:      hcl.Pos{Line: 9, Column: 11},
+					},
+				},
+			},
+		},
+		{
+			Name: "read-only attribute",
+			Content: `
+resource "aws_instance" "web" {
+  ami = "ami-1234"
+  arn = "arn:aws:ec2:us-east-1:123456789012:instance/i-1234"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "`arn` is a read-only attribute of `aws_instance`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 3},
+						End:      hcl.Pos{Line: 4, Column: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown data source attribute",
+			Content: `
+data "aws_ami" "ubuntu" {
+  most_recently = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformUnknownAttributesRule(),
+					Message: "Unsupported argument of `aws_ami`: An argument named \"most_recently\" is not expected here. Did you mean \"most_recent\"?",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 3},
+						End:      hcl.Pos{Line: 3, Column: 16},
+					},
+				},
+			},
+		},
+		{
+			Name: "resource not in schemas",
+			Content: `
+resource "google_compute_instance" "web" {
+  unknown = true
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformUnknownAttributesRule()
+
+	for _, tc := range cases {
+		runner := testRunnerWithProviderSchemas(t, tc.Content)
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func testRunnerWithProviderSchemas(t *testing.T, content string) *tflint.Runner {
+	dir, err := ioutil.TempDir("", "provider-schemas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "schemas.json")
+	if err := ioutil.WriteFile(path, []byte(testProviderSchemas), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := tflint.EmptyConfig()
+	config.ProviderSchemas = path
+	return tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": content}, config)
+}
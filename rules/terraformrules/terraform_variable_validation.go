@@ -0,0 +1,158 @@
+package terraformrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/lang"
+	"github.com/terraform-linters/tflint/tflint"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// TerraformVariableValidationRule checks validation blocks of variables
+type TerraformVariableValidationRule struct{}
+
+// NewTerraformVariableValidationRule returns a new rule
+func NewTerraformVariableValidationRule() *TerraformVariableValidationRule {
+	return &TerraformVariableValidationRule{}
+}
+
+// Name returns the rule name
+func (r *TerraformVariableValidationRule) Name() string {
+	return "terraform_variable_validation"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TerraformVariableValidationRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *TerraformVariableValidationRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TerraformVariableValidationRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TerraformVariableValidationRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Checks `validation` blocks of variables: conditions must be valid and the default value must satisfy them. Sensitive variables, which have `sensitive = true` or names matching `sensitive_pattern` in the config, must have validation.",
+		Rationale:   "Terraform evaluates conditions only when a value is passed to the variable, so an invalid condition is not reported until then. A default value that does not satisfy the validation makes the variable required in practice. Validations of sensitive variables report wrong values at plan time instead of failing in the middle of apply.",
+		Violating: `variable "region" {
+  type    = string
+  default = "eu-west-1"
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}
+`,
+		Compliant: `variable "region" {
+  type    = string
+  default = "us-east-1"
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}
+`,
+		Fix: "Fix the condition or the default value, and add a `validation` block to sensitive variables.",
+	}
+}
+
+// Check checks whether conditions of validations can be evaluated to a bool, default values satisfy the validations,
+// and sensitive variables have validations
+// Conditions are evaluated with the default value, or an unknown value of the type if the variable has no default.
+func (r *TerraformVariableValidationRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	sensitives, err := runner.SensitiveVariables()
+	if err != nil {
+		return err
+	}
+	sensitive := map[string]bool{}
+	for _, name := range sensitives {
+		sensitive[name] = true
+	}
+
+	variables := []*configs.Variable{}
+	for _, variable := range runner.TFConfig.Module.Variables {
+		variables = append(variables, variable)
+	}
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+
+	scope := &lang.Scope{BaseDir: runner.TFConfig.Module.SourceDir, PureOnly: true}
+
+	for _, variable := range variables {
+		if len(variable.Validations) == 0 {
+			if sensitive[variable.Name] {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` variable is sensitive but has no validation", variable.Name),
+					variable.DeclRange,
+				)
+			}
+			continue
+		}
+
+		val := cty.UnknownVal(variable.Type)
+		if variable.Default != cty.NilVal {
+			converted, err := convert.Convert(variable.Default, variable.Type)
+			if err != nil {
+				// Terraform reports default values of wrong types
+				continue
+			}
+			val = converted
+		}
+		ctx := &hcl.EvalContext{
+			Variables: map[string]cty.Value{
+				"var": cty.ObjectVal(map[string]cty.Value{variable.Name: val}),
+			},
+			Functions: scope.Functions(),
+		}
+
+		for _, validation := range variable.Validations {
+			result, diags := validation.Condition.Value(ctx)
+			if diags.HasErrors() {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The condition of `%s` variable is invalid: %s", variable.Name, diags[0].Detail),
+					validation.Condition.Range(),
+				)
+				continue
+			}
+
+			satisfied, err := convert.Convert(result, cty.Bool)
+			if err != nil {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The condition of `%s` variable must return a bool, but it returns %s", variable.Name, result.Type().FriendlyName()),
+					validation.Condition.Range(),
+				)
+				continue
+			}
+
+			if satisfied.IsKnown() && !satisfied.IsNull() && satisfied.False() {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The default value of `%s` variable does not satisfy the validation: %s", variable.Name, validation.ErrorMessage),
+					validation.Condition.Range(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
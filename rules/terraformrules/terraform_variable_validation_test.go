@@ -0,0 +1,159 @@
+package terraformrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TerraformVariableValidationRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "valid condition",
+			Content: `
+variable "region" {
+  type    = string
+  default = "us-east-1"
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "condition without default",
+			Content: `
+variable "region" {
+  type = string
+
+  validation {
+    condition     = length(var.region) > 0
+    error_message = "The region must not be empty."
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "invalid condition",
+			Content: `
+variable "region" {
+  type = string
+
+  validation {
+    condition     = unknown(var.region)
+    error_message = "The region must be supported."
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformVariableValidationRule(),
+					Message: "The condition of `region` variable is invalid: There is no function named \"unknown\".",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 6, Column: 21},
+						End:      hcl.Pos{Line: 6, Column: 40},
+					},
+				},
+			},
+		},
+		{
+			Name: "non-bool condition",
+			Content: `
+variable "region" {
+  type = string
+
+  validation {
+    condition     = [var.region]
+    error_message = "The region must be supported."
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformVariableValidationRule(),
+					Message: "The condition of `region` variable must return a bool, but it returns tuple",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 6, Column: 21},
+						End:      hcl.Pos{Line: 6, Column: 33},
+					},
+				},
+			},
+		},
+		{
+			Name: "default does not satisfy",
+			Content: `
+variable "region" {
+  type    = string
+  default = "eu-west-1"
+
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformVariableValidationRule(),
+					Message: "The default value of `region` variable does not satisfy the validation: The region must be supported.",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 7, Column: 21},
+						End:      hcl.Pos{Line: 7, Column: 69},
+					},
+				},
+			},
+		},
+		{
+			Name: "sensitive without validation",
+			Content: `
+variable "password" {
+  type      = string
+  sensitive = true
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTerraformVariableValidationRule(),
+					Message: "`password` variable is sensitive but has no validation",
+					Range: hcl.Range{
+						Filename: "variables.tf",
+						Start:    hcl.Pos{Line: 2, Column: 1},
+						End:      hcl.Pos{Line: 2, Column: 20},
+					},
+				},
+			},
+		},
+		{
+			Name: "sensitive with validation",
+			Content: `
+variable "password" {
+  type      = string
+  sensitive = true
+
+  validation {
+    condition     = length(var.password) >= 16
+    error_message = "The password must be at least 16 characters."
+  }
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTerraformVariableValidationRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"variables.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,131 @@
+package tlsrules
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/lang"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TLSPrivateKeyExposureRule checks whether private keys of tls_private_key are exposed without sensitive handling
+type TLSPrivateKeyExposureRule struct{}
+
+// NewTLSPrivateKeyExposureRule returns a new rule
+func NewTLSPrivateKeyExposureRule() *TLSPrivateKeyExposureRule {
+	return &TLSPrivateKeyExposureRule{}
+}
+
+// Name returns the rule name
+func (r *TLSPrivateKeyExposureRule) Name() string {
+	return "tls_private_key_exposure"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TLSPrivateKeyExposureRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TLSPrivateKeyExposureRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TLSPrivateKeyExposureRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TLSPrivateKeyExposureRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows exposing private keys of `tls_private_key` without sensitive handling, that is, in outputs without `sensitive = true` and in `content` of `local_file`.",
+		Rationale:   "Outputs are shown in plans, apply logs and CI logs unless they are sensitive. Private keys written by `content` of `local_file` are also shown in plans. Anyone who can read the logs can use the key.",
+		Violating: `resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+}
+
+output "private_key" {
+  value = tls_private_key.ssh.private_key_pem
+}
+`,
+		Compliant: `resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+}
+
+output "private_key" {
+  value     = tls_private_key.ssh.private_key_pem
+  sensitive = true
+}
+`,
+		Fix: "Set `sensitive = true` to outputs, and use `sensitive_content` instead of `content` in `local_file`. Note that the private key is still recorded in the state, so the state must be protected as well.",
+	}
+}
+
+// Check checks whether outputs without `sensitive = true` and `content` of local_file refer to private keys
+// Terraform shows these values in plans and outputs, though they are also recorded in the state anyway.
+func (r *TLSPrivateKeyExposureRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	outputs := []*configs.Output{}
+	for _, output := range runner.TFConfig.Module.Outputs {
+		outputs = append(outputs, output)
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].Name < outputs[j].Name
+	})
+
+	for _, output := range outputs {
+		if output.Sensitive {
+			continue
+		}
+		if key := r.privateKeyRef(output.Expr); key != "" {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` output exposes the private key of `%s`. Set `sensitive = true`", output.Name, key),
+				output.DeclRange,
+			)
+		}
+	}
+
+	return runner.WalkResourceAttributes("local_file", "content", func(attribute *hcl.Attribute) error {
+		if key := r.privateKeyRef(attribute.Expr); key != "" {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`content` exposes the private key of `%s`. Use `sensitive_content` instead", key),
+				attribute.Expr.Range(),
+			)
+		}
+		return nil
+	})
+}
+
+// privateKeyRef returns the address of tls_private_key whose private key is referred to by the expression
+// References to the whole resource also include the private key.
+func (r *TLSPrivateKeyExposureRule) privateKeyRef(expr hcl.Expression) string {
+	refs, _ := lang.ReferencesInExpr(expr)
+	for _, ref := range refs {
+		switch subject := ref.Subject.(type) {
+		case addrs.Resource:
+			if subject.Mode == addrs.ManagedResourceMode && subject.Type == "tls_private_key" {
+				return subject.String()
+			}
+		case addrs.ResourceInstance:
+			if subject.Resource.Mode != addrs.ManagedResourceMode || subject.Resource.Type != "tls_private_key" {
+				continue
+			}
+			if len(ref.Remaining) == 0 {
+				return subject.String()
+			}
+			if attr, ok := ref.Remaining[0].(hcl.TraverseAttr); ok && strings.HasPrefix(attr.Name, "private_key") {
+				return subject.String()
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,119 @@
+package tlsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TLSPrivateKeyExposureRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "output",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+}
+
+output "private_key" {
+  value = tls_private_key.ssh.private_key_pem
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTLSPrivateKeyExposureRule(),
+					Message: "`private_key` output exposes the private key of `tls_private_key.ssh`. Set `sensitive = true`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 6, Column: 1},
+						End:      hcl.Pos{Line: 6, Column: 21},
+					},
+				},
+			},
+		},
+		{
+			Name: "output of the whole resource",
+			Content: `
+resource "tls_private_key" "ssh" {
+  count     = 2
+  algorithm = "RSA"
+}
+
+output "keys" {
+  value = tls_private_key.ssh
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTLSPrivateKeyExposureRule(),
+					Message: "`keys` output exposes the private key of `tls_private_key.ssh`. Set `sensitive = true`",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 1},
+						End:      hcl.Pos{Line: 7, Column: 14},
+					},
+				},
+			},
+		},
+		{
+			Name: "sensitive output and public key",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+}
+
+output "private_key" {
+  value     = tls_private_key.ssh.private_key_pem
+  sensitive = true
+}
+
+output "public_key" {
+  value = tls_private_key.ssh.public_key_openssh
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "local_file",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+}
+
+resource "local_file" "private_key" {
+  content  = tls_private_key.ssh.private_key_pem
+  filename = "id_rsa"
+}
+
+resource "local_file" "public_key" {
+  content  = tls_private_key.ssh.public_key_openssh
+  filename = "id_rsa.pub"
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTLSPrivateKeyExposureRule(),
+					Message: "`content` exposes the private key of `tls_private_key.ssh`. Use `sensitive_content` instead",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 7, Column: 14},
+						End:      hcl.Pos{Line: 7, Column: 49},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTLSPrivateKeyExposureRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
@@ -0,0 +1,122 @@
+package tlsrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TLSPrivateKeyRSABitsRule checks whether RSA keys of tls_private_key are large enough
+type TLSPrivateKeyRSABitsRule struct{}
+
+// minRSABits is the minimum RSA key size recommended by NIST
+const minRSABits = 2048
+
+// NewTLSPrivateKeyRSABitsRule returns a new rule
+func NewTLSPrivateKeyRSABitsRule() *TLSPrivateKeyRSABitsRule {
+	return &TLSPrivateKeyRSABitsRule{}
+}
+
+// Name returns the rule name
+func (r *TLSPrivateKeyRSABitsRule) Name() string {
+	return "tls_private_key_rsa_bits"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TLSPrivateKeyRSABitsRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TLSPrivateKeyRSABitsRule) Severity() string {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *TLSPrivateKeyRSABitsRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TLSPrivateKeyRSABitsRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows RSA keys of `tls_private_key` smaller than 2048 bits.",
+		Rationale:   "NIST disallows RSA keys smaller than 2048 bits since 2013. Many clients and services reject them as well.",
+		Violating: `resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+  rsa_bits  = 1024
+}
+`,
+		Compliant: `resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+  rsa_bits  = 4096
+}
+`,
+		Fix: "Remove `rsa_bits` to use the default of 2048 bits, or set a larger size such as 4096.",
+	}
+}
+
+// Check checks whether `rsa_bits` of RSA keys is less than 2048
+// `rsa_bits` is ignored by other algorithms, and defaults to 2048.
+func (r *TLSPrivateKeyRSABitsRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	for _, resource := range runner.LookupResourcesByType("tls_private_key") {
+		body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{
+				{
+					Name: "algorithm",
+				},
+				{
+					Name: "rsa_bits",
+				},
+			},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		bits, exists := body.Attributes["rsa_bits"]
+		if !exists {
+			continue
+		}
+		rsa := true
+		if algorithm, exists := body.Attributes["algorithm"]; exists {
+			var name string
+			err := runner.EvaluateExpr(algorithm.Expr, &name)
+
+			// Keys of unknown algorithms are skipped
+			rsa = false
+			err = runner.EnsureNoError(err, func() error {
+				rsa = name == "RSA"
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if !rsa {
+			continue
+		}
+
+		var size int
+		err := runner.EvaluateExpr(bits.Expr, &size)
+		err = runner.EnsureNoError(err, func() error {
+			if size < minRSABits {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The RSA key size is %d bits. It should be at least %d bits", size, minRSABits),
+					bits.Expr.Range(),
+				)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package tlsrules
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TLSPrivateKeyRSABitsRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "small RSA key",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+  rsa_bits  = 1024
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTLSPrivateKeyRSABitsRule(),
+					Message: "The RSA key size is 1024 bits. It should be at least 2048 bits",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 4, Column: 15},
+						End:      hcl.Pos{Line: 4, Column: 19},
+					},
+				},
+			},
+		},
+		{
+			Name: "default size",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "large RSA key",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "RSA"
+  rsa_bits  = 4096
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "ECDSA key",
+			Content: `
+resource "tls_private_key" "ssh" {
+  algorithm = "ECDSA"
+  rsa_bits  = 1024
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "unknown algorithm",
+			Content: `
+variable "algorithm" {}
+
+resource "tls_private_key" "ssh" {
+  algorithm = var.algorithm
+  rsa_bits  = 1024
+}`,
+			Expected: tflint.Issues{},
+		},
+	}
+
+	rule := NewTLSPrivateKeyRSABitsRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
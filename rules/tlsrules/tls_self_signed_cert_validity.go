@@ -0,0 +1,92 @@
+package tlsrules
+
+import (
+	"fmt"
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+// TLSSelfSignedCertValidityRule checks whether self-signed certificates are valid for too long
+type TLSSelfSignedCertValidityRule struct{}
+
+type tlsSelfSignedCertValidityRuleConfig struct {
+	MaxValidityPeriodHours int `hcl:"max_validity_period_hours,optional"`
+}
+
+// NewTLSSelfSignedCertValidityRule returns a new rule
+func NewTLSSelfSignedCertValidityRule() *TLSSelfSignedCertValidityRule {
+	return &TLSSelfSignedCertValidityRule{}
+}
+
+// Name returns the rule name
+func (r *TLSSelfSignedCertValidityRule) Name() string {
+	return "tls_self_signed_cert_validity"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *TLSSelfSignedCertValidityRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *TLSSelfSignedCertValidityRule) Severity() string {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *TLSSelfSignedCertValidityRule) Link() string {
+	return tflint.ReferenceLink(r.Name())
+}
+
+// Doc returns the rule documentation
+func (r *TLSSelfSignedCertValidityRule) Doc() *tflint.RuleDoc {
+	return &tflint.RuleDoc{
+		Description: "Disallows `tls_self_signed_cert` resources whose `validity_period_hours` is longer than `max_validity_period_hours`.",
+		Rationale:   "Self-signed certificates cannot be revoked, so a leaked key is trusted until the certificate expires. Long validity periods also mean that nobody remembers how to rotate the certificate when it finally expires.",
+		Violating: `resource "tls_self_signed_cert" "ca" {
+  validity_period_hours = 87600
+}
+`,
+		Compliant: `resource "tls_self_signed_cert" "ca" {
+  validity_period_hours = 8760
+  early_renewal_hours   = 720
+}
+`,
+		Fix: "Shorten `validity_period_hours`, and set `early_renewal_hours` so that Terraform renews the certificate before it expires.",
+	}
+}
+
+// DefaultConfig returns the rule config with default values
+func (r *TLSSelfSignedCertValidityRule) DefaultConfig() interface{} {
+	return tlsSelfSignedCertValidityRuleConfig{
+		MaxValidityPeriodHours: 8760,
+	}
+}
+
+// Check checks whether `validity_period_hours` of tls_self_signed_cert resources is greater than the maximum
+func (r *TLSSelfSignedCertValidityRule) Check(runner *tflint.Runner) error {
+	log.Printf("[TRACE] Check `%s` rule for `%s` runner", r.Name(), runner.TFConfigPath())
+
+	config := r.DefaultConfig().(tlsSelfSignedCertValidityRuleConfig)
+	if err := runner.DecodeRuleConfig(r.Name(), &config); err != nil {
+		return err
+	}
+
+	return runner.WalkResourceAttributes("tls_self_signed_cert", "validity_period_hours", func(attribute *hcl.Attribute) error {
+		var hours int
+		err := runner.EvaluateExpr(attribute.Expr, &hours)
+
+		return runner.EnsureNoError(err, func() error {
+			if hours > config.MaxValidityPeriodHours {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("The certificate is valid for %d hours. It should be at most %d hours", hours, config.MaxValidityPeriodHours),
+					attribute.Expr.Range(),
+				)
+			}
+			return nil
+		})
+	})
+}
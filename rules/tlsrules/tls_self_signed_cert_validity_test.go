@@ -0,0 +1,102 @@
+package tlsrules
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint/tflint"
+)
+
+func Test_TLSSelfSignedCertValidityRule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Config   string
+		Expected tflint.Issues
+	}{
+		{
+			Name: "too long",
+			Content: `
+resource "tls_self_signed_cert" "ca" {
+  validity_period_hours = 87600
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTLSSelfSignedCertValidityRule(),
+					Message: "The certificate is valid for 87600 hours. It should be at most 8760 hours",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 27},
+						End:      hcl.Pos{Line: 3, Column: 32},
+					},
+				},
+			},
+		},
+		{
+			Name: "within a year",
+			Content: `
+resource "tls_self_signed_cert" "ca" {
+  validity_period_hours = 720
+}`,
+			Expected: tflint.Issues{},
+		},
+		{
+			Name: "max_validity_period_hours",
+			Content: `
+resource "tls_self_signed_cert" "ca" {
+  validity_period_hours = 720
+}`,
+			Config: `
+rule "tls_self_signed_cert_validity" {
+  enabled                   = true
+  max_validity_period_hours = 168
+}`,
+			Expected: tflint.Issues{
+				{
+					Rule:    NewTLSSelfSignedCertValidityRule(),
+					Message: "The certificate is valid for 720 hours. It should be at most 168 hours",
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 3, Column: 27},
+						End:      hcl.Pos{Line: 3, Column: 30},
+					},
+				},
+			},
+		},
+	}
+
+	rule := NewTLSSelfSignedCertValidityRule()
+
+	for _, tc := range cases {
+		runner := tflint.TestRunnerWithConfig(t, map[string]string{"main.tf": tc.Content}, loadConfigfromTempFile(t, tc.Config))
+
+		if err := rule.Check(runner); err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		tflint.AssertIssues(t, tc.Expected, runner.Issues)
+	}
+}
+
+func loadConfigfromTempFile(t *testing.T, content string) *tflint.Config {
+	if content == "" {
+		return tflint.EmptyConfig()
+	}
+
+	tmpfile, err := ioutil.TempFile("", "tls_self_signed_cert_validity")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	if _, err := tmpfile.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	config, err := tflint.LoadConfig(tmpfile.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
@@ -3,16 +3,24 @@ package tflint
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 )
 
-var annotationPattern = regexp.MustCompile(`tflint-ignore: (\S+)`)
+var annotationPattern = regexp.MustCompile(`tflint-ignore: (\S+)(.*)`)
 
 // Annotation represents comments with special meaning in TFLint
 type Annotation struct {
 	Content string
-	Token   hclsyntax.Token
+	// Reason is the text following the rule name, such as `tflint-ignore: rule_name # reason`
+	Reason string
+	Token  hclsyntax.Token
+}
+
+// reasonRequiredRule is a rule whose issues are only ignored by annotations with reasons
+type reasonRequiredRule interface {
+	IgnoreReasonRequired() bool
 }
 
 // Annotations is slice of Annotation
@@ -28,11 +36,13 @@ func NewAnnotations(tokens hclsyntax.Tokens) Annotations {
 		}
 
 		match := annotationPattern.FindStringSubmatch(string(token.Bytes))
-		if len(match) != 2 {
+		if len(match) != 3 {
 			continue
 		}
+		reason := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(match[2]), "*/"))
 		ret = append(ret, Annotation{
 			Content: match[1],
+			Reason:  strings.TrimSpace(strings.TrimLeft(reason, "#-:/")),
 			Token:   token,
 		})
 	}
@@ -46,6 +56,9 @@ func (a *Annotation) IsAffected(issue *Issue) bool {
 		return false
 	}
 	if a.Content == issue.Rule.Name() || a.Content == "all" {
+		if rule, ok := issue.Rule.(reasonRequiredRule); ok && rule.IgnoreReasonRequired() && a.Reason == "" {
+			return false
+		}
 		if a.Token.Range.Start.Line == issue.Range.Start.Line {
 			return true
 		}
@@ -57,6 +57,7 @@ func Test_NewAnnotations(t *testing.T) {
 		},
 		{
 			Content: "aws_instance_invalid_type",
+			Reason:  "This is also comment",
 			Token: hclsyntax.Token{
 				Type:  hclsyntax.TokenComment,
 				Bytes: []byte(fmt.Sprintf("# tflint-ignore: aws_instance_invalid_type This is also comment%s", newLine())),
@@ -183,3 +184,39 @@ func Test_IsAffected(t *testing.T) {
 		}
 	}
 }
+
+type reasonRequiredTestRule struct {
+	testRule
+}
+
+func (r *reasonRequiredTestRule) IgnoreReasonRequired() bool {
+	return true
+}
+
+func Test_IsAffected_reasonRequired(t *testing.T) {
+	issue := &Issue{
+		Rule:    &reasonRequiredTestRule{},
+		Message: "Test rule",
+		Range: hcl.Range{
+			Filename: "test.tf",
+			Start:    hcl.Pos{Line: 2},
+		},
+	}
+	token := hclsyntax.Token{
+		Type: hclsyntax.TokenComment,
+		Range: hcl.Range{
+			Filename: "test.tf",
+			Start:    hcl.Pos{Line: 2},
+		},
+	}
+
+	if (&Annotation{Content: "test_rule", Token: token}).IsAffected(issue) {
+		t.Fatal("Expected the annotation without a reason does not ignore the issue")
+	}
+	if (&Annotation{Content: "all", Token: token}).IsAffected(issue) {
+		t.Fatal("Expected the annotation without a reason does not ignore the issue")
+	}
+	if !(&Annotation{Content: "test_rule", Reason: "Wait for DNS propagation", Token: token}).IsAffected(issue) {
+		t.Fatal("Expected the annotation with a reason ignores the issue")
+	}
+}
@@ -0,0 +1,161 @@
+package tflint
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// archiveSuffixes are suffixes of archives which can be inspected
+var archiveSuffixes = []string{".zip", ".tar.gz", ".tgz"}
+
+const (
+	// maxArchiveFileSize is the maximum size of a file extracted from archives
+	maxArchiveFileSize = 10 * 1024 * 1024
+	// maxArchiveSize is the maximum total size of files extracted from an archive
+	maxArchiveSize = 100 * 1024 * 1024
+)
+
+// IsArchive returns whether the passed file name is an archive which can be inspected
+func IsArchive(filename string) bool {
+	for _, suffix := range archiveSuffixes {
+		if strings.HasSuffix(filename, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewArchiveFs extracts the passed archive into an in-memory filesystem
+// Only Terraform files are extracted, and the extracted size is capped so that archive bombs cannot exhaust memory.
+// It returns the filesystem and the directory of the root module in it. If all files are in a single top-level
+// directory, like archives created by `git archive --prefix` or GitHub releases, the directory is the root module.
+func NewArchiveFs(filename string) (afero.Fs, string, error) {
+	log.Printf("[INFO] Extract archive: %s", filename)
+
+	fs := afero.NewMemMapFs()
+	writer := &archiveWriter{fs: fs}
+	var err error
+	if strings.HasSuffix(filename, ".zip") {
+		err = extractZip(writer, filename)
+	} else {
+		err = extractTarGz(writer, filename)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to extract `%s`: %s", filename, err)
+	}
+
+	dir, err := archiveRootDir(fs)
+	if err != nil {
+		return nil, "", err
+	}
+	log.Printf("[DEBUG] Root module in the archive: %s", dir)
+	return fs, dir, nil
+}
+
+func extractZip(writer *archiveWriter, filename string) error {
+	reader, err := zip.OpenReader(filename)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !isTerraformFile(file.Name) {
+			continue
+		}
+		src, err := file.Open()
+		if err != nil {
+			return err
+		}
+		err = writer.write(file.Name, src)
+		src.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractTarGz(writer *archiveWriter, filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg || !isTerraformFile(header.Name) {
+			continue
+		}
+		if err := writer.write(header.Name, reader); err != nil {
+			return err
+		}
+	}
+}
+
+// archiveWriter writes files extracted from an archive while keeping track of the total size
+type archiveWriter struct {
+	fs      afero.Fs
+	written int64
+}
+
+func (w *archiveWriter) write(name string, src io.Reader) error {
+	name = path.Clean(strings.TrimPrefix(name, "/"))
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return fmt.Errorf("`%s` is outside the archive", name)
+	}
+
+	// Read one more byte than the limit to tell whether the file exceeds it
+	content, err := ioutil.ReadAll(io.LimitReader(src, maxArchiveFileSize+1))
+	if err != nil {
+		return err
+	}
+	if len(content) > maxArchiveFileSize {
+		return fmt.Errorf("`%s` exceeds the maximum file size of %d bytes", name, maxArchiveFileSize)
+	}
+	w.written += int64(len(content))
+	if w.written > maxArchiveSize {
+		return fmt.Errorf("Extracted files exceed the maximum size of %d bytes", maxArchiveSize)
+	}
+
+	if err := w.fs.MkdirAll(path.Dir(name), os.ModePerm); err != nil {
+		return err
+	}
+	return afero.WriteFile(w.fs, name, content, os.ModePerm)
+}
+
+// archiveRootDir returns the directory of the root module in the extracted archive
+func archiveRootDir(fs afero.Fs) (string, error) {
+	infos, err := afero.ReadDir(fs, ".")
+	if err != nil {
+		return "", err
+	}
+	if len(infos) == 1 && infos[0].IsDir() {
+		return infos[0].Name(), nil
+	}
+	return ".", nil
+}
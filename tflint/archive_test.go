@@ -0,0 +1,143 @@
+package tflint
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func Test_NewArchiveFs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	zipFile := filepath.Join(dir, "module.zip")
+	f, err := os.Create(zipFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for name, content := range map[string]string{"main.tf": "zip", "modules/vpc/main.tf": "vpc", "README.md": "readme"} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	tarFile := filepath.Join(dir, "module-1.0.0.tar.gz")
+	f, err = os.Create(tarFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{Name: "module-1.0.0/", Typeflag: tar.TypeDir, Mode: 0755}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: "module-1.0.0/main.tf", Typeflag: tar.TypeReg, Mode: 0644, Size: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte("tar")); err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	gw.Close()
+	f.Close()
+
+	largeFile := filepath.Join(dir, "large.zip")
+	f, err = os.Create(largeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw = zip.NewWriter(f)
+	w, err := zw.Create("main.tf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(make([]byte, maxArchiveFileSize+1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	cases := []struct {
+		Name    string
+		File    string
+		Dir     string
+		Files   map[string]string
+		Skipped []string
+		Error   string
+	}{
+		{
+			Name:    "zip",
+			File:    zipFile,
+			Dir:     ".",
+			Files:   map[string]string{"main.tf": "zip", "modules/vpc/main.tf": "vpc"},
+			Skipped: []string{"README.md"},
+		},
+		{
+			Name:  "tar.gz with top-level directory",
+			File:  tarFile,
+			Dir:   "module-1.0.0",
+			Files: map[string]string{"module-1.0.0/main.tf": "tar"},
+		},
+		{
+			Name:  "not found",
+			File:  filepath.Join(dir, "not_found.zip"),
+			Error: "Failed to extract `" + filepath.Join(dir, "not_found.zip") + "`: open " + filepath.Join(dir, "not_found.zip") + ": no such file or directory",
+		},
+		{
+			Name:  "too large",
+			File:  largeFile,
+			Error: "Failed to extract `" + largeFile + "`: `main.tf` exceeds the maximum file size of 10485760 bytes",
+		},
+	}
+
+	for _, tc := range cases {
+		fs, root, err := NewArchiveFs(tc.File)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if root != tc.Dir {
+			t.Fatalf("Failed `%s` test: expected root is `%s`, but got `%s`", tc.Name, tc.Dir, root)
+		}
+		for name, content := range tc.Files {
+			src, err := afero.ReadFile(fs, name)
+			if err != nil {
+				t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+			}
+			if string(src) != content {
+				t.Fatalf("Failed `%s` test: expected `%s` in `%s`, but got `%s`", tc.Name, content, name, src)
+			}
+		}
+		for _, name := range tc.Skipped {
+			if exists, _ := afero.Exists(fs, name); exists {
+				t.Fatalf("Failed `%s` test: expected `%s` is not extracted, but it exists", tc.Name, name)
+			}
+		}
+	}
+}
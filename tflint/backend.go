@@ -0,0 +1,558 @@
+package tflint
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	svchost "github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform/command/cliconfig"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/client"
+)
+
+// backendHTTPClient is the HTTP client of the `remote`, `consul` and `http` backends
+// Requests time out so that an unresponsive backend does not hang the inspection. They are also canceled with the inspection.
+var backendHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// defaultWorkspaceKeyPrefix is the prefix of state keys of non-default workspaces in the S3 backend
+var defaultWorkspaceKeyPrefix = "env:"
+
+// s3Backend is the configuration of the `s3` backend
+// See https://www.terraform.io/docs/backends/types/s3.html
+type s3Backend struct {
+	Bucket                string   `hcl:"bucket,optional"`
+	Key                   string   `hcl:"key,optional"`
+	Region                string   `hcl:"region,optional"`
+	WorkspaceKeyPrefix    string   `hcl:"workspace_key_prefix,optional"`
+	Profile               string   `hcl:"profile,optional"`
+	AccessKey             string   `hcl:"access_key,optional"`
+	SecretKey             string   `hcl:"secret_key,optional"`
+	SharedCredentialsFile string   `hcl:"shared_credentials_file,optional"`
+	RoleARN               string   `hcl:"role_arn,optional"`
+	Remain                hcl.Body `hcl:",remain"`
+}
+
+// loadBackendState reads the state of the workspace from the backend configured in the module
+// The `s3`, `remote`, `consul` and `http` backends are fetched remotely. If the module has another backend or the backend is configured
+// partially, e.g. with `-backend-config` of `terraform init`, the local state file is read instead. The local state file is also read
+// when the `consul` or `http` backend is unreachable, because they are often only reachable from inside private networks.
+func loadBackendState(ctx context.Context, fs afero.Afero, module *configs.Module, c *Config) (*states.State, error) {
+	workspace := getTFWorkspace(fs, module.SourceDir, c.Workspace)
+
+	s3Backend, err := decodeS3Backend(module.Backend)
+	if err != nil {
+		return nil, err
+	}
+	if s3Backend != nil {
+		svc, err := client.NewAwsClient(c.AwsCredentials.Merge(s3Backend.credentials()))
+		if err != nil {
+			return nil, err
+		}
+		return loadS3State(ctx, svc.S3, s3Backend, workspace)
+	}
+
+	remoteBackend, err := decodeRemoteBackend(module.Backend)
+	if err != nil {
+		return nil, err
+	}
+	if remoteBackend != nil {
+		token, err := remoteBackend.token()
+		if err != nil {
+			return nil, err
+		}
+		return loadRemoteState(ctx, "https://"+remoteBackend.Hostname, token, remoteBackend, workspace)
+	}
+
+	consulBackend, err := decodeConsulBackend(module.Backend)
+	if err != nil {
+		return nil, err
+	}
+	if consulBackend != nil {
+		return fallbackToLocalState(fs, module.SourceDir, workspace)(loadConsulState(ctx, consulBackend, workspace))
+	}
+
+	httpBackend, err := decodeHTTPBackend(module.Backend)
+	if err != nil {
+		return nil, err
+	}
+	if httpBackend != nil {
+		return fallbackToLocalState(fs, module.SourceDir, workspace)(loadHTTPState(ctx, httpBackend, workspace))
+	}
+
+	return loadTFState(fs, module.SourceDir, workspace)
+}
+
+// unreachableBackendError is an error of requests which did not reach the backend
+type unreachableBackendError struct {
+	address string
+	err     error
+}
+
+func (e *unreachableBackendError) Error() string {
+	return fmt.Sprintf("Failed to reach the backend `%s`: %s", e.address, e.err)
+}
+
+// fallbackToLocalState returns a function which reads the local state instead if the backend is unreachable
+func fallbackToLocalState(fs afero.Afero, dir string, workspace string) func(*states.State, error) (*states.State, error) {
+	return func(state *states.State, err error) (*states.State, error) {
+		if _, ok := err.(*unreachableBackendError); ok {
+			log.Printf("[WARN] %s. Read the local state instead", err)
+			return loadTFState(fs, dir, workspace)
+		}
+		return state, err
+	}
+}
+
+// getBackendState sends the request and reads the state in the response
+// It returns nil without an error if the state is not found, and unreachableBackendError if the request fails unless the context is canceled.
+func getBackendState(client *http.Client, req *http.Request) (*states.State, error) {
+	// URLs can contain credentials, so only the host and the path are printed
+	location := req.URL.Host + req.URL.Path
+	log.Printf("[INFO] Load state: %s", location)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// Canceled requests are not retried with the local state, because the inspection is stopping
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, &unreachableBackendError{address: req.URL.Host, err: err}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent:
+		log.Printf("[INFO] State is not found: %s", location)
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("Failed to get the state from %s: %s", location, resp.Status)
+	}
+
+	var body io.Reader = bufio.NewReader(resp.Body)
+	// The consul backend compresses large states with gzip
+	if magic, err := body.(*bufio.Reader).Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		body, err = gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := statefile.Read(body)
+	if err != nil {
+		if err == statefile.ErrNoState {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return file.State, nil
+}
+
+// decodeS3Backend returns the configuration of the `s3` backend
+// It returns nil if the backend is not `s3` or the bucket or key is not configured statically.
+func decodeS3Backend(backend *configs.Backend) (*s3Backend, error) {
+	if backend == nil || backend.Type != "s3" {
+		return nil, nil
+	}
+
+	ret := &s3Backend{}
+	diags := gohcl.DecodeBody(backend.Config, nil, ret)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if ret.Bucket == "" || ret.Key == "" {
+		log.Printf("[WARN] The S3 backend is configured partially. Read the local state instead")
+		return nil, nil
+	}
+	return ret, nil
+}
+
+// stateKey returns the object key of the state of the workspace
+// As with Terraform, states of non-default workspaces are stored under `<workspace_key_prefix>/<workspace>/`.
+func (b *s3Backend) stateKey(workspace string) string {
+	if workspace == "default" {
+		return b.Key
+	}
+	prefix := b.WorkspaceKeyPrefix
+	if prefix == "" {
+		prefix = defaultWorkspaceKeyPrefix
+	}
+	return fmt.Sprintf("%s/%s/%s", prefix, workspace, b.Key)
+}
+
+func (b *s3Backend) credentials() client.AwsCredentials {
+	return client.AwsCredentials{
+		AccessKey:     b.AccessKey,
+		SecretKey:     b.SecretKey,
+		Profile:       b.Profile,
+		CredsFile:     b.SharedCredentialsFile,
+		AssumeRoleARN: b.RoleARN,
+		Region:        b.Region,
+	}
+}
+
+// loadS3State downloads the state of the workspace from the bucket
+// If the object does not exist, it returns nil without an error as with local state files.
+func loadS3State(ctx context.Context, svc s3iface.S3API, backend *s3Backend, workspace string) (*states.State, error) {
+	key := backend.stateKey(workspace)
+	log.Printf("[INFO] Load state: s3://%s/%s", backend.Bucket, key)
+
+	out, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(backend.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			log.Printf("[INFO] State object is not found: s3://%s/%s", backend.Bucket, key)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Failed to download the state from s3://%s/%s: %s", backend.Bucket, key, err)
+	}
+	defer out.Body.Close()
+
+	file, err := statefile.Read(out.Body)
+	if err != nil {
+		if err == statefile.ErrNoState {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return file.State, nil
+}
+
+// defaultRemoteBackendHostname is the hostname of Terraform Cloud
+var defaultRemoteBackendHostname = "app.terraform.io"
+
+// remoteBackend is the configuration of the `remote` backend for Terraform Cloud and Terraform Enterprise
+// See https://www.terraform.io/docs/backends/types/remote.html
+type remoteBackend struct {
+	Hostname     string                    `hcl:"hostname,optional"`
+	Organization string                    `hcl:"organization,optional"`
+	Token        string                    `hcl:"token,optional"`
+	Workspaces   []*remoteBackendWorkspace `hcl:"workspaces,block"`
+	Remain       hcl.Body                  `hcl:",remain"`
+}
+
+type remoteBackendWorkspace struct {
+	Name   string `hcl:"name,optional"`
+	Prefix string `hcl:"prefix,optional"`
+}
+
+// decodeRemoteBackend returns the configuration of the `remote` backend
+// It returns nil if the backend is not `remote` or the organization or workspaces are not configured statically.
+func decodeRemoteBackend(backend *configs.Backend) (*remoteBackend, error) {
+	if backend == nil || backend.Type != "remote" {
+		return nil, nil
+	}
+
+	ret := &remoteBackend{}
+	diags := gohcl.DecodeBody(backend.Config, nil, ret)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if ret.Hostname == "" {
+		ret.Hostname = defaultRemoteBackendHostname
+	}
+	if ret.Organization == "" || len(ret.Workspaces) == 0 {
+		log.Printf("[WARN] The remote backend is configured partially. Read the local state instead")
+		return nil, nil
+	}
+	return ret, nil
+}
+
+// workspaceName returns the name of the workspace in Terraform Cloud
+// As with Terraform, the name is the local workspace name with the prefix when `prefix` is set.
+func (b *remoteBackend) workspaceName(workspace string) string {
+	if b.Workspaces[0].Name != "" {
+		return b.Workspaces[0].Name
+	}
+	return b.Workspaces[0].Prefix + workspace
+}
+
+// token returns the API token for the hostname
+// The token in the backend block takes precedence, followed by the TF_TOKEN_<hostname> environment variable
+// and credentials in the CLI config file, such as those saved by `terraform login`.
+func (b *remoteBackend) token() (string, error) {
+	if b.Token != "" {
+		return b.Token, nil
+	}
+
+	envName := "TF_TOKEN_" + strings.Replace(strings.Replace(b.Hostname, ".", "_", -1), "-", "__", -1)
+	if token := os.Getenv(envName); token != "" {
+		log.Printf("[INFO] %s environment variable found", envName)
+		return token, nil
+	}
+
+	cliConfig, diags := cliconfig.LoadConfig()
+	if diags.HasErrors() {
+		return "", diags.Err()
+	}
+	source, err := cliConfig.CredentialsSource(nil)
+	if err != nil {
+		return "", err
+	}
+	host, err := svchost.ForComparison(b.Hostname)
+	if err != nil {
+		return "", err
+	}
+	creds, err := source.ForHost(host)
+	if err != nil {
+		return "", err
+	}
+	if creds == nil {
+		return "", fmt.Errorf("No API token for %s is found. Please set %s environment variable or run `terraform login`", b.Hostname, envName)
+	}
+	return creds.Token(), nil
+}
+
+type remoteWorkspaceResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type remoteStateVersionResponse struct {
+	Data struct {
+		Attributes struct {
+			DownloadURL string `json:"hosted-state-download-url"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// loadRemoteState downloads the current state version of the workspace from Terraform Cloud or Terraform Enterprise
+// If the workspace has no state versions yet, it returns nil without an error as with local state files.
+func loadRemoteState(ctx context.Context, baseURL string, token string, backend *remoteBackend, workspace string) (*states.State, error) {
+	name := backend.workspaceName(workspace)
+	log.Printf("[INFO] Load state: %s/%s/%s", backend.Hostname, backend.Organization, name)
+
+	var ws remoteWorkspaceResponse
+	found, err := remoteAPIRequest(ctx, fmt.Sprintf("%s/api/v2/organizations/%s/workspaces/%s", baseURL, url.PathEscape(backend.Organization), url.PathEscape(name)), token, &ws)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the workspace `%s`: %s", name, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("The workspace `%s` is not found in `%s` organization", name, backend.Organization)
+	}
+
+	var version remoteStateVersionResponse
+	found, err = remoteAPIRequest(ctx, fmt.Sprintf("%s/api/v2/workspaces/%s/current-state-version", baseURL, url.PathEscape(ws.Data.ID)), token, &version)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the current state version of `%s`: %s", name, err)
+	}
+	if !found {
+		log.Printf("[INFO] State version is not found: %s", name)
+		return nil, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", version.Data.Attributes.DownloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := backendHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to download the state of `%s`: %s", name, resp.Status)
+	}
+
+	file, err := statefile.Read(resp.Body)
+	if err != nil {
+		if err == statefile.ErrNoState {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return file.State, nil
+}
+
+// remoteAPIRequest sends a GET request to the API and decodes the response
+// It returns false without an error if the resource is not found.
+func remoteAPIRequest(ctx context.Context, endpoint string, token string, out interface{}) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := backendHTTPClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.New(resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(body, out)
+}
+
+// consulWorkspaceKeySuffix is inserted between the path and the workspace name in keys of non-default workspaces
+var consulWorkspaceKeySuffix = "-env:"
+
+// consulBackend is the configuration of the `consul` backend
+// See https://www.terraform.io/docs/backends/types/consul.html
+type consulBackend struct {
+	Path        string   `hcl:"path,optional"`
+	Address     string   `hcl:"address,optional"`
+	Scheme      string   `hcl:"scheme,optional"`
+	Datacenter  string   `hcl:"datacenter,optional"`
+	AccessToken string   `hcl:"access_token,optional"`
+	Remain      hcl.Body `hcl:",remain"`
+}
+
+// decodeConsulBackend returns the configuration of the `consul` backend
+// As with Terraform, the address, the scheme and the token default to CONSUL_HTTP_ADDR, CONSUL_HTTP_SSL and CONSUL_HTTP_TOKEN
+// environment variables.
+// It returns nil if the backend is not `consul` or the path is not configured statically.
+func decodeConsulBackend(backend *configs.Backend) (*consulBackend, error) {
+	if backend == nil || backend.Type != "consul" {
+		return nil, nil
+	}
+
+	ret := &consulBackend{}
+	diags := gohcl.DecodeBody(backend.Config, nil, ret)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if ret.Path == "" {
+		log.Printf("[WARN] The consul backend is configured partially. Read the local state instead")
+		return nil, nil
+	}
+	if ret.Address == "" {
+		ret.Address = os.Getenv("CONSUL_HTTP_ADDR")
+	}
+	if ret.Address == "" {
+		ret.Address = "127.0.0.1:8500"
+	}
+	if ret.Scheme == "" {
+		ret.Scheme = "http"
+		if ssl, _ := strconv.ParseBool(os.Getenv("CONSUL_HTTP_SSL")); ssl {
+			ret.Scheme = "https"
+		}
+	}
+	if ret.AccessToken == "" {
+		ret.AccessToken = os.Getenv("CONSUL_HTTP_TOKEN")
+	}
+	return ret, nil
+}
+
+// stateKey returns the key of the state of the workspace in the KV store
+func (b *consulBackend) stateKey(workspace string) string {
+	if workspace == "default" {
+		return b.Path
+	}
+	return b.Path + consulWorkspaceKeySuffix + workspace
+}
+
+// loadConsulState reads the state of the workspace from the KV store of Consul
+func loadConsulState(ctx context.Context, backend *consulBackend, workspace string) (*states.State, error) {
+	address := backend.Address
+	if !strings.Contains(address, "://") {
+		address = backend.Scheme + "://" + address
+	}
+	endpoint, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.Path = "/v1/kv/" + strings.TrimPrefix(backend.stateKey(workspace), "/")
+	query := url.Values{"raw": []string{""}}
+	if backend.Datacenter != "" {
+		query.Set("dc", backend.Datacenter)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if backend.AccessToken != "" {
+		req.Header.Set("X-Consul-Token", backend.AccessToken)
+	}
+	return getBackendState(backendHTTPClient, req)
+}
+
+// httpBackend is the configuration of the `http` backend
+// See https://www.terraform.io/docs/backends/types/http.html
+type httpBackend struct {
+	Address              string   `hcl:"address,optional"`
+	Username             string   `hcl:"username,optional"`
+	Password             string   `hcl:"password,optional"`
+	SkipCertVerification bool     `hcl:"skip_cert_verification,optional"`
+	Remain               hcl.Body `hcl:",remain"`
+}
+
+// decodeHTTPBackend returns the configuration of the `http` backend
+// It returns nil if the backend is not `http` or the address is not configured statically.
+func decodeHTTPBackend(backend *configs.Backend) (*httpBackend, error) {
+	if backend == nil || backend.Type != "http" {
+		return nil, nil
+	}
+
+	ret := &httpBackend{}
+	diags := gohcl.DecodeBody(backend.Config, nil, ret)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if ret.Address == "" {
+		log.Printf("[WARN] The http backend is configured partially. Read the local state instead")
+		return nil, nil
+	}
+	return ret, nil
+}
+
+// loadHTTPState reads the state from the address of the `http` backend
+// The backend does not support workspaces, so the state is read only in the default workspace.
+func loadHTTPState(ctx context.Context, backend *httpBackend, workspace string) (*states.State, error) {
+	if workspace != "default" {
+		return nil, fmt.Errorf("The http backend does not support workspaces, but `%s` workspace is selected", workspace)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", backend.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if backend.Username != "" || backend.Password != "" {
+		req.SetBasicAuth(backend.Username, backend.Password)
+	}
+
+	client := backendHTTPClient
+	if backend.SkipCertVerification {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		client = &http.Client{Transport: transport, Timeout: backendHTTPClient.Timeout}
+	}
+	return getBackendState(client, req)
+}
@@ -0,0 +1,542 @@
+package tflint
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/client"
+)
+
+var testBackendState = `{
+  "version": 4,
+  "terraform_version": "0.12.24",
+  "serial": 1,
+  "lineage": "c4d0d6b8-5f2b-4a3f-9e4e-1c6a1c0f9e7a",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "main",
+      "provider": "provider.aws",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {"bucket": "main"}
+        }
+      ]
+    }
+  ]
+}`
+
+func Test_decodeS3Backend(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected *s3Backend
+	}{
+		{
+			Name: "s3 backend",
+			Content: `
+terraform {
+  backend "s3" {
+    bucket               = "tfstate"
+    key                  = "network/terraform.tfstate"
+    region               = "us-east-1"
+    workspace_key_prefix = "workspaces"
+    encrypt              = true
+  }
+}`,
+			Expected: &s3Backend{
+				Bucket:             "tfstate",
+				Key:                "network/terraform.tfstate",
+				Region:             "us-east-1",
+				WorkspaceKeyPrefix: "workspaces",
+			},
+		},
+		{
+			Name: "partial configuration",
+			Content: `
+terraform {
+  backend "s3" {
+    key = "network/terraform.tfstate"
+  }
+}`,
+			Expected: nil,
+		},
+		{
+			Name: "other backend",
+			Content: `
+terraform {
+  backend "local" {
+    path = "terraform.tfstate"
+  }
+}`,
+			Expected: nil,
+		},
+		{
+			Name:     "no backend",
+			Content:  `resource "null_resource" "foo" {}`,
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		runner := TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		got, err := decodeS3Backend(runner.TFConfig.Module.Backend)
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		opt := cmpopts.IgnoreFields(s3Backend{}, "Remain")
+		if !cmp.Equal(tc.Expected, got, opt) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, got, opt))
+		}
+	}
+}
+
+func Test_s3Backend_stateKey(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Backend   *s3Backend
+		Workspace string
+		Expected  string
+	}{
+		{
+			Name:      "default workspace",
+			Backend:   &s3Backend{Key: "network/terraform.tfstate"},
+			Workspace: "default",
+			Expected:  "network/terraform.tfstate",
+		},
+		{
+			Name:      "non-default workspace",
+			Backend:   &s3Backend{Key: "network/terraform.tfstate"},
+			Workspace: "staging",
+			Expected:  "env:/staging/network/terraform.tfstate",
+		},
+		{
+			Name:      "workspace key prefix",
+			Backend:   &s3Backend{Key: "network/terraform.tfstate", WorkspaceKeyPrefix: "workspaces"},
+			Workspace: "staging",
+			Expected:  "workspaces/staging/network/terraform.tfstate",
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.Backend.stateKey(tc.Workspace)
+		if got != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%s, got=%s", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func Test_loadS3State(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backend := &s3Backend{Bucket: "tfstate", Key: "terraform.tfstate"}
+
+	mock := client.NewMockS3API(ctrl)
+	mock.EXPECT().GetObjectWithContext(gomock.Any(), &s3.GetObjectInput{
+		Bucket: aws.String("tfstate"),
+		Key:    aws.String("env:/staging/terraform.tfstate"),
+	}).Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewBufferString(testBackendState))}, nil)
+
+	got, err := loadS3State(context.Background(), mock, backend, "staging")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got == nil || len(got.RootModule().Resources) != 1 {
+		t.Fatalf("Expected a state with 1 resource, but got %#v", got)
+	}
+
+	mock.EXPECT().GetObjectWithContext(gomock.Any(), &s3.GetObjectInput{
+		Bucket: aws.String("tfstate"),
+		Key:    aws.String("terraform.tfstate"),
+	}).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "The specified key does not exist.", nil))
+
+	got, err = loadS3State(context.Background(), mock, backend, "default")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got != nil {
+		t.Fatalf("Expected nil state, but got %#v", got)
+	}
+}
+
+func Test_decodeRemoteBackend(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected *remoteBackend
+	}{
+		{
+			Name: "workspace name",
+			Content: `
+terraform {
+  backend "remote" {
+    organization = "example"
+
+    workspaces {
+      name = "network"
+    }
+  }
+}`,
+			Expected: &remoteBackend{
+				Hostname:     "app.terraform.io",
+				Organization: "example",
+				Workspaces:   []*remoteBackendWorkspace{{Name: "network"}},
+			},
+		},
+		{
+			Name: "Terraform Enterprise",
+			Content: `
+terraform {
+  backend "remote" {
+    hostname     = "tfe.example.com"
+    organization = "example"
+
+    workspaces {
+      prefix = "network-"
+    }
+  }
+}`,
+			Expected: &remoteBackend{
+				Hostname:     "tfe.example.com",
+				Organization: "example",
+				Workspaces:   []*remoteBackendWorkspace{{Prefix: "network-"}},
+			},
+		},
+		{
+			Name: "partial configuration",
+			Content: `
+terraform {
+  backend "remote" {}
+}`,
+			Expected: nil,
+		},
+		{
+			Name: "other backend",
+			Content: `
+terraform {
+  backend "s3" {
+    bucket = "tfstate"
+    key    = "terraform.tfstate"
+  }
+}`,
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		runner := TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		got, err := decodeRemoteBackend(runner.TFConfig.Module.Backend)
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		opt := cmpopts.IgnoreFields(remoteBackend{}, "Remain")
+		if !cmp.Equal(tc.Expected, got, opt) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, got, opt))
+		}
+	}
+}
+
+func Test_remoteBackend_workspaceName(t *testing.T) {
+	named := &remoteBackend{Workspaces: []*remoteBackendWorkspace{{Name: "network"}}}
+	if got := named.workspaceName("default"); got != "network" {
+		t.Fatalf("Expected `network`, but got `%s`", got)
+	}
+
+	prefixed := &remoteBackend{Workspaces: []*remoteBackendWorkspace{{Prefix: "network-"}}}
+	if got := prefixed.workspaceName("staging"); got != "network-staging" {
+		t.Fatalf("Expected `network-staging`, but got `%s`", got)
+	}
+}
+
+func Test_remoteBackend_token(t *testing.T) {
+	backend := &remoteBackend{Hostname: "tfe.example-corp.com", Token: "config-token"}
+	token, err := backend.token()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if token != "config-token" {
+		t.Fatalf("Expected `config-token`, but got `%s`", token)
+	}
+
+	os.Setenv("TF_TOKEN_tfe_example__corp_com", "env-token")
+	defer os.Unsetenv("TF_TOKEN_tfe_example__corp_com")
+
+	backend.Token = ""
+	token, err = backend.token()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if token != "env-token" {
+		t.Fatalf("Expected `env-token`, but got `%s`", token)
+	}
+}
+
+func Test_loadRemoteState(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v2/organizations/example/workspaces/network-staging":
+			w.Write([]byte(`{"data": {"id": "ws-123"}}`))
+		case "/api/v2/organizations/example/workspaces/network-empty":
+			w.Write([]byte(`{"data": {"id": "ws-456"}}`))
+		case "/api/v2/workspaces/ws-123/current-state-version":
+			w.Write([]byte(fmt.Sprintf(`{"data": {"attributes": {"hosted-state-download-url": "%s/state/sv-123"}}}`, server.URL)))
+		case "/state/sv-123":
+			w.Write([]byte(testBackendState))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	backend := &remoteBackend{
+		Hostname:     "app.terraform.io",
+		Organization: "example",
+		Workspaces:   []*remoteBackendWorkspace{{Prefix: "network-"}},
+	}
+
+	got, err := loadRemoteState(context.Background(), server.URL, "secret", backend, "staging")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got == nil || len(got.RootModule().Resources) != 1 {
+		t.Fatalf("Expected a state with 1 resource, but got %#v", got)
+	}
+
+	got, err = loadRemoteState(context.Background(), server.URL, "secret", backend, "empty")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got != nil {
+		t.Fatalf("Expected nil state, but got %#v", got)
+	}
+
+	_, err = loadRemoteState(context.Background(), server.URL, "secret", backend, "unknown")
+	if err == nil || err.Error() != "The workspace `network-unknown` is not found in `example` organization" {
+		t.Fatalf("Expected a not found error, but got `%v`", err)
+	}
+
+	_, err = loadRemoteState(context.Background(), server.URL, "invalid", backend, "staging")
+	if err == nil || err.Error() != "Failed to get the workspace `network-staging`: 401 Unauthorized" {
+		t.Fatalf("Expected an unauthorized error, but got `%v`", err)
+	}
+}
+
+func Test_decodeConsulBackend(t *testing.T) {
+	runner := TestRunner(t, map[string]string{"main.tf": `
+terraform {
+  backend "consul" {
+    address = "consul.example.com:8500"
+    path    = "network/terraform.tfstate"
+  }
+}`})
+
+	os.Setenv("CONSUL_HTTP_TOKEN", "secret")
+	defer os.Unsetenv("CONSUL_HTTP_TOKEN")
+
+	got, err := decodeConsulBackend(runner.TFConfig.Module.Backend)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	expected := &consulBackend{
+		Path:        "network/terraform.tfstate",
+		Address:     "consul.example.com:8500",
+		Scheme:      "http",
+		AccessToken: "secret",
+	}
+	opt := cmpopts.IgnoreFields(consulBackend{}, "Remain")
+	if !cmp.Equal(expected, got, opt) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got, opt))
+	}
+
+	if got := got.stateKey("staging"); got != "network/terraform.tfstate-env:staging" {
+		t.Fatalf("Expected `network/terraform.tfstate-env:staging`, but got `%s`", got)
+	}
+}
+
+func Test_loadConsulState(t *testing.T) {
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	w.Write([]byte(testBackendState))
+	w.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Consul-Token") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if _, ok := r.URL.Query()["raw"]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/kv/network/terraform.tfstate":
+			w.Write([]byte(testBackendState))
+		case "/v1/kv/network/terraform.tfstate-env:staging":
+			w.Write(compressed.Bytes())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	backend := &consulBackend{Path: "network/terraform.tfstate", Address: server.URL, Scheme: "http", AccessToken: "secret"}
+
+	for _, workspace := range []string{"default", "staging"} {
+		got, err := loadConsulState(context.Background(), backend, workspace)
+		if err != nil {
+			t.Fatalf("Failed `%s` workspace: Unexpected error occurred: %s", workspace, err)
+		}
+		if got == nil || len(got.RootModule().Resources) != 1 {
+			t.Fatalf("Failed `%s` workspace: Expected a state with 1 resource, but got %#v", workspace, got)
+		}
+	}
+
+	got, err := loadConsulState(context.Background(), backend, "production")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got != nil {
+		t.Fatalf("Expected nil state, but got %#v", got)
+	}
+}
+
+func Test_loadHTTPState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(testBackendState))
+	}))
+	defer server.Close()
+
+	backend := &httpBackend{Address: server.URL + "/state/network", Username: "user", Password: "pass"}
+	got, err := loadHTTPState(context.Background(), backend, "default")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got == nil || len(got.RootModule().Resources) != 1 {
+		t.Fatalf("Expected a state with 1 resource, but got %#v", got)
+	}
+
+	backend.Password = "invalid"
+	_, err = loadHTTPState(context.Background(), backend, "default")
+	expected := fmt.Sprintf("Failed to get the state from %s/state/network: 401 Unauthorized", server.Listener.Addr())
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected `%s`, but got `%v`", expected, err)
+	}
+
+	_, err = loadHTTPState(context.Background(), backend, "staging")
+	if err == nil || err.Error() != "The http backend does not support workspaces, but `staging` workspace is selected" {
+		t.Fatalf("Expected a workspace error, but got `%v`", err)
+	}
+}
+
+func Test_loadBackendState_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	address := server.URL
+	server.Close()
+
+	runner := TestRunner(t, map[string]string{"main.tf": fmt.Sprintf(`
+terraform {
+  backend "http" {
+    address = "%s/state"
+  }
+}`, address)})
+
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	if err := fs.WriteFile("terraform.tfstate", []byte(testBackendState), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := loadBackendState(context.Background(), fs, runner.TFConfig.Module, EmptyConfig())
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if got == nil || len(got.RootModule().Resources) != 1 {
+		t.Fatalf("Expected the local state with 1 resource, but got %#v", got)
+	}
+}
+
+func Test_decodeConsulBackend_env(t *testing.T) {
+	runner := TestRunner(t, map[string]string{"main.tf": `
+terraform {
+  backend "consul" {
+    path = "network/terraform.tfstate"
+  }
+}`})
+
+	os.Setenv("CONSUL_HTTP_ADDR", "consul.example.com:8501")
+	os.Setenv("CONSUL_HTTP_SSL", "true")
+	os.Setenv("CONSUL_HTTP_TOKEN", "secret")
+	defer os.Unsetenv("CONSUL_HTTP_ADDR")
+	defer os.Unsetenv("CONSUL_HTTP_SSL")
+	defer os.Unsetenv("CONSUL_HTTP_TOKEN")
+
+	got, err := decodeConsulBackend(runner.TFConfig.Module.Backend)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	expected := &consulBackend{
+		Path:        "network/terraform.tfstate",
+		Address:     "consul.example.com:8501",
+		Scheme:      "https",
+		AccessToken: "secret",
+	}
+	opt := cmpopts.IgnoreFields(consulBackend{}, "Remain")
+	if !cmp.Equal(expected, got, opt) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got, opt))
+	}
+}
+
+func Test_loadHTTPState_timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	original := backendHTTPClient.Timeout
+	backendHTTPClient.Timeout = 10 * time.Millisecond
+	defer func() { backendHTTPClient.Timeout = original }()
+
+	_, err := loadHTTPState(context.Background(), &httpBackend{Address: server.URL}, "default")
+	if _, ok := err.(*unreachableBackendError); !ok {
+		t.Fatalf("Expected an unreachable error, but got `%v`", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = loadHTTPState(ctx, &httpBackend{Address: server.URL}, "default")
+	if err != context.Canceled {
+		t.Fatalf("Expected `%s`, but got `%v`", context.Canceled, err)
+	}
+}
@@ -0,0 +1,164 @@
+package tflint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform/configs"
+	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/client"
+)
+
+// CheckRule is a rule which can be run against runners directly, such as built-in rules
+type CheckRule interface {
+	Rule
+	Check(runner *Runner) error
+}
+
+// BatchRunner inspects many root modules with the shared config in one process
+// Parsed files, AWS clients for the same credentials and provider schemas are reused across root modules,
+// so modules shared by root modules are parsed only once. Root modules are inspected one by one in order.
+type BatchRunner struct {
+	config       *Config
+	rules        []CheckRule
+	parser       *configs.Parser
+	parseWorkers []*configs.Parser
+	cache        *runnerCache
+}
+
+// BatchResult is the result of inspecting a root module in a batch
+// If the root module cannot be loaded or a rule fails, Err is set and Issues are empty.
+type BatchResult struct {
+	Dir     string
+	Issues  Issues
+	Sources map[string][]byte
+	Err     error
+}
+
+// runnerCache holds resources shared by runners of different root modules
+type runnerCache struct {
+	awsClients map[client.AwsCredentials]*client.AwsClient
+	schemas    *ProviderSchemas
+}
+
+// NewBatchRunner returns a batch runner which inspects root modules with the passed config and rules
+// Directories of root modules are relative to the current directory, as with the CLI in recursive mode.
+func NewBatchRunner(c *Config, rules []CheckRule) *BatchRunner {
+	return &BatchRunner{
+		config: c,
+		rules:  rules,
+		cache:  &runnerCache{awsClients: map[client.AwsCredentials]*client.AwsClient{}},
+	}
+}
+
+// Run inspects the root modules and streams a result per root module in the order of the directories
+// The channel is closed after all root modules are inspected. A failure of a root module does not stop the others.
+func (b *BatchRunner) Run(dirs []string) <-chan *BatchResult {
+	results := make(chan *BatchResult)
+	go func() {
+		defer close(results)
+		for _, dir := range dirs {
+			results <- b.run(dir)
+		}
+	}()
+	return results
+}
+
+func (b *BatchRunner) run(dir string) *BatchResult {
+	log.Printf("[INFO] Inspect root module in a batch: %s", dir)
+	result := &BatchResult{Dir: dir, Issues: Issues{}, Sources: map[string][]byte{}}
+
+	loader, err := b.newLoader(dir)
+	if err != nil {
+		result.Err = NewContextError(fmt.Sprintf("Failed to prepare loading `%s`", dir), err)
+		return result
+	}
+	runners, err := b.setupRunners(loader, dir)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	// The parser is shared, so sources of other root modules are filtered out
+	dirs := map[string]bool{}
+	for _, runner := range runners {
+		dirs[filepath.Clean(runner.TFConfig.Module.SourceDir)] = true
+	}
+	for filename, src := range loader.Sources() {
+		if dirs[filepath.Dir(filename)] {
+			result.Sources[filename] = src
+		}
+	}
+
+	for _, rule := range b.rules {
+		for _, runner := range runners {
+			if err := rule.Check(runner); err != nil {
+				result.Err = NewContextError(fmt.Sprintf("Failed to check `%s` rule", rule.Name()), err)
+				return result
+			}
+		}
+	}
+	for _, runner := range runners {
+		result.Issues = append(result.Issues, runner.LookupIssues()...)
+	}
+	return result
+}
+
+// newLoader returns a loader of the root module which shares the parsers with other root modules
+// Broken files are hidden from the parsers of each loader when reporting syntax errors, so the parsers are not shared in that case.
+func (b *BatchRunner) newLoader(dir string) (*Loader, error) {
+	opts := []LoaderOption{WithModuleManifestDir(dir)}
+	if b.config.ModuleDownload {
+		opts = append(opts, WithModuleResolver(RemoteModuleResolver(context.Background(), afero.Afero{Fs: afero.NewOsFs()})))
+	}
+	loader, err := NewLoader(b.config, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if b.config.ReportSyntaxErrors {
+		return loader, nil
+	}
+
+	if b.parser == nil {
+		b.parser = loader.parser
+		b.parseWorkers = loader.parseWorkers
+	} else {
+		loader.parser = b.parser
+		loader.parseWorkers = b.parseWorkers
+	}
+	return loader, nil
+}
+
+func (b *BatchRunner) setupRunners(loader *Loader, dir string) ([]*Runner, error) {
+	cfg, err := loader.LoadConfig(dir)
+	if err != nil {
+		return nil, NewContextError("Failed to load configurations", err)
+	}
+	annotations, err := loader.LoadAnnotations(dir)
+	if err != nil {
+		return nil, NewContextError("Failed to load configuration tokens", err)
+	}
+	variables, err := loader.LoadValuesFiles(b.config.Varfiles...)
+	if err != nil {
+		return nil, NewContextError("Failed to load values files", err)
+	}
+	variables, err = ArrangeTFVariables(variables, b.config, cfg.Module.Variables)
+	if err != nil {
+		return nil, NewContextError("Failed to parse variables", err)
+	}
+
+	runner, err := newRunner(context.Background(), loader.FS(), b.config, annotations, cfg, b.cache, variables...)
+	if err != nil {
+		return nil, NewContextError("Failed to initialize a runner", err)
+	}
+	runner.Sources = loader.Sources()
+	runner.EmitSyntaxErrors(loader.SyntaxErrors())
+
+	runners, err := NewModuleRunners(runner)
+	if err != nil {
+		return nil, NewContextError("Failed to prepare rule checking", err)
+	}
+	return append(runners, runner), nil
+}
@@ -0,0 +1,144 @@
+package tflint
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+type batchTestRule struct {
+	testRule
+}
+
+func (r *batchTestRule) Check(runner *Runner) error {
+	for _, resource := range runner.LookupResourcesByType("aws_instance") {
+		runner.EmitIssue(r, "instance found", resource.DeclRange)
+	}
+	return nil
+}
+
+func Test_BatchRunner(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(currentDir)
+	if err := os.Chdir(filepath.Join(currentDir, "test-fixtures", "batch")); err != nil {
+		t.Fatal(err)
+	}
+
+	batch := NewBatchRunner(EmptyConfig(), []CheckRule{&batchTestRule{}})
+
+	type result struct {
+		Dir     string
+		Issues  []string
+		Sources []string
+		Err     bool
+	}
+	got := []result{}
+	for r := range batch.Run([]string{"prod", "broken", "staging"}) {
+		issues := []string{}
+		for _, issue := range r.Issues.Sort() {
+			issues = append(issues, issue.Range.String())
+		}
+		sources := []string{}
+		for filename := range r.Sources {
+			sources = append(sources, filepath.ToSlash(filename))
+		}
+		sort.Strings(sources)
+		got = append(got, result{Dir: r.Dir, Issues: issues, Sources: sources, Err: r.Err != nil})
+	}
+
+	expected := []result{
+		{
+			Dir:     "prod",
+			Issues:  []string{filepath.Join("prod", "main.tf") + ":1,1-30"},
+			Sources: []string{"prod/main.tf"},
+		},
+		{
+			Dir:     "broken",
+			Issues:  []string{},
+			Sources: []string{},
+			Err:     true,
+		},
+		{
+			Dir:     "staging",
+			Issues:  []string{filepath.Join("staging", "main.tf") + ":1,1-30", filepath.Join("staging", "main.tf") + ":5,1-29"},
+			Sources: []string{"staging/main.tf"},
+		},
+	}
+	if !cmp.Equal(expected, got) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got))
+	}
+
+	// The parsers are shared, so files of all root modules are cached in them
+	parsed := 0
+	for _, parser := range batch.parseWorkers {
+		parsed += len(parser.Sources())
+	}
+	if parsed != 3 {
+		t.Fatalf("Expected 3 files in the shared parsers, but got %d", parsed)
+	}
+}
+
+type batchValuesTestRule struct {
+	testRule
+}
+
+func (r *batchValuesTestRule) Check(runner *Runner) error {
+	for _, resource := range runner.LookupResourcesByType("aws_instance") {
+		body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{{Name: "instance_type"}, {Name: "tags"}},
+		})
+		if diags.HasErrors() {
+			return diags
+		}
+
+		var instanceType string
+		if err := runner.EvaluateExpr(body.Attributes["instance_type"].Expr, &instanceType); err != nil {
+			return err
+		}
+		var tags map[string]string
+		if err := runner.EvaluateExpr(body.Attributes["tags"].Expr, &tags); err != nil {
+			return err
+		}
+		runner.EmitIssue(r, instanceType+" in "+tags["Workspace"], resource.DeclRange)
+	}
+	return nil
+}
+
+func Test_BatchRunner_valuesPerDirectory(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(currentDir)
+	// terraform.tfvars in the current directory must not be used for the stacks
+	if err := os.Chdir(filepath.Join(currentDir, "test-fixtures", "stacks")); err != nil {
+		t.Fatal(err)
+	}
+
+	batch := NewBatchRunner(EmptyConfig(), []CheckRule{&batchValuesTestRule{}})
+
+	got := map[string][]string{}
+	for r := range batch.Run([]string{"a", "b"}) {
+		if r.Err != nil {
+			t.Fatalf("Unexpected error occurred in `%s`: %s", r.Dir, r.Err)
+		}
+		for _, issue := range r.Issues {
+			got[r.Dir] = append(got[r.Dir], issue.Message)
+		}
+	}
+
+	expected := map[string][]string{
+		"a": {"t2.micro in staging"},
+		"b": {"m5.large in production"},
+	}
+	if !cmp.Equal(expected, got) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got))
+	}
+}
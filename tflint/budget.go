@@ -0,0 +1,64 @@
+package tflint
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// HasBudgets returns whether issue budgets are configured
+// With budgets, issues within the budgets are tolerated, so teams can reduce existing issues gradually.
+func (c *Config) HasBudgets() bool {
+	return c.MaxIssuesPerFile > 0 || c.MaxIssuesPerModule > 0
+}
+
+// BudgetError is an aggregate error of files and modules whose issues exceed the budgets
+type BudgetError struct {
+	Exceeded []string
+}
+
+// Error returns the error message listing all exceeded budgets
+func (e *BudgetError) Error() string {
+	return strings.Join(e.Exceeded, "\n")
+}
+
+// CheckBudgets returns a BudgetError listing files and modules whose issues exceed the budgets
+// A module is the directory containing the file where the issue is reported.
+func (c *Config) CheckBudgets(issues Issues) error {
+	files := map[string]int{}
+	modules := map[string]int{}
+	for _, issue := range issues {
+		files[issue.Range.Filename]++
+		modules[filepath.Dir(issue.Range.Filename)]++
+	}
+
+	exceeded := []string{}
+	if c.MaxIssuesPerFile > 0 {
+		for _, filename := range sortedKeys(files) {
+			if count := files[filename]; count > c.MaxIssuesPerFile {
+				exceeded = append(exceeded, fmt.Sprintf("`%s` has %d issues, exceeding the budget of %d issues per file", filename, count, c.MaxIssuesPerFile))
+			}
+		}
+	}
+	if c.MaxIssuesPerModule > 0 {
+		for _, dir := range sortedKeys(modules) {
+			if count := modules[dir]; count > c.MaxIssuesPerModule {
+				exceeded = append(exceeded, fmt.Sprintf("`%s` module has %d issues, exceeding the budget of %d issues per module", dir, count, c.MaxIssuesPerModule))
+			}
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	return &BudgetError{Exceeded: exceeded}
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
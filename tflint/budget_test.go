@@ -0,0 +1,70 @@
+package tflint
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_CheckBudgets(t *testing.T) {
+	issues := Issues{
+		{Rule: &testRule{}, Range: hcl.Range{Filename: "main.tf"}},
+		{Rule: &testRule{}, Range: hcl.Range{Filename: "main.tf"}},
+		{Rule: &testRule{}, Range: hcl.Range{Filename: "variables.tf"}},
+		{Rule: &testRule{}, Range: hcl.Range{Filename: "modules/vpc/main.tf"}},
+	}
+
+	cases := []struct {
+		Name      string
+		PerFile   int
+		PerModule int
+		Expected  string
+	}{
+		{
+			Name:     "no budgets",
+			Expected: "",
+		},
+		{
+			Name:      "within budgets",
+			PerFile:   2,
+			PerModule: 3,
+			Expected:  "",
+		},
+		{
+			Name:     "file budget exceeded",
+			PerFile:  1,
+			Expected: "`main.tf` has 2 issues, exceeding the budget of 1 issues per file",
+		},
+		{
+			Name:      "module budget exceeded",
+			PerModule: 2,
+			Expected:  "`.` module has 3 issues, exceeding the budget of 2 issues per module",
+		},
+		{
+			Name:      "both budgets exceeded",
+			PerFile:   1,
+			PerModule: 1,
+			Expected:  "`main.tf` has 2 issues, exceeding the budget of 1 issues per file\n`.` module has 3 issues, exceeding the budget of 1 issues per module",
+		},
+	}
+
+	for _, tc := range cases {
+		cfg := EmptyConfig()
+		cfg.MaxIssuesPerFile = tc.PerFile
+		cfg.MaxIssuesPerModule = tc.PerModule
+
+		err := cfg.CheckBudgets(issues)
+		if tc.Expected == "" {
+			if err != nil {
+				t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected error is `%s`, but got %v", tc.Name, tc.Expected, err)
+		}
+		if _, ok := err.(*BudgetError); !ok {
+			t.Fatalf("Failed `%s` test: expected BudgetError, but got %T", tc.Name, err)
+		}
+	}
+}
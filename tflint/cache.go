@@ -0,0 +1,113 @@
+package tflint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// fileCacheVersion is the version of the format of cached entries
+// Entries of other versions are ignored, so bump it when changing the format or the way files are scanned.
+const fileCacheVersion = 1
+
+// FileCache stores results of scanning files on disk, keyed by SHA-256 of file contents
+// Entries never become stale because changing a file changes its key. Parsed configurations are not cached
+// because HCL bodies have no serialized form, so only annotations found by lexing files are stored.
+// Failures of reading and writing the cache are logged and treated as cache misses.
+type FileCache struct {
+	dir string
+}
+
+type fileCacheEntry struct {
+	Version     int         `json:"version"`
+	Annotations Annotations `json:"annotations"`
+}
+
+// NewFileCache returns a cache in the directory. The directory is created on the first write
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir}
+}
+
+// Annotations returns annotations of the file cached by its content
+// File names of tokens are replaced with the passed name because files with the same content share the entry.
+func (c *FileCache) Annotations(filename string, src []byte) (Annotations, bool) {
+	path, err := c.path("annotations", src)
+	if err != nil {
+		log.Printf("[WARN] Failed to read the cache of `%s`: %s", filename, err)
+		return nil, false
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[WARN] Failed to read the cache of `%s`: %s", filename, err)
+		}
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		log.Printf("[WARN] Failed to parse the cache of `%s`: %s", filename, err)
+		return nil, false
+	}
+	if entry.Version != fileCacheVersion {
+		return nil, false
+	}
+
+	ret := Annotations{}
+	for _, annotation := range entry.Annotations {
+		annotation.Token.Range.Filename = filename
+		ret = append(ret, annotation)
+	}
+	return ret, true
+}
+
+// SaveAnnotations stores annotations of the file keyed by its content
+// The entry is replaced by renaming a temporary file, so concurrent runs never read a partially written entry.
+func (c *FileCache) SaveAnnotations(filename string, src []byte, annotations Annotations) {
+	if err := c.write("annotations", src, &fileCacheEntry{Version: fileCacheVersion, Annotations: annotations}); err != nil {
+		log.Printf("[WARN] Failed to write the cache of `%s`: %s", filename, err)
+	}
+}
+
+func (c *FileCache) write(kind string, src []byte, entry *fileCacheEntry) error {
+	path, err := c.path(kind, src)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".entry")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (c *FileCache) path(kind string, src []byte) (string, error) {
+	dir, err := homedir.Expand(c.dir)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(src)
+	return filepath.Join(dir, kind, hex.EncodeToString(sum[:])+".json"), nil
+}
@@ -0,0 +1,68 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func Test_FileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := []byte("// tflint-ignore: aws_instance_invalid_type\n")
+	annotations := Annotations{
+		{
+			Content: "aws_instance_invalid_type",
+			Token: hclsyntax.Token{
+				Type:  hclsyntax.TokenComment,
+				Bytes: src,
+				Range: hcl.Range{
+					Filename: "main.tf",
+					Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+					End:      hcl.Pos{Line: 2, Column: 1, Byte: 44},
+				},
+			},
+		},
+	}
+
+	cache := NewFileCache(dir)
+	if _, ok := cache.Annotations("main.tf", src); ok {
+		t.Fatal("Expected a cache miss before saving, but got a hit")
+	}
+	cache.SaveAnnotations("main.tf", src, annotations)
+
+	// Files with the same content share the entry
+	ret, ok := cache.Annotations("copy.tf", src)
+	if !ok {
+		t.Fatal("Expected a cache hit after saving, but got a miss")
+	}
+	expected := Annotations{annotations[0]}
+	expected[0].Token.Range.Filename = "copy.tf"
+	if !cmp.Equal(expected, ret) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, ret))
+	}
+
+	if _, ok := cache.Annotations("main.tf", append(src, '\n')); ok {
+		t.Fatal("Expected a cache miss for the changed content, but got a hit")
+	}
+
+	// Entries of other versions are ignored
+	path, err := cache.path("annotations", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(`{"version": 0, "annotations": []}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Annotations("main.tf", src); ok {
+		t.Fatal("Expected a cache miss for the entry of another version, but got a hit")
+	}
+}
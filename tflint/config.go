@@ -5,6 +5,11 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
@@ -20,18 +25,54 @@ var fallbackConfigFile = "~/.tflint.hcl"
 type rawConfig struct {
 	Config *struct {
 		Module         *bool              `hcl:"module"`
+		ModuleDownload *bool              `hcl:"module_download"`
 		DeepCheck      *bool              `hcl:"deep_check"`
 		Force          *bool              `hcl:"force"`
 		AwsCredentials *map[string]string `hcl:"aws_credentials"`
 		IgnoreModule   *map[string]bool   `hcl:"ignore_module"`
 		Varfile        *[]string          `hcl:"varfile"`
 		Variables      *[]string          `hcl:"variables"`
+		// Cache credentials of assumed roles across runs
+		CacheAwsCredentials *bool `hcl:"cache_aws_credentials"`
+		// Plugin signature policy: "required" or "warn" (default)
+		PluginSignaturePolicy *string `hcl:"plugin_signature_policy"`
+		// Regexp of variable names whose values are redacted from outputs
+		SensitivePattern *string `hcl:"sensitive_pattern"`
+		// Timeout of the whole inspection, e.g. "5m"
+		Timeout *string `hcl:"timeout"`
+		// Path of a text/template file for the default output
+		Template *string `hcl:"template"`
+		// Name of the color theme of the default output
+		Theme *string `hcl:"theme"`
+		// Maximum numbers of issues tolerated in each file and module
+		MaxIssuesPerFile   *int `hcl:"max_issues_per_file"`
+		MaxIssuesPerModule *int `hcl:"max_issues_per_module"`
+		// Command which post-processes issues, e.g. ["python3", "triage.py"]
+		IssueHook *[]string `hcl:"issue_hook"`
+		// Skip rules for resource types not found in modules
+		Quick *bool `hcl:"quick"`
+		// Heap size in MiB after which optional caches are dropped
+		MemoryLimit *int `hcl:"memory_limit"`
+		// Files overriding datasets embedded in rules, keyed by dataset names
+		DataFiles *map[string]string `hcl:"data_files"`
+		// Report syntax errors as issues and inspect other files
+		ReportSyntaxErrors *bool `hcl:"report_syntax_errors"`
+		// Number of issues of a rule in a module after which they are escalated
+		EscalateAfter *int `hcl:"escalate_after"`
+		// Path of the output of `terraform providers schema -json`
+		ProviderSchemas *string `hcl:"provider_schemas"`
+		// Workspace overriding TF_WORKSPACE and `terraform workspace select`
+		Workspace *string `hcl:"workspace"`
+		// Directory of the cache of files keyed by content hashes
+		CacheDir *string `hcl:"cache_dir"`
 		// Removed options
 		TerraformVersion *string          `hcl:"terraform_version"`
 		IgnoreRule       *map[string]bool `hcl:"ignore_rule"`
 	} `hcl:"config,block"`
-	Rules   []RuleConfig   `hcl:"rule,block"`
-	Plugins []PluginConfig `hcl:"plugin,block"`
+	Rules    []RuleConfig    `hcl:"rule,block"`
+	Plugins  []PluginConfig  `hcl:"plugin,block"`
+	Themes   []ThemeConfig   `hcl:"theme,block"`
+	Accounts []AccountConfig `hcl:"account,block"`
 }
 
 // Config describes the behavior of TFLint
@@ -45,19 +86,125 @@ type Config struct {
 	Variables      []string
 	Rules          map[string]*RuleConfig
 	Plugins        map[string]*PluginConfig
+
+	PluginSignaturePolicy string
+	SensitivePattern      string
+	Timeout               time.Duration
+	// ModuleMode inspects the root module as a reusable module rather than a configuration to apply
+	ModuleMode bool
+	Template   string
+	Theme      string
+	Themes     map[string]*ThemeConfig
+	// MaxIssuesPerFile and MaxIssuesPerModule are issue budgets. Zero means no budget
+	MaxIssuesPerFile   int
+	MaxIssuesPerModule int
+	// Accounts are AWS accounts used in deep checking, keyed by provider aliases
+	Accounts map[string]*AccountConfig
+	// IssueHook is a command which receives issues as JSON and returns them after triage
+	IssueHook []string
+	// ModuleDownload downloads registry modules by itself instead of reading modules installed by `terraform init`
+	ModuleDownload bool
+	// Quick skips rules for resource types not found in each module without calling them
+	Quick bool
+	// MemoryLimit is a heap size in MiB after which optional caches are dropped. Zero means no limit
+	MemoryLimit int
+	// Excludes are gitignore-style patterns of files which are never loaded, in addition to patterns in `.tflintignore`
+	Excludes []string
+	// DataFiles are files overriding datasets embedded in rules, such as instance types and regions, keyed by dataset names
+	DataFiles map[string]string
+	// ReportSyntaxErrors reports syntax errors in files of the root module as issues instead of aborting the inspection
+	ReportSyntaxErrors bool
+	// EscalateAfter is a number of issues of a rule in a module after which an aggregate error is reported. Zero means never
+	EscalateAfter int
+	// ProviderSchemas is a path of the output of `terraform providers schema -json`, used by rules validating resources generically
+	ProviderSchemas string
+	// Workspace selects the state and the value of `terraform.workspace` instead of the detected workspace
+	Workspace string
+	// VariablePositions are numbers of values files preceding each variable on the command line. Nil means after all values files
+	VariablePositions []int
+	// CacheDir is a directory where results of scanning files are cached by their content hashes. Empty means no cache
+	CacheDir string
 }
 
 // RuleConfig is a TFLint's rule config
 type RuleConfig struct {
-	Name    string   `hcl:"name,label"`
-	Enabled bool     `hcl:"enabled"`
-	Body    hcl.Body `hcl:",remain"`
+	Name    string `hcl:"name,label"`
+	Enabled bool   `hcl:"enabled"`
+	// Timeout is a duration after which the rule is aborted, e.g. "30s"
+	Timeout string `hcl:"timeout,optional"`
+	// Only, OnlyResources and OnlyTags limit the scope of the rule by gitignore-style path patterns,
+	// glob patterns of resource addresses, and tag values of resources. Issues out of the scope are not reported
+	Only          []string          `hcl:"only,optional"`
+	OnlyResources []string          `hcl:"only_resources,optional"`
+	OnlyTags      map[string]string `hcl:"only_tags,optional"`
+	Body          hcl.Body          `hcl:",remain"`
 }
 
 // PluginConfig is a TFLint's plugin config
 type PluginConfig struct {
 	Name    string `hcl:"name,label"`
 	Enabled bool   `hcl:"enabled"`
+	Version string `hcl:"version,optional"`
+	Source  string `hcl:"source,optional"`
+	// SigningKey is an ASCII armored PGP public key used for verifying the plugin release
+	SigningKey string `hcl:"signing_key,optional"`
+}
+
+// ThemeConfig is a color theme of the default output
+// Each attribute is a list of color names, such as `["red", "bold"]`. Omitted attributes are the same as the default theme.
+type ThemeConfig struct {
+	Name      string   `hcl:"name,label"`
+	Error     []string `hcl:"error,optional"`
+	Warning   []string `hcl:"warning,optional"`
+	Notice    []string `hcl:"notice,optional"`
+	Message   []string `hcl:"message,optional"`
+	Highlight []string `hcl:"highlight,optional"`
+}
+
+// AccountConfig is an AWS account used in deep checking
+// The label is the alias of the provider whose resources are checked with the account. Also, the account is used
+// for all resources of the root module in the directories.
+type AccountConfig struct {
+	Name        string   `hcl:"name,label"`
+	Profile     string   `hcl:"profile,optional"`
+	CredsFile   string   `hcl:"shared_credentials_file,optional"`
+	RoleARN     string   `hcl:"role_arn,optional"`
+	ExternalID  string   `hcl:"external_id,optional"`
+	SessionName string   `hcl:"session_name,optional"`
+	Region      string   `hcl:"region,optional"`
+	Directories []string `hcl:"directories,optional"`
+}
+
+// Credentials returns credentials of the account
+func (a *AccountConfig) Credentials() client.AwsCredentials {
+	return client.AwsCredentials{
+		Profile:               a.Profile,
+		CredsFile:             a.CredsFile,
+		AssumeRoleARN:         a.RoleARN,
+		AssumeRoleExternalID:  a.ExternalID,
+		AssumeRoleSessionName: a.SessionName,
+		Region:                a.Region,
+	}
+}
+
+// DirectoryAccount returns the account used for the passed directory
+// If the directory is under multiple directories of accounts, the deepest one wins. It returns nil if no account is declared for the directory.
+func (c *Config) DirectoryAccount(dir string) *AccountConfig {
+	var ret *AccountConfig
+	matched := ""
+	for _, account := range c.Accounts {
+		for _, accountDir := range account.Directories {
+			rel, err := filepath.Rel(accountDir, dir)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				continue
+			}
+			if ret == nil || len(filepath.Clean(accountDir)) > len(matched) {
+				ret = account
+				matched = filepath.Clean(accountDir)
+			}
+		}
+	}
+	return ret
 }
 
 // EmptyConfig returns default config
@@ -73,6 +220,8 @@ func EmptyConfig() *Config {
 		Variables:      []string{},
 		Rules:          map[string]*RuleConfig{},
 		Plugins:        map[string]*PluginConfig{},
+		Themes:         map[string]*ThemeConfig{},
+		Accounts:       map[string]*AccountConfig{},
 	}
 }
 
@@ -123,6 +272,9 @@ func (c *Config) Merge(other *Config) *Config {
 	if other.Module {
 		ret.Module = true
 	}
+	if other.ModuleDownload {
+		ret.ModuleDownload = true
+	}
 	if other.DeepCheck {
 		ret.DeepCheck = true
 	}
@@ -132,12 +284,90 @@ func (c *Config) Merge(other *Config) *Config {
 
 	ret.AwsCredentials = ret.AwsCredentials.Merge(other.AwsCredentials)
 	ret.IgnoreModules = mergeBoolMap(ret.IgnoreModules, other.IgnoreModules)
+	positions := []int{}
+	for i := range ret.Variables {
+		positions = append(positions, ret.variablePosition(i))
+	}
+	for i := range other.Variables {
+		positions = append(positions, len(ret.Varfiles)+other.variablePosition(i))
+	}
 	ret.Varfiles = append(ret.Varfiles, other.Varfiles...)
 	ret.Variables = append(ret.Variables, other.Variables...)
+	ret.VariablePositions = nil
+	for _, pos := range positions {
+		if pos < len(ret.Varfiles) {
+			ret.VariablePositions = positions
+			break
+		}
+	}
 
 	ret.Rules = mergeRuleMap(ret.Rules, other.Rules)
 	ret.Plugins = mergePluginMap(ret.Plugins, other.Plugins)
 
+	if other.PluginSignaturePolicy != "" {
+		ret.PluginSignaturePolicy = other.PluginSignaturePolicy
+	}
+	if other.SensitivePattern != "" {
+		ret.SensitivePattern = other.SensitivePattern
+	}
+	if other.Timeout != 0 {
+		ret.Timeout = other.Timeout
+	}
+	if other.ModuleMode {
+		ret.ModuleMode = true
+	}
+	if other.Template != "" {
+		ret.Template = other.Template
+	}
+	if other.Theme != "" {
+		ret.Theme = other.Theme
+	}
+	ret.Themes = mergeThemeMap(ret.Themes, other.Themes)
+	if other.MaxIssuesPerFile != 0 {
+		ret.MaxIssuesPerFile = other.MaxIssuesPerFile
+	}
+	if other.MaxIssuesPerModule != 0 {
+		ret.MaxIssuesPerModule = other.MaxIssuesPerModule
+	}
+	ret.Accounts = mergeAccountMap(ret.Accounts, other.Accounts)
+	if len(other.IssueHook) > 0 {
+		ret.IssueHook = other.IssueHook
+	}
+	if other.Quick {
+		ret.Quick = true
+	}
+	if other.MemoryLimit != 0 {
+		ret.MemoryLimit = other.MemoryLimit
+	}
+	if len(other.Excludes) > 0 {
+		ret.Excludes = append(append([]string{}, ret.Excludes...), other.Excludes...)
+	}
+	if len(other.DataFiles) > 0 {
+		dataFiles := map[string]string{}
+		for k, v := range ret.DataFiles {
+			dataFiles[k] = v
+		}
+		for k, v := range other.DataFiles {
+			dataFiles[k] = v
+		}
+		ret.DataFiles = dataFiles
+	}
+	if other.ReportSyntaxErrors {
+		ret.ReportSyntaxErrors = true
+	}
+	if other.EscalateAfter != 0 {
+		ret.EscalateAfter = other.EscalateAfter
+	}
+	if other.ProviderSchemas != "" {
+		ret.ProviderSchemas = other.ProviderSchemas
+	}
+	if other.Workspace != "" {
+		ret.Workspace = other.Workspace
+	}
+	if other.CacheDir != "" {
+		ret.CacheDir = other.CacheDir
+	}
+
 	return ret
 }
 
@@ -153,6 +383,18 @@ func (c *Config) ToPluginConfig() *tfplugin.Config {
 	return cfg
 }
 
+// RuleTimeout returns the timeout of the passed rule
+// If the timeout is not set, it returns 0, which means no timeout.
+func (c *Config) RuleTimeout(name string) time.Duration {
+	rule, exists := c.Rules[name]
+	if !exists || rule.Timeout == "" {
+		return 0
+	}
+	// The duration is already validated when loading the config
+	timeout, _ := time.ParseDuration(rule.Timeout)
+	return timeout
+}
+
 // RuleSet is an interface to handle plugin's RuleSet and core RuleSet both
 // In the future, when all RuleSets are cut out into plugins, it will no longer be needed.
 type RuleSet interface {
@@ -192,6 +434,14 @@ func (c *Config) ValidateRules(rulesets ...RuleSet) error {
 	return nil
 }
 
+// variablePosition returns the number of values files preceding the variable at the index
+func (c *Config) variablePosition(i int) int {
+	if i < len(c.VariablePositions) {
+		return c.VariablePositions[i]
+	}
+	return len(c.Varfiles)
+}
+
 func (c *Config) copy() *Config {
 	ignoreModules := make(map[string]bool)
 	for k, v := range c.IgnoreModules {
@@ -204,6 +454,12 @@ func (c *Config) copy() *Config {
 	variables := make([]string, len(c.Variables))
 	copy(variables, c.Variables)
 
+	var variablePositions []int
+	if c.VariablePositions != nil {
+		variablePositions = make([]int, len(c.VariablePositions))
+		copy(variablePositions, c.VariablePositions)
+	}
+
 	rules := map[string]*RuleConfig{}
 	for k, v := range c.Rules {
 		rules[k] = &RuleConfig{}
@@ -216,8 +472,21 @@ func (c *Config) copy() *Config {
 		*plugins[k] = *v
 	}
 
+	themes := map[string]*ThemeConfig{}
+	for k, v := range c.Themes {
+		themes[k] = &ThemeConfig{}
+		*themes[k] = *v
+	}
+
+	accounts := map[string]*AccountConfig{}
+	for k, v := range c.Accounts {
+		accounts[k] = &AccountConfig{}
+		*accounts[k] = *v
+	}
+
 	return &Config{
 		Module:         c.Module,
+		ModuleDownload: c.ModuleDownload,
 		DeepCheck:      c.DeepCheck,
 		Force:          c.Force,
 		AwsCredentials: c.AwsCredentials,
@@ -226,6 +495,28 @@ func (c *Config) copy() *Config {
 		Variables:      variables,
 		Rules:          rules,
 		Plugins:        plugins,
+
+		PluginSignaturePolicy: c.PluginSignaturePolicy,
+		SensitivePattern:      c.SensitivePattern,
+		Timeout:               c.Timeout,
+		ModuleMode:            c.ModuleMode,
+		Template:              c.Template,
+		Theme:                 c.Theme,
+		Themes:                themes,
+		MaxIssuesPerFile:      c.MaxIssuesPerFile,
+		MaxIssuesPerModule:    c.MaxIssuesPerModule,
+		Accounts:              accounts,
+		IssueHook:             c.IssueHook,
+		Quick:                 c.Quick,
+		MemoryLimit:           c.MemoryLimit,
+		Excludes:              c.Excludes,
+		DataFiles:             c.DataFiles,
+		ReportSyntaxErrors:    c.ReportSyntaxErrors,
+		EscalateAfter:         c.EscalateAfter,
+		ProviderSchemas:       c.ProviderSchemas,
+		Workspace:             c.Workspace,
+		VariablePositions:     variablePositions,
+		CacheDir:              c.CacheDir,
 	}
 }
 
@@ -251,22 +542,107 @@ func loadConfigFromFile(file string) (*Config, error) {
 		if raw.Config.IgnoreRule != nil {
 			return nil, errors.New("`ignore_rule` was removed in v0.12.0. Please define `rule` block with `enabled = false` instead")
 		}
+
+		if policy := raw.Config.PluginSignaturePolicy; policy != nil && *policy != "required" && *policy != "warn" {
+			return nil, fmt.Errorf("`%s` is invalid plugin_signature_policy. Please specify \"required\" or \"warn\"", *policy)
+		}
+		if timeout := raw.Config.Timeout; timeout != nil {
+			if _, err := time.ParseDuration(*timeout); err != nil {
+				return nil, fmt.Errorf("`%s` is invalid timeout. Please specify a duration such as \"5m\"", *timeout)
+			}
+		}
+		if max := raw.Config.MaxIssuesPerFile; max != nil && *max < 0 {
+			return nil, fmt.Errorf("`%d` is invalid max_issues_per_file. Please specify a positive number", *max)
+		}
+		if max := raw.Config.MaxIssuesPerModule; max != nil && *max < 0 {
+			return nil, fmt.Errorf("`%d` is invalid max_issues_per_module. Please specify a positive number", *max)
+		}
+		if limit := raw.Config.MemoryLimit; limit != nil && *limit < 0 {
+			return nil, fmt.Errorf("`%d` is invalid memory_limit. Please specify a positive number", *limit)
+		}
+		if after := raw.Config.EscalateAfter; after != nil && *after < 0 {
+			return nil, fmt.Errorf("`%d` is invalid escalate_after. Please specify a positive number", *after)
+		}
+		if dataFiles := raw.Config.DataFiles; dataFiles != nil {
+			for name, path := range *dataFiles {
+				if err := validateDataFile(name, path); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if pattern := raw.Config.SensitivePattern; pattern != nil {
+			if _, err := regexp.Compile(*pattern); err != nil {
+				return nil, fmt.Errorf("`%s` is invalid sensitive_pattern: %s", *pattern, err)
+			}
+		}
+	}
+	for _, rule := range raw.Rules {
+		for _, pattern := range rule.OnlyResources {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("`%s` is invalid resource pattern of `%s` rule: %s", pattern, rule.Name, err)
+			}
+		}
+		if rule.Timeout == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(rule.Timeout); err != nil {
+			return nil, fmt.Errorf("`%s` is invalid timeout of `%s` rule. Please specify a duration such as \"30s\"", rule.Timeout, rule.Name)
+		}
 	}
 
 	cfg := raw.toConfig()
 	log.Printf("[DEBUG] Config loaded")
 	log.Printf("[DEBUG]   Module: %t", cfg.Module)
+	log.Printf("[DEBUG]   ModuleDownload: %t", cfg.ModuleDownload)
 	log.Printf("[DEBUG]   DeepCheck: %t", cfg.DeepCheck)
 	log.Printf("[DEBUG]   Force: %t", cfg.Force)
 	log.Printf("[DEBUG]   IgnoreModules: %#v", cfg.IgnoreModules)
 	log.Printf("[DEBUG]   Varfiles: %#v", cfg.Varfiles)
-	log.Printf("[DEBUG]   Variables: %#v", cfg.Variables)
+	log.Printf("[DEBUG]   Variables: %#v", RedactVariables(cfg.Variables))
+	log.Printf("[DEBUG]   VariablePositions: %#v", cfg.VariablePositions)
 	log.Printf("[DEBUG]   Rules: %#v", cfg.Rules)
 	log.Printf("[DEBUG]   Plugins: %#v", cfg.Plugins)
+	log.Printf("[DEBUG]   PluginSignaturePolicy: %s", cfg.PluginSignaturePolicy)
+	log.Printf("[DEBUG]   SensitivePattern: %s", cfg.SensitivePattern)
+	log.Printf("[DEBUG]   Timeout: %s", cfg.Timeout)
+	log.Printf("[DEBUG]   Template: %s", cfg.Template)
+	log.Printf("[DEBUG]   Theme: %s", cfg.Theme)
+	log.Printf("[DEBUG]   MaxIssuesPerFile: %d", cfg.MaxIssuesPerFile)
+	log.Printf("[DEBUG]   MaxIssuesPerModule: %d", cfg.MaxIssuesPerModule)
+	log.Printf("[DEBUG]   Accounts: %#v", cfg.Accounts)
+	log.Printf("[DEBUG]   IssueHook: %#v", cfg.IssueHook)
+	log.Printf("[DEBUG]   Quick: %t", cfg.Quick)
+	log.Printf("[DEBUG]   MemoryLimit: %d", cfg.MemoryLimit)
+	log.Printf("[DEBUG]   DataFiles: %#v", cfg.DataFiles)
+	log.Printf("[DEBUG]   ReportSyntaxErrors: %t", cfg.ReportSyntaxErrors)
+	log.Printf("[DEBUG]   EscalateAfter: %d", cfg.EscalateAfter)
+	log.Printf("[DEBUG]   ProviderSchemas: %s", cfg.ProviderSchemas)
+	log.Printf("[DEBUG]   Workspace: %s", cfg.Workspace)
+	log.Printf("[DEBUG]   CacheDir: %s", cfg.CacheDir)
 
 	return raw.toConfig(), nil
 }
 
+// validateDataFile checks the name of the dataset and the content of the file
+// Rules cannot return errors while being prepared, so invalid files are reported when loading the config.
+func validateDataFile(name string, path string) error {
+	var err error
+	switch name {
+	case DataInstanceTypes:
+		_, err = LoadInstanceTypesFile(path)
+	case DataRuntimes, DataRegions:
+		_, err = LoadDataList(path)
+	case DataServices:
+		_, err = LoadDataMap(path)
+	default:
+		return fmt.Errorf("`%s` is invalid dataset name of data_files. Please specify %s, %s, %s or %s", name, DataInstanceTypes, DataRuntimes, DataRegions, DataServices)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to load `%s` dataset: %s", name, err)
+	}
+	return nil
+}
+
 func mergeBoolMap(a, b map[string]bool) map[string]bool {
 	ret := map[string]bool{}
 	for k, v := range a {
@@ -300,6 +676,28 @@ func mergePluginMap(a, b map[string]*PluginConfig) map[string]*PluginConfig {
 	return ret
 }
 
+func mergeThemeMap(a, b map[string]*ThemeConfig) map[string]*ThemeConfig {
+	ret := map[string]*ThemeConfig{}
+	for k, v := range a {
+		ret[k] = v
+	}
+	for k, v := range b {
+		ret[k] = v
+	}
+	return ret
+}
+
+func mergeAccountMap(a, b map[string]*AccountConfig) map[string]*AccountConfig {
+	ret := map[string]*AccountConfig{}
+	for k, v := range a {
+		ret[k] = v
+	}
+	for k, v := range b {
+		ret[k] = v
+	}
+	return ret
+}
+
 func (raw *rawConfig) toConfig() *Config {
 	ret := EmptyConfig()
 	rc := raw.Config
@@ -308,6 +706,9 @@ func (raw *rawConfig) toConfig() *Config {
 		if rc.Module != nil {
 			ret.Module = *rc.Module
 		}
+		if rc.ModuleDownload != nil {
+			ret.ModuleDownload = *rc.ModuleDownload
+		}
 		if rc.DeepCheck != nil {
 			ret.DeepCheck = *rc.DeepCheck
 		}
@@ -321,6 +722,11 @@ func (raw *rawConfig) toConfig() *Config {
 			ret.AwsCredentials.Profile = credentials["profile"]
 			ret.AwsCredentials.CredsFile = credentials["shared_credentials_file"]
 			ret.AwsCredentials.Region = credentials["region"]
+			ret.AwsCredentials.CABundle = credentials["ca_bundle"]
+			ret.AwsCredentials.Partition = credentials["partition"]
+		}
+		if rc.CacheAwsCredentials != nil {
+			ret.AwsCredentials.CacheCredentials = *rc.CacheAwsCredentials
 		}
 		if rc.IgnoreModule != nil {
 			ret.IgnoreModules = *rc.IgnoreModule
@@ -331,6 +737,55 @@ func (raw *rawConfig) toConfig() *Config {
 		if rc.Variables != nil {
 			ret.Variables = *rc.Variables
 		}
+		if rc.PluginSignaturePolicy != nil {
+			ret.PluginSignaturePolicy = *rc.PluginSignaturePolicy
+		}
+		if rc.SensitivePattern != nil {
+			ret.SensitivePattern = *rc.SensitivePattern
+		}
+		if rc.Timeout != nil {
+			// The duration is already validated in loadConfigFromFile
+			ret.Timeout, _ = time.ParseDuration(*rc.Timeout)
+		}
+		if rc.Template != nil {
+			ret.Template = *rc.Template
+		}
+		if rc.Theme != nil {
+			ret.Theme = *rc.Theme
+		}
+		if rc.MaxIssuesPerFile != nil {
+			ret.MaxIssuesPerFile = *rc.MaxIssuesPerFile
+		}
+		if rc.MaxIssuesPerModule != nil {
+			ret.MaxIssuesPerModule = *rc.MaxIssuesPerModule
+		}
+		if rc.IssueHook != nil {
+			ret.IssueHook = *rc.IssueHook
+		}
+		if rc.Quick != nil {
+			ret.Quick = *rc.Quick
+		}
+		if rc.MemoryLimit != nil {
+			ret.MemoryLimit = *rc.MemoryLimit
+		}
+		if rc.DataFiles != nil {
+			ret.DataFiles = *rc.DataFiles
+		}
+		if rc.ReportSyntaxErrors != nil {
+			ret.ReportSyntaxErrors = *rc.ReportSyntaxErrors
+		}
+		if rc.EscalateAfter != nil {
+			ret.EscalateAfter = *rc.EscalateAfter
+		}
+		if rc.ProviderSchemas != nil {
+			ret.ProviderSchemas = *rc.ProviderSchemas
+		}
+		if rc.Workspace != nil {
+			ret.Workspace = *rc.Workspace
+		}
+		if rc.CacheDir != nil {
+			ret.CacheDir = *rc.CacheDir
+		}
 	}
 
 	for _, r := range raw.Rules {
@@ -343,5 +798,15 @@ func (raw *rawConfig) toConfig() *Config {
 		ret.Plugins[plugin.Name] = &plugin
 	}
 
+	for _, t := range raw.Themes {
+		var theme = t
+		ret.Themes[theme.Name] = &theme
+	}
+
+	for _, a := range raw.Accounts {
+		var account = a
+		ret.Accounts[account.Name] = &account
+	}
+
 	return ret
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -38,6 +39,10 @@ func Test_LoadConfig(t *testing.T) {
 					Region:    "us-east-1",
 					Profile:   "production",
 					CredsFile: "~/.aws/myapp",
+					CABundle:  "~/.aws/ca.pem",
+					Partition: "aws",
+
+					CacheCredentials: true,
 				},
 				IgnoreModules: map[string]bool{
 					"github.com/terraform-linters/example-module": true,
@@ -50,8 +55,12 @@ func Test_LoadConfig(t *testing.T) {
 						Enabled: false,
 					},
 					"aws_instance_previous_type": {
-						Name:    "aws_instance_previous_type",
-						Enabled: false,
+						Name:          "aws_instance_previous_type",
+						Enabled:       false,
+						Timeout:       "30s",
+						Only:          []string{"envs/prod/**"},
+						OnlyResources: []string{"aws_instance.prod_*"},
+						OnlyTags:      map[string]string{"env": "prod"},
 					},
 				},
 				Plugins: map[string]*PluginConfig{
@@ -62,8 +71,45 @@ func Test_LoadConfig(t *testing.T) {
 					"bar": {
 						Name:    "bar",
 						Enabled: false,
+						Version: "0.1.0",
+						Source:  "github.com/foo/tflint-ruleset-bar",
+					},
+				},
+				PluginSignaturePolicy: "required",
+				SensitivePattern:      "password|token",
+				Timeout:               5 * time.Minute,
+				Template:              "tflint.tmpl",
+				Theme:                 "custom",
+				Themes: map[string]*ThemeConfig{
+					"custom": {
+						Name:    "custom",
+						Error:   []string{"hi-red", "bold"},
+						Warning: []string{"yellow"},
+					},
+				},
+				Accounts: map[string]*AccountConfig{
+					"production": {
+						Name:        "production",
+						Profile:     "production",
+						RoleARN:     "arn:aws:iam::123456789012:role/tflint",
+						Region:      "us-west-2",
+						Directories: []string{"environments/production"},
 					},
 				},
+				MaxIssuesPerFile:   20,
+				MaxIssuesPerModule: 50,
+				IssueHook:          []string{"python3", "triage.py"},
+				ModuleDownload:     true,
+				Quick:              true,
+				MemoryLimit:        2048,
+				ReportSyntaxErrors: true,
+				EscalateAfter:      30,
+				ProviderSchemas:    "schemas.json",
+				Workspace:          "staging",
+				CacheDir:           ".tflint.cache",
+				DataFiles: map[string]string{
+					"regions": "test-fixtures/config/regions.json",
+				},
 			},
 		},
 		{
@@ -89,6 +135,8 @@ func Test_LoadConfig(t *testing.T) {
 				Variables:     []string{},
 				Rules:         map[string]*RuleConfig{},
 				Plugins:       map[string]*PluginConfig{},
+				Themes:        map[string]*ThemeConfig{},
+				Accounts:      map[string]*AccountConfig{},
 			},
 		},
 		{
@@ -167,6 +215,41 @@ func Test_LoadConfig_error(t *testing.T) {
 			File:     filepath.Join(currentDir, "test-fixtures", "config", "ignore_rule.hcl"),
 			Expected: "`ignore_rule` was removed in v0.12.0. Please define `rule` block with `enabled = false` instead",
 		},
+		{
+			Name:     "plugin_signature_policy",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "plugin_signature_policy.hcl"),
+			Expected: "`none` is invalid plugin_signature_policy. Please specify \"required\" or \"warn\"",
+		},
+		{
+			Name:     "timeout",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "timeout.hcl"),
+			Expected: "`10` is invalid timeout. Please specify a duration such as \"5m\"",
+		},
+		{
+			Name:     "sensitive_pattern",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "sensitive_pattern.hcl"),
+			Expected: "`password(` is invalid sensitive_pattern: error parsing regexp: missing closing ): `password(`",
+		},
+		{
+			Name:     "max_issues_per_file",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "max_issues_per_file.hcl"),
+			Expected: "`-1` is invalid max_issues_per_file. Please specify a positive number",
+		},
+		{
+			Name:     "escalate_after",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "escalate_after.hcl"),
+			Expected: "`-5` is invalid escalate_after. Please specify a positive number",
+		},
+		{
+			Name:     "rule_resources",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "rule_resources.hcl"),
+			Expected: "`aws_instance.[prod` is invalid resource pattern of `aws_instance_previous_type` rule: syntax error in pattern",
+		},
+		{
+			Name:     "data_files",
+			File:     filepath.Join(currentDir, "test-fixtures", "config", "data_files.hcl"),
+			Expected: "`amis` is invalid dataset name of data_files. Please specify instance_types, runtimes, regions or services",
+		},
 	}
 
 	for _, tc := range cases {
@@ -207,7 +290,9 @@ func Test_Merge(t *testing.T) {
 				Enabled: true,
 			},
 		},
-		Plugins: map[string]*PluginConfig{},
+		Plugins:  map[string]*PluginConfig{},
+		Themes:   map[string]*ThemeConfig{},
+		Accounts: map[string]*AccountConfig{},
 	}
 
 	cases := []struct {
@@ -272,6 +357,11 @@ func Test_Merge(t *testing.T) {
 						Enabled: false,
 					},
 				},
+				Theme: "base",
+				Themes: map[string]*ThemeConfig{
+					"base": {Name: "base", Error: []string{"red"}},
+				},
+				Accounts: map[string]*AccountConfig{},
 			},
 			Other: &Config{
 				Module:    false,
@@ -309,6 +399,11 @@ func Test_Merge(t *testing.T) {
 						Enabled: true,
 					},
 				},
+				Theme: "other",
+				Themes: map[string]*ThemeConfig{
+					"other": {Name: "other", Error: []string{"hi-red"}},
+				},
+				Accounts: map[string]*AccountConfig{},
 			},
 			Expected: &Config{
 				Module:    true,
@@ -326,8 +421,9 @@ func Test_Merge(t *testing.T) {
 					"github.com/terraform-linters/example-2": true,
 					"github.com/terraform-linters/example-3": false,
 				},
-				Varfiles:  []string{"example1.tfvars", "example2.tfvars", "example3.tfvars"},
-				Variables: []string{"foo=bar", "bar=baz"},
+				Varfiles:          []string{"example1.tfvars", "example2.tfvars", "example3.tfvars"},
+				Variables:         []string{"foo=bar", "bar=baz"},
+				VariablePositions: []int{2, 3},
 				Rules: map[string]*RuleConfig{
 					"aws_instance_invalid_type": {
 						Name:    "aws_instance_invalid_type",
@@ -356,6 +452,12 @@ func Test_Merge(t *testing.T) {
 						Enabled: true,
 					},
 				},
+				Theme: "other",
+				Themes: map[string]*ThemeConfig{
+					"base":  {Name: "base", Error: []string{"red"}},
+					"other": {Name: "other", Error: []string{"hi-red"}},
+				},
+				Accounts: map[string]*AccountConfig{},
 			},
 		},
 	}
@@ -400,6 +502,63 @@ func Test_ToPluginConfig(t *testing.T) {
 	}
 }
 
+func Test_DirectoryAccount(t *testing.T) {
+	config := &Config{
+		Accounts: map[string]*AccountConfig{
+			"staging": {
+				Name:        "staging",
+				Directories: []string{"environments"},
+			},
+			"production": {
+				Name:        "production",
+				Directories: []string{"environments/production", "global"},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Dir      string
+		Expected string
+	}{
+		{
+			Name:     "exact directory",
+			Dir:      "global",
+			Expected: "production",
+		},
+		{
+			Name:     "child directory",
+			Dir:      "environments/staging",
+			Expected: "staging",
+		},
+		{
+			Name:     "deepest directory",
+			Dir:      "environments/production/network",
+			Expected: "production",
+		},
+		{
+			Name:     "similar prefix",
+			Dir:      "globals",
+			Expected: "",
+		},
+		{
+			Name:     "no account",
+			Dir:      ".",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		ret := ""
+		if account := config.DirectoryAccount(tc.Dir); account != nil {
+			ret = account.Name
+		}
+		if ret != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected `%s`, but got `%s`", tc.Name, tc.Expected, ret)
+		}
+	}
+}
+
 type ruleSetA struct{}
 
 func (*ruleSetA) RuleSetName() (string, error) {
@@ -514,6 +673,8 @@ func Test_copy(t *testing.T) {
 				Enabled: false,
 			},
 		},
+		Themes:   map[string]*ThemeConfig{},
+		Accounts: map[string]*AccountConfig{},
 	}
 
 	cases := []struct {
@@ -0,0 +1,63 @@
+package tflint
+
+import (
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/lang"
+)
+
+// maxExpandedInstances is the maximum number of instances a resource is expanded into
+// Rules are called for each instance, so resources with a larger count are not expanded to keep the inspection fast.
+var maxExpandedInstances = 100
+
+// refersCountIndex returns whether the expression refers to `count.index`
+func refersCountIndex(expr hcl.Expression) bool {
+	refs, diags := lang.ReferencesInExpr(expr)
+	if diags.HasErrors() {
+		return false
+	}
+	for _, ref := range refs {
+		if attr, ok := ref.Subject.(addrs.CountAttr); ok && attr.Name == "index" {
+			return true
+		}
+	}
+	return false
+}
+
+// walkResourceInstances invokes the walker for each instance of the resource if the expression refers to `count.index`
+// While walking, `count.index` is evaluated as the index of the instance, and issues report the index in their messages.
+// Otherwise, all instances have the same value, so the walker is invoked only once. If the count is not known,
+// the walker is also invoked once and `count.index` is not evaluable as before. The same applies if the count exceeds maxExpandedInstances.
+func (r *Runner) walkResourceInstances(resource *configs.Resource, expr hcl.Expression, walker func() error) error {
+	if resource.Count == nil || !refersCountIndex(expr) {
+		return walker()
+	}
+
+	var count int
+	err := r.EvaluateExpr(resource.Count, &count)
+	if err != nil {
+		if appErr, ok := err.(*Error); ok && appErr.Level == WarningLevel {
+			log.Printf("[DEBUG] Walk `%s` without expanding instances because the count is not known", resource.Addr())
+			return walker()
+		}
+		return err
+	}
+	if count > maxExpandedInstances {
+		log.Printf("[DEBUG] Walk `%s` without expanding instances because the count %d exceeds %d", resource.Addr(), count, maxExpandedInstances)
+		return walker()
+	}
+
+	for i := 0; i < count; i++ {
+		log.Printf("[DEBUG] Walk `%s[%d]`", resource.Addr(), i)
+		r.instanceKey = addrs.IntKey(i)
+		err := walker()
+		r.instanceKey = nil
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_WalkResourceAttributes_count(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected []string
+	}{
+		{
+			Name: "count.index",
+			Content: `
+variable "types" {
+  default = ["t2.micro", "t2.small", "t2.medium"]
+}
+
+resource "null_resource" "test" {
+  count = length(var.types)
+  key   = var.types[count.index]
+}`,
+			Expected: []string{"t2.micro", "t2.small", "t2.medium"},
+		},
+		{
+			Name: "inside interpolation",
+			Content: `
+resource "null_resource" "test" {
+  count = 2
+  key   = "web-${count.index}"
+}`,
+			Expected: []string{"web-0", "web-1"},
+		},
+		{
+			Name: "zero count",
+			Content: `
+resource "null_resource" "test" {
+  count = 0
+  key   = "web-${count.index}"
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "without count.index",
+			Content: `
+resource "null_resource" "test" {
+  count = 3
+  key   = "web"
+}`,
+			Expected: []string{"web"},
+		},
+		{
+			Name: "unknown count",
+			Content: `
+variable "instances" {}
+
+resource "null_resource" "test" {
+  count = var.instances
+  key   = "web-${count.index}"
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "too many instances",
+			Content: `
+resource "null_resource" "test" {
+  count = 101
+  key   = "web-${count.index}"
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "without count",
+			Content: `
+resource "null_resource" "test" {
+  key = "web-${count.index}"
+}`,
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		runner := TestRunner(t, map[string]string{"main.tf": tc.Content})
+
+		got := []string{}
+		err := runner.WalkResourceAttributes("null_resource", "key", func(attribute *hcl.Attribute) error {
+			var val string
+			err := runner.EvaluateExpr(attribute.Expr, &val)
+			return runner.EnsureNoError(err, func() error {
+				got = append(got, val)
+				return nil
+			})
+		})
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if !cmp.Equal(tc.Expected, got) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, got))
+		}
+	}
+}
+
+func Test_EmitIssue_count(t *testing.T) {
+	content := `
+resource "null_resource" "test" {
+  count = 2
+  key   = "web-${count.index}"
+}`
+	runner := TestRunner(t, map[string]string{"main.tf": content})
+
+	err := runner.WalkResourceAttributes("null_resource", "key", func(attribute *hcl.Attribute) error {
+		runner.EmitIssue(&testRule{}, "test", attribute.Expr.Range())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	runner.EmitIssue(&testRule{}, "outside", hcl.Range{Filename: "main.tf"})
+
+	got := []string{}
+	for _, issue := range runner.Issues {
+		got = append(got, issue.Message)
+	}
+	expected := []string{"test (count.index = 0)", "test (count.index = 1)", "outside"}
+	if !cmp.Equal(expected, got) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got))
+	}
+}
@@ -0,0 +1,132 @@
+package tflint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// Names of datasets which can be overridden by `data_files`
+const (
+	DataInstanceTypes = "instance_types"
+	DataRuntimes      = "runtimes"
+	DataRegions       = "regions"
+	DataServices      = "services"
+)
+
+// InstanceTypesFile is the data file of EC2 instance types updated by `tflint --update-data`
+var InstanceTypesFile = "~/.tflint.d/data/aws_instance_types.json"
+
+// InstanceTypes is the list of EC2 instance types retrieved from the AWS API
+// Rules have built-in lists of instance types, so the data is only used to add types released after the build.
+type InstanceTypes struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Region    string    `json:"region"`
+	Current   []string  `json:"current"`
+	Previous  []string  `json:"previous"`
+}
+
+// LoadInstanceTypes reads the data file of EC2 instance types
+// It returns nil if the data has never been updated.
+func LoadInstanceTypes() (*InstanceTypes, error) {
+	path, err := homedir.Expand(InstanceTypesFile)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	return LoadInstanceTypesFile(path)
+}
+
+// LoadInstanceTypesFile reads EC2 instance types from the passed file
+// The file has the same format as the data file saved by `--update-data`.
+func LoadInstanceTypesFile(path string) (*InstanceTypes, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data InstanceTypes
+	if err := json.Unmarshal(src, &data); err != nil {
+		return nil, fmt.Errorf("Failed to parse `%s`: %s", path, err)
+	}
+	return &data, nil
+}
+
+// LoadDataList reads a dataset declared in `data_files` other than instance types
+// The file is a JSON array of strings, such as `["us-gov-west-1", "us-gov-east-1"]`.
+func LoadDataList(path string) ([]string, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []string
+	if err := json.Unmarshal(src, &data); err != nil {
+		return nil, fmt.Errorf("Failed to parse `%s`: %s", path, err)
+	}
+	return data, nil
+}
+
+// LoadDataMap reads the services dataset declared in `data_files`
+// The file is a JSON object mapping service IDs to regions, such as `{"qldb": ["us-east-1", "us-west-2"]}`.
+func LoadDataMap(path string) (map[string][]string, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string][]string
+	if err := json.Unmarshal(src, &data); err != nil {
+		return nil, fmt.Errorf("Failed to parse `%s`: %s", path, err)
+	}
+	return data, nil
+}
+
+// SaveInstanceTypes writes the data file of EC2 instance types and returns the path
+// The file is replaced by renaming a temporary file, so concurrent runs never read a partially written file.
+func SaveInstanceTypes(data *InstanceTypes) (string, error) {
+	path, err := homedir.Expand(InstanceTypesFile)
+	if err != nil {
+		return "", err
+	}
+	src, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".aws_instance_types")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(src); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	return path, os.Rename(tmp.Name(), path)
+}
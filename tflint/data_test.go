@@ -0,0 +1,53 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_SaveInstanceTypes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	original := InstanceTypesFile
+	InstanceTypesFile = filepath.Join(dir, "data", "aws_instance_types.json")
+	defer func() { InstanceTypesFile = original }()
+
+	data, err := LoadInstanceTypes()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if data != nil {
+		t.Fatalf("Expected no data before updates, but got %#v", data)
+	}
+
+	expected := &InstanceTypes{
+		UpdatedAt: time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+		Region:    "us-east-1",
+		Current:   []string{"m6g.large", "t3.micro"},
+		Previous:  []string{"t1.micro"},
+	}
+	path, err := SaveInstanceTypes(expected)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if path != InstanceTypesFile {
+		t.Fatalf("Unexpected path: %s", path)
+	}
+
+	data, err = LoadInstanceTypes()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if !cmp.Equal(expected, data) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, data))
+	}
+}
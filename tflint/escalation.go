@@ -0,0 +1,55 @@
+package tflint
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// EscalateIssues aggregates issues of rules reported more than `escalate_after` times in a module
+// Such a rule is likely a systemic problem rather than individual mistakes, e.g. nothing is tagged. The issues are
+// downgraded to notices, and an error summarizing them is added at the first issue. A module is the directory
+// containing the file where the issue is reported.
+func (c *Config) EscalateIssues(issues Issues) Issues {
+	if c.EscalateAfter <= 0 {
+		return issues
+	}
+
+	type group struct {
+		rule   string
+		module string
+	}
+	groups := map[group]Issues{}
+	for _, issue := range issues {
+		key := group{rule: issue.Rule.Name(), module: filepath.Dir(issue.Range.Filename)}
+		groups[key] = append(groups[key], issue)
+	}
+
+	escalated := map[*Issue]bool{}
+	aggregates := Issues{}
+	for key, grouped := range groups {
+		if len(grouped) <= c.EscalateAfter {
+			continue
+		}
+		first := append(Issues{}, grouped...).Sort()[0]
+
+		for _, issue := range grouped {
+			escalated[issue] = true
+		}
+		aggregates = append(aggregates, &Issue{
+			Rule:    &severityRule{Rule: first.Rule, severity: ERROR},
+			Message: fmt.Sprintf("`%s` reported %d issues in `%s` module, exceeding %d issues", key.rule, len(grouped), key.module, c.EscalateAfter),
+			Range:   first.Range,
+		})
+	}
+
+	ret := Issues{}
+	for _, issue := range issues {
+		if escalated[issue] {
+			downgraded := *issue
+			downgraded.Rule = &severityRule{Rule: issue.Rule, severity: NOTICE}
+			issue = &downgraded
+		}
+		ret = append(ret, issue)
+	}
+	return append(ret, aggregates.Sort()...)
+}
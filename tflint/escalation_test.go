@@ -0,0 +1,78 @@
+package tflint
+
+import (
+	"fmt"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_EscalateIssues(t *testing.T) {
+	issues := Issues{
+		{Rule: &testRule{}, Message: "1", Range: hcl.Range{Filename: "variables.tf", Start: hcl.Pos{Line: 1}}},
+		{Rule: &testRule{}, Message: "2", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3}}},
+		{Rule: &testRule{}, Message: "3", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}}},
+		{Rule: &testRule{}, Message: "4", Range: hcl.Range{Filename: "modules/vpc/main.tf", Start: hcl.Pos{Line: 1}}},
+	}
+
+	cases := []struct {
+		Name       string
+		After      int
+		Severities []string
+		Aggregates []string
+	}{
+		{
+			Name:       "disabled",
+			After:      0,
+			Severities: []string{ERROR, ERROR, ERROR, ERROR},
+			Aggregates: []string{},
+		},
+		{
+			Name:       "not exceeded",
+			After:      3,
+			Severities: []string{ERROR, ERROR, ERROR, ERROR},
+			Aggregates: []string{},
+		},
+		{
+			Name:       "exceeded",
+			After:      2,
+			Severities: []string{NOTICE, NOTICE, NOTICE, ERROR},
+			Aggregates: []string{"main.tf:1: `test_rule` reported 3 issues in `.` module, exceeding 2 issues"},
+		},
+	}
+
+	for _, tc := range cases {
+		cfg := EmptyConfig()
+		cfg.EscalateAfter = tc.After
+
+		ret := cfg.EscalateIssues(issues)
+		if len(ret) != len(issues)+len(tc.Aggregates) {
+			t.Fatalf("Failed `%s` test: expected %d issues, but got %d issues", tc.Name, len(issues)+len(tc.Aggregates), len(ret))
+		}
+		for i, severity := range tc.Severities {
+			if ret[i].Message != issues[i].Message {
+				t.Fatalf("Failed `%s` test: expected `%s` at %d, but got `%s`", tc.Name, issues[i].Message, i, ret[i].Message)
+			}
+			if ret[i].Rule.Severity() != severity {
+				t.Fatalf("Failed `%s` test: expected %s severity at %d, but got %s", tc.Name, severity, i, ret[i].Rule.Severity())
+			}
+		}
+		for i, expected := range tc.Aggregates {
+			aggregate := ret[len(issues)+i]
+			got := fmt.Sprintf("%s:%d: %s", aggregate.Range.Filename, aggregate.Range.Start.Line, aggregate.Message)
+			if got != expected {
+				t.Fatalf("Failed `%s` test: expected `%s`, but got `%s`", tc.Name, expected, got)
+			}
+			if aggregate.Rule.Severity() != ERROR {
+				t.Fatalf("Failed `%s` test: expected ERROR severity, but got %s", tc.Name, aggregate.Rule.Severity())
+			}
+		}
+	}
+
+	// The passed issues are not modified
+	for _, issue := range issues {
+		if issue.Rule.Severity() != ERROR {
+			t.Fatalf("The passed issue is modified: %#v", issue)
+		}
+	}
+}
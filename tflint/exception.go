@@ -0,0 +1,134 @@
+package tflint
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+var defaultExceptionsFile = "exceptions.hcl"
+
+// exceptionDateLayout is the layout of expiry dates of exceptions
+const exceptionDateLayout = "2006-01-02"
+
+// Exception is an approved suppression of issues of the rule in the resource
+// Unlike annotations, exceptions are kept in a file separate from configurations, so they can be reviewed
+// and audited with the approver and the ticket.
+type Exception struct {
+	Rule     string `hcl:"rule"`
+	Address  string `hcl:"address"`
+	Approver string `hcl:"approver"`
+	Ticket   string `hcl:"ticket"`
+	// Expires is the date, like "2020-12-31", after which the exception is no longer applied
+	Expires string `hcl:"expires"`
+
+	expiresAt time.Time
+}
+
+// Exceptions is a list of Exception
+type Exceptions []*Exception
+
+type rawExceptions struct {
+	Exceptions []*Exception `hcl:"exception,block"`
+}
+
+// LoadExceptions loads exceptions from the file
+// If the default exceptions file does not exist, it returns no exceptions without an error.
+func LoadExceptions(file string) (Exceptions, error) {
+	log.Printf("[INFO] Load exceptions: %s", file)
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		if file == defaultExceptionsFile {
+			log.Printf("[INFO] Default exceptions file is not found. Ignored")
+			return Exceptions{}, nil
+		}
+		return nil, fmt.Errorf("`%s` is not found", file)
+	}
+
+	parser := hclparse.NewParser()
+	f, diags := parser.ParseHCLFile(file)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var raw rawExceptions
+	diags = gohcl.DecodeBody(f.Body, nil, &raw)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	for _, exception := range raw.Exceptions {
+		if err := exception.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[DEBUG] %d exception(s) loaded", len(raw.Exceptions))
+	return Exceptions(raw.Exceptions), nil
+}
+
+func (e *Exception) validate() error {
+	if e.Rule == "" || e.Address == "" || e.Approver == "" {
+		return fmt.Errorf("The exception of `%s` rule for `%s` must have rule, address, and approver", e.Rule, e.Address)
+	}
+
+	ticket, err := url.Parse(e.Ticket)
+	if err != nil || (ticket.Scheme != "http" && ticket.Scheme != "https") || ticket.Host == "" {
+		return fmt.Errorf("`%s` is invalid ticket of the exception of `%s` rule for `%s`. Please specify a URL", e.Ticket, e.Rule, e.Address)
+	}
+
+	expiresAt, err := time.Parse(exceptionDateLayout, e.Expires)
+	if err != nil {
+		return fmt.Errorf("`%s` is invalid expires of the exception of `%s` rule for `%s`. Please specify a date such as \"2020-12-31\"", e.Expires, e.Rule, e.Address)
+	}
+	e.expiresAt = expiresAt
+
+	return nil
+}
+
+// IsActive returns whether the exception is not expired at the passed time
+// The exception is applied until the end of the expiry date in UTC.
+func (e *Exception) IsActive(now time.Time) bool {
+	return now.Before(e.expiresAt.AddDate(0, 0, 1))
+}
+
+// IsAffected checks if the passed issue is suppressed by the exception
+func (e *Exception) IsAffected(issue *Issue) bool {
+	return e.Rule == issue.Rule.Name() && e.Address == issue.Address
+}
+
+// Active returns exceptions which are not expired at the passed time
+func (exceptions Exceptions) Active(now time.Time) Exceptions {
+	ret := Exceptions{}
+	for _, exception := range exceptions {
+		if exception.IsActive(now) {
+			ret = append(ret, exception)
+		} else {
+			log.Printf("[WARN] The exception of `%s` rule for `%s` expired on %s", exception.Rule, exception.Address, exception.Expires)
+		}
+	}
+	return ret
+}
+
+// Apply returns issues which are not suppressed by the exceptions
+func (exceptions Exceptions) Apply(issues Issues) Issues {
+	ret := Issues{}
+	for _, issue := range issues {
+		suppressed := false
+		for _, exception := range exceptions {
+			if exception.IsAffected(issue) {
+				log.Printf("[DEBUG] Issue of `%s` rule for `%s` is suppressed by the exception approved by %s", issue.Rule.Name(), issue.Address, exception.Approver)
+				suppressed = true
+				break
+			}
+		}
+		if !suppressed {
+			ret = append(ret, issue)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,152 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_LoadExceptions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected Exceptions
+		Error    string
+	}{
+		{
+			Name: "valid",
+			Content: `
+exception {
+  rule     = "aws_instance_invalid_type"
+  address  = "aws_instance.web"
+  approver = "security-team@example.com"
+  ticket   = "https://jira.example.com/browse/SEC-123"
+  expires  = "2020-12-31"
+}`,
+			Expected: Exceptions{
+				{
+					Rule:     "aws_instance_invalid_type",
+					Address:  "aws_instance.web",
+					Approver: "security-team@example.com",
+					Ticket:   "https://jira.example.com/browse/SEC-123",
+					Expires:  "2020-12-31",
+				},
+			},
+		},
+		{
+			Name: "missing attribute",
+			Content: `
+exception {
+  rule     = "aws_instance_invalid_type"
+  address  = "aws_instance.web"
+  ticket   = "https://jira.example.com/browse/SEC-123"
+  expires  = "2020-12-31"
+}`,
+			Error: `exceptions.hcl:2,11-11: Missing required argument; The argument "approver" is required, but no definition was found.`,
+		},
+		{
+			Name: "invalid ticket",
+			Content: `
+exception {
+  rule     = "aws_instance_invalid_type"
+  address  = "aws_instance.web"
+  approver = "security-team@example.com"
+  ticket   = "SEC-123"
+  expires  = "2020-12-31"
+}`,
+			Error: "`SEC-123` is invalid ticket of the exception of `aws_instance_invalid_type` rule for `aws_instance.web`. Please specify a URL",
+		},
+		{
+			Name: "invalid expires",
+			Content: `
+exception {
+  rule     = "aws_instance_invalid_type"
+  address  = "aws_instance.web"
+  approver = "security-team@example.com"
+  ticket   = "https://jira.example.com/browse/SEC-123"
+  expires  = "next month"
+}`,
+			Error: "`next month` is invalid expires of the exception of `aws_instance_invalid_type` rule for `aws_instance.web`. Please specify a date such as \"2020-12-31\"",
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "exceptions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "exceptions.hcl")
+
+	for _, tc := range cases {
+		if err := ioutil.WriteFile(file, []byte(tc.Content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		ret, err := LoadExceptions(file)
+		if tc.Error != "" {
+			if err == nil {
+				t.Fatalf("Failed `%s` test: expected error is not occurred", tc.Name)
+			}
+			if msg := err.Error(); !strings.HasSuffix(msg, tc.Error) {
+				t.Fatalf("Failed `%s` test: expected error is %s, but get %s", tc.Name, tc.Error, msg)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+
+		opt := cmpopts.IgnoreUnexported(Exception{})
+		if !cmp.Equal(tc.Expected, ret, opt) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, ret, opt))
+		}
+	}
+}
+
+func Test_LoadExceptions_notFound(t *testing.T) {
+	ret, err := LoadExceptions(defaultExceptionsFile)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if len(ret) != 0 {
+		t.Fatalf("Expected no exceptions, but got %d", len(ret))
+	}
+
+	if _, err := LoadExceptions("not_found.hcl"); err == nil || err.Error() != "`not_found.hcl` is not found" {
+		t.Fatalf("Expected not found error, but got %s", err)
+	}
+}
+
+func Test_Exceptions_Apply(t *testing.T) {
+	exceptions := Exceptions{
+		{Rule: "test_rule", Address: "aws_instance.web", expiresAt: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{Rule: "test_rule", Address: "aws_instance.db", expiresAt: time.Date(2020, 6, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	active := exceptions.Active(time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC))
+	if len(active) != 1 || active[0].Address != "aws_instance.web" {
+		t.Fatalf("Unexpected active exceptions: %#v", active)
+	}
+
+	issues := Issues{
+		{Rule: &testRule{}, Message: "web", Address: "aws_instance.web"},
+		{Rule: &testRule{}, Message: "db", Address: "aws_instance.db"},
+		{Rule: &testRule{}, Message: "outside"},
+	}
+	ret := active.Apply(issues)
+
+	messages := []string{}
+	for _, issue := range ret {
+		messages = append(messages, issue.Message)
+	}
+	expected := []string{"db", "outside"}
+	if !cmp.Equal(expected, messages) {
+		t.Fatalf("Failed test: diff=%s", cmp.Diff(expected, messages))
+	}
+}
@@ -0,0 +1,194 @@
+package tflint
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+)
+
+// Fix is a text edit which resolves an issue
+// The bytes in Range are replaced with Text. If Range is empty (Start == End), Text is inserted at the position.
+// Use FileWriter to build fixes which edit attributes and blocks.
+type Fix struct {
+	Range hcl.Range
+	Text  string
+}
+
+// ApplyFixes applies fixes of the passed issues to the sources
+// It returns patched sources of changed files and the issues whose fixes are applied.
+// When fixes overlap in a file, only the one that appears first is applied and the others are left as they are.
+func ApplyFixes(sources map[string][]byte, issues Issues) (map[string][]byte, Issues) {
+	fixesByFile := map[string]Issues{}
+	for _, issue := range issues {
+		if issue.Fix == nil {
+			continue
+		}
+		filename := issue.Fix.Range.Filename
+		fixesByFile[filename] = append(fixesByFile[filename], issue)
+	}
+
+	patched := map[string][]byte{}
+	fixed := Issues{}
+
+	for filename, fixIssues := range fixesByFile {
+		src, exists := sources[filename]
+		if !exists {
+			log.Printf("[WARN] `%s` is not found in loaded sources. Fixes are not applied", filename)
+			continue
+		}
+
+		sort.SliceStable(fixIssues, func(i, j int) bool {
+			return fixIssues[i].Fix.Range.Start.Byte < fixIssues[j].Fix.Range.Start.Byte
+		})
+
+		ret := []byte{}
+		offset := 0
+		for _, issue := range fixIssues {
+			start, end := issue.Fix.Range.Start.Byte, issue.Fix.Range.End.Byte
+			if start < offset || end < start || end > len(src) {
+				log.Printf("[WARN] Fix for %s (%s) overlaps with other fixes or is out of range. Skipped", issue.Range.String(), issue.Rule.Name())
+				continue
+			}
+			ret = append(ret, src[offset:start]...)
+			ret = append(ret, issue.Fix.Text...)
+			offset = end
+			fixed = append(fixed, issue)
+		}
+		ret = append(ret, src[offset:]...)
+
+		patched[filename] = ret
+	}
+
+	return patched, fixed
+}
+
+// ValidateFixes checks the patched sources before they are written
+// Each file is parsed again, and the rules of the fixed issues are run against a runner built from the patched sources
+// by newRunner to confirm the issues are resolved. It returns the reason for each file that failed the validation.
+func ValidateFixes(patched map[string][]byte, fixed Issues, newRunner func(map[string][]byte) (*Runner, error)) map[string]error {
+	failures := map[string]error{}
+	valid := map[string][]byte{}
+
+	for filename, src := range patched {
+		if err := parseSource(filename, src); err != nil {
+			failures[filename] = err
+			continue
+		}
+		valid[filename] = src
+	}
+	if len(valid) == 0 {
+		return failures
+	}
+
+	runner, err := newRunner(valid)
+	if err != nil {
+		for filename := range valid {
+			failures[filename] = err
+		}
+		return failures
+	}
+
+	// Issues are matched without their locations, since the fixes shift positions in the file
+	originals := map[string]*Issue{}
+	rules := map[string]Rule{}
+	for _, issue := range fixed {
+		if _, ok := valid[issue.Fix.Range.Filename]; !ok {
+			continue
+		}
+		originals[fixValidationKey(issue)] = issue
+		rules[issue.Rule.Name()] = issue.Rule
+	}
+
+	for _, rule := range rules {
+		// Rules of plugins cannot be run against the runner directly, so their fixes are only checked by parsing
+		checker, ok := rule.(interface{ Check(*Runner) error })
+		if !ok {
+			continue
+		}
+		if err := checker.Check(runner); err != nil {
+			for filename := range valid {
+				if _, failed := failures[filename]; !failed {
+					failures[filename] = err
+				}
+			}
+			return failures
+		}
+	}
+
+	for _, issue := range runner.Issues {
+		if original, ok := originals[fixValidationKey(issue)]; ok {
+			filename := original.Fix.Range.Filename
+			if _, failed := failures[filename]; !failed {
+				failures[filename] = fmt.Errorf("The fix does not resolve the issue: %s (%s)", original.Message, original.Rule.Name())
+			}
+		}
+	}
+
+	return failures
+}
+
+func fixValidationKey(issue *Issue) string {
+	return strings.Join([]string{issue.Rule.Name(), issue.Range.Filename, issue.Message}, "\x00")
+}
+
+// parseSource parses the source as HCL native syntax or JSON syntax depending on the file extension
+func parseSource(filename string, src []byte) error {
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		_, diags = hcljson.Parse(src, filename)
+	} else {
+		_, diags = hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	}
+	if diags.HasErrors() {
+		return diags
+	}
+	return nil
+}
+
+// Diff renders the fix as a unified diff of the lines it changes
+// The diff has `a/` and `b/` prefixes like `git diff`, so it can be applied with `git apply` or `patch -p1`.
+// It returns an empty string if the fix is out of range of the source.
+func (fix *Fix) Diff(src []byte) string {
+	start, end := fix.Range.Start.Byte, fix.Range.End.Byte
+	if start < 0 || end < start || end > len(src) {
+		return ""
+	}
+
+	lineStart := bytes.LastIndexByte(src[:start], '\n') + 1
+	lineEnd := len(src)
+	if idx := bytes.IndexByte(src[end:], '\n'); idx >= 0 {
+		lineEnd = end + idx + 1
+	}
+
+	before := string(src[lineStart:lineEnd])
+	after := string(src[lineStart:start]) + fix.Text + string(src[end:lineEnd])
+	line := bytes.Count(src[:lineStart], []byte("\n")) + 1
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n+++ b/%s\n", fix.Range.Filename, fix.Range.Filename)
+	fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", line, countLines(before), line, countLines(after))
+	for _, l := range splitLines(before) {
+		fmt.Fprintf(&b, "-%s\n", l)
+	}
+	for _, l := range splitLines(after) {
+		fmt.Fprintf(&b, "+%s\n", l)
+	}
+	return b.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+func countLines(s string) int {
+	return len(splitLines(s))
+}
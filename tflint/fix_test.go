@@ -0,0 +1,138 @@
+package tflint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_ApplyFixes(t *testing.T) {
+	insert := &Issue{
+		Rule:  &testRule{},
+		Range: hcl.Range{Filename: "main.tf"},
+		Fix: &Fix{
+			Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 0}, End: hcl.Pos{Byte: 0}},
+			Text:  "# header\n",
+		},
+	}
+	replace := &Issue{
+		Rule:  &testRule{},
+		Range: hcl.Range{Filename: "main.tf"},
+		Fix: &Fix{
+			Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 7}, End: hcl.Pos{Byte: 11}},
+			Text:  `"t3"`,
+		},
+	}
+	overlap := &Issue{
+		Rule:  &testRule{},
+		Range: hcl.Range{Filename: "main.tf"},
+		Fix: &Fix{
+			Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 9}, End: hcl.Pos{Byte: 12}},
+			Text:  "",
+		},
+	}
+	noFix := &Issue{Rule: &testRule{}, Range: hcl.Range{Filename: "other.tf"}}
+
+	sources := map[string][]byte{
+		"main.tf":  []byte(`type = "t2"` + "\n"),
+		"other.tf": []byte(""),
+	}
+
+	patched, fixed := ApplyFixes(sources, Issues{replace, noFix, overlap, insert})
+
+	expected := map[string][]byte{
+		"main.tf": []byte("# header\ntype = \"t3\"\n"),
+	}
+	if !cmp.Equal(expected, patched) {
+		t.Fatalf("Failed test: diff: %s", cmp.Diff(expected, patched))
+	}
+	if len(fixed) != 2 || fixed[0] != insert || fixed[1] != replace {
+		t.Fatalf("Expected fixed issues are insert and replace, but got %#v", fixed)
+	}
+}
+
+// headerTestRule reports files which do not start with "# header"
+type headerTestRule struct{ testRule }
+
+func (r *headerTestRule) Check(runner *Runner) error {
+	for filename, src := range runner.Sources {
+		if !strings.HasPrefix(string(src), "# header") {
+			runner.EmitIssue(r, "missing header", hcl.Range{Filename: filename})
+		}
+	}
+	return nil
+}
+
+func Test_ValidateFixes(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Text     string
+		Expected string
+	}{
+		{
+			Name:     "resolved",
+			Text:     "# header\n",
+			Expected: "",
+		},
+		{
+			Name:     "syntax error",
+			Text:     "# header\n}\n",
+			Expected: "main.tf:2,1-2: Argument or block definition required; An argument or block definition is required here.",
+		},
+		{
+			Name:     "not resolved",
+			Text:     "# heading\n",
+			Expected: "The fix does not resolve the issue: missing header (test_rule)",
+		},
+	}
+
+	for _, tc := range cases {
+		issue := &Issue{
+			Rule:    &headerTestRule{},
+			Message: "missing header",
+			Range:   hcl.Range{Filename: "main.tf"},
+			Fix: &Fix{
+				Range: hcl.Range{Filename: "main.tf"},
+				Text:  tc.Text,
+			},
+		}
+		sources := map[string][]byte{"main.tf": []byte(`resource "aws_instance" "web" {}` + "\n")}
+		patched, fixed := ApplyFixes(sources, Issues{issue})
+
+		failures := ValidateFixes(patched, fixed, func(sources map[string][]byte) (*Runner, error) {
+			files := map[string]string{}
+			for filename, src := range sources {
+				files[filename] = string(src)
+			}
+			return TestRunner(t, files), nil
+		})
+
+		got := ""
+		if err, ok := failures["main.tf"]; ok {
+			got = err.Error()
+		}
+		if got != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected failure is `%s`, but got `%s`", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func Test_FixDiff(t *testing.T) {
+	src := []byte("a = 1\nb = 2\n")
+	fix := &Fix{
+		Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Byte: 10}, End: hcl.Pos{Byte: 11}},
+		Text:  "20",
+	}
+
+	expected := `--- a/main.tf
++++ b/main.tf
+@@ -2,1 +2,1 @@
+-b = 2
++b = 20
+`
+	if got := fix.Diff(src); got != expected {
+		t.Fatalf("Failed test: expected=%s, got=%s", expected, got)
+	}
+}
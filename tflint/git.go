@@ -0,0 +1,118 @@
+package tflint
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// terraformFileSuffixes are suffixes of files read from git trees and archives
+var terraformFileSuffixes = []string{".tf", ".tf.json", ".tfvars", ".tfvars.json"}
+
+// isTerraformFile returns whether the passed file is a configuration or variable definitions file
+func isTerraformFile(name string) bool {
+	for _, suffix := range terraformFileSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewGitTreeFs returns an in-memory filesystem with Terraform files in the tree of the passed git revision
+// Files are read with the `git` command from the repository of the current directory, so no checkout is needed.
+// Paths in the filesystem are relative to the root of the repository.
+//
+// The `git` command is used instead of a Go implementation like go-git, so that revisions are resolved
+// exactly as the user's git does, including alternates, partial clones and worktrees, without large dependencies.
+// This makes `git` a runtime dependency of `--git-rev` and `--github-pr`. It returns an error if the command is not installed.
+func NewGitTreeFs(rev string) (afero.Fs, error) {
+	log.Printf("[INFO] Load files from git revision: %s", rev)
+
+	if _, err := exec.LookPath("git"); err != nil {
+		log.Printf("[ERROR] %s", err)
+		return nil, errors.New("`git` command is not found. Install git to inspect files in a git revision")
+	}
+
+	out, err := gitCommand(nil, "ls-tree", "-r", "-z", "--full-tree", "--name-only", rev)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, name := range strings.Split(string(out), "\x00") {
+		if isTerraformFile(name) {
+			names = append(names, name)
+		}
+	}
+
+	fs := afero.NewMemMapFs()
+	if len(names) == 0 {
+		return fs, nil
+	}
+
+	input := &bytes.Buffer{}
+	for _, name := range names {
+		fmt.Fprintf(input, "%s:%s\n", rev, name)
+	}
+	out, err = gitCommand(input, "cat-file", "--batch")
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(out))
+	for _, name := range names {
+		// Each object is printed as "<sha> <type> <size>\n<contents>\n"
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		fields := strings.Fields(header)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("Failed to read `%s` from git: %s", name, strings.TrimSpace(header))
+		}
+		size, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, err
+		}
+
+		src := make([]byte, size)
+		if _, err := io.ReadFull(reader, src); err != nil {
+			return nil, err
+		}
+		if _, err := reader.Discard(1); err != nil {
+			return nil, err
+		}
+
+		if err := afero.WriteFile(fs, name, src, os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+
+	return fs, nil
+}
+
+func gitCommand(stdin io.Reader, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("Failed to run `git %s`: %s", args[0], msg)
+		}
+		return nil, fmt.Errorf("Failed to run `git %s`: %s", args[0], err)
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,133 @@
+package tflint
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	getter "github.com/hashicorp/go-getter"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+)
+
+// gitModuleSource is a module source of a Git repository
+type gitModuleSource struct {
+	URL string
+	// Ref is a branch, tag or commit specified by the `ref` query. The default branch is used if empty
+	Ref    string
+	Subdir string
+}
+
+// parseGitModuleSource parses module sources of Git repositories, such as `git::https://...`, `github.com/...` and `git@github.com:...`
+// It returns nil if the source is not a Git repository.
+func parseGitModuleSource(source string) (*gitModuleSource, error) {
+	// Sources of Git repositories are not local paths, so the working directory is never used for detection
+	detected, err := getter.Detect(source, "/", getter.Detectors)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(detected, "git::") {
+		return nil, nil
+	}
+
+	src, subdir := getter.SourceDirSubdir(strings.TrimPrefix(detected, "git::"))
+	u, err := url.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	ref := query.Get("ref")
+	query.Del("ref")
+	u.RawQuery = query.Encode()
+
+	return &gitModuleSource{URL: u.String(), Ref: ref, Subdir: subdir}, nil
+}
+
+// cacheDir returns the directory where the repository is cloned
+// Repositories are cached per ref. Remove the directory to fetch the latest commit of a branch.
+func (s *gitModuleSource) cacheDir(cache string) string {
+	sum := sha256.Sum256([]byte(s.URL + "\x00" + s.Ref))
+	return filepath.Join(cache, "git", hex.EncodeToString(sum[:]))
+}
+
+// resolveGitModule returns the directory of the Git module in the cache, cloning it if not cached
+func resolveGitModule(ctx context.Context, req *configs.ModuleRequest, source *gitModuleSource, cache string) (string, hcl.Diagnostics) {
+	dir := source.cacheDir(cache)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		log.Printf("[INFO] Clone `%s` module: url=%s, ref=%s", req.Name, source.URL, source.Ref)
+		if err := cloneGitModule(ctx, source, dir); err != nil {
+			return "", moduleDiagnostics(req, "Failed to clone", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Use the cached module: url=%s, ref=%s", source.URL, source.Ref)
+	}
+
+	if source.Subdir != "" {
+		dir = filepath.Join(dir, filepath.FromSlash(source.Subdir))
+	}
+	return dir, nil
+}
+
+// cloneGitModule clones the repository into the directory
+// Only the latest commit of the ref is cloned. Since commits cannot be cloned shallowly by hashes, the whole repository
+// is cloned and the commit is checked out if the shallow clone fails. As with downloading registry modules, the repository
+// is cloned into a temporary directory first.
+func cloneGitModule(ctx context.Context, source *gitModuleSource, dir string) error {
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), ".clone")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	dst := filepath.Join(tmp, "module")
+
+	args := []string{"clone", "--depth", "1"}
+	if source.Ref != "" {
+		args = append(args, "--branch", source.Ref)
+	}
+	if err := runGit(ctx, "", append(args, source.URL, dst)...); err != nil {
+		if source.Ref == "" {
+			return err
+		}
+		log.Printf("[DEBUG] Failed to clone `%s` shallowly. Clone the whole repository: %s", source.Ref, err)
+		if err := os.RemoveAll(dst); err != nil {
+			return err
+		}
+		if err := runGit(ctx, "", "clone", source.URL, dst); err != nil {
+			return err
+		}
+		if err := runGit(ctx, dst, "checkout", source.Ref); err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(dst, dir)
+}
+
+// runGit runs the git command, which is killed when the context is canceled
+func runGit(ctx context.Context, dir string, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	// Never prompt for credentials. Authentication should be configured with SSH agents or credential helpers
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("`git %s` failed: %s", args[0], msg)
+		}
+		return fmt.Errorf("`git %s` failed: %s", args[0], err)
+	}
+	return nil
+}
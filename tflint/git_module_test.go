@@ -0,0 +1,128 @@
+package tflint
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/registry"
+	"github.com/spf13/afero"
+)
+
+func Test_parseGitModuleSource(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Source   string
+		Expected *gitModuleSource
+	}{
+		{
+			Name:   "git::https",
+			Source: "git::https://example.com/vpc.git?ref=v1.2.0",
+			Expected: &gitModuleSource{
+				URL: "https://example.com/vpc.git",
+				Ref: "v1.2.0",
+			},
+		},
+		{
+			Name:   "github",
+			Source: "github.com/hashicorp/example//modules/vpc?ref=main",
+			Expected: &gitModuleSource{
+				URL:    "https://github.com/hashicorp/example.git",
+				Ref:    "main",
+				Subdir: "modules/vpc",
+			},
+		},
+		{
+			Name:   "ssh",
+			Source: "git@github.com:hashicorp/example.git",
+			Expected: &gitModuleSource{
+				URL: "ssh://git@github.com/hashicorp/example.git",
+			},
+		},
+		{
+			Name:     "s3",
+			Source:   "s3::https://s3-eu-west-1.amazonaws.com/examplecorp-terraform-modules/vpc.zip",
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		source, err := parseGitModuleSource(tc.Source)
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if !cmp.Equal(tc.Expected, source) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, source))
+		}
+	}
+}
+
+func Test_RemoteModuleResolver_git(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git_module")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repo := filepath.Join(dir, "repo")
+	if err := os.MkdirAll(filepath.Join(repo, "modules", "vpc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	commit := func(content string, tag string) {
+		if err := ioutil.WriteFile(filepath.Join(repo, "modules", "vpc", "main.tf"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		for _, args := range [][]string{
+			{"add", "-A"},
+			{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", tag},
+			{"tag", tag},
+		} {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = repo
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%s: %s", err, out)
+			}
+		}
+	}
+	if out, err := exec.Command("git", "init", "-q", repo).CombinedOutput(); err != nil {
+		t.Fatalf("%s: %s", err, out)
+	}
+	commit(`resource "aws_vpc" "v1" {}`, "v1.0.0")
+	commit(`resource "aws_vpc" "v2" {}`, "v2.0.0")
+
+	root := filepath.Join(dir, "root")
+	if err := os.MkdirAll(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+	config := `
+module "vpc" {
+  source = "git::file://` + filepath.ToSlash(repo) + `//modules/vpc?ref=v1.0.0"
+}`
+	if err := ioutil.WriteFile(filepath.Join(root, "main.tf"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := afero.Afero{Fs: afero.NewOsFs()}
+	resolver := remoteModuleResolver(context.Background(), fs, filepath.Join(dir, "cache"), registry.NewClient(nil, nil))
+	mod, diags := configs.NewParser(fs).LoadConfigDir(root)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+	cfg, diags := configs.BuildConfig(mod, resolver)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	vpc, exists := cfg.Children["vpc"]
+	if !exists {
+		t.Fatalf("`vpc` module is not loaded")
+	}
+	if _, exists := vpc.Module.ManagedResources["aws_vpc.v1"]; !exists {
+		t.Fatalf("`aws_vpc.v1` is not found in the module: %#v", vpc.Module.ManagedResources)
+	}
+}
@@ -0,0 +1,108 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func Test_NewGitTreeFs(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "tflint-git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer os.Chdir(currentDir)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"main.tf":               "committed",
+		"modules/vpc/main.tf":   "module",
+		"terraform.tfvars":      "vars",
+		"README.md":             "readme",
+		"modules/vpc/README.md": "readme",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(name), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "-A"},
+		{"-c", "user.name=tflint", "-c", "user.email=tflint@example.com", "commit", "-q", "-m", "init"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("Failed to run git %s: %s", args[0], out)
+		}
+	}
+	// Changes in the working tree must not be visible
+	if err := ioutil.WriteFile("main.tf", []byte("modified"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewGitTreeFs("HEAD")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := map[string]string{
+		"main.tf":             "committed",
+		"modules/vpc/main.tf": "module",
+		"terraform.tfvars":    "vars",
+	}
+	for name, content := range expected {
+		src, err := afero.ReadFile(fs, name)
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		if string(src) != content {
+			t.Fatalf("Expected `%s` in `%s`, but got `%s`", content, name, src)
+		}
+	}
+	if exists, _ := afero.Exists(fs, "README.md"); exists {
+		t.Fatal("Expected `README.md` is not loaded, but it exists")
+	}
+
+	_, err = NewGitTreeFs("undefined")
+	if err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+}
+
+func Test_NewGitTreeFs_gitNotFound(t *testing.T) {
+	path := os.Getenv("PATH")
+	defer os.Setenv("PATH", path)
+	dir, err := ioutil.TempDir("", "tflint-git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv("PATH", dir)
+
+	_, err = NewGitTreeFs("HEAD")
+	if err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+	expected := "`git` command is not found. Install git to inspect files in a git revision"
+	if err.Error() != expected {
+		t.Fatalf("Expected error is `%s`, but got `%s`", expected, err)
+	}
+}
@@ -0,0 +1,115 @@
+package tflint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+)
+
+// hookIssue is an issue passed to the issue hook
+// The hook returns issues with IDs it received. Issues not returned are dropped, and severities and messages can be changed.
+type hookIssue struct {
+	ID          int    `json:"id"`
+	Rule        string `json:"rule"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	Filename    string `json:"filename"`
+	Line        int    `json:"line"`
+	Address     string `json:"address"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+type hookPayload struct {
+	Issues []*hookIssue `json:"issues"`
+}
+
+var hookSeverities = map[string]string{
+	"error":   ERROR,
+	"warning": WARNING,
+	"notice":  NOTICE,
+}
+
+// severityRule is a rule whose severity is changed by the issue hook
+type severityRule struct {
+	Rule
+	severity string
+}
+
+// Severity returns the changed severity
+func (r *severityRule) Severity() string {
+	return r.severity
+}
+
+// RunIssueHook passes issues to the external command as JSON on stdin, and returns issues the command printed on stdout
+// It is an escape hatch for triage logic specific to organizations. The command fails the inspection if it exits with non-zero status.
+func RunIssueHook(command []string, issues Issues) (Issues, error) {
+	if len(command) == 0 {
+		return issues, nil
+	}
+	log.Printf("[INFO] Run issue hook: %s", strings.Join(command, " "))
+
+	payload := hookPayload{Issues: make([]*hookIssue, len(issues))}
+	for i, issue := range issues {
+		payload.Issues[i] = &hookIssue{
+			ID:          i,
+			Rule:        issue.Rule.Name(),
+			Severity:    strings.ToLower(issue.Rule.Severity()),
+			Message:     issue.Message,
+			Filename:    issue.Range.Filename,
+			Line:        issue.Range.Start.Line,
+			Address:     issue.Address,
+			Fingerprint: issue.Fingerprint(),
+		}
+	}
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return issues, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return issues, fmt.Errorf("`%s` failed: %s", command[0], msg)
+		}
+		return issues, fmt.Errorf("`%s` failed: %s", command[0], err)
+	}
+
+	var ret hookPayload
+	if err := json.Unmarshal(stdout.Bytes(), &ret); err != nil {
+		return issues, fmt.Errorf("Failed to parse the output of `%s`: %s", command[0], err)
+	}
+	if ret.Issues == nil {
+		return issues, errors.New("The issue hook must print `issues`. Print an empty list to drop all issues")
+	}
+
+	processed := Issues{}
+	for _, hi := range ret.Issues {
+		if hi.ID < 0 || hi.ID >= len(issues) {
+			return issues, fmt.Errorf("`%d` is not an ID of the passed issues", hi.ID)
+		}
+		issue := *issues[hi.ID]
+
+		if hi.Severity != "" && hi.Severity != strings.ToLower(issue.Rule.Severity()) {
+			severity, exists := hookSeverities[hi.Severity]
+			if !exists {
+				return issues, fmt.Errorf("`%s` is invalid severity. Please specify \"error\", \"warning\" or \"notice\"", hi.Severity)
+			}
+			issue.Rule = &severityRule{Rule: issue.Rule, severity: severity}
+		}
+		if hi.Message != "" {
+			issue.Message = hi.Message
+		}
+		processed = append(processed, &issue)
+	}
+	log.Printf("[INFO] The issue hook returned %d of %d issues", len(processed), len(issues))
+
+	return processed, nil
+}
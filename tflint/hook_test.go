@@ -0,0 +1,101 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_RunIssueHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test hook is a shell script")
+	}
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+
+	dir, err := ioutil.TempDir("", "issue-hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	issues := Issues{
+		{
+			Rule:    &testRule{},
+			Message: "dropped",
+			Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}},
+		},
+		{
+			Rule:    &testRule{},
+			Message: "kept",
+			Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2}},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Output   string
+		Expected Issues
+		Severity string
+		Error    string
+	}{
+		{
+			Name:   "veto and re-severity",
+			Output: `{"issues":[{"id":1,"severity":"notice","message":"kept (triaged)"}]}`,
+			Expected: Issues{
+				{
+					Rule:    &testRule{},
+					Message: "kept (triaged)",
+					Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2}},
+				},
+			},
+			Severity: NOTICE,
+		},
+		{
+			Name:   "unknown ID",
+			Output: `{"issues":[{"id":2}]}`,
+			Error:  "`2` is not an ID of the passed issues",
+		},
+		{
+			Name:   "invalid severity",
+			Output: `{"issues":[{"id":0,"severity":"fatal"}]}`,
+			Error:  "`fatal` is invalid severity. Please specify \"error\", \"warning\" or \"notice\"",
+		},
+		{
+			Name:   "no issues",
+			Output: `{}`,
+			Error:  "The issue hook must print `issues`. Print an empty list to drop all issues",
+		},
+	}
+
+	for _, tc := range cases {
+		script := filepath.Join(dir, "hook.sh")
+		if err := ioutil.WriteFile(script, []byte("#!/bin/sh\ncat > /dev/null\necho '"+tc.Output+"'\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		ret, err := RunIssueHook([]string{"sh", script}, issues)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		AssertIssues(t, tc.Expected, ret)
+		if got := ret[0].Rule.Severity(); got != tc.Severity {
+			t.Fatalf("Failed `%s` test: expected severity is `%s`, but got `%s`", tc.Name, tc.Severity, got)
+		}
+		if issues[1].Message != "kept" {
+			t.Fatalf("Failed `%s` test: the passed issue must not be changed", tc.Name)
+		}
+	}
+}
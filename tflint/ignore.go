@@ -0,0 +1,215 @@
+package tflint
+
+import (
+	"bufio"
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+var defaultIgnoreFile = ".tflintignore"
+
+// IgnoreMatcher matches paths against gitignore-style patterns
+// Patterns without slashes match names at any level, and patterns with slashes are relative to the current directory.
+// Patterns ending with a slash only match directories, and patterns starting with `!` re-include paths excluded by previous patterns.
+// As with gitignore, files in excluded directories cannot be re-included.
+type IgnoreMatcher struct {
+	patterns []*ignorePattern
+}
+
+type ignorePattern struct {
+	regexp  *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// NewIgnoreMatcher returns a matcher of the passed patterns. Empty patterns and comments starting with `#` are ignored
+func NewIgnoreMatcher(patterns []string) *IgnoreMatcher {
+	matcher := &IgnoreMatcher{patterns: []*ignorePattern{}}
+	for _, raw := range patterns {
+		pattern := strings.TrimSpace(raw)
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		ret := &ignorePattern{}
+		if strings.HasPrefix(pattern, "!") {
+			ret.negate = true
+			pattern = pattern[1:]
+		}
+		if strings.HasSuffix(pattern, "/") {
+			ret.dirOnly = true
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		prefix := "^(.*/)?"
+		if strings.Contains(pattern, "/") {
+			prefix = "^"
+			pattern = strings.TrimPrefix(pattern, "/")
+		}
+		ret.regexp = regexp.MustCompile(prefix + globToRegexp(pattern) + "$")
+
+		matcher.patterns = append(matcher.patterns, ret)
+	}
+	return matcher
+}
+
+// LoadIgnoreMatcher returns a matcher of patterns in `.tflintignore` in the current directory and the passed patterns
+// The file is optional. Patterns passed as arguments take precedence over patterns in the file.
+func LoadIgnoreMatcher(fs afero.Afero, excludes []string) (*IgnoreMatcher, error) {
+	patterns := []string{}
+
+	src, err := fs.ReadFile(defaultIgnoreFile)
+	if err == nil {
+		log.Printf("[INFO] Load ignore file: %s", defaultIgnoreFile)
+		scanner := bufio.NewScanner(bytes.NewReader(src))
+		for scanner.Scan() {
+			patterns = append(patterns, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return NewIgnoreMatcher(append(patterns, excludes...)), nil
+}
+
+// addPath excludes the exact path in addition to the patterns
+func (m *IgnoreMatcher) addPath(path string) {
+	m.patterns = append(m.patterns, &ignorePattern{
+		regexp: regexp.MustCompile("^" + regexp.QuoteMeta(filepath.ToSlash(filepath.Clean(path))) + "$"),
+	})
+}
+
+// Empty returns whether the matcher has no patterns
+func (m *IgnoreMatcher) Empty() bool {
+	return len(m.patterns) == 0
+}
+
+// Match returns whether the path is excluded
+func (m *IgnoreMatcher) Match(path string, isDir bool) bool {
+	path = filepath.ToSlash(filepath.Clean(path))
+	if path == "." {
+		return false
+	}
+
+	parts := strings.Split(path, "/")
+	for i := 1; i < len(parts); i++ {
+		if m.match(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return m.match(path, isDir)
+}
+
+func (m *IgnoreMatcher) match(path string, isDir bool) bool {
+	excluded := false
+	for _, pattern := range m.patterns {
+		if pattern.dirOnly && !isDir {
+			continue
+		}
+		if pattern.regexp.MatchString(path) {
+			excluded = !pattern.negate
+		}
+	}
+	return excluded
+}
+
+// globToRegexp converts the glob to the regexp
+// `**` matches any number of directories, `*` matches any characters except slashes, and `?` matches a character except slashes.
+func globToRegexp(glob string) string {
+	var ret strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			ret.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			ret.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			ret.WriteString("[^/]*")
+		case glob[i] == '?':
+			ret.WriteString("[^/]")
+		default:
+			ret.WriteString(regexp.QuoteMeta(string(glob[i])))
+		}
+	}
+	return ret.String()
+}
+
+// NewIgnoreFs returns a filesystem which hides excluded files of the base filesystem
+// Excluded files are neither listed in directories nor opened, so they are never parsed.
+func NewIgnoreFs(base afero.Fs, matcher *IgnoreMatcher) afero.Fs {
+	return &ignoreFs{Fs: base, matcher: matcher}
+}
+
+type ignoreFs struct {
+	afero.Fs
+	matcher *IgnoreMatcher
+}
+
+func (fs *ignoreFs) Open(name string) (afero.File, error) {
+	file, err := fs.Fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if fs.matcher.Match(name, info.IsDir()) {
+		file.Close()
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	if info.IsDir() {
+		return &ignoreDir{File: file, name: name, matcher: fs.matcher}, nil
+	}
+	return file, nil
+}
+
+func (fs *ignoreFs) Stat(name string) (os.FileInfo, error) {
+	info, err := fs.Fs.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if fs.matcher.Match(name, info.IsDir()) {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+	return info, nil
+}
+
+type ignoreDir struct {
+	afero.File
+	name    string
+	matcher *IgnoreMatcher
+}
+
+func (d *ignoreDir) Readdir(count int) ([]os.FileInfo, error) {
+	infos, err := d.File.Readdir(count)
+	ret := []os.FileInfo{}
+	for _, info := range infos {
+		if d.matcher.Match(filepath.Join(d.name, info.Name()), info.IsDir()) {
+			log.Printf("[DEBUG] Ignore `%s`", filepath.Join(d.name, info.Name()))
+			continue
+		}
+		ret = append(ret, info)
+	}
+	return ret, err
+}
+
+func (d *ignoreDir) Readdirnames(count int) ([]string, error) {
+	infos, err := d.Readdir(count)
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.Name()
+	}
+	return names, err
+}
@@ -0,0 +1,104 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func Test_IgnoreMatcher_Match(t *testing.T) {
+	matcher := NewIgnoreMatcher([]string{
+		"# generated files",
+		"*_gen.tf",
+		"vendor/",
+		"/stacks/legacy",
+		"modules/**/examples",
+		"!keep_gen.tf",
+		"",
+	})
+
+	cases := []struct {
+		Path     string
+		IsDir    bool
+		Expected bool
+	}{
+		{Path: "main.tf", Expected: false},
+		{Path: "network_gen.tf", Expected: true},
+		{Path: "stacks/network/network_gen.tf", Expected: true},
+		{Path: "keep_gen.tf", Expected: false},
+		{Path: "vendor", IsDir: true, Expected: true},
+		{Path: "vendor", IsDir: false, Expected: false},
+		{Path: "vendor/module/main.tf", Expected: true},
+		{Path: "stacks/vendor/main.tf", Expected: true},
+		{Path: "stacks/legacy/main.tf", Expected: true},
+		{Path: "other/stacks/legacy/main.tf", Expected: false},
+		{Path: "./stacks/legacy", IsDir: true, Expected: true},
+		{Path: "modules/examples/main.tf", Expected: true},
+		{Path: "modules/vpc/examples/main.tf", Expected: true},
+		{Path: "modules/vpc/main.tf", Expected: false},
+		{Path: ".", IsDir: true, Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := matcher.Match(tc.Path, tc.IsDir); got != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%t, got=%t", tc.Path, tc.Expected, got)
+		}
+	}
+}
+
+func Test_LoadIgnoreMatcher(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	matcher, err := LoadIgnoreMatcher(fs, []string{})
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if !matcher.Empty() {
+		t.Fatal("Expected no patterns without the ignore file")
+	}
+
+	fs.WriteFile(".tflintignore", []byte("# comment\ngenerated/\n"), 0644)
+	matcher, err = LoadIgnoreMatcher(fs, []string{"*_gen.tf"})
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if !matcher.Match("generated/main.tf", false) {
+		t.Fatal("Expected patterns in the ignore file are loaded")
+	}
+	if !matcher.Match("network_gen.tf", false) {
+		t.Fatal("Expected passed patterns are loaded")
+	}
+}
+
+func Test_LoadConfig_withIgnoreFile(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	fs.WriteFile(".tflintignore", []byte("*_gen.tf\n"), 0644)
+	fs.WriteFile("main.tf", []byte(`resource "aws_instance" "web" {}`), 0644)
+	// The file is invalid, but it is never parsed
+	fs.WriteFile("network_gen.tf", []byte(`resource "aws_instance" {`), 0644)
+	fs.WriteFile("vendor/main.tf", []byte(`resource "aws_instance" {`), 0644)
+
+	cfg := EmptyConfig()
+	cfg.Excludes = []string{"vendor/"}
+	loader, err := NewLoader(cfg, WithFS(fs))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	config, err := loader.LoadConfig(".")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if _, exists := config.Module.ManagedResources["aws_instance.web"]; !exists {
+		t.Fatalf("`main.tf` is not loaded: %#v", config.Module.ManagedResources)
+	}
+	if _, exists := loader.Sources()["network_gen.tf"]; exists {
+		t.Fatal("`network_gen.tf` must not be loaded")
+	}
+
+	dirs, err := FindConfigDirs(afero.Afero{Fs: NewIgnoreFs(fs, NewIgnoreMatcher(cfg.Excludes))}, ".")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if len(dirs) != 1 || dirs[0] != "." {
+		t.Fatalf("Unexpected directories: %#v", dirs)
+	}
+}
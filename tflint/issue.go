@@ -1,7 +1,11 @@
 package tflint
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
 	"sort"
+	"strings"
 
 	hcl "github.com/hashicorp/hcl/v2"
 )
@@ -12,6 +16,23 @@ type Issue struct {
 	Message string
 	Range   hcl.Range
 	Callers []hcl.Range
+	// Address is the resource address where the issue is found, such as `module.network.aws_subnet.private`
+	// It is empty when the issue is found outside of resources.
+	Address string
+	Fix     *Fix
+	// Evidence is the result of the API call which caused the issue in deep checking
+	Evidence *Evidence
+	// Aggregated are issues summarized by this issue in the output. It is empty unless issues are aggregated
+	Aggregated Issues
+}
+
+// Evidence represents what was checked against the cloud provider
+type Evidence struct {
+	// Operation is the name of the API action, such as `DescribeSecurityGroups`
+	Operation   string
+	Identifiers []string
+	Region      string
+	AccountID   string
 }
 
 // Issues is an alias for the map of Issue
@@ -26,9 +47,25 @@ const (
 	NOTICE = "Notice"
 )
 
+// Fingerprint returns a stable identifier of the issue
+// It is a hash of the rule name, the resource address (or the file name outside of resources), and the message with normalized whitespace,
+// so it does not change when lines are added or removed. Note that identical issues in the same location share the fingerprint.
+func (i *Issue) Fingerprint() string {
+	location := i.Address
+	if location == "" {
+		location = filepath.ToSlash(i.Range.Filename)
+	}
+	message := strings.Join(strings.Fields(i.Message), " ")
+	key := strings.Join([]string{i.Rule.Name(), location, message}, "\x00")
+
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
 // Sort returns the sorted receiver
+// Issues are ordered by their content only, so the order is the same on any machine.
 func (issues Issues) Sort() Issues {
-	sort.Slice(issues, func(i, j int) bool {
+	sort.SliceStable(issues, func(i, j int) bool {
 		iRange := issues[i].Range
 		jRange := issues[j].Range
 		if iRange.Filename != jRange.Filename {
@@ -46,7 +83,10 @@ func (issues Issues) Sort() Issues {
 		if iRange.End.Column != jRange.End.Column {
 			return iRange.End.Column > jRange.End.Column
 		}
-		return issues[i].Rule.Name() < issues[j].Rule.Name()
+		if issues[i].Rule.Name() != issues[j].Rule.Name() {
+			return issues[i].Rule.Name() < issues[j].Rule.Name()
+		}
+		return issues[i].Message < issues[j].Message
 	})
 	return issues
 }
@@ -127,3 +127,40 @@ func Test_Sort(t *testing.T) {
 		t.Fatalf("Failed: diff=%s", cmp.Diff(got, expected))
 	}
 }
+
+func Test_Fingerprint(t *testing.T) {
+	issue := &Issue{
+		Rule:    &testRule{},
+		Message: "instance type is  invalid",
+		Range: hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 1, Column: 1},
+			End:      hcl.Pos{Line: 1, Column: 2},
+		},
+	}
+	moved := &Issue{
+		Rule:    &testRule{},
+		Message: "instance type is invalid",
+		Range: hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 10, Column: 3},
+			End:      hcl.Pos{Line: 10, Column: 4},
+		},
+	}
+	other := &Issue{
+		Rule:    &testRule{},
+		Message: "instance type is invalid",
+		Range: hcl.Range{
+			Filename: "other.tf",
+			Start:    hcl.Pos{Line: 1, Column: 1},
+			End:      hcl.Pos{Line: 1, Column: 2},
+		},
+	}
+
+	if issue.Fingerprint() != moved.Fingerprint() {
+		t.Fatalf("Fingerprints should be the same regardless of positions: %s, %s", issue.Fingerprint(), moved.Fingerprint())
+	}
+	if issue.Fingerprint() == other.Fingerprint() {
+		t.Fatalf("Fingerprints should be different between files: %s", issue.Fingerprint())
+	}
+}
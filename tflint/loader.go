@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 
 	version "github.com/hashicorp/go-version"
 	hcl "github.com/hashicorp/hcl/v2"
@@ -26,16 +27,74 @@ type AbstractLoader interface {
 	LoadAnnotations(string) (map[string]Annotations, error)
 	LoadValuesFiles(...string) ([]terraform.InputValues, error)
 	Sources() map[string][]byte
+	FS() afero.Afero
+	SyntaxErrors() hcl.Diagnostics
 }
 
 // Loader is a wrapper of Terraform's configload.Loader
+// It is safe for concurrent use. Since the parser cache and the current directory are shared,
+// loading is serialized, and parsed files are reused across calls. Files in a directory are parsed concurrently.
 type Loader struct {
+	mu sync.Mutex
+
 	parser               *configs.Parser
+	parseWorkers         []*configs.Parser
 	fs                   afero.Afero
+	logger               *log.Logger
+	workingDir           string
+	moduleManifestDir    string
+	moduleResolver       configs.ModuleWalker
 	currentDir           string
 	config               *Config
 	moduleSourceVersions map[string][]*version.Version
 	moduleManifest       map[string]*moduleManifest
+	// brokenFiles, brokenSources and syntaxErrors are files skipped due to syntax errors when reporting them as issues
+	brokenFiles   *IgnoreMatcher
+	brokenSources map[string][]byte
+	syntaxErrors  hcl.Diagnostics
+	// cache is the cache of annotations keyed by file contents. nil if `cache_dir` is not set
+	cache *FileCache
+}
+
+// LoaderOption configures a loader created by NewLoader
+type LoaderOption func(*Loader)
+
+// WithFS makes the loader read files from the passed filesystem instead of the OS filesystem
+func WithFS(fs afero.Fs) LoaderOption {
+	return func(l *Loader) {
+		l.fs = afero.Afero{Fs: fs}
+	}
+}
+
+// WithLogger makes the loader write logs to the passed logger instead of the standard logger
+func WithLogger(logger *log.Logger) LoaderOption {
+	return func(l *Loader) {
+		l.logger = logger
+	}
+}
+
+// WithWorkingDir makes the loader resolve relative paths from the passed directory instead of the process working directory
+// Module manifests and values files are also looked up in the directory. File names in sources and diagnostics remain relative.
+func WithWorkingDir(dir string) LoaderOption {
+	return func(l *Loader) {
+		l.workingDir = dir
+	}
+}
+
+// WithModuleManifestDir makes the loader read the module manifest of `terraform init` in the passed directory
+// instead of the working directory. It is useful for loading a configuration initialized in its own directory.
+func WithModuleManifestDir(dir string) LoaderOption {
+	return func(l *Loader) {
+		l.moduleManifestDir = dir
+	}
+}
+
+// WithModuleResolver makes the loader load child modules with the passed walker instead of the module manifest of `terraform init`
+// It is only used when module inspection is enabled.
+func WithModuleResolver(resolver configs.ModuleWalker) LoaderOption {
+	return func(l *Loader) {
+		l.moduleResolver = resolver
+	}
 }
 
 type moduleManifest struct {
@@ -51,21 +110,51 @@ type moduleManifestFile struct {
 }
 
 // NewLoader returns a loader with module manifests
-func NewLoader(fs afero.Afero, cfg *Config) (*Loader, error) {
-	log.Print("[INFO] Initialize new loader")
-
+// By default, it reads files from the OS filesystem relative to the process working directory and writes logs to the standard logger.
+func NewLoader(cfg *Config, opts ...LoaderOption) (*Loader, error) {
 	l := &Loader{
-		parser:               configs.NewParser(fs),
-		fs:                   fs,
+		fs:                   afero.Afero{Fs: afero.NewOsFs()},
+		logger:               log.New(log.Writer(), log.Prefix(), log.Flags()),
 		config:               cfg,
 		moduleSourceVersions: map[string][]*version.Version{},
 		moduleManifest:       map[string]*moduleManifest{},
 	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.workingDir != "" {
+		l.fs = afero.Afero{Fs: afero.NewBasePathFs(l.fs.Fs, l.workingDir)}
+	}
+	matcher, err := LoadIgnoreMatcher(l.fs, cfg.Excludes)
+	if err != nil {
+		l.logger.Printf("[ERROR] %s", err)
+		return nil, err
+	}
+	if !matcher.Empty() {
+		l.fs = afero.Afero{Fs: NewIgnoreFs(l.fs.Fs, matcher)}
+	}
+	if cfg.ReportSyntaxErrors {
+		// Broken files are only hidden from the parser. Other files such as the state are read as usual
+		l.brokenFiles = NewIgnoreMatcher([]string{})
+		l.brokenSources = map[string][]byte{}
+		parserFs := afero.Afero{Fs: NewIgnoreFs(l.fs.Fs, l.brokenFiles)}
+		l.parser = configs.NewParser(parserFs)
+		l.parseWorkers = newParseWorkers(parserFs)
+	} else {
+		l.parser = configs.NewParser(l.fs)
+		l.parseWorkers = newParseWorkers(l.fs)
+	}
+
+	if cfg.CacheDir != "" {
+		l.cache = NewFileCache(cfg.CacheDir)
+	}
+
+	l.logger.Print("[INFO] Initialize new loader")
 
-	if _, err := os.Stat(getTFModuleManifestPath()); !os.IsNotExist(err) {
-		log.Print("[INFO] Module manifest file found. Initializing...")
+	if _, err := l.fs.Stat(l.moduleManifestPath()); !os.IsNotExist(err) {
+		l.logger.Print("[INFO] Module manifest file found. Initializing...")
 		if err := l.initializeModuleManifest(); err != nil {
-			log.Printf("[ERROR] %s", err)
+			l.logger.Printf("[ERROR] %s", err)
 			return nil, err
 		}
 	}
@@ -76,38 +165,56 @@ func NewLoader(fs afero.Afero, cfg *Config) (*Loader, error) {
 // LoadConfig loads Terraform's configurations
 // TODO: Can we use configload.LoadConfig instead?
 func (l *Loader) LoadConfig(dir string) (*configs.Config, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	dir = l.canonicalDir(dir)
 	l.currentDir = dir
-	log.Printf("[INFO] Load configurations under %s", dir)
-	rootMod, diags := l.parser.LoadConfigDir(dir)
+	l.logger.Printf("[INFO] Load configurations under %s", dir)
+	if l.config.ReportSyntaxErrors {
+		l.skipBrokenFiles(dir)
+	}
+	rootMod, diags := l.loadConfigDir(dir)
+	diags = ignoreVariableValidationDiagnostics(ignoreSensitiveArgumentDiagnostics(ignoreRefactoringBlockDiagnostics(diags)))
 	if diags.HasErrors() {
-		log.Printf("[ERROR] %s", diags)
+		l.logger.Printf("[ERROR] %s", diags)
 		return nil, diags
 	}
 
 	if !l.config.Module {
-		log.Print("[INFO] Module inspection is disabled. Building a root module without children...")
+		l.logger.Print("[INFO] Module inspection is disabled. Building a root module without children...")
 		cfg, diags := configs.BuildConfig(rootMod, l.ignoreModuleWalker())
 		if diags.HasErrors() {
 			return nil, diags
 		}
 		return cfg, nil
 	}
-	log.Print("[INFO] Module inspection is enabled. Building a root module with children...")
+	l.logger.Print("[INFO] Module inspection is enabled. Building a root module with children...")
 
-	cfg, diags := configs.BuildConfig(rootMod, l.moduleWalker())
+	walker := l.moduleWalker()
+	if l.moduleResolver != nil {
+		walker = l.resolverWalker()
+	}
+	cfg, diags := configs.BuildConfig(rootMod, walker)
 	if !diags.HasErrors() {
 		return cfg, nil
 	}
 
-	log.Printf("[ERROR] Failed to load modules: %s", diags)
+	l.logger.Printf("[ERROR] Failed to load modules: %s", diags)
 	return nil, diags
 }
 
 // LoadAnnotations load TFLint annotation comments as HCL tokens.
+// If `cache_dir` is set, files whose contents are found in the cache are not lexed again.
 func (l *Loader) LoadAnnotations(dir string) (map[string]Annotations, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	dir = l.canonicalDir(dir)
+
 	primary, override, diags := l.parser.ConfigDirFiles(dir)
 	if diags != nil {
-		log.Printf("[ERROR] %s", diags)
+		l.logger.Printf("[ERROR] %s", diags)
 		return nil, diags
 	}
 	configFiles := append(primary, override...)
@@ -123,11 +230,21 @@ func (l *Loader) LoadAnnotations(dir string) (map[string]Annotations, error) {
 		if err != nil {
 			return nil, err
 		}
+		if l.cache != nil {
+			if annotations, ok := l.cache.Annotations(configFile, src); ok {
+				l.logger.Printf("[DEBUG] Annotations of `%s` found in the cache", configFile)
+				ret[configFile] = annotations
+				continue
+			}
+		}
 		tokens, diags := hclsyntax.LexConfig(src, configFile, hcl.Pos{Byte: 0, Line: 1, Column: 1})
 		if diags.HasErrors() {
 			return nil, diags
 		}
 		ret[configFile] = NewAnnotations(tokens)
+		if l.cache != nil {
+			l.cache.SaveAnnotations(configFile, src, ret[configFile])
+		}
 	}
 
 	return ret, nil
@@ -136,24 +253,37 @@ func (l *Loader) LoadAnnotations(dir string) (map[string]Annotations, error) {
 // LoadValuesFiles reads Terraform's values files and returns terraform.InputValues list in order of priority
 // Pass values ​​files specified from the CLI as the arguments in order of priority
 // This is the responsibility of the caller
+// terraform.tfvars and *.auto.tfvars are loaded from the directory of the last loaded configuration,
+// so each configuration gets its own values even if multiple directories are inspected in one process.
 func (l *Loader) LoadValuesFiles(files ...string) ([]terraform.InputValues, error) {
-	log.Print("[INFO] Load values files")
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.logger.Print("[INFO] Load values files")
 
 	values := []terraform.InputValues{}
 
 	for _, file := range files {
-		if _, err := os.Stat(file); os.IsNotExist(err) {
+		if _, err := l.fs.Stat(file); os.IsNotExist(err) {
 			return values, fmt.Errorf("`%s` is not found", file)
 		}
 	}
 
-	autoLoadFiles, err := l.autoLoadValuesFiles()
+	dir := l.currentDir
+	if dir == "" {
+		dir = "."
+	}
+	autoLoadFiles, err := l.autoLoadValuesFiles(dir)
 	if err != nil {
-		log.Printf("[ERROR] %s", err)
+		l.logger.Printf("[ERROR] %s", err)
 		return nil, err
 	}
-	if _, err := os.Stat(defaultValuesFile); !os.IsNotExist(err) {
-		autoLoadFiles = append([]string{defaultValuesFile}, autoLoadFiles...)
+	// As with Terraform, terraform.tfvars takes the lowest precedence, followed by terraform.tfvars.json
+	if _, err := l.fs.Stat(filepath.Join(dir, defaultValuesJSONFile)); !os.IsNotExist(err) {
+		autoLoadFiles = append([]string{filepath.Join(dir, defaultValuesJSONFile)}, autoLoadFiles...)
+	}
+	if _, err := l.fs.Stat(filepath.Join(dir, defaultValuesFile)); !os.IsNotExist(err) {
+		autoLoadFiles = append([]string{filepath.Join(dir, defaultValuesFile)}, autoLoadFiles...)
 	}
 
 	for _, file := range autoLoadFiles {
@@ -176,14 +306,54 @@ func (l *Loader) LoadValuesFiles(files ...string) ([]terraform.InputValues, erro
 
 // Sources returns the source code cache for the underlying parser of this loader
 func (l *Loader) Sources() map[string][]byte {
-	return l.parser.Sources()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sources := l.parser.Sources()
+	for _, parser := range l.parseWorkers {
+		for filename, src := range parser.Sources() {
+			sources[filename] = src
+		}
+	}
+	for filename, src := range l.brokenSources {
+		sources[filename] = src
+	}
+	return sources
 }
 
-// autoLoadValuesFiles returns all files which match *.auto.tfvars present in the current directory
+// canonicalDir returns the directory relative to the working directory if it is an absolute path in it
+// File names in ranges are derived from the directory, so issues do not depend on how the directory is passed.
+func (l *Loader) canonicalDir(dir string) string {
+	if !filepath.IsAbs(dir) {
+		return filepath.Clean(dir)
+	}
+
+	base := l.workingDir
+	if base == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return dir
+		}
+		base = wd
+	}
+	rel, err := filepath.Rel(base, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return dir
+	}
+	return rel
+}
+
+// FS returns the filesystem which the loader reads files from
+// Other files related to the configuration, such as the state, should be read from it as well.
+func (l *Loader) FS() afero.Afero {
+	return l.fs
+}
+
+// autoLoadValuesFiles returns all files which match *.auto.tfvars present in the passed directory
 // The list is sorted alphabetically. This is equivalent to priority
 // Please note that terraform.tfvars is not included in this list
-func (l *Loader) autoLoadValuesFiles() ([]string, error) {
-	files, err := l.fs.ReadDir(".")
+func (l *Loader) autoLoadValuesFiles(dir string) ([]string, error) {
+	files, err := l.fs.ReadDir(dir)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +365,7 @@ func (l *Loader) autoLoadValuesFiles() ([]string, error) {
 		}
 
 		if strings.HasSuffix(file.Name(), ".auto.tfvars") || strings.HasSuffix(file.Name(), ".auto.tfvars.json") {
-			ret = append(ret, file.Name())
+			ret = append(ret, filepath.Join(dir, file.Name()))
 		}
 	}
 	sort.Strings(ret)
@@ -204,10 +374,10 @@ func (l *Loader) autoLoadValuesFiles() ([]string, error) {
 }
 
 func (l *Loader) loadValuesFile(file string, sourceType terraform.ValueSourceType) (terraform.InputValues, error) {
-	log.Printf("[INFO] Load `%s`", file)
+	l.logger.Printf("[INFO] Load `%s`", file)
 	vals, diags := l.parser.LoadValuesFile(file)
 	if diags.HasErrors() {
-		log.Printf("[ERROR] %s", diags)
+		l.logger.Printf("[ERROR] %s", diags)
 		if diags[0].Subject == nil {
 			// HACK: When Subject is nil, it outputs unintended message, so it replaces with actual file.
 			return nil, errors.New(strings.Replace(diags.Error(), "<nil>: ", fmt.Sprintf("%s: ", file), 1))
@@ -230,7 +400,7 @@ func (l *Loader) moduleWalker() configs.ModuleWalker {
 		key := req.Path.String()
 		record, ok := l.moduleManifest[key]
 		if !ok {
-			log.Printf("[DEBUG] Failed to search by `%s` key.", key)
+			l.logger.Printf("[DEBUG] Failed to search by `%s` key.", key)
 			return nil, nil, hcl.Diagnostics{
 				{
 					Severity: hcl.DiagError,
@@ -245,10 +415,65 @@ func (l *Loader) moduleWalker() configs.ModuleWalker {
 			// If record.Dir is an absolute path, leave it
 			dir = record.Dir
 		}
-		log.Printf("[DEBUG] Trying to load the module: key=%s, version=%s, dir=%s", key, record.VersionStr, dir)
+		l.logger.Printf("[DEBUG] Trying to load the module: key=%s, version=%s, dir=%s", key, record.VersionStr, dir)
 
-		mod, diags := l.parser.LoadConfigDir(dir)
-		return mod, record.Version, diags
+		mod, diags := l.loadConfigDir(dir)
+		return mod, record.Version, ignoreVariableValidationDiagnostics(ignoreSensitiveArgumentDiagnostics(ignoreRefactoringBlockDiagnostics(diags)))
+	})
+}
+
+// resolverWalker wraps the module resolver to ignore diagnostics of the syntax introduced after Terraform v0.12
+// Unlike modules installed by `terraform init`, resolved modules can call each other, so cycles are detected here.
+func (l *Loader) resolverWalker() configs.ModuleWalker {
+	return configs.ModuleWalkerFunc(func(req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+		mod, ver, diags := l.moduleResolver.LoadModule(req)
+		if mod != nil {
+			if cycle := moduleCycle(req, mod.SourceDir); cycle != nil {
+				return nil, nil, hcl.Diagnostics{
+					{
+						Severity: hcl.DiagError,
+						Summary:  fmt.Sprintf("`%s` module calls itself", req.Name),
+						Detail:   fmt.Sprintf("Module cycle: %s", strings.Join(cycle, " -> ")),
+						Subject:  &req.CallRange,
+					},
+				}
+			}
+		}
+		return mod, ver, ignoreVariableValidationDiagnostics(ignoreSensitiveArgumentDiagnostics(ignoreRefactoringBlockDiagnostics(diags)))
+	})
+}
+
+// moduleCycle returns directories of the modules forming a cycle if the directory is already loaded by an ancestor of the request
+func moduleCycle(req *configs.ModuleRequest, dir string) []string {
+	dir = filepath.Clean(dir)
+	cycle := []string{dir}
+	for parent := req.Parent; parent != nil; parent = parent.Parent {
+		if parent.Module == nil {
+			return nil
+		}
+		cycle = append([]string{filepath.Clean(parent.Module.SourceDir)}, cycle...)
+		if filepath.Clean(parent.Module.SourceDir) == dir {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// LocalModuleResolver returns a module resolver which loads modules with local paths directly from the passed filesystem
+// Modules from other sources are skipped. It is useful for loading configurations which are not initialized
+// by `terraform init`, such as examples of a module. Pass it to WithModuleResolver.
+func LocalModuleResolver(fs afero.Afero) configs.ModuleWalker {
+	parser := configs.NewParser(fs)
+	return configs.ModuleWalkerFunc(func(req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+		if !strings.HasPrefix(req.SourceAddr, "./") && !strings.HasPrefix(req.SourceAddr, "../") {
+			log.Printf("[DEBUG] Skip `%s` module because the source is not a local path: %s", req.Name, req.SourceAddr)
+			return nil, nil, nil
+		}
+
+		dir := filepath.Join(req.Parent.Module.SourceDir, req.SourceAddr)
+		log.Printf("[DEBUG] Trying to load the local module: name=%s, dir=%s", req.Name, dir)
+		mod, diags := parser.LoadConfigDir(dir)
+		return mod, nil, diags
 	})
 }
 
@@ -259,11 +484,11 @@ func (l *Loader) ignoreModuleWalker() configs.ModuleWalker {
 }
 
 func (l *Loader) initializeModuleManifest() error {
-	file, err := l.fs.ReadFile(getTFModuleManifestPath())
+	file, err := l.fs.ReadFile(l.moduleManifestPath())
 	if err != nil {
 		return err
 	}
-	log.Printf("[DEBUG] Parsing the module manifest file: %s", file)
+	l.logger.Printf("[DEBUG] Parsing the module manifest file: %s", file)
 
 	var manifestFile moduleManifestFile
 	err = json.Unmarshal(file, &manifestFile)
@@ -284,3 +509,11 @@ func (l *Loader) initializeModuleManifest() error {
 
 	return nil
 }
+
+func (l *Loader) moduleManifestPath() string {
+	path := getTFModuleManifestPath()
+	if l.moduleManifestDir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(l.moduleManifestDir, path)
+}
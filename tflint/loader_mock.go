@@ -6,8 +6,10 @@ package tflint
 
 import (
 	gomock "github.com/golang/mock/gomock"
+	hcl "github.com/hashicorp/hcl/v2"
 	configs "github.com/hashicorp/terraform/configs"
 	terraform "github.com/hashicorp/terraform/terraform"
+	afero "github.com/spf13/afero"
 	reflect "reflect"
 )
 
@@ -96,3 +98,31 @@ func (mr *MockAbstractLoaderMockRecorder) Sources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sources", reflect.TypeOf((*MockAbstractLoader)(nil).Sources))
 }
+
+// FS mocks base method
+func (m *MockAbstractLoader) FS() afero.Afero {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FS")
+	ret0, _ := ret[0].(afero.Afero)
+	return ret0
+}
+
+// FS indicates an expected call of FS
+func (mr *MockAbstractLoaderMockRecorder) FS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FS", reflect.TypeOf((*MockAbstractLoader)(nil).FS))
+}
+
+// SyntaxErrors mocks base method
+func (m *MockAbstractLoader) SyntaxErrors() hcl.Diagnostics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntaxErrors")
+	ret0, _ := ret[0].(hcl.Diagnostics)
+	return ret0
+}
+
+// SyntaxErrors indicates an expected call of SyntaxErrors
+func (mr *MockAbstractLoaderMockRecorder) SyntaxErrors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntaxErrors", reflect.TypeOf((*MockAbstractLoader)(nil).SyntaxErrors))
+}
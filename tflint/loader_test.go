@@ -1,14 +1,24 @@
 package tflint
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	version "github.com/hashicorp/go-version"
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/spf13/afero"
 	"github.com/zclconf/go-cty/cty"
@@ -16,7 +26,7 @@ import (
 
 func Test_LoadConfig_v0_12_0(t *testing.T) {
 	withinFixtureDir(t, "v0.12.0_module", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, moduleConfig())
+		loader, err := NewLoader(moduleConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -85,7 +95,7 @@ func Test_LoadConfig_v0_12_0(t *testing.T) {
 
 func Test_LoadConfig_moduleNotFound(t *testing.T) {
 	withinFixtureDir(t, "before_terraform_init", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, moduleConfig())
+		loader, err := NewLoader(moduleConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -103,7 +113,7 @@ func Test_LoadConfig_moduleNotFound(t *testing.T) {
 
 func Test_LoadConfig_disableModules(t *testing.T) {
 	withinFixtureDir(t, "before_terraform_init", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, EmptyConfig())
+		loader, err := NewLoader(EmptyConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -118,9 +128,243 @@ func Test_LoadConfig_disableModules(t *testing.T) {
 	})
 }
 
+func Test_LoadConfig_jsonSyntax(t *testing.T) {
+	withinFixtureDir(t, "json_syntax", func() {
+		loader, err := NewLoader(EmptyConfig())
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		config, err := loader.LoadConfig(".")
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		for _, addr := range []string{"aws_instance.web", "aws_db_instance.main"} {
+			if _, exists := config.Module.ManagedResources[addr]; !exists {
+				t.Fatalf("`%s` is not loaded: %#v", addr, config.Module.ManagedResources)
+			}
+		}
+		if _, exists := loader.Sources()["database.tf.json"]; !exists {
+			t.Fatalf("`database.tf.json` is not in sources: %#v", loader.Sources())
+		}
+	})
+}
+
+func Test_LoadConfig_absoluteDir(t *testing.T) {
+	withinFixtureDir(t, "", func() {
+		wd, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		loader, err := NewLoader(EmptyConfig())
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		if _, err := loader.LoadConfig(filepath.Join(wd, "json_syntax")); err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		annotations, err := loader.LoadAnnotations(filepath.Join(wd, "json_syntax"))
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		// File names are the same as when the relative path is passed
+		filename := filepath.Join("json_syntax", "database.tf.json")
+		if _, exists := loader.Sources()[filename]; !exists {
+			t.Fatalf("`%s` is not in sources: %#v", filename, loader.Sources())
+		}
+		if _, exists := annotations[filepath.Join("json_syntax", "main.tf")]; !exists {
+			t.Fatalf("`main.tf` is not in annotations: %#v", annotations)
+		}
+	})
+}
+
+func Test_LoadConfig_reportSyntaxErrors(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "main.tf", []byte(`resource "aws_instance" "web" {}`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := afero.WriteFile(fs, "broken.tf", []byte(`resource "aws_instance" "db" {`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := EmptyConfig()
+	cfg.ReportSyntaxErrors = true
+	loader, err := NewLoader(cfg, WithFS(fs))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	config, err := loader.LoadConfig(".")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if _, err := loader.LoadAnnotations("."); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	if _, exists := config.Module.ManagedResources["aws_instance.web"]; !exists {
+		t.Fatalf("Expected `aws_instance.web` to be loaded, but not: %#v", config.Module.ManagedResources)
+	}
+	if _, exists := loader.Sources()["broken.tf"]; !exists {
+		t.Fatalf("`broken.tf` is not in sources: %#v", loader.Sources())
+	}
+
+	runner := TestRunner(t, map[string]string{})
+	runner.EmitSyntaxErrors(loader.SyntaxErrors())
+
+	expected := Issues{
+		{
+			Rule:    &syntaxErrorRule{},
+			Message: "Argument or block definition required; An argument or block definition is required here.",
+			Range: hcl.Range{
+				Filename: "broken.tf",
+				Start:    hcl.Pos{Line: 1, Column: 31},
+				End:      hcl.Pos{Line: 1, Column: 31},
+			},
+		},
+	}
+	AssertIssues(t, expected, runner.Issues)
+}
+
+func Test_LoadConfig_overrideFiles(t *testing.T) {
+	withinFixtureDir(t, "override_files", func() {
+		loader, err := NewLoader(EmptyConfig())
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		config, err := loader.LoadConfig(".")
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+
+		if len(config.Module.ManagedResources) != 1 {
+			t.Fatalf("Override files must be merged into the resource: %#v", config.Module.ManagedResources)
+		}
+		attrs, diags := config.Module.ManagedResources["aws_instance.web"].Config.JustAttributes()
+		if diags.HasErrors() {
+			t.Fatalf("Unexpected error occurred: %s", diags)
+		}
+
+		expected := map[string]string{
+			"ami":           "override.tf.json",
+			"instance_type": "main_override.tf",
+		}
+		for name, filename := range expected {
+			if got := attrs[name].Expr.Range().Filename; got != filename {
+				t.Fatalf("`%s` must be declared in `%s`, but got `%s`", name, filename, got)
+			}
+		}
+	})
+}
+
+func Test_LoadConfig_concurrent(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	dirs := []string{"a", "b", "c", "d"}
+	for _, dir := range dirs {
+		if err := fs.WriteFile(filepath.Join(dir, "main.tf"), []byte(`resource "null_resource" "`+dir+`" {}`), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loader, err := NewLoader(EmptyConfig(), WithFS(fs))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(dirs)*2)
+	for _, dir := range dirs {
+		wg.Add(1)
+		go func(dir string) {
+			defer wg.Done()
+			config, err := loader.LoadConfig(dir)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if _, exists := config.Module.ManagedResources["null_resource."+dir]; !exists {
+				errs <- fmt.Errorf("`null_resource.%s` is not found in `%s`", dir, dir)
+			}
+			loader.Sources()
+		}(dir)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+}
+
+func Test_LoadConfig_parseWorkers(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	files := map[string]string{
+		"main.tf":          `resource "null_resource" "main" {}`,
+		"broken.tf":        `resource "null_resource" "broken" {`,
+		"outputs.tf":       `output "id" { value = null_resource.main.id }`,
+		"variables.tf":     `variable "foo" {}`,
+		"invalid.tf":       `variable "foo" {}`,
+		"main_override.tf": `resource "null_resource" "main" { triggers = {} }`,
+		"override.tf":      `variable "foo" { default = "bar" }`,
+	}
+	for name, src := range files {
+		if err := fs.WriteFile(filepath.Join("module", name), []byte(src), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, expectedDiags := configs.NewParser(fs).LoadConfigDir("module")
+
+	for i := 0; i < 10; i++ {
+		loader, err := NewLoader(EmptyConfig(), WithFS(fs))
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		loader.parseWorkers = newParseWorkers(fs)
+		for len(loader.parseWorkers) < 4 {
+			loader.parseWorkers = append(loader.parseWorkers, configs.NewParser(fs))
+		}
+
+		mod, diags := loader.loadConfigDir("module")
+		if diags.Error() != expectedDiags.Error() {
+			t.Fatalf("Expected diagnostics are `%s`, but got `%s`", expectedDiags, diags)
+		}
+		if mod.SourceDir != "module" {
+			t.Fatalf("Expected source dir is `module`, but got `%s`", mod.SourceDir)
+		}
+		if !cmp.Equal(summarizeModule(expected), summarizeModule(mod)) {
+			t.Fatalf("Failed: diff=%s", cmp.Diff(summarizeModule(expected), summarizeModule(mod)))
+		}
+		if len(loader.Sources()) != len(files) {
+			t.Fatalf("Expected %d sources, but got %d", len(files), len(loader.Sources()))
+		}
+	}
+}
+
+// summarizeModule returns declarations of the module with their ranges for comparison
+func summarizeModule(mod *configs.Module) map[string]string {
+	ret := map[string]string{}
+	for key, resource := range mod.ManagedResources {
+		ret[key] = resource.DeclRange.String()
+		if attrs, diags := resource.Config.JustAttributes(); !diags.HasErrors() {
+			for name, attr := range attrs {
+				ret[key+"."+name] = attr.Range.String()
+			}
+		}
+	}
+	for name, variable := range mod.Variables {
+		ret["var."+name] = variable.DeclRange.String()
+		ret["var."+name+".default"] = variable.Default.GoString()
+	}
+	for name, output := range mod.Outputs {
+		ret["output."+name] = output.DeclRange.String()
+	}
+	return ret
+}
+
 func Test_LoadConfig_invalidConfiguration(t *testing.T) {
 	withinFixtureDir(t, "invalid_configuration", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, EmptyConfig())
+		loader, err := NewLoader(EmptyConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -138,7 +382,7 @@ func Test_LoadConfig_invalidConfiguration(t *testing.T) {
 
 func Test_LoadAnnotations(t *testing.T) {
 	withinFixtureDir(t, "annotation_files", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, EmptyConfig())
+		loader, err := NewLoader(EmptyConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -186,9 +430,57 @@ func Test_LoadAnnotations(t *testing.T) {
 	})
 }
 
+func Test_LoadAnnotations_cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs := afero.NewMemMapFs()
+	src := []byte(`
+resource "aws_instance" "web" {
+  # tflint-ignore: aws_instance_invalid_type
+  instance_type = "t1.2xlarge"
+}`)
+	if err := afero.WriteFile(fs, "main.tf", src, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	config := EmptyConfig()
+	config.CacheDir = dir
+
+	loadAnnotations := func() Annotations {
+		loader, err := NewLoader(config, WithFS(fs))
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		ret, err := loader.LoadAnnotations(".")
+		if err != nil {
+			t.Fatalf("Unexpected error occurred: %s", err)
+		}
+		return ret["main.tf"]
+	}
+
+	lexed := loadAnnotations()
+	if len(lexed) != 1 || lexed[0].Content != "aws_instance_invalid_type" {
+		t.Fatalf("Unexpected annotations: %#v", lexed)
+	}
+
+	// The second loader must use the entry saved by the first loader instead of lexing the file
+	cached := lexed[0]
+	cached.Content = "cached"
+	NewFileCache(dir).SaveAnnotations("main.tf", src, Annotations{cached})
+
+	ret := loadAnnotations()
+	expected := Annotations{cached}
+	if !cmp.Equal(expected, ret) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, ret))
+	}
+}
+
 func Test_LoadValuesFiles(t *testing.T) {
 	withinFixtureDir(t, "values_files", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, EmptyConfig())
+		loader, err := NewLoader(EmptyConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -204,6 +496,12 @@ func Test_LoadValuesFiles(t *testing.T) {
 					SourceType: terraform.ValueFromAutoFile,
 				},
 			},
+			{
+				"default_json": {
+					Value:      cty.StringVal("terraform.tfvars.json"),
+					SourceType: terraform.ValueFromAutoFile,
+				},
+			},
 			{
 				"auto1": {
 					Value:      cty.StringVal("auto1.auto.tfvars"),
@@ -216,6 +514,12 @@ func Test_LoadValuesFiles(t *testing.T) {
 					SourceType: terraform.ValueFromAutoFile,
 				},
 			},
+			{
+				"auto3": {
+					Value:      cty.StringVal("auto3.auto.tfvars.json"),
+					SourceType: terraform.ValueFromAutoFile,
+				},
+			},
 			{
 				"cli1": {
 					Value:      cty.StringVal("cli1.tfvars"),
@@ -236,9 +540,37 @@ func Test_LoadValuesFiles(t *testing.T) {
 	})
 }
 
+func Test_LoadValuesFiles_inspectedDir(t *testing.T) {
+	loader, err := NewLoader(EmptyConfig())
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	dir := filepath.Join("test-fixtures", "values_files")
+	if _, err := loader.LoadConfig(dir); err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	ret, err := loader.LoadValuesFiles()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// Values files are auto-loaded from the inspected directory, not from the current directory
+	expected := []terraform.InputValues{
+		{"default": {Value: cty.StringVal("terraform.tfvars"), SourceType: terraform.ValueFromAutoFile}},
+		{"default_json": {Value: cty.StringVal("terraform.tfvars.json"), SourceType: terraform.ValueFromAutoFile}},
+		{"auto1": {Value: cty.StringVal("auto1.auto.tfvars"), SourceType: terraform.ValueFromAutoFile}},
+		{"auto2": {Value: cty.StringVal("auto2.auto.tfvars"), SourceType: terraform.ValueFromAutoFile}},
+		{"auto3": {Value: cty.StringVal("auto3.auto.tfvars.json"), SourceType: terraform.ValueFromAutoFile}},
+	}
+	if !reflect.DeepEqual(expected, ret) {
+		t.Fatalf("Unexpected input values are received: expected=%#v actual=%#v", expected, ret)
+	}
+}
+
 func Test_LoadValuesFiles_invalidValuesFile(t *testing.T) {
 	withinFixtureDir(t, "invalid_values_files", func() {
-		loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, EmptyConfig())
+		loader, err := NewLoader(EmptyConfig())
 		if err != nil {
 			t.Fatalf("Unexpected error occurred: %s", err)
 		}
@@ -253,3 +585,114 @@ func Test_LoadValuesFiles_invalidValuesFile(t *testing.T) {
 		}
 	})
 }
+
+func Test_NewLoader_withWorkingDirAndLogger(t *testing.T) {
+	var buf bytes.Buffer
+	loader, err := NewLoader(EmptyConfig(), WithWorkingDir(filepath.Join("test-fixtures", "values_files")), WithLogger(log.New(&buf, "", 0)))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	ret, err := loader.LoadValuesFiles("cli1.tfvars")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if len(ret) != 6 {
+		t.Fatalf("Expected 6 values files are loaded, but got %d", len(ret))
+	}
+
+	if !strings.Contains(buf.String(), "[INFO] Initialize new loader") {
+		t.Fatalf("Expected logs are written to the logger, but got `%s`", buf.String())
+	}
+}
+
+func Test_NewLoader_withModuleResolver(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	if err := fs.WriteFile("main.tf", []byte(`module "ec2" { source = "example/ec2/aws" }`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.WriteFile(filepath.Join("modules", "ec2", "main.tf"), []byte(`resource "aws_instance" "main" {}`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := configs.ModuleWalkerFunc(func(req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+		mod, diags := configs.NewParser(fs).LoadConfigDir(filepath.Join("modules", req.Name))
+		return mod, nil, diags
+	})
+
+	loader, err := NewLoader(moduleConfig(), WithFS(fs), WithModuleResolver(resolver))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	config, err := loader.LoadConfig(".")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	child, exists := config.Children["ec2"]
+	if !exists {
+		t.Fatal("Expected `ec2` module is loaded, but not found")
+	}
+	if _, exists := child.Module.ManagedResources["aws_instance.main"]; !exists {
+		t.Fatalf("Expected `aws_instance.main` in the module, but got %#v", child.Module.ManagedResources)
+	}
+}
+
+func Test_NewLoader_withModuleResolver_nestedModules(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	files := map[string]string{
+		"main.tf": `module "network" { source = "./modules/network" }`,
+		filepath.Join("modules", "network", "main.tf"): `module "subnet" { source = "../subnet" }`,
+		filepath.Join("modules", "subnet", "main.tf"):  `resource "aws_subnet" "main" {}`,
+	}
+	for name, src := range files {
+		if err := fs.WriteFile(name, []byte(src), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loader, err := NewLoader(moduleConfig(), WithFS(fs), WithModuleResolver(LocalModuleResolver(fs)))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	config, err := loader.LoadConfig(".")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	subnet := config.DescendentForInstance(addrs.RootModuleInstance.Child("network", addrs.NoKey).Child("subnet", addrs.NoKey))
+	if subnet == nil {
+		t.Fatal("`module.network.module.subnet` is not loaded")
+	}
+	if _, exists := subnet.Module.ManagedResources["aws_subnet.main"]; !exists {
+		t.Fatalf("Resources of the nested module are not loaded: %#v", subnet.Module.ManagedResources)
+	}
+}
+
+func Test_NewLoader_withModuleResolver_cycle(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	files := map[string]string{
+		"main.tf":                                `module "a" { source = "./modules/a" }`,
+		filepath.Join("modules", "a", "main.tf"): `module "b" { source = "../b" }`,
+		filepath.Join("modules", "b", "main.tf"): `module "a" { source = "../a" }`,
+	}
+	for name, src := range files {
+		if err := fs.WriteFile(name, []byte(src), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	loader, err := NewLoader(moduleConfig(), WithFS(fs), WithModuleResolver(LocalModuleResolver(fs)))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	_, err = loader.LoadConfig(".")
+	if err == nil {
+		t.Fatal("Expected an error, but no error occurred")
+	}
+
+	expected := fmt.Sprintf("modules/b/main.tf:1,1-11: `a` module calls itself; Module cycle: %s -> %s -> %s", filepath.Join("modules", "a"), filepath.Join("modules", "b"), filepath.Join("modules", "a"))
+	if err.Error() != expected {
+		t.Fatalf("Expected error is `%s`, but got `%s`", expected, err.Error())
+	}
+}
@@ -0,0 +1,74 @@
+package tflint
+
+import (
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/lang"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// prepareLocalValues evaluates local values declared in all files of the module so that `local.*` references can be evaluated
+// Local values are evaluated in dependency order. Those referring to resources, or to themselves through a cycle, are not evaluable,
+// and expressions referring to them are ignored in the same way as expressions referring to resources.
+func (r *Runner) prepareLocalValues() {
+	r.locals = map[string]bool{}
+
+	names := []string{}
+	for name := range r.TFConfig.Module.Locals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	visiting := map[string]bool{}
+	for _, name := range names {
+		r.evaluateLocalValue(name, visiting)
+	}
+}
+
+// evaluateLocalValue evaluates the local value after its dependencies, and returns whether it is evaluable
+// Local values being evaluated are tracked in `visiting` to detect cycles.
+func (r *Runner) evaluateLocalValue(name string, visiting map[string]bool) bool {
+	if evaluable, evaluated := r.locals[name]; evaluated {
+		return evaluable
+	}
+	local, exists := r.TFConfig.Module.Locals[name]
+	if !exists {
+		return false
+	}
+	if visiting[name] {
+		log.Printf("[WARN] `local.%s` refers to itself through a cycle. TFLint ignores the local value", name)
+		return false
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	refs, diags := lang.ReferencesInExpr(local.Expr)
+	evaluable := !diags.HasErrors()
+	for _, ref := range refs {
+		if dependency, ok := ref.Subject.(addrs.LocalValue); ok {
+			if !r.evaluateLocalValue(dependency.Name, visiting) {
+				evaluable = false
+			}
+			continue
+		}
+		if !r.isEvaluableRef(ref) {
+			evaluable = false
+		}
+	}
+
+	if evaluable {
+		val, diags := r.ctx.EvaluateExpr(local.Expr, cty.DynamicPseudoType, nil)
+		if diags.HasErrors() {
+			log.Printf("[WARN] Failed to evaluate `local.%s`: %s", name, r.redact(diags.Err().Error()))
+			evaluable = false
+		} else {
+			r.ctx.Evaluator.State.SetLocalValue(addrs.LocalValue{Name: name}.Absolute(r.ctx.PathValue), val)
+		}
+	}
+
+	log.Printf("[DEBUG] `local.%s` is evaluable: %t", name, evaluable)
+	r.locals[name] = evaluable
+	return evaluable
+}
@@ -0,0 +1,89 @@
+package tflint
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_prepareLocalValues(t *testing.T) {
+	content := `
+variable "env" {
+  default = "production"
+}
+
+locals {
+  prefix = "web"
+  name   = "${local.prefix}-${var.env}"
+  ami    = aws_ami.ubuntu.id
+  image  = local.ami
+  first  = local.second
+  second = local.first
+}
+
+locals {
+  instance_types = {
+    production = "m5.large"
+  }
+  instance_type = local.instance_types[var.env]
+}
+
+resource "null_resource" "name" {
+  key = local.name
+}
+
+resource "null_resource" "instance_type" {
+  key = local.instance_type
+}
+
+resource "null_resource" "image" {
+  key = local.image
+}
+
+resource "null_resource" "cycle" {
+  key = local.first
+}
+
+resource "null_resource" "undeclared" {
+  key = local.undeclared
+}`
+
+	runner := TestRunner(t, map[string]string{"main.tf": content})
+
+	expected := map[string]bool{
+		"prefix":         true,
+		"name":           true,
+		"ami":            false,
+		"image":          false,
+		"first":          false,
+		"second":         false,
+		"instance_types": true,
+		"instance_type":  true,
+	}
+	for name, evaluable := range expected {
+		if runner.locals[name] != evaluable {
+			t.Fatalf("`local.%s`: expected evaluable is %t, but got %t", name, evaluable, runner.locals[name])
+		}
+	}
+
+	got := []string{}
+	err := runner.WalkResourceAttributes("null_resource", "key", func(attribute *hcl.Attribute) error {
+		var val string
+		err := runner.EvaluateExpr(attribute.Expr, &val)
+		return runner.EnsureNoError(err, func() error {
+			got = append(got, val)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	sort.Strings(got)
+	// Unevaluable local values and undeclared ones are ignored
+	if expected := []string{"m5.large", "web-production"}; !cmp.Equal(expected, got) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got))
+	}
+}
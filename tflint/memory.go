@@ -0,0 +1,43 @@
+package tflint
+
+import (
+	"log"
+	"runtime"
+	"runtime/debug"
+)
+
+// MemoryGuard drops optional caches of runners when the heap of the process exceeds the limit
+// It never aborts the inspection, so a large configuration is inspected more slowly instead of being killed by the OOM killer.
+// It is a soft limit checked between rules. Sources of files are neither dropped nor spilled to temporary files
+// even if the limit is exceeded, because parsed files of the loader and issues refer to the same bytes.
+type MemoryGuard struct {
+	limit    uint64
+	degraded bool
+}
+
+// NewMemoryGuard returns a guard with the limit in MiB. Zero means no limit
+func NewMemoryGuard(limitMiB int) *MemoryGuard {
+	return &MemoryGuard{limit: uint64(limitMiB) * 1024 * 1024}
+}
+
+// Check compares the heap with the limit, and drops caches of the runners if the heap exceeds it
+// It returns true only when caches are dropped by this call. Caches are dropped at most once.
+func (g *MemoryGuard) Check(runners []*Runner) bool {
+	if g.limit == 0 || g.degraded {
+		return false
+	}
+
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	if stats.HeapAlloc <= g.limit {
+		return false
+	}
+
+	log.Printf("[WARN] Heap usage (%d MiB) exceeds the memory limit (%d MiB). Dropping evaluation caches of %d runners", stats.HeapAlloc/1024/1024, g.limit/1024/1024, len(runners))
+	for _, runner := range runners {
+		runner.dropEvalCache()
+	}
+	debug.FreeOSMemory()
+	g.degraded = true
+	return true
+}
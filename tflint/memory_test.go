@@ -0,0 +1,60 @@
+package tflint
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_MemoryGuard_Check(t *testing.T) {
+	content := `
+variable "instance_type" {
+  default = "t2.micro"
+}
+
+resource "aws_instance" "web" {
+  instance_type = var.instance_type
+}`
+	runner := TestRunner(t, map[string]string{"main.tf": content})
+	evaluate := func() {
+		err := runner.WalkResourceAttributes("aws_instance", "instance_type", func(attribute *hcl.Attribute) error {
+			var ret string
+			if err := runner.EvaluateExpr(attribute.Expr, &ret); err != nil {
+				return err
+			}
+			if ret != "t2.micro" {
+				t.Fatalf("Unexpected value: %s", ret)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Failed: `%s` occurred", err)
+		}
+	}
+
+	evaluate()
+	if NewMemoryGuard(0).Check([]*Runner{runner}) {
+		t.Fatal("Expected caches to be kept without the limit")
+	}
+	if len(runner.evalCache) != 1 {
+		t.Fatalf("Unexpected cache: %#v", runner.evalCache)
+	}
+
+	// The heap of the test process always exceeds 1 MiB
+	guard := NewMemoryGuard(1)
+	if !guard.Check([]*Runner{runner}) {
+		t.Fatal("Expected caches to be dropped")
+	}
+	if runner.evalCache != nil {
+		t.Fatalf("Unexpected cache: %#v", runner.evalCache)
+	}
+	if guard.Check([]*Runner{runner}) {
+		t.Fatal("Expected caches to be dropped only once")
+	}
+
+	// Evaluation still works without caches
+	evaluate()
+	if runner.evalCache != nil {
+		t.Fatalf("Unexpected cache: %#v", runner.evalCache)
+	}
+}
@@ -9,3 +9,24 @@ const Version string = "0.15.4"
 func ReferenceLink(name string) string {
 	return fmt.Sprintf("https://github.com/terraform-linters/tflint/blob/v%s/docs/rules/%s.md", Version, name)
 }
+
+// ReferenceIndexLink returns the link to the section of the rule list
+// It is used by generated rules, which have no reference pages of their own.
+func ReferenceIndexLink(section string) string {
+	return fmt.Sprintf("https://github.com/terraform-linters/tflint/blob/v%s/docs/rules/README.md#%s", Version, section)
+}
+
+// RuleDoc is the documentation of a rule printed by `tflint explain`
+// Examples are Terraform configurations. The violating example emits issues and the compliant one does not.
+type RuleDoc struct {
+	// Description is what the rule checks
+	Description string
+	// Rationale is why the rule is worth checking
+	Rationale string
+	// Config is the rule block assumed by the examples. It is empty if the examples assume the default options
+	Config    string
+	Violating string
+	Compliant string
+	// Fix is how to fix issues
+	Fix string
+}
@@ -0,0 +1,151 @@
+package tflint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+)
+
+// MovedBlock is a `moved` block introduced in Terraform v1.1
+// Since the Terraform version bundled with TFLint does not know these blocks, they are parsed from loaded sources.
+// Expressions are nil if the attribute is not set.
+type MovedBlock struct {
+	From      hcl.Expression
+	To        hcl.Expression
+	DeclRange hcl.Range
+}
+
+// ImportBlock is an `import` block introduced in Terraform v1.5
+type ImportBlock struct {
+	To        hcl.Expression
+	ID        hcl.Expression
+	DeclRange hcl.Range
+}
+
+var refactoringBlockSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "moved"},
+		{Type: "import"},
+	},
+}
+
+var movedBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "from"},
+		{Name: "to"},
+	},
+}
+
+var importBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "to"},
+		{Name: "id"},
+		{Name: "provider"},
+	},
+}
+
+// MovedBlocks returns `moved` and `import` blocks declared in the module of the runner
+func (r *Runner) MovedBlocks() ([]*MovedBlock, []*ImportBlock, error) {
+	moved := []*MovedBlock{}
+	imports := []*ImportBlock{}
+
+	files, err := r.moduleFiles()
+	if err != nil {
+		return moved, imports, err
+	}
+
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(refactoringBlockSchema)
+		if diags.HasErrors() {
+			return moved, imports, diags
+		}
+
+		for _, block := range content.Blocks {
+			switch block.Type {
+			case "moved":
+				attrs, diags := block.Body.Content(movedBlockSchema)
+				if diags.HasErrors() {
+					return moved, imports, diags
+				}
+				moved = append(moved, &MovedBlock{
+					From:      attributeExpr(attrs, "from"),
+					To:        attributeExpr(attrs, "to"),
+					DeclRange: block.DefRange,
+				})
+			case "import":
+				attrs, diags := block.Body.Content(importBlockSchema)
+				if diags.HasErrors() {
+					return moved, imports, diags
+				}
+				imports = append(imports, &ImportBlock{
+					To:        attributeExpr(attrs, "to"),
+					ID:        attributeExpr(attrs, "id"),
+					DeclRange: block.DefRange,
+				})
+			}
+		}
+	}
+
+	return moved, imports, nil
+}
+
+func attributeExpr(content *hcl.BodyContent, name string) hcl.Expression {
+	if attr, exists := content.Attributes[name]; exists {
+		return attr.Expr
+	}
+	return nil
+}
+
+// RefactoringAddress returns an address of the resource or module referenced by `from` and `to` in `moved` and `import` blocks
+// The returned address is normalized in the same way as ReferenceIndex. For example, `aws_instance.web[0]` is `aws_instance.web`,
+// and `module.network.aws_vpc.main` is `module.network`.
+func RefactoringAddress(expr hcl.Expression) (string, error) {
+	traversal, diags := hcl.AbsTraversalForExpr(expr)
+	if diags.HasErrors() {
+		return "", diags
+	}
+
+	ref, tfdiags := addrs.ParseRef(traversal)
+	if tfdiags.HasErrors() {
+		return "", tfdiags.Err()
+	}
+	switch ref.Subject.(type) {
+	case addrs.Resource, addrs.ResourceInstance, addrs.ModuleCallInstance, addrs.ModuleCallOutput:
+		return referenceSubject(ref.Subject), nil
+	default:
+		return "", fmt.Errorf("`%s` is not a resource or module address", ref.Subject.String())
+	}
+}
+
+// ignoreRefactoringBlockDiagnostics removes errors of `moved` and `import` blocks from the passed diagnostics
+// These blocks are parsed separately by MovedBlocks, so they should not prevent loading configurations.
+func ignoreRefactoringBlockDiagnostics(diags hcl.Diagnostics) hcl.Diagnostics {
+	var ret hcl.Diagnostics
+	for _, diag := range diags {
+		if isRefactoringBlockDiagnostic(diag) {
+			log.Printf("[DEBUG] Ignore an error of the unsupported block: %s", diag.Error())
+			continue
+		}
+		ret = append(ret, diag)
+	}
+	return ret
+}
+
+// isRefactoringBlockDiagnostic returns whether the passed diagnostic is an error of `moved` or `import` blocks
+// that the bundled Terraform does not support yet
+func isRefactoringBlockDiagnostic(diag *hcl.Diagnostic) bool {
+	for _, blockType := range []string{"moved", "import"} {
+		// HCL native syntax
+		if diag.Summary == "Unsupported block type" && strings.HasPrefix(diag.Detail, fmt.Sprintf("Blocks of type %q are not expected here.", blockType)) {
+			return true
+		}
+		// JSON syntax
+		if diag.Summary == "Extraneous JSON object property" && strings.HasPrefix(diag.Detail, fmt.Sprintf("No argument or block type is named %q.", blockType)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package tflint
+
+import (
+	"testing"
+)
+
+func Test_MovedBlocks(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+resource "aws_instance" "web" {}
+
+moved {
+  from = aws_instance.app[0]
+  to   = aws_instance.web
+}`,
+		"import.tf.json": `
+{
+  "import": {
+    "to": "aws_instance.web",
+    "id": "i-1234567890"
+  }
+}`,
+	})
+
+	moved, imports, err := runner.MovedBlocks()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if len(moved) != 1 || len(imports) != 1 {
+		t.Fatalf("Expected 1 moved block and 1 import block, but got %d and %d", len(moved), len(imports))
+	}
+
+	from, err := RefactoringAddress(moved[0].From)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if from != "aws_instance.app" {
+		t.Fatalf("Expected `aws_instance.app`, but got `%s`", from)
+	}
+}
@@ -0,0 +1,94 @@
+package tflint
+
+import (
+	"hash/fnv"
+	"runtime"
+	"sync"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/spf13/afero"
+)
+
+// maxParseWorkers is the maximum number of files parsed concurrently
+var maxParseWorkers = 8
+
+// newParseWorkers returns parsers of files parsed concurrently
+// The number of parsers is bounded by the number of CPUs.
+func newParseWorkers(fs afero.Afero) []*configs.Parser {
+	n := runtime.GOMAXPROCS(0)
+	if n > maxParseWorkers {
+		n = maxParseWorkers
+	}
+	parsers := make([]*configs.Parser, n)
+	for i := range parsers {
+		parsers[i] = configs.NewParser(fs)
+	}
+	return parsers
+}
+
+// loadConfigDir is the same as Parser.LoadConfigDir, except that files are parsed concurrently
+// The parser is not safe for concurrent use, so each file is parsed by the parser of a worker determined by the file name.
+// Since a file is always parsed by the same parser, parsed files are reused across calls as with a single parser.
+// Files and diagnostics are in the same order as Parser.LoadConfigDir regardless of which worker finishes first.
+func (l *Loader) loadConfigDir(dir string) (*configs.Module, hcl.Diagnostics) {
+	primaryPaths, overridePaths, diags := l.parser.ConfigDirFiles(dir)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	paths := append(primaryPaths, overridePaths...)
+
+	files := make([]*configs.File, len(paths))
+	fileDiags := make([]hcl.Diagnostics, len(paths))
+	queues := make([][]int, len(l.parseWorkers))
+	for i, path := range paths {
+		worker := parseWorkerOf(path, len(l.parseWorkers))
+		queues[worker] = append(queues[worker], i)
+	}
+
+	var wg sync.WaitGroup
+	for worker, queue := range queues {
+		if len(queue) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(parser *configs.Parser, queue []int) {
+			defer wg.Done()
+			for _, i := range queue {
+				if i < len(primaryPaths) {
+					files[i], fileDiags[i] = parser.LoadConfigFile(paths[i])
+				} else {
+					files[i], fileDiags[i] = parser.LoadConfigFileOverride(paths[i])
+				}
+			}
+		}(l.parseWorkers[worker], queue)
+	}
+	wg.Wait()
+
+	primary := []*configs.File{}
+	override := []*configs.File{}
+	for i, file := range files {
+		diags = append(diags, fileDiags[i]...)
+		if file == nil {
+			continue
+		}
+		if i < len(primaryPaths) {
+			primary = append(primary, file)
+		} else {
+			override = append(override, file)
+		}
+	}
+
+	mod, modDiags := configs.NewModule(primary, override)
+	diags = append(diags, modDiags...)
+	mod.SourceDir = dir
+
+	return mod, diags
+}
+
+// parseWorkerOf returns the index of the worker parsing the file
+func parseWorkerOf(path string, workers int) int {
+	h := fnv.New32a()
+	h.Write([]byte(path))
+	return int(h.Sum32() % uint32(workers))
+}
@@ -0,0 +1,164 @@
+package tflint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// plan is the machine-readable plan printed by `terraform show -json`
+// See https://www.terraform.io/docs/internals/json-format.html
+type plan struct {
+	FormatVersion string `json:"format_version"`
+	PlannedValues struct {
+		RootModule planModule `json:"root_module"`
+	} `json:"planned_values"`
+}
+
+type planModule struct {
+	Address      string         `json:"address"`
+	Resources    []planResource `json:"resources"`
+	ChildModules []planModule   `json:"child_modules"`
+}
+
+type planResource struct {
+	Address string                 `json:"address"`
+	Mode    string                 `json:"mode"`
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Values  map[string]interface{} `json:"values"`
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// NewPlanFs converts planned values in the JSON plan into a configuration in an in-memory filesystem
+// Every resource instance, including instances in child modules, is written as a resource in the JSON
+// configuration syntax in the current directory, so rules check values after interpolation and module expansion.
+// Unknown values are omitted from planned values, so they are not checked.
+func NewPlanFs(filename string) (afero.Fs, error) {
+	log.Printf("[INFO] Load plan: %s", filename)
+
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var p plan
+	if err := json.Unmarshal(src, &p); err != nil {
+		return nil, fmt.Errorf("Failed to parse `%s`: %s", filename, err)
+	}
+	if p.FormatVersion == "" {
+		return nil, fmt.Errorf("`%s` is not a JSON plan. Run `terraform show -json` to print it", filename)
+	}
+
+	config, err := planConfig(p.PlannedValues.RootModule)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, planConfigFilename(filename), config, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// planConfigFilename returns the name of the configuration file converted from the plan
+func planConfigFilename(filename string) string {
+	return strings.TrimSuffix(filepath.Base(filename), ".json") + ".tf.json"
+}
+
+func planConfig(root planModule) ([]byte, error) {
+	blocks := map[string]map[string]map[string]interface{}{}
+	var walk func(module planModule) error
+	walk = func(module planModule) error {
+		for _, resource := range module.Resources {
+			blockType := "resource"
+			if resource.Mode == "data" {
+				blockType = "data"
+			}
+			if _, exists := blocks[blockType]; !exists {
+				blocks[blockType] = map[string]map[string]interface{}{}
+			}
+			if _, exists := blocks[blockType][resource.Type]; !exists {
+				blocks[blockType][resource.Type] = map[string]interface{}{}
+			}
+
+			name := planResourceName(resource)
+			if name == "" {
+				return fmt.Errorf("Failed to convert `%s` in the plan", resource.Address)
+			}
+			unique := name
+			for i := 2; blocks[blockType][resource.Type][unique] != nil; i++ {
+				unique = fmt.Sprintf("%s_%d", name, i)
+			}
+			log.Printf("[DEBUG] Convert `%s` in the plan to `%s.%s`", resource.Address, resource.Type, unique)
+
+			values := escapePlanValue(resource.Values)
+			if values == nil {
+				values = map[string]interface{}{}
+			}
+			blocks[blockType][resource.Type][unique] = values
+		}
+		for _, child := range module.ChildModules {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+
+	if len(blocks) == 0 {
+		return nil, errors.New("No planned resources found")
+	}
+	return json.MarshalIndent(blocks, "", "  ")
+}
+
+// planResourceName returns a resource name derived from the address
+// Module paths and instance keys are included in the name, such as `module_vpc_this_0` for `module.vpc.aws_subnet.this[0]`.
+func planResourceName(resource planResource) string {
+	name := strings.Replace(resource.Address, resource.Type+".", "", 1)
+	if resource.Mode == "data" {
+		name = strings.Replace(name, "data.", "", 1)
+	}
+	return strings.Trim(invalidNameChars.ReplaceAllString(name, "_"), "_")
+}
+
+// escapePlanValue escapes template sequences in strings and removes null values
+// In the JSON configuration syntax, strings are interpreted as templates, but planned values are literals.
+func escapePlanValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(v)
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i, item := range v {
+			ret[i] = escapePlanValue(item)
+		}
+		return ret
+	case map[string]interface{}:
+		if v == nil {
+			return nil
+		}
+		ret := map[string]interface{}{}
+		for key, item := range v {
+			if item == nil {
+				continue
+			}
+			ret[key] = escapePlanValue(item)
+		}
+		return ret
+	default:
+		return v
+	}
+}
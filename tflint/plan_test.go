@@ -0,0 +1,124 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func Test_NewPlanFs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-plan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	planFile := filepath.Join(dir, "plan.json")
+	plan := `{
+  "format_version": "0.1",
+  "planned_values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_instance.web[0]",
+          "mode": "managed",
+          "type": "aws_instance",
+          "name": "web",
+          "index": 0,
+          "values": {
+            "instance_type": "t2.micro",
+            "user_data": "echo ${HOME}",
+            "key_name": null
+          }
+        }
+      ],
+      "child_modules": [
+        {
+          "address": "module.vpc",
+          "resources": [
+            {
+              "address": "module.vpc.data.aws_ami.ubuntu",
+              "mode": "data",
+              "type": "aws_ami",
+              "name": "ubuntu",
+              "values": {
+                "tags": {"Name": "ubuntu"}
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+	if err := ioutil.WriteFile(planFile, []byte(plan), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewPlanFs(planFile)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	src, err := afero.ReadFile(fs, "plan.tf.json")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := `{
+  "data": {
+    "aws_ami": {
+      "module_vpc_ubuntu": {
+        "tags": {
+          "Name": "ubuntu"
+        }
+      }
+    }
+  },
+  "resource": {
+    "aws_instance": {
+      "web_0": {
+        "instance_type": "t2.micro",
+        "user_data": "echo $${HOME}"
+      }
+    }
+  }
+}`
+	if !cmp.Equal(expected, string(src)) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, string(src)))
+	}
+
+	loader, err := NewLoader(EmptyConfig(), WithFS(fs))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	config, err := loader.LoadConfig(".")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if _, exists := config.Module.ManagedResources["aws_instance.web_0"]; !exists {
+		t.Fatalf("Expected `aws_instance.web_0` to be loaded, but not: %#v", config.Module.ManagedResources)
+	}
+}
+
+func Test_NewPlanFs_notPlan(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tflint-plan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stateFile := filepath.Join(dir, "terraform.tfstate")
+	if err := ioutil.WriteFile(stateFile, []byte(`{"version": 4}`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewPlanFs(stateFile)
+	expected := "`" + stateFile + "` is not a JSON plan. Run `terraform show -json` to print it"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error `%s`, but got %v", expected, err)
+	}
+}
@@ -2,10 +2,12 @@ package tflint
 
 import (
 	"log"
+	"os"
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/terraform-linters/tflint/client"
 )
 
 // ProviderConfig represents a provider block with an eval context (runner)
@@ -85,3 +87,138 @@ func (p *ProviderConfig) GetBlock(key string, schema *configschema.Block) (map[s
 	}
 	return val, true, nil
 }
+
+// AwsRegion returns the AWS region where resources will be created
+// If it cannot be determined statically, it returns an empty string
+func (r *Runner) AwsRegion() string {
+	return r.awsRegion
+}
+
+// AwsRegionAt returns the AWS region of the provider for the resource or data source containing the passed range
+// Resources of alias providers are created in the regions of the aliases. Otherwise, it returns the default region.
+func (r *Runner) AwsRegionAt(rng hcl.Range) string {
+	if resource := r.resourceAt(rng); resource != nil {
+		if region, exists := r.awsRegions[resource.ProviderConfigAddr().Alias]; exists {
+			return region
+		}
+	}
+	return r.awsRegion
+}
+
+// resolveAwsRegion determines the region in the same order of precedence as the AWS client:
+// TFLint config, `aws` provider block, and environment variables
+func resolveAwsRegion(c *Config, runner *Runner) string {
+	if c.AwsCredentials.Region != "" {
+		return c.AwsCredentials.Region
+	}
+
+	if region := providerRegion(runner, runner.TFConfig.Module.ProviderConfigs["aws"]); region != "" {
+		return region
+	}
+
+	for _, env := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if region := os.Getenv(env); region != "" {
+			return region
+		}
+	}
+	return ""
+}
+
+// resolveAwsAliasRegions determines regions of alias providers, keyed by aliases
+// The region of the account of the same name takes precedence over the provider block.
+// Aliases without regions are omitted, and their resources are regarded as in the default region.
+func resolveAwsAliasRegions(c *Config, runner *Runner) map[string]string {
+	regions := map[string]string{}
+	for _, provider := range runner.TFConfig.Module.ProviderConfigs {
+		if provider.Name != "aws" || provider.Alias == "" {
+			continue
+		}
+		if account, exists := c.Accounts[provider.Alias]; exists && account.Region != "" {
+			regions[provider.Alias] = account.Region
+			continue
+		}
+		if region := providerRegion(runner, provider); region != "" {
+			regions[provider.Alias] = region
+		}
+	}
+	return regions
+}
+
+// providerRegion returns the region declared in the provider block
+// If it is not declared or cannot be evaluated, it returns an empty string
+func providerRegion(runner *Runner, provider *configs.Provider) string {
+	providerConfig, err := NewProviderConfig(provider, runner, client.AwsProviderBlockSchema)
+	if err != nil {
+		log.Printf("[WARN] Failed to load the provider config: %s", err)
+		return ""
+	}
+	region, exists, err := providerConfig.Get("region")
+	if err != nil {
+		log.Printf("[WARN] Failed to evaluate the region: %s", runner.redact(err.Error()))
+		return ""
+	}
+	if !exists {
+		return ""
+	}
+	return region
+}
+
+// newAwsClients returns clients for the default `aws` provider and each alias provider of the root module
+// The default provider is checked with the account of the module directory, and alias providers are checked with
+// the accounts of the same names. Credentials of accounts take precedence over provider blocks.
+func newAwsClients(c *Config, runner *Runner) (*client.AwsClient, map[string]*client.AwsClient, error) {
+	module := runner.TFConfig.Module
+
+	account := c.DirectoryAccount(module.SourceDir)
+	if account != nil {
+		log.Printf("[INFO] Use `%s` account for `%s`", account.Name, module.SourceDir)
+	}
+	defaultClient, err := newAwsClient(c, runner, module.ProviderConfigs["aws"], account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clients := map[string]*client.AwsClient{}
+	for _, provider := range module.ProviderConfigs {
+		if provider.Name != "aws" || provider.Alias == "" {
+			continue
+		}
+		log.Printf("[INFO] Initialize AWS client for `aws.%s` provider", provider.Alias)
+		clients[provider.Alias], err = newAwsClient(c, runner, provider, c.Accounts[provider.Alias])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return defaultClient, clients, nil
+}
+
+func newAwsClient(c *Config, runner *Runner, provider *configs.Provider, account *AccountConfig) (*client.AwsClient, error) {
+	providerConfig, err := NewProviderConfig(provider, runner, client.AwsProviderBlockSchema)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := client.ConvertToCredentials(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	creds = c.AwsCredentials.Merge(creds)
+	if account != nil {
+		creds = creds.Merge(account.Credentials())
+	}
+	if runner.cache == nil {
+		return client.NewAwsClient(creds)
+	}
+
+	if cached, exists := runner.cache.awsClients[creds]; exists {
+		log.Printf("[INFO] Reuse the AWS client initialized for another root module")
+		return cached, nil
+	}
+	ret, err := client.NewAwsClient(creds)
+	if err != nil {
+		return nil, err
+	}
+	runner.cache.awsClients[creds] = ret
+	return ret, nil
+}
@@ -0,0 +1,43 @@
+package tflint
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// FindConfigDirs returns directories containing Terraform configuration files under the passed directory, including itself
+// Hidden directories such as `.terraform` are skipped because they contain installed modules rather than configurations.
+// The returned directories are sorted so that the inspection order is stable. Directories linked by symlinks are
+// also searched, but a directory linked from several places is returned only once.
+func FindConfigDirs(fs afero.Afero, dir string) ([]string, error) {
+	found := map[string]bool{}
+	err := WalkFollowingSymlinks(fs, dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ".tf") || strings.HasSuffix(path, ".tf.json") {
+			found[filepath.Dir(path)] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := []string{}
+	for d := range found {
+		dirs = append(dirs, d)
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
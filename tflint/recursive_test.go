@@ -0,0 +1,62 @@
+package tflint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func Test_FindConfigDirs(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	files := []string{
+		"main.tf",
+		"stacks/network/main.tf",
+		"stacks/network/README.md",
+		"stacks/database/main.tf.json",
+		"stacks/database/.terraform/modules/rds/main.tf",
+		"stacks/empty/README.md",
+		".git/hooks/main.tf",
+	}
+	for _, file := range files {
+		if err := fs.WriteFile(filepath.FromSlash(file), []byte{}, os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Dir      string
+		Expected []string
+	}{
+		{
+			Name: "current directory",
+			Dir:  ".",
+			Expected: []string{
+				".",
+				filepath.Join("stacks", "database"),
+				filepath.Join("stacks", "network"),
+			},
+		},
+		{
+			Name: "subdirectory",
+			Dir:  "stacks",
+			Expected: []string{
+				filepath.Join("stacks", "database"),
+				filepath.Join("stacks", "network"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		dirs, err := FindConfigDirs(fs, tc.Dir)
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if !cmp.Equal(tc.Expected, dirs) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, dirs))
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package tflint
+
+import (
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+)
+
+// ReferenceIndex maps identifiers in a module to their definitions and reference sites
+// Identifiers are represented as Terraform addresses such as `var.foo`, `local.bar`, `aws_instance.web`,
+// `data.aws_ami.ubuntu`, `module.network`, and `output.id`. References to resource instances (`aws_instance.web[0]`)
+// and module outputs (`module.network.vpc_id`) are indexed under the resource and the module call.
+type ReferenceIndex struct {
+	Definitions map[string]hcl.Range
+	References  map[string][]hcl.Range
+	// Dependencies maps identifiers to identifiers referenced in their expressions, except for `depends_on`
+	Dependencies map[string][]string
+
+	// dir is the directory of the module, where the local state is read from
+	dir string
+}
+
+// NewReferenceIndex builds a reference index of the passed module
+// Only expressions in HCL native syntax are indexed. References in JSON syntax are not detected.
+func NewReferenceIndex(module *configs.Module) *ReferenceIndex {
+	index := &ReferenceIndex{
+		Definitions:  map[string]hcl.Range{},
+		References:   map[string][]hcl.Range{},
+		Dependencies: map[string][]string{},
+		dir:          module.SourceDir,
+	}
+
+	for name, variable := range module.Variables {
+		index.Definitions["var."+name] = variable.DeclRange
+	}
+	for name, local := range module.Locals {
+		index.Definitions["local."+name] = local.DeclRange
+		index.addReferences(local.Expr)
+		index.addDependencies("local."+name, local.Expr)
+	}
+	for name, output := range module.Outputs {
+		index.Definitions["output."+name] = output.DeclRange
+		index.addReferences(output.Expr)
+		index.addDependencies("output."+name, output.Expr)
+		for _, traversal := range output.DependsOn {
+			index.addTraversal(traversal)
+		}
+	}
+	for name, call := range module.ModuleCalls {
+		index.Definitions["module."+name] = call.DeclRange
+		index.addReferences(call.Config)
+		index.addDependencies("module."+name, call.Config)
+	}
+	for _, resource := range module.ManagedResources {
+		index.Definitions[resource.Addr().String()] = resource.DeclRange
+		index.addReferences(resource.Config)
+		index.addDependencies(resource.Addr().String(), resource.Config)
+	}
+	for _, resource := range module.DataResources {
+		index.Definitions[resource.Addr().String()] = resource.DeclRange
+		index.addReferences(resource.Config)
+		index.addDependencies(resource.Addr().String(), resource.Config)
+	}
+	for _, provider := range module.ProviderConfigs {
+		index.addReferences(provider.Config)
+	}
+
+	for _, refs := range index.References {
+		sort.Slice(refs, func(i, j int) bool {
+			if refs[i].Filename != refs[j].Filename {
+				return refs[i].Filename < refs[j].Filename
+			}
+			return refs[i].Start.Byte < refs[j].Start.Byte
+		})
+	}
+
+	return index
+}
+
+// ReferenceIndex returns a reference index of the module of the runner
+func (r *Runner) ReferenceIndex() *ReferenceIndex {
+	return NewReferenceIndex(r.TFConfig.Module)
+}
+
+// Unreferenced returns sorted identifiers that are defined but never referenced
+// Outputs are always excluded because they are referenced from outside of the module.
+func (i *ReferenceIndex) Unreferenced() []string {
+	ret := []string{}
+	for subject := range i.Definitions {
+		if _, ok := i.References[subject]; ok {
+			continue
+		}
+		if strings.HasPrefix(subject, "output.") {
+			continue
+		}
+		ret = append(ret, subject)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// DependsOn returns whether the first identifier depends on the second one implicitly
+// Dependencies are followed transitively, so a resource referring to a local value which refers to another resource depends on it.
+func (i *ReferenceIndex) DependsOn(from string, to string) bool {
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dep := range i.Dependencies[current] {
+			if dep == to {
+				return true
+			}
+			if !visited[dep] {
+				visited[dep] = true
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return false
+}
+
+// addReferences walks the passed node (hcl.Expression or hcl.Body) and records all references in it
+// Since the walk is based on the syntax tree, bodies are walked including attributes hidden by partial decoding,
+// such as `count` and `depends_on` in resources.
+func (i *ReferenceIndex) addReferences(node interface{}) {
+	var syntaxNode hclsyntax.Node
+	switch n := node.(type) {
+	case *hclsyntax.Body:
+		syntaxNode = n
+	case hclsyntax.Expression:
+		syntaxNode = n
+	default:
+		return
+	}
+
+	hclsyntax.VisitAll(syntaxNode, func(n hclsyntax.Node) hcl.Diagnostics {
+		if expr, ok := n.(*hclsyntax.ScopeTraversalExpr); ok {
+			i.addTraversal(expr.Traversal)
+		}
+		return nil
+	})
+}
+
+// addDependencies records identifiers referenced in the passed node (hcl.Expression or hcl.Body) as dependencies of the identifier
+// Top-level `depends_on` in bodies is skipped because it is an explicit dependency.
+func (i *ReferenceIndex) addDependencies(from string, node interface{}) {
+	nodes := []hclsyntax.Node{}
+	switch n := node.(type) {
+	case *hclsyntax.Body:
+		for name, attr := range n.Attributes {
+			if name != "depends_on" {
+				nodes = append(nodes, attr.Expr)
+			}
+		}
+		for _, block := range n.Blocks {
+			nodes = append(nodes, block.Body)
+		}
+	case hclsyntax.Expression:
+		nodes = append(nodes, n)
+	default:
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, node := range nodes {
+		hclsyntax.VisitAll(node, func(n hclsyntax.Node) hcl.Diagnostics {
+			expr, ok := n.(*hclsyntax.ScopeTraversalExpr)
+			if !ok {
+				return nil
+			}
+			ref, diags := addrs.ParseRef(expr.Traversal)
+			if diags.HasErrors() {
+				return nil
+			}
+			if subject := referenceSubject(ref.Subject); subject != "" && !seen[subject] {
+				seen[subject] = true
+				i.Dependencies[from] = append(i.Dependencies[from], subject)
+			}
+			return nil
+		})
+	}
+}
+
+func (i *ReferenceIndex) addTraversal(traversal hcl.Traversal) {
+	ref, diags := addrs.ParseRef(traversal)
+	if diags.HasErrors() {
+		return
+	}
+
+	subject := referenceSubject(ref.Subject)
+	if subject == "" {
+		return
+	}
+	i.References[subject] = append(i.References[subject], ref.SourceRange.ToHCL())
+}
+
+// referenceSubject returns an identifier of the referenced object
+// Objects that cannot be defined in the module, such as `count.index` and `path.module`, are ignored.
+func referenceSubject(subject addrs.Referenceable) string {
+	switch s := subject.(type) {
+	case addrs.InputVariable:
+		return s.String()
+	case addrs.LocalValue:
+		return s.String()
+	case addrs.Resource:
+		return s.String()
+	case addrs.ResourceInstance:
+		return s.ContainingResource().String()
+	case addrs.ModuleCallInstance:
+		return s.Call.String()
+	case addrs.ModuleCallOutput:
+		return s.Call.Call.String()
+	default:
+		return ""
+	}
+}
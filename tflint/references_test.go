@@ -0,0 +1,122 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func Test_ReferenceIndex(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "instance_type" {}
+variable "unused" {}
+
+locals {
+  name = "web-${var.instance_type}"
+}
+
+resource "aws_instance" "web" {
+  count         = 2
+  instance_type = var.instance_type
+  tags = {
+    Name = local.name
+  }
+}
+
+module "network" {
+  source = "./network"
+  name   = local.name
+}
+
+output "ids" {
+  value = aws_instance.web[*].id
+}
+
+output "vpc" {
+  value      = module.network.vpc_id
+  depends_on = [aws_instance.web]
+}`,
+		"network/main.tf": `output "vpc_id" { value = "vpc-12345678" }`,
+	})
+
+	index := runner.ReferenceIndex()
+
+	expectedDefinitions := map[string]hcl.Range{
+		"var.instance_type": {Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 1}, End: hcl.Pos{Line: 2, Column: 25}},
+		"var.unused":        {Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 1}, End: hcl.Pos{Line: 3, Column: 18}},
+		"local.name":        {Filename: "main.tf", Start: hcl.Pos{Line: 6, Column: 3}, End: hcl.Pos{Line: 6, Column: 36}},
+		"aws_instance.web":  {Filename: "main.tf", Start: hcl.Pos{Line: 9, Column: 1}, End: hcl.Pos{Line: 9, Column: 30}},
+		"module.network":    {Filename: "main.tf", Start: hcl.Pos{Line: 17, Column: 1}, End: hcl.Pos{Line: 17, Column: 17}},
+		"output.ids":        {Filename: "main.tf", Start: hcl.Pos{Line: 22, Column: 1}, End: hcl.Pos{Line: 22, Column: 13}},
+		"output.vpc":        {Filename: "main.tf", Start: hcl.Pos{Line: 26, Column: 1}, End: hcl.Pos{Line: 26, Column: 13}},
+	}
+	opt := cmpopts.IgnoreFields(hcl.Pos{}, "Byte")
+	if !cmp.Equal(expectedDefinitions, index.Definitions, opt) {
+		t.Fatalf("Failed test: diff: %s", cmp.Diff(expectedDefinitions, index.Definitions, opt))
+	}
+
+	expectedReferences := map[string][]int{
+		"var.instance_type": {6, 11},
+		"local.name":        {13, 19},
+		"aws_instance.web":  {23, 28},
+		"module.network":    {27},
+	}
+	got := map[string][]int{}
+	for subject, refs := range index.References {
+		for _, ref := range refs {
+			got[subject] = append(got[subject], ref.Start.Line)
+		}
+	}
+	if !cmp.Equal(expectedReferences, got) {
+		t.Fatalf("Failed test: diff: %s", cmp.Diff(expectedReferences, got))
+	}
+
+	expectedUnreferenced := []string{"var.unused"}
+	if !cmp.Equal(expectedUnreferenced, index.Unreferenced()) {
+		t.Fatalf("Failed test: diff: %s", cmp.Diff(expectedUnreferenced, index.Unreferenced()))
+	}
+}
+
+func Test_ReferenceIndex_DependsOn(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+resource "aws_vpc" "main" {}
+
+locals {
+  vpc_id = aws_vpc.main.id
+}
+
+resource "aws_subnet" "main" {
+  vpc_id = local.vpc_id
+}
+
+resource "aws_instance" "web" {
+  subnet_id  = aws_subnet.main.id
+  depends_on = [aws_s3_bucket.main]
+}
+
+resource "aws_s3_bucket" "main" {}`,
+	})
+
+	cases := []struct {
+		From     string
+		To       string
+		Expected bool
+	}{
+		{From: "aws_subnet.main", To: "local.vpc_id", Expected: true},
+		{From: "aws_subnet.main", To: "aws_vpc.main", Expected: true},
+		{From: "aws_instance.web", To: "aws_vpc.main", Expected: true},
+		{From: "aws_instance.web", To: "aws_s3_bucket.main", Expected: false},
+		{From: "aws_vpc.main", To: "aws_instance.web", Expected: false},
+	}
+
+	index := runner.ReferenceIndex()
+	for _, tc := range cases {
+		if got := index.DependsOn(tc.From, tc.To); got != tc.Expected {
+			t.Fatalf("Failed test: expected `%s` depends on `%s` is %t, but got %t", tc.From, tc.To, tc.Expected, got)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package tflint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	getter "github.com/hashicorp/go-getter"
+	version "github.com/hashicorp/go-version"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/registry"
+	"github.com/hashicorp/terraform/registry/regsrc"
+	"github.com/hashicorp/terraform/registry/response"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/afero"
+)
+
+// ModuleCacheDir is the directory where downloaded modules are cached
+var ModuleCacheDir = "~/.tflint.d/modules"
+
+// RemoteModuleResolver returns a module resolver which downloads registry modules and Git repositories into the cache directory by itself
+// It allows inspecting module calls without `terraform init`. Modules with local paths are loaded from the passed filesystem,
+// or from the cache if they are called by a downloaded module. Modules with other sources, such as S3 buckets, are ignored.
+func RemoteModuleResolver(ctx context.Context, fs afero.Afero) configs.ModuleWalker {
+	return remoteModuleResolver(ctx, fs, ModuleCacheDir, registry.NewClient(nil, nil))
+}
+
+func remoteModuleResolver(ctx context.Context, fs afero.Afero, cacheDir string, client *registry.Client) configs.ModuleWalker {
+	parser := configs.NewParser(fs)
+	cacheParser := configs.NewParser(afero.NewOsFs())
+
+	return configs.ModuleWalkerFunc(func(req *configs.ModuleRequest) (*configs.Module, *version.Version, hcl.Diagnostics) {
+		cache, err := homedir.Expand(cacheDir)
+		if err != nil {
+			return nil, nil, moduleDiagnostics(req, "Failed to resolve the module cache", err)
+		}
+
+		if strings.HasPrefix(req.SourceAddr, "./") || strings.HasPrefix(req.SourceAddr, "../") {
+			dir := filepath.Join(req.Parent.Module.SourceDir, req.SourceAddr)
+			if strings.HasPrefix(req.Parent.Module.SourceDir, cache) {
+				log.Printf("[DEBUG] Trying to load the local module in the cache: name=%s, dir=%s", req.Name, dir)
+				mod, diags := cacheParser.LoadConfigDir(dir)
+				return mod, nil, diags
+			}
+			log.Printf("[DEBUG] Trying to load the local module: name=%s, dir=%s", req.Name, dir)
+			mod, diags := parser.LoadConfigDir(dir)
+			return mod, nil, diags
+		}
+
+		if source, err := regsrc.ParseModuleSource(req.SourceAddr); err == nil {
+			if ctx.Err() != nil {
+				return nil, nil, moduleDiagnostics(req, "Interrupted the resolution", errors.New("The inspection was interrupted"))
+			}
+			dir, ver, diags := resolveRegistryModule(ctx, req, source, cache, client)
+			if diags.HasErrors() {
+				return nil, nil, diags
+			}
+			log.Printf("[DEBUG] Trying to load the registry module: name=%s, dir=%s", req.Name, dir)
+			mod, diags := cacheParser.LoadConfigDir(dir)
+			return mod, ver, diags
+		}
+
+		source, err := parseGitModuleSource(req.SourceAddr)
+		if err != nil {
+			return nil, nil, moduleDiagnostics(req, "Failed to parse the source", err)
+		}
+		if source != nil {
+			if ctx.Err() != nil {
+				return nil, nil, moduleDiagnostics(req, "Interrupted the resolution", errors.New("The inspection was interrupted"))
+			}
+			dir, diags := resolveGitModule(ctx, req, source, cache)
+			if diags.HasErrors() {
+				return nil, nil, diags
+			}
+			log.Printf("[DEBUG] Trying to load the Git module: name=%s, dir=%s", req.Name, dir)
+			mod, diags := cacheParser.LoadConfigDir(dir)
+			return mod, nil, diags
+		}
+
+		log.Printf("[DEBUG] Skip `%s` module because the source cannot be downloaded: %s", req.Name, req.SourceAddr)
+		return nil, nil, nil
+	})
+}
+
+// resolveRegistryModule returns the directory of the registry module in the cache, downloading it if not cached
+func resolveRegistryModule(ctx context.Context, req *configs.ModuleRequest, source *regsrc.Module, cache string, client *registry.Client) (string, *version.Version, hcl.Diagnostics) {
+	resp, err := client.ModuleVersions(source)
+	if err != nil {
+		return "", nil, moduleDiagnostics(req, "Failed to retrieve available versions", err)
+	}
+	if len(resp.Modules) == 0 {
+		return "", nil, moduleDiagnostics(req, "Failed to retrieve available versions", fmt.Errorf("%s is not found", source.Display()))
+	}
+	ver, err := selectModuleVersion(resp.Modules[0].Versions, req.VersionConstraint.Required)
+	if err != nil {
+		return "", nil, moduleDiagnostics(req, "Failed to select the version", err)
+	}
+
+	dir := filepath.Join(cache, filepath.FromSlash(source.Normalized()), ver.String())
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		log.Printf("[INFO] Download `%s` module: source=%s, version=%s", req.Name, source.Display(), ver)
+		if err := downloadModule(ctx, client, source, ver, dir); err != nil {
+			return "", nil, moduleDiagnostics(req, "Failed to download", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Use the cached module: source=%s, version=%s", source.Display(), ver)
+	}
+
+	if source.RawSubmodule != "" {
+		dir = filepath.Join(dir, filepath.FromSlash(source.RawSubmodule))
+	}
+	return dir, ver, nil
+}
+
+// selectModuleVersion returns the latest version which meets the constraints
+// Pre-releases are only selected when the constraints contain pre-releases, as with `terraform init`.
+func selectModuleVersion(versions []*response.ModuleVersion, constraints version.Constraints) (*version.Version, error) {
+	candidates := version.Collection{}
+	for _, v := range versions {
+		ver, err := version.NewVersion(v.Version)
+		if err != nil {
+			log.Printf("[WARN] Ignore the invalid module version `%s`: %s", v.Version, err)
+			continue
+		}
+		if constraints.Check(ver) {
+			candidates = append(candidates, ver)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("No versions match the constraints `%s`", constraints)
+	}
+
+	sort.Sort(candidates)
+	return candidates[len(candidates)-1], nil
+}
+
+// downloadModule downloads the module into the directory
+// The module is downloaded into a temporary directory first, so an interrupted download never leaves a broken cache.
+// The download is aborted when the context is canceled.
+func downloadModule(ctx context.Context, client *registry.Client, source *regsrc.Module, ver *version.Version, dir string) error {
+	location, err := client.ModuleLocation(source, ver.String())
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), ".download")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	// The temporary directory already exists, so download into its child
+	dst := filepath.Join(tmp, "module")
+	if err := (&getter.Client{Ctx: ctx, Src: location, Dst: dst, Pwd: wd, Mode: getter.ClientModeDir}).Get(); err != nil {
+		return err
+	}
+
+	return os.Rename(dst, dir)
+}
+
+func moduleDiagnostics(req *configs.ModuleRequest, summary string, err error) hcl.Diagnostics {
+	return hcl.Diagnostics{
+		{
+			Severity: hcl.DiagError,
+			Summary:  fmt.Sprintf("%s of `%s` module", summary, req.Name),
+			Detail:   err.Error(),
+			Subject:  &req.CallRange,
+		},
+	}
+}
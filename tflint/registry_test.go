@@ -0,0 +1,177 @@
+package tflint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/disco"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/registry"
+	"github.com/hashicorp/terraform/registry/response"
+	"github.com/spf13/afero"
+)
+
+func Test_selectModuleVersion(t *testing.T) {
+	versions := []*response.ModuleVersion{
+		{Version: "1.0.0"},
+		{Version: "1.2.0"},
+		{Version: "1.10.0"},
+		{Version: "2.0.0-beta1"},
+		{Version: "2.0.0"},
+		{Version: "invalid"},
+	}
+
+	cases := []struct {
+		Name        string
+		Constraints string
+		Expected    string
+		Error       string
+	}{
+		{
+			Name:        "no constraints",
+			Constraints: "",
+			Expected:    "2.0.0",
+		},
+		{
+			Name:        "pessimistic constraint",
+			Constraints: "~> 1.0",
+			Expected:    "1.10.0",
+		},
+		{
+			Name:        "pre-release",
+			Constraints: "2.0.0-beta1",
+			Expected:    "2.0.0-beta1",
+		},
+		{
+			Name:        "no match",
+			Constraints: "> 3.0",
+			Error:       "No versions match the constraints `> 3.0`",
+		},
+	}
+
+	for _, tc := range cases {
+		constraints, err := version.NewConstraint(tc.Constraints)
+		if tc.Constraints == "" {
+			constraints, err = version.Constraints{}, nil
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ver, err := selectModuleVersion(versions, constraints)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if ver.String() != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected version is `%s`, but got `%s`", tc.Name, tc.Expected, ver)
+		}
+	}
+}
+
+func Test_RemoteModuleResolver_registry(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	moduleDir := filepath.Join(currentDir, "test-fixtures", "registry_module", "module")
+
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/modules/org/vpc/aws/versions":
+			fmt.Fprint(w, `{"modules":[{"source":"example.com/org/vpc/aws","versions":[{"version":"1.0.0"},{"version":"1.2.0"},{"version":"2.0.0"}]}]}`)
+		case "/v1/modules/org/vpc/aws/1.2.0/download":
+			downloads++
+			w.Header().Set("X-Terraform-Get", "file://"+filepath.ToSlash(moduleDir))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	services := disco.New()
+	services.ForceHostServices(svchost.Hostname("example.com"), map[string]interface{}{
+		"modules.v1": server.URL + "/v1/modules/",
+	})
+
+	cacheDir, err := ioutil.TempDir("", "modules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	withinFixtureDir(t, filepath.Join("registry_module", "root"), func() {
+		fs := afero.Afero{Fs: afero.NewOsFs()}
+		resolver := remoteModuleResolver(context.Background(), fs, cacheDir, registry.NewClient(services, nil))
+
+		// The second build uses the cached module
+		for i := 0; i < 2; i++ {
+			root, diags := configs.NewParser(fs).LoadConfigDir(".")
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+			cfg, diags := configs.BuildConfig(root, resolver)
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+
+			vpc, exists := cfg.Children["vpc"]
+			if !exists {
+				t.Fatalf("`vpc` module is not loaded")
+			}
+			if vpc.Version.String() != "1.2.0" {
+				t.Fatalf("expected version is `1.2.0`, but got `%s`", vpc.Version)
+			}
+			if _, exists := vpc.Module.ManagedResources["aws_vpc.main"]; !exists {
+				t.Fatalf("`aws_vpc.main` is not found in the module")
+			}
+			if _, exists := cfg.Children["s3"]; exists {
+				t.Fatalf("`s3` module should be skipped")
+			}
+		}
+	})
+
+	if downloads != 1 {
+		t.Fatalf("expected downloads are 1, but got %d", downloads)
+	}
+	if _, err := os.Stat(filepath.Join(cacheDir, "example.com", "org", "vpc", "aws", "1.2.0", "main.tf")); err != nil {
+		t.Fatalf("The module is not cached: %s", err)
+	}
+}
+
+func Test_RemoteModuleResolver_interrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	withinFixtureDir(t, filepath.Join("registry_module", "root"), func() {
+		fs := afero.Afero{Fs: afero.NewOsFs()}
+		resolver := remoteModuleResolver(ctx, fs, "cache", registry.NewClient(nil, nil))
+
+		root, diags := configs.NewParser(fs).LoadConfigDir(".")
+		if diags.HasErrors() {
+			t.Fatal(diags)
+		}
+		_, diags = configs.BuildConfig(root, resolver)
+		if !diags.HasErrors() {
+			t.Fatal("Expected an error, but got nothing")
+		}
+		if diags[0].Summary != "Interrupted the resolution of `vpc` module" {
+			t.Fatalf("Unexpected error occurred: %s", diags)
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package tflint
+
+import (
+	"fmt"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/spf13/afero"
+)
+
+// RenameImpact is the result of analyzing a rename of an identifier in the module
+type RenameImpact struct {
+	From       string
+	To         string
+	Definition hcl.Range
+	References []hcl.Range
+	// StateMove is true when the renamed object is recorded in the state.
+	// Without `terraform state mv`, Terraform destroys the old object and creates a new one.
+	StateMove bool
+}
+
+// AnalyzeRename returns places that must be changed to rename `from` to `to`, and whether the state must be moved
+// The state is read from the local state file of the workspace in the module directory of the passed filesystem.
+// The workspace is detected if it is empty.
+func (i *ReferenceIndex) AnalyzeRename(from string, to string, fs afero.Afero, workspace string) (*RenameImpact, error) {
+	state, err := loadTFState(fs, i.dir, workspace)
+	if err != nil {
+		return nil, err
+	}
+	return i.analyzeRename(from, to, state)
+}
+
+func (i *ReferenceIndex) analyzeRename(from string, to string, state *states.State) (*RenameImpact, error) {
+	definition, ok := i.Definitions[from]
+	if !ok {
+		return nil, fmt.Errorf("`%s` is not found in the configuration", from)
+	}
+	if _, exists := i.Definitions[to]; exists {
+		return nil, fmt.Errorf("`%s` is already defined in the configuration", to)
+	}
+	if renameKind(from) != renameKind(to) {
+		return nil, fmt.Errorf("`%s` cannot be renamed to `%s`. Only the name can be changed", from, to)
+	}
+
+	return &RenameImpact{
+		From:       from,
+		To:         to,
+		Definition: definition,
+		References: i.References[from],
+		StateMove:  isAddressInState(from, state),
+	}, nil
+}
+
+// renameKind returns the part of the address other than the name, e.g. `aws_instance` of `aws_instance.web`
+func renameKind(address string) string {
+	idx := strings.LastIndex(address, ".")
+	if idx < 0 {
+		return ""
+	}
+	return address[:idx]
+}
+
+// isAddressInState returns whether the passed address of the root module is recorded in the state
+// Only managed resources and module calls are recorded. Data sources are read again on every run.
+func isAddressInState(address string, state *states.State) bool {
+	if state == nil {
+		return false
+	}
+
+	if strings.HasPrefix(address, "module.") {
+		name := strings.TrimPrefix(address, "module.")
+		for _, module := range state.Modules {
+			if len(module.Addr) > 0 && module.Addr[0].Name == name && len(module.Resources) > 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	resource, diags := addrs.ParseAbsResourceStr(address)
+	if diags.HasErrors() || resource.Resource.Mode != addrs.ManagedResourceMode {
+		return false
+	}
+	return state.Resource(resource) != nil
+}
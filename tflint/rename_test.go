@@ -0,0 +1,144 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+)
+
+func Test_AnalyzeRename(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "instance_type" {}
+
+resource "aws_instance" "web" {
+  instance_type = var.instance_type
+}
+
+resource "aws_instance" "new" {
+  instance_type = var.instance_type
+}
+
+module "network" {
+  source = "./network"
+}
+
+output "id" {
+  value = aws_instance.web.id
+}
+
+output "vpc" {
+  value = module.network.vpc_id
+}`,
+		"network/main.tf": `
+resource "aws_vpc" "main" {}
+
+output "vpc_id" {
+  value = aws_vpc.main.id
+}`,
+	})
+
+	state := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "web",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"i-1234567890"}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_vpc",
+				Name: "main",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance.Child("network", addrs.NoKey)),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"id":"vpc-1234567890"}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+	})
+
+	cases := []struct {
+		Name       string
+		From       string
+		To         string
+		References int
+		StateMove  bool
+		Error      string
+	}{
+		{
+			Name:       "resource in state",
+			From:       "aws_instance.web",
+			To:         "aws_instance.app",
+			References: 1,
+			StateMove:  true,
+		},
+		{
+			Name:      "resource not in state",
+			From:      "aws_instance.new",
+			To:        "aws_instance.app",
+			StateMove: false,
+		},
+		{
+			Name:       "module in state",
+			From:       "module.network",
+			To:         "module.vpc",
+			References: 1,
+			StateMove:  true,
+		},
+		{
+			Name:       "variable",
+			From:       "var.instance_type",
+			To:         "var.type",
+			References: 2,
+			StateMove:  false,
+		},
+		{
+			Name:  "undefined",
+			From:  "aws_instance.undefined",
+			To:    "aws_instance.app",
+			Error: "`aws_instance.undefined` is not found in the configuration",
+		},
+		{
+			Name:  "already defined",
+			From:  "aws_instance.web",
+			To:    "aws_instance.new",
+			Error: "`aws_instance.new` is already defined in the configuration",
+		},
+		{
+			Name:  "different kind",
+			From:  "aws_instance.web",
+			To:    "aws_spot_instance_request.web",
+			Error: "`aws_instance.web` cannot be renamed to `aws_spot_instance_request.web`. Only the name can be changed",
+		},
+	}
+
+	index := runner.ReferenceIndex()
+	for _, tc := range cases {
+		impact, err := index.analyzeRename(tc.From, tc.To, state)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got `%v`", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		if len(impact.References) != tc.References {
+			t.Fatalf("Failed `%s` test: expected %d references, but got %d", tc.Name, tc.References, len(impact.References))
+		}
+		if impact.StateMove != tc.StateMove {
+			t.Fatalf("Failed `%s` test: expected state move is %t, but got %t", tc.Name, tc.StateMove, impact.StateMove)
+		}
+	}
+}
@@ -1,19 +1,27 @@
 package tflint
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/json"
 	"github.com/hashicorp/terraform/addrs"
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/lang"
+	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/spf13/afero"
 	"github.com/terraform-linters/tflint/client"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
@@ -24,15 +32,42 @@ import (
 // For variables interplation, it has Terraform eval context.
 // After checking, it accumulates results as issues.
 type Runner struct {
-	TFConfig  *configs.Config
+	TFConfig *configs.Config
+	// Issues are emitted issues. Use LookupIssues to read them while rules may be running
 	Issues    Issues
+	issuesMu  sync.Mutex
 	AwsClient *client.AwsClient
+	// Sources is the source code cache of loaded files, used for lexing tokens
+	Sources map[string][]byte
 
 	ctx         terraform.BuiltinEvalContext
 	annotations map[string]Annotations
 	config      *Config
 	currentExpr hcl.Expression
 	modVars     map[string]*moduleVariable
+	state       *states.State
+	awsRegion   string
+	// awsRegions are regions of alias providers, keyed by aliases
+	awsRegions map[string]string
+	// awsClients are clients for alias providers, keyed by aliases
+	awsClients map[string]*client.AwsClient
+	fs         afero.Afero
+	// sensitiveValues are values of sensitive variables, which are redacted from issue messages and logs
+	sensitiveValues []string
+	sensitiveOnce   sync.Once
+	// resources are managed resources of the module indexed by types
+	resources map[string][]*configs.Resource
+	// evalCache memoizes results of evaluating references. Each runner has its own cache because the scope is a module
+	evalCache   map[string]cty.Value
+	evalCacheMu sync.Mutex
+	// schemas are schemas of resources loaded from `provider_schemas`
+	schemas *ProviderSchemas
+	// cache is shared with runners of other root modules in a batch. It is nil outside of batches
+	cache *runnerCache
+	// locals are whether local values of the module are evaluable, indexed by names
+	locals map[string]bool
+	// instanceKey is the key of the resource instance being walked. It is nil unless resources with `count` are expanded
+	instanceKey addrs.InstanceKey
 }
 
 // Rule is interface for building the issue
@@ -45,13 +80,40 @@ type Rule interface {
 // NewRunner returns new TFLint runner
 // It prepares built-in context (workpace metadata, variables) from
 // received `configs.Config` and `terraform.InputValues`
+// The workspace and the state are read from the OS filesystem. Use NewRunnerWithFS to read them from another filesystem.
 func NewRunner(c *Config, ants map[string]Annotations, cfg *configs.Config, variables ...terraform.InputValues) (*Runner, error) {
+	return NewRunnerWithFS(afero.Afero{Fs: afero.NewOsFs()}, c, ants, cfg, variables...)
+}
+
+// NewRunnerWithFS returns new TFLint runner which reads the workspace and the state from the passed filesystem
+// It is usually the filesystem of the loader which loaded the configuration.
+func NewRunnerWithFS(fs afero.Afero, c *Config, ants map[string]Annotations, cfg *configs.Config, variables ...terraform.InputValues) (*Runner, error) {
+	return NewRunnerWithContext(context.Background(), fs, c, ants, cfg, variables...)
+}
+
+// NewRunnerWithContext is the same as NewRunnerWithFS, except that the state is fetched from the backend within the context
+// Fetching the state is canceled when the context is canceled, e.g. when the inspection is interrupted.
+func NewRunnerWithContext(ctx context.Context, fs afero.Afero, c *Config, ants map[string]Annotations, cfg *configs.Config, variables ...terraform.InputValues) (*Runner, error) {
+	return newRunner(ctx, fs, c, ants, cfg, nil, variables...)
+}
+
+func newRunner(ctx context.Context, fs afero.Afero, c *Config, ants map[string]Annotations, cfg *configs.Config, cache *runnerCache, variables ...terraform.InputValues) (*Runner, error) {
 	path := "root"
 	if !cfg.Path.IsRoot() {
 		path = cfg.Path.String()
 	}
 	log.Printf("[INFO] Initialize new runner for %s", path)
 
+	variableValues := prepareVariableValues(cfg.Module.Variables, variables...)
+	if c.ModuleMode && cfg.Path.IsRoot() {
+		// Inputs of a reusable module can be anything its callers pass, so variables are treated as unknown values of their types
+		log.Printf("[DEBUG] Module mode is enabled. Treat all variables as unknown")
+		variableValues = map[string]map[string]cty.Value{"": {}}
+		for name, variable := range cfg.Module.Variables {
+			variableValues[""][name] = cty.UnknownVal(variable.Type)
+		}
+	}
+
 	runner := &Runner{
 		TFConfig:  cfg,
 		Issues:    Issues{},
@@ -60,38 +122,52 @@ func NewRunner(c *Config, ants map[string]Annotations, cfg *configs.Config, vari
 		ctx: terraform.BuiltinEvalContext{
 			Evaluator: &terraform.Evaluator{
 				Meta: &terraform.ContextMeta{
-					Env: getTFWorkspace(),
+					Env: getTFWorkspace(fs, cfg.Root.Module.SourceDir, c.Workspace),
 				},
 				Config:             cfg,
-				VariableValues:     prepareVariableValues(cfg.Module.Variables, variables...),
+				VariableValues:     variableValues,
 				VariableValuesLock: &sync.Mutex{},
+				// The state only holds evaluated local values
+				State: states.NewState().SyncWrapper(),
 			},
 		},
 		annotations: ants,
 		config:      c,
+		fs:          fs,
+		resources:   map[string][]*configs.Resource{},
+		evalCache:   map[string]cty.Value{},
+		cache:       cache,
+	}
+	for _, resource := range cfg.Module.ManagedResources {
+		runner.resources[resource.Type] = append(runner.resources[resource.Type], resource)
 	}
 
-	// Initialize client for the root runner
-	if c.DeepCheck && cfg.Path.IsRoot() {
-		// FIXME: Alias providers are not considered
-		providerConfig, err := NewProviderConfig(
-			cfg.Module.ProviderConfigs["aws"],
-			runner,
-			client.AwsProviderBlockSchema,
-		)
+	if cfg.Path.IsRoot() {
+		runner.awsRegion = resolveAwsRegion(c, runner)
+		runner.awsRegions = resolveAwsAliasRegions(c, runner)
+	}
+	if c.ProviderSchemas != "" && cfg.Path.IsRoot() {
+		var err error
+		runner.schemas, err = runner.loadProviderSchemas()
 		if err != nil {
 			return nil, err
 		}
-		creds, err := client.ConvertToCredentials(providerConfig)
+	}
+
+	// Initialize clients for the root runner
+	if c.DeepCheck && cfg.Path.IsRoot() {
+		var err error
+		runner.AwsClient, runner.awsClients, err = newAwsClients(c, runner)
 		if err != nil {
 			return nil, err
 		}
 
-		runner.AwsClient, err = client.NewAwsClient(c.AwsCredentials.Merge(creds))
+		runner.state, err = loadBackendState(ctx, fs, cfg.Module, c)
 		if err != nil {
 			return nil, err
 		}
 	}
+	runner.prepareLocalValues()
 
 	return runner, nil
 }
@@ -139,7 +215,7 @@ func NewModuleRunners(parent *Runner) ([]*Runner, error) {
 		modVars := map[string]*moduleVariable{}
 		for varName, rawVar := range cfg.Module.Variables {
 			if attribute, exists := attributes[varName]; exists {
-				evalauble, err := isEvaluableExpr(attribute.Expr)
+				evalauble, err := parent.isEvaluableExpr(attribute.Expr)
 				if err != nil {
 					return runners, err
 				}
@@ -188,13 +264,19 @@ func NewModuleRunners(parent *Runner) ([]*Runner, error) {
 			}
 		}
 
-		runner, err := NewRunner(parent.config, parent.annotations, cfg)
+		runner, err := NewRunnerWithFS(parent.fs, parent.config, parent.annotations, cfg)
 		if err != nil {
 			return runners, err
 		}
 		runner.modVars = modVars
-		// Inherit parent's AwsClient
+		// Inherit parent's AWS clients, sources, state, region, and schemas
 		runner.AwsClient = parent.AwsClient
+		runner.awsClients = parent.awsClients
+		runner.Sources = parent.Sources
+		runner.state = parent.state
+		runner.awsRegion = parent.awsRegion
+		runner.awsRegions = parent.awsRegions
+		runner.schemas = parent.schemas
 		runners = append(runners, runner)
 		moudleRunners, err := NewModuleRunners(runner)
 		if err != nil {
@@ -210,7 +292,7 @@ func NewModuleRunners(parent *Runner) ([]*Runner, error) {
 // In addition, this method determines whether the expression is evaluable, contains no unknown values, and so on.
 // The returned cty.Value is converted according to the value passed as `ret`.
 func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Type) (cty.Value, error) {
-	evaluable, err := isEvaluableExpr(expr)
+	evaluable, err := r.isEvaluableExpr(expr)
 	if err != nil {
 		err := &Error{
 			Code:  EvaluationError,
@@ -222,7 +304,7 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 			),
 			Cause: err,
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return cty.NullVal(cty.NilType), err
 	}
 
@@ -236,7 +318,7 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 				expr.Range().Start.Line,
 			),
 		}
-		log.Printf("[WARN] %s; TFLint ignores an unevaluable expression.", err)
+		log.Printf("[WARN] %s; TFLint ignores an unevaluable expression.", r.redact(err.Error()))
 		return cty.NullVal(cty.NilType), err
 	}
 
@@ -259,7 +341,7 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 		}
 	}
 
-	val, diags := r.ctx.EvaluateExpr(expr, wantType, nil)
+	val, diags := r.evaluateExpr(expr, wantType)
 	if diags.HasErrors() {
 		err := &Error{
 			Code:  EvaluationError,
@@ -269,9 +351,9 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 				expr.Range().Filename,
 				expr.Range().Start.Line,
 			),
-			Cause: diags.Err(),
+			Cause: r.redactError(diags.Err()),
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return cty.NullVal(cty.NilType), err
 	}
 
@@ -286,7 +368,7 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 					expr.Range().Start.Line,
 				),
 			}
-			log.Printf("[WARN] %s; TFLint ignores an expression includes an unknown value.", err)
+			log.Printf("[WARN] %s; TFLint ignores an expression includes an unknown value.", r.redact(err.Error()))
 			return false, err
 		}
 
@@ -300,7 +382,7 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 					expr.Range().Start.Line,
 				),
 			}
-			log.Printf("[WARN] %s; TFLint ignores an expression includes an null value.", err)
+			log.Printf("[WARN] %s; TFLint ignores an expression includes an null value.", r.redact(err.Error()))
 			return false, err
 		}
 
@@ -314,6 +396,47 @@ func (r *Runner) EvalExpr(expr hcl.Expression, ret interface{}, wantType cty.Typ
 	return val, nil
 }
 
+// evaluateExpr evaluates the expression in the module scope
+// References such as `var.foo` are often repeated across hundreds of resources, so their results are memoized.
+// Evaluable expressions do not depend on resources, so a result of the same reference is always the same in the module.
+func (r *Runner) evaluateExpr(expr hcl.Expression, wantType cty.Type) (cty.Value, tfdiags.Diagnostics) {
+	if r.instanceKey != nil {
+		// Results of `count.index` differ between instances, so they are not memoized
+		return r.ctx.EvaluationScope(nil, terraform.EvalDataForInstanceKey(r.instanceKey, nil)).EvalExpr(expr, wantType)
+	}
+	traversal, ok := expr.(*hclsyntax.ScopeTraversalExpr)
+	if !ok {
+		return r.ctx.EvaluateExpr(expr, wantType, nil)
+	}
+	key := traversalKey(traversal.Traversal) + " " + wantType.GoString()
+
+	r.evalCacheMu.Lock()
+	cached, exists := r.evalCache[key]
+	r.evalCacheMu.Unlock()
+	if exists {
+		return cached, nil
+	}
+
+	val, diags := r.ctx.EvaluateExpr(expr, wantType, nil)
+	if diags.HasErrors() {
+		// Diagnostics contain the range of the expression, so they cannot be reused for other expressions
+		return val, diags
+	}
+	r.evalCacheMu.Lock()
+	if r.evalCache != nil {
+		r.evalCache[key] = val
+	}
+	r.evalCacheMu.Unlock()
+	return val, diags
+}
+
+// dropEvalCache releases memoized results and disables memoization for the rest of the inspection
+func (r *Runner) dropEvalCache() {
+	r.evalCacheMu.Lock()
+	r.evalCache = nil
+	r.evalCacheMu.Unlock()
+}
+
 // EvaluateExpr evaluates the expression and reflects the result in the value of `ret`.
 // In the future, it will be no longer needed because all evaluation requests are invoked from RPC client
 func (r *Runner) EvaluateExpr(expr hcl.Expression, ret interface{}) error {
@@ -346,7 +469,7 @@ func (r *Runner) fromCtyValue(val cty.Value, expr hcl.Expression, ret interface{
 			),
 			Cause: err,
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return err
 	}
 	return nil
@@ -354,7 +477,7 @@ func (r *Runner) fromCtyValue(val cty.Value, expr hcl.Expression, ret interface{
 
 // EvaluateBlock is a wrapper of terraform.BultinEvalContext.EvaluateBlock and gocty.FromCtyValue
 func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret interface{}) error {
-	evaluable, err := isEvaluableBlock(block.Body, schema)
+	evaluable, err := r.isEvaluableBlock(block.Body, schema)
 	if err != nil {
 		err := &Error{
 			Code:  EvaluationError,
@@ -366,7 +489,7 @@ func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret
 			),
 			Cause: err,
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return err
 	}
 
@@ -380,7 +503,7 @@ func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret
 				block.DefRange.Start.Line,
 			),
 		}
-		log.Printf("[WARN] %s; TFLint ignores an unevaluable block.", err)
+		log.Printf("[WARN] %s; TFLint ignores an unevaluable block.", r.redact(err.Error()))
 		return err
 	}
 
@@ -394,9 +517,9 @@ func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret
 				block.DefRange.Filename,
 				block.DefRange.Start.Line,
 			),
-			Cause: diags.Err(),
+			Cause: r.redactError(diags.Err()),
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return err
 	}
 
@@ -411,7 +534,7 @@ func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret
 					block.DefRange.Start.Line,
 				),
 			}
-			log.Printf("[WARN] %s; TFLint ignores a block includes an unknown value.", err)
+			log.Printf("[WARN] %s; TFLint ignores a block includes an unknown value.", r.redact(err.Error()))
 			return false, err
 		}
 
@@ -454,7 +577,7 @@ func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret
 			),
 			Cause: err,
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return err
 	}
 
@@ -470,7 +593,7 @@ func (r *Runner) EvaluateBlock(block *hcl.Block, schema *configschema.Block, ret
 			),
 			Cause: err,
 		}
-		log.Printf("[ERROR] %s", err)
+		log.Printf("[ERROR] %s", r.redact(err.Error()))
 		return err
 	}
 	return nil
@@ -484,10 +607,110 @@ func (r *Runner) TFConfigPath() string {
 	return r.TFConfig.Path.String()
 }
 
+// FS returns the filesystem which the configuration was loaded from
+func (r *Runner) FS() afero.Afero {
+	return r.fs
+}
+
+// Tokens returns all tokens in the passed file, including comments
+// It is useful for rules that need precise positions of tokens or comments that are not preserved in configs.
+// Returns empty tokens for files other than HCL native syntax such as `.tf.json`.
+func (r *Runner) Tokens(filename string) (hclsyntax.Tokens, error) {
+	if !strings.HasSuffix(filename, ".tf") {
+		return hclsyntax.Tokens{}, nil
+	}
+
+	src, exists := r.Sources[filename]
+	if !exists {
+		return nil, fmt.Errorf("`%s` is not found in loaded sources", filename)
+	}
+
+	tokens, diags := hclsyntax.LexConfig(src, filename, hcl.Pos{Byte: 0, Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return tokens, nil
+}
+
+// Comments returns comment tokens in the passed file
+// Note that the bytes of a line comment include the trailing newline.
+func (r *Runner) Comments(filename string) (hclsyntax.Tokens, error) {
+	tokens, err := r.Tokens(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := hclsyntax.Tokens{}
+	for _, token := range tokens {
+		if token.Type == hclsyntax.TokenComment {
+			comments = append(comments, token)
+		}
+	}
+	return comments, nil
+}
+
+// moduleFiles parses loaded sources in the module directory again
+// It is useful for reading blocks and attributes that are not supported by the bundled Terraform.
+func (r *Runner) moduleFiles() ([]*hcl.File, error) {
+	sources := r.Sources
+	if sources == nil {
+		// Sources are not set while initializing the runner, so read files from the filesystem instead
+		sources = map[string][]byte{}
+		for _, pattern := range []string{"*.tf", "*.tf.json"} {
+			matches, err := afero.Glob(r.fs, filepath.Join(r.TFConfig.Module.SourceDir, pattern))
+			if err != nil {
+				return nil, err
+			}
+			for _, match := range matches {
+				src, err := r.fs.ReadFile(match)
+				if err != nil {
+					return nil, err
+				}
+				sources[match] = src
+			}
+		}
+	}
+
+	filenames := []string{}
+	for filename := range sources {
+		if filepath.Dir(filename) == filepath.Clean(r.TFConfig.Module.SourceDir) {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+
+	files := []*hcl.File{}
+	for _, filename := range filenames {
+		var file *hcl.File
+		var diags hcl.Diagnostics
+		switch {
+		case strings.HasSuffix(filename, ".tf"):
+			file, diags = hclsyntax.ParseConfig(sources[filename], filename, hcl.InitialPos)
+		case strings.HasSuffix(filename, ".tf.json"):
+			file, diags = json.Parse(sources[filename], filename)
+		default:
+			continue
+		}
+		if diags.HasErrors() {
+			// Sources include files skipped due to syntax errors when they are reported as issues
+			if r.config.ReportSyntaxErrors {
+				continue
+			}
+			return files, diags
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
 // LookupIssues returns issues according to the received files
+// It returns a snapshot, so it can be called while a check abandoned by a timeout or an interruption is still emitting issues.
 func (r *Runner) LookupIssues(files ...string) Issues {
+	r.issuesMu.Lock()
+	defer r.issuesMu.Unlock()
+
 	if len(files) == 0 {
-		return r.Issues
+		return append(Issues{}, r.Issues...)
 	}
 
 	issues := Issues{}
@@ -503,7 +726,18 @@ func (r *Runner) LookupIssues(files ...string) Issues {
 
 // WalkResourceAttributes searches for resources and passes the appropriate attributes to the walker function
 func (r *Runner) WalkResourceAttributes(resource, attributeName string, walker func(*hcl.Attribute) error) error {
-	for _, resource := range r.LookupResourcesByType(resource) {
+	return r.walkResourceAttributes(r.LookupResourcesByType(resource), attributeName, walker)
+}
+
+// WalkNewResourceAttributes is like WalkResourceAttributes, but it skips resources already recorded in the state
+// This is useful for checking whether a resource to be created collides with existing ones.
+// If the state is not loaded, no resources are walked. See LookupNewResourcesByType.
+func (r *Runner) WalkNewResourceAttributes(resource, attributeName string, walker func(*hcl.Attribute) error) error {
+	return r.walkResourceAttributes(r.LookupNewResourcesByType(resource), attributeName, walker)
+}
+
+func (r *Runner) walkResourceAttributes(resources []*configs.Resource, attributeName string, walker func(*hcl.Attribute) error) error {
+	for _, resource := range resources {
 		body, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
 			Attributes: []hcl.AttributeSchema{
 				{
@@ -517,8 +751,10 @@ func (r *Runner) WalkResourceAttributes(resource, attributeName string, walker f
 
 		if attribute, ok := body.Attributes[attributeName]; ok {
 			log.Printf("[DEBUG] Walk `%s` attribute", resource.Type+"."+resource.Name+"."+attributeName)
-			err := r.WithExpressionContext(attribute.Expr, func() error {
-				return walker(attribute)
+			err := r.walkResourceInstances(resource, attribute.Expr, func() error {
+				return r.WithExpressionContext(attribute.Expr, func() error {
+					return walker(attribute)
+				})
 			})
 			if err != nil {
 				return err
@@ -615,7 +851,7 @@ func (r *Runner) EnsureNoError(err error, proc func() error) error {
 
 // IsNullExpr check the passed expression is null
 func (r *Runner) IsNullExpr(expr hcl.Expression) (bool, error) {
-	evaluable, err := isEvaluableExpr(expr)
+	evaluable, err := r.isEvaluableExpr(expr)
 	if err != nil {
 		return false, err
 	}
@@ -632,12 +868,25 @@ func (r *Runner) IsNullExpr(expr hcl.Expression) (bool, error) {
 
 // LookupResourcesByType returns `configs.Resource` list according to the resource type
 func (r *Runner) LookupResourcesByType(resourceType string) []*configs.Resource {
+	return append([]*configs.Resource{}, r.resources[resourceType]...)
+}
+
+// LookupNewResourcesByType is like LookupResourcesByType, but it excludes resources already recorded in the state
+// If the state is not loaded, such as when the backend is not supported, resources already applied cannot be told apart
+// from new ones, so it returns no resources. Note that an empty state is loaded, so all resources are new in that case.
+func (r *Runner) LookupNewResourcesByType(resourceType string) []*configs.Resource {
 	ret := []*configs.Resource{}
+	if !r.HasState() {
+		log.Printf("[INFO] The state is not loaded, so no `%s` resources are considered new", resourceType)
+		return ret
+	}
 
-	for _, resource := range r.TFConfig.Module.ManagedResources {
-		if resource.Type == resourceType {
-			ret = append(ret, resource)
+	for _, resource := range r.LookupResourcesByType(resourceType) {
+		if r.IsResourceInState(resource) {
+			log.Printf("[DEBUG] `%s` is already in the state. Skipped", resource.Type+"."+resource.Name)
+			continue
 		}
+		ret = append(ret, resource)
 	}
 
 	return ret
@@ -668,22 +917,135 @@ func (r *Runner) EachStringSliceExprs(expr hcl.Expression, proc func(val string,
 
 // EmitIssue builds an issue and accumulates it
 func (r *Runner) EmitIssue(rule Rule, message string, location hcl.Range) {
+	for _, issue := range r.buildIssues(rule, message, location) {
+		r.emitIssue(issue)
+	}
+}
+
+// buildIssues returns the issue at the location
+// In child modules, issues are built at the declarations of variables passed to the expression being evaluated.
+func (r *Runner) buildIssues(rule Rule, message string, location hcl.Range) Issues {
 	if r.TFConfig.Path.IsRoot() {
-		r.emitIssue(&Issue{
+		return Issues{
+			{
+				Rule:    rule,
+				Message: message,
+				Range:   location,
+				Address: r.ResourceAddress(location),
+			},
+		}
+	}
+
+	ret := Issues{}
+	for _, modVar := range r.listModuleVars(r.currentExpr) {
+		ret = append(ret, &Issue{
 			Rule:    rule,
 			Message: message,
-			Range:   location,
+			Range:   modVar.DeclRange,
+			Callers: append(modVar.callers(), location),
+			Address: r.ResourceAddress(location),
 		})
-	} else {
-		for _, modVar := range r.listModuleVars(r.currentExpr) {
-			r.emitIssue(&Issue{
-				Rule:    rule,
-				Message: message,
-				Range:   modVar.DeclRange,
-				Callers: append(modVar.callers(), location),
-			})
+	}
+	return ret
+}
+
+// EmitIssueWithFix builds an issue with a fix which can be applied by `--fix` and accumulates it
+// Fixes are only attached to issues in the root module because issues in child modules are reported at the module call.
+func (r *Runner) EmitIssueWithFix(rule Rule, message string, location hcl.Range, fix *Fix) {
+	if !r.TFConfig.Path.IsRoot() {
+		r.EmitIssue(rule, message, location)
+		return
+	}
+
+	r.emitIssue(&Issue{
+		Rule:    rule,
+		Message: message,
+		Range:   location,
+		Address: r.ResourceAddress(location),
+		Fix:     fix,
+	})
+}
+
+// EmitIssueWithEvidence builds an issue with the evidence of deep checking and accumulates it
+func (r *Runner) EmitIssueWithEvidence(rule Rule, message string, location hcl.Range, evidence *Evidence) {
+	if awsClient := r.AwsClientAt(location); evidence != nil && awsClient != nil {
+		evidence.Region = awsClient.Region
+		evidence.AccountID = awsClient.AccountID
+	}
+
+	for _, issue := range r.buildIssues(rule, message, location) {
+		issue.Evidence = evidence
+		r.emitIssue(issue)
+	}
+}
+
+// NewEvidence returns evidence of the passed API action with the region and the account of the AWS client
+func (r *Runner) NewEvidence(operation string, identifiers ...string) *Evidence {
+	evidence := &Evidence{
+		Operation:   operation,
+		Identifiers: make([]string, len(identifiers)),
+	}
+	for i, identifier := range identifiers {
+		evidence.Identifiers[i] = r.redact(identifier)
+	}
+	if r.AwsClient != nil {
+		evidence.Region = r.AwsClient.Region
+		evidence.AccountID = r.AwsClient.AccountID
+	}
+	return evidence
+}
+
+// ResourceAddress returns the address of the resource or data source containing the passed range
+// The address includes the module path like `module.network.aws_subnet.private`, but does not include instance keys
+// because they are not known until planning. It returns an empty string if the range is not in any resources.
+func (r *Runner) ResourceAddress(rng hcl.Range) string {
+	resource := r.resourceAt(rng)
+	if resource == nil {
+		return ""
+	}
+
+	address := resource.Addr().String()
+	for i := len(r.TFConfig.Path) - 1; i >= 0; i-- {
+		address = "module." + r.TFConfig.Path[i] + "." + address
+	}
+	return address
+}
+
+// AwsClientAt returns the AWS client for the resource or data source containing the passed range
+// Resources of alias providers are checked with the clients of the aliases. Otherwise, it returns the default client.
+func (r *Runner) AwsClientAt(rng hcl.Range) *client.AwsClient {
+	if resource := r.resourceAt(rng); resource != nil {
+		if awsClient, exists := r.awsClients[resource.ProviderConfigAddr().Alias]; exists {
+			return awsClient
 		}
 	}
+	return r.AwsClient
+}
+
+func (r *Runner) resourceAt(rng hcl.Range) *configs.Resource {
+	resources := []*configs.Resource{}
+	for _, resource := range r.TFConfig.Module.ManagedResources {
+		resources = append(resources, resource)
+	}
+	for _, resource := range r.TFConfig.Module.DataResources {
+		resources = append(resources, resource)
+	}
+
+	for _, resource := range resources {
+		if resource.DeclRange.Filename != rng.Filename {
+			continue
+		}
+
+		declRange := resource.DeclRange
+		// In HCL native syntax, the range is expanded to the end of the block
+		if body, ok := resource.Config.(*hclsyntax.Body); ok {
+			declRange = hcl.RangeBetween(resource.DeclRange, body.SrcRange)
+		}
+		if declRange.Start.Byte <= rng.Start.Byte && rng.End.Byte <= declRange.End.Byte {
+			return resource
+		}
+	}
+	return nil
 }
 
 // WithExpressionContext sets the context of the passed expression currently being processed.
@@ -706,6 +1068,10 @@ func (r *Runner) DecodeRuleConfig(ruleName string, val interface{}) error {
 }
 
 func (r *Runner) emitIssue(issue *Issue) {
+	if !r.inRuleScope(issue) {
+		log.Printf("[DEBUG] %s (%s) is out of the rule scope", issue.Range.String(), issue.Rule.Name())
+		return
+	}
 	if annotations, ok := r.annotations[issue.Range.Filename]; ok {
 		for _, annotation := range annotations {
 			if annotation.IsAffected(issue) {
@@ -714,6 +1080,14 @@ func (r *Runner) emitIssue(issue *Issue) {
 			}
 		}
 	}
+	if index, ok := r.instanceKey.(addrs.IntKey); ok {
+		issue.Message = fmt.Sprintf("%s (count.index = %d)", issue.Message, int(index))
+	}
+	issue.Message = r.redact(issue.Message)
+
+	// Checks abandoned by timeouts and interruptions may still emit issues while they are reported
+	r.issuesMu.Lock()
+	defer r.issuesMu.Unlock()
 	r.Issues = append(r.Issues, issue)
 }
 
@@ -734,7 +1108,7 @@ func (r *Runner) listModuleVars(expr hcl.Expression) []*moduleVariable {
 // This is the responsibility of the caller.
 // See https://www.terraform.io/intro/getting-started/variables.html#assigning-variables
 func prepareVariableValues(configVars map[string]*configs.Variable, variables ...terraform.InputValues) map[string]map[string]cty.Value {
-	overrideVariables := terraform.DefaultVariableValues(configVars).Override(getTFEnvVariables()).Override(variables...)
+	overrideVariables := terraform.DefaultVariableValues(configVars).Override(getTFEnvVariables(configVars)).Override(variables...)
 
 	variableValues := make(map[string]map[string]cty.Value)
 	variableValues[""] = make(map[string]cty.Value)
@@ -744,40 +1118,44 @@ func prepareVariableValues(configVars map[string]*configs.Variable, variables ..
 	return variableValues
 }
 
-func isEvaluableExpr(expr hcl.Expression) (bool, error) {
+func (r *Runner) isEvaluableExpr(expr hcl.Expression) (bool, error) {
 	refs, diags := lang.ReferencesInExpr(expr)
 	if diags.HasErrors() {
 		return false, diags.Err()
 	}
 	for _, ref := range refs {
-		if !isEvaluableRef(ref) {
+		if !r.isEvaluableRef(ref) {
 			return false, nil
 		}
 	}
 	return true, nil
 }
 
-func isEvaluableBlock(body hcl.Body, schema *configschema.Block) (bool, error) {
+func (r *Runner) isEvaluableBlock(body hcl.Body, schema *configschema.Block) (bool, error) {
 	refs, diags := lang.ReferencesInBlock(body, schema)
 	if diags.HasErrors() {
 		return false, diags.Err()
 	}
 	for _, ref := range refs {
-		if !isEvaluableRef(ref) {
+		if !r.isEvaluableRef(ref) {
 			return false, nil
 		}
 	}
 	return true, nil
 }
 
-func isEvaluableRef(ref *addrs.Reference) bool {
-	switch ref.Subject.(type) {
+func (r *Runner) isEvaluableRef(ref *addrs.Reference) bool {
+	switch subject := ref.Subject.(type) {
+	case addrs.LocalValue:
+		return r.locals[subject.Name]
 	case addrs.InputVariable:
 		return true
 	case addrs.TerraformAttr:
 		return true
 	case addrs.PathAttr:
 		return true
+	case addrs.CountAttr:
+		return r.instanceKey != nil && subject.Name == "index"
 	default:
 		return false
 	}
@@ -799,3 +1177,21 @@ func listVarRefs(expr hcl.Expression) []addrs.InputVariable {
 
 	return ret
 }
+
+// traversalKey returns a string which identifies the traversal regardless of its range
+func traversalKey(traversal hcl.Traversal) string {
+	var b strings.Builder
+	for _, step := range traversal {
+		switch s := step.(type) {
+		case hcl.TraverseRoot:
+			b.WriteString(s.Name)
+		case hcl.TraverseAttr:
+			b.WriteString("." + s.Name)
+		case hcl.TraverseIndex:
+			b.WriteString("[" + s.Key.GoString() + "]")
+		case hcl.TraverseSplat:
+			b.WriteString("[*]")
+		}
+	}
+	return b.String()
+}
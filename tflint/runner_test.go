@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/configs/configschema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-linters/tflint/client"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -123,6 +124,39 @@ resource "null_resource" "test" {
 }`,
 			Expected: "acbd18db4cc2f85cedef654fccc4a4d8",
 		},
+		{
+			Name: "format function",
+			Content: `
+variable "size" {
+  default = "micro"
+}
+
+resource "null_resource" "test" {
+  key = "${format("t2.%s", var.size)}"
+}`,
+			Expected: "t2.micro",
+		},
+		{
+			Name: "collection functions",
+			Content: `
+variable "types" {
+  default = "t2.micro,t2.small"
+}
+
+variable "overrides" {
+  default = {}
+}
+
+resource "null_resource" "test" {
+  key = join("-", [
+    element(split(",", var.types), 1),
+    lookup(merge({ env = "dev" }, var.overrides), "env"),
+    length(concat(["a"], ["b", "c"])),
+    coalesce("", "fallback"),
+  ])
+}`,
+			Expected: "t2.small-dev-3-fallback",
+		},
 		{
 			Name: "terraform workspace",
 			Content: `
@@ -212,6 +246,101 @@ resource "null_resource" "test" {
 	}
 }
 
+func Test_EvaluateExpr_moduleMode(t *testing.T) {
+	content := `
+variable "instance_type" {
+  type    = string
+  default = "t2.micro"
+}
+
+resource "null_resource" "test" {
+  key = var.instance_type
+}`
+	config := EmptyConfig()
+	config.ModuleMode = true
+	runner := TestRunnerWithConfig(t, map[string]string{"main.tf": content}, config)
+
+	err := runner.WalkResourceAttributes("null_resource", "key", func(attribute *hcl.Attribute) error {
+		var ret string
+		err := runner.EvaluateExpr(attribute.Expr, &ret)
+
+		appErr, ok := err.(*Error)
+		if !ok || appErr.Code != UnknownValueError {
+			t.Fatalf("Expected unknown value error, but got `%v`", err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Failed: `%s` occurred", err)
+	}
+}
+
+func Test_EvaluateExpr_workspace(t *testing.T) {
+	content := `
+resource "null_resource" "test" {
+  key = terraform.workspace
+}`
+	config := EmptyConfig()
+	config.Workspace = "production"
+	runner := TestRunnerWithConfig(t, map[string]string{"main.tf": content}, config)
+
+	err := runner.WalkResourceAttributes("null_resource", "key", func(attribute *hcl.Attribute) error {
+		var ret string
+		if err := runner.EvaluateExpr(attribute.Expr, &ret); err != nil {
+			return err
+		}
+		if ret != "production" {
+			t.Fatalf("Expected `production`, but got `%s`", ret)
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Failed: `%s` occurred", err)
+	}
+}
+
+func Test_EvaluateExpr_memoized(t *testing.T) {
+	content := `
+variable "instance_type" {
+  default = "t2.micro"
+}
+
+variable "ami" {
+  default = "ami-12345678"
+}
+
+resource "aws_instance" "web" {
+  instance_type = var.instance_type
+  ami           = var.ami
+}
+
+resource "aws_instance" "db" {
+  instance_type = var.instance_type
+  ami           = var.ami
+}`
+	runner := TestRunner(t, map[string]string{"main.tf": content})
+
+	for _, attributeName := range []string{"instance_type", "ami"} {
+		err := runner.WalkResourceAttributes("aws_instance", attributeName, func(attribute *hcl.Attribute) error {
+			var ret string
+			return runner.EvaluateExpr(attribute.Expr, &ret)
+		})
+		if err != nil {
+			t.Fatalf("Failed: `%s` occurred", err)
+		}
+	}
+
+	expected := map[string]cty.Value{
+		"var.instance_type cty.String": cty.StringVal("t2.micro"),
+		"var.ami cty.String":           cty.StringVal("ami-12345678"),
+	}
+	if !cmp.Equal(expected, runner.evalCache, cmpopts.IgnoreUnexported(cty.Value{})) {
+		t.Fatalf("Unexpected cache: %#v", runner.evalCache)
+	}
+}
+
 func Test_EvaluateExpr_integer(t *testing.T) {
 	cases := []struct {
 		Name     string
@@ -923,7 +1052,7 @@ resource "null_resource" "test" {
 		runner := TestRunner(t, map[string]string{"main.tf": tc.Content})
 
 		err := runner.WalkResourceAttributes("null_resource", "key", func(attribute *hcl.Attribute) error {
-			ret, err := isEvaluableExpr(attribute.Expr)
+			ret, err := runner.isEvaluableExpr(attribute.Expr)
 			if err != nil && tc.Error == "" {
 				t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
 			}
@@ -1353,6 +1482,64 @@ func Test_LookupIssues(t *testing.T) {
 	}
 }
 
+func Test_Comments(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `# Copyright Example Inc.
+resource "aws_instance" "web" {
+  /* TODO: pin the AMI */
+  ami = "ami-12345678" // tflint-ignore: aws_instance_invalid_ami
+}`,
+		"main.tf.json": `{"resource": {"aws_instance": {"db": {}}}}`,
+	})
+
+	comments, err := runner.Comments("main.tf")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := []struct {
+		Bytes string
+		Range hcl.Range
+	}{
+		{
+			Bytes: "# Copyright Example Inc.\n",
+			Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1, Byte: 0}, End: hcl.Pos{Line: 2, Column: 1, Byte: 25}},
+		},
+		{
+			Bytes: "/* TODO: pin the AMI */",
+			Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 3, Byte: 59}, End: hcl.Pos{Line: 3, Column: 26, Byte: 82}},
+		},
+		{
+			Bytes: "// tflint-ignore: aws_instance_invalid_ami\n",
+			Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 4, Column: 24, Byte: 106}, End: hcl.Pos{Line: 5, Column: 1, Byte: 149}},
+		},
+	}
+
+	if len(comments) != len(expected) {
+		t.Fatalf("Expected %d comments, but got %d", len(expected), len(comments))
+	}
+	for i, comment := range comments {
+		if string(comment.Bytes) != expected[i].Bytes {
+			t.Fatalf("Expected comment is `%s`, but got `%s`", expected[i].Bytes, string(comment.Bytes))
+		}
+		if !cmp.Equal(expected[i].Range, comment.Range) {
+			t.Fatalf("Failed test: diff: %s", cmp.Diff(expected[i].Range, comment.Range))
+		}
+	}
+
+	comments, err = runner.Comments("main.tf.json")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if len(comments) != 0 {
+		t.Fatalf("Comments in JSON syntax must be empty, but got %d comments", len(comments))
+	}
+
+	if _, err := runner.Comments("unknown.tf"); err == nil {
+		t.Fatal("Expected error is not occurred")
+	}
+}
+
 func Test_WalkResourceAttributes(t *testing.T) {
 	cases := []struct {
 		Name      string
@@ -1811,3 +1998,146 @@ func Test_EmitIssue(t *testing.T) {
 		}
 	}
 }
+
+func Test_EmitIssueWithEvidence(t *testing.T) {
+	runner := TestRunner(t, map[string]string{})
+	runner.AwsClient = &client.AwsClient{Region: "us-east-1", AccountID: "123456789012"}
+
+	location := hcl.Range{
+		Filename: "test.tf",
+		Start:    hcl.Pos{Line: 1},
+	}
+	runner.EmitIssueWithEvidence(&testRule{}, "This is test message", location, runner.NewEvidence("DescribeImages", "ami-1234567"))
+
+	expected := Issues{
+		{
+			Rule:    &testRule{},
+			Message: "This is test message",
+			Range:   location,
+			Evidence: &Evidence{
+				Operation:   "DescribeImages",
+				Identifiers: []string{"ami-1234567"},
+				Region:      "us-east-1",
+				AccountID:   "123456789012",
+			},
+		},
+	}
+	if !cmp.Equal(runner.Issues, expected) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(runner.Issues, expected))
+	}
+}
+
+func Test_ResourceAddress(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "instance_type" {}
+
+resource "aws_instance" "web" {
+  instance_type = var.instance_type
+
+  ebs_block_device {
+    volume_size = 10
+  }
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}`,
+	})
+
+	cases := []struct {
+		Name     string
+		Range    hcl.Range
+		Expected string
+	}{
+		{
+			Name: "attribute in resource",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 5, Column: 19, Byte: 80},
+				End:      hcl.Pos{Line: 5, Column: 36, Byte: 97},
+			},
+			Expected: "aws_instance.web",
+		},
+		{
+			Name: "nested block",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 8, Column: 5, Byte: 124},
+				End:      hcl.Pos{Line: 8, Column: 21, Byte: 140},
+			},
+			Expected: "aws_instance.web",
+		},
+		{
+			Name: "data source",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 13, Column: 3, Byte: 176},
+				End:      hcl.Pos{Line: 13, Column: 21, Byte: 194},
+			},
+			Expected: "data.aws_ami.ubuntu",
+		},
+		{
+			Name: "outside of resources",
+			Range: hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: 2, Column: 1, Byte: 1},
+				End:      hcl.Pos{Line: 2, Column: 25, Byte: 25},
+			},
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := runner.ResourceAddress(tc.Range); got != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%s, got=%s", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func Test_AwsClientAt(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+provider "aws" {
+  alias  = "east"
+  region = "us-east-1"
+}
+
+resource "aws_instance" "default" {
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "east" {
+  provider      = aws.east
+  instance_type = "t2.micro"
+}`,
+	})
+	defaultClient := &client.AwsClient{Region: "us-west-2"}
+	eastClient := &client.AwsClient{Region: "us-east-1"}
+	runner.AwsClient = defaultClient
+	runner.awsClients = map[string]*client.AwsClient{"east": eastClient}
+
+	cases := []struct {
+		Name     string
+		Resource string
+		Expected *client.AwsClient
+	}{
+		{
+			Name:     "default provider",
+			Resource: "aws_instance.default",
+			Expected: defaultClient,
+		},
+		{
+			Name:     "alias provider",
+			Resource: "aws_instance.east",
+			Expected: eastClient,
+		},
+	}
+
+	for _, tc := range cases {
+		rng := runner.TFConfig.Module.ManagedResources[tc.Resource].DeclRange
+		if got := runner.AwsClientAt(rng); got != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%s, got=%s", tc.Name, tc.Expected.Region, got.Region)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package tflint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// ProviderSchemas are schemas of resources and data sources printed by `terraform providers schema -json`
+// Schemas are indexed by types without provider names because resource types are prefixed by provider names.
+type ProviderSchemas struct {
+	Resources   map[string]*configschema.Block
+	DataSources map[string]*configschema.Block
+}
+
+// jsonProviderSchemas is the output format of `terraform providers schema -json`
+// See https://www.terraform.io/docs/commands/providers/schema.html
+type jsonProviderSchemas struct {
+	FormatVersion   string                         `json:"format_version"`
+	ProviderSchemas map[string]*jsonProviderSchema `json:"provider_schemas"`
+}
+
+type jsonProviderSchema struct {
+	ResourceSchemas   map[string]*jsonSchema `json:"resource_schemas"`
+	DataSourceSchemas map[string]*jsonSchema `json:"data_source_schemas"`
+}
+
+type jsonSchema struct {
+	Block *jsonSchemaBlock `json:"block"`
+}
+
+type jsonSchemaBlock struct {
+	Attributes map[string]*jsonSchemaAttribute `json:"attributes"`
+	BlockTypes map[string]*jsonSchemaBlockType `json:"block_types"`
+}
+
+type jsonSchemaAttribute struct {
+	Type      json.RawMessage `json:"type"`
+	Required  bool            `json:"required"`
+	Optional  bool            `json:"optional"`
+	Computed  bool            `json:"computed"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+type jsonSchemaBlockType struct {
+	NestingMode string           `json:"nesting_mode"`
+	Block       *jsonSchemaBlock `json:"block"`
+	MinItems    int              `json:"min_items"`
+	MaxItems    int              `json:"max_items"`
+}
+
+var nestingModes = map[string]configschema.NestingMode{
+	"single": configschema.NestingSingle,
+	"group":  configschema.NestingGroup,
+	"list":   configschema.NestingList,
+	"set":    configschema.NestingSet,
+	"map":    configschema.NestingMap,
+}
+
+// loadProviderSchemas reads schemas of the config, or returns the schemas loaded for another root module in the batch
+func (r *Runner) loadProviderSchemas() (*ProviderSchemas, error) {
+	if r.cache != nil && r.cache.schemas != nil {
+		return r.cache.schemas, nil
+	}
+
+	log.Printf("[INFO] Load provider schemas from %s", r.config.ProviderSchemas)
+	schemas, err := LoadProviderSchemas(r.config.ProviderSchemas)
+	if err != nil {
+		return nil, err
+	}
+	if r.cache != nil {
+		r.cache.schemas = schemas
+	}
+	return schemas, nil
+}
+
+// LoadProviderSchemas reads schemas from the file printed by `terraform providers schema -json`
+func LoadProviderSchemas(path string) (*ProviderSchemas, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw jsonProviderSchemas
+	if err := json.Unmarshal(src, &raw); err != nil {
+		return nil, fmt.Errorf("Failed to parse %s: %s", path, err)
+	}
+	if raw.FormatVersion == "" {
+		return nil, fmt.Errorf("%s is not the output of `terraform providers schema -json`", path)
+	}
+
+	ret := &ProviderSchemas{
+		Resources:   map[string]*configschema.Block{},
+		DataSources: map[string]*configschema.Block{},
+	}
+	for provider, schema := range raw.ProviderSchemas {
+		log.Printf("[DEBUG] Load schemas of `%s` provider", provider)
+		if err := decodeSchemas(ret.Resources, schema.ResourceSchemas); err != nil {
+			return nil, fmt.Errorf("Failed to load schemas of `%s` provider: %s", provider, err)
+		}
+		if err := decodeSchemas(ret.DataSources, schema.DataSourceSchemas); err != nil {
+			return nil, fmt.Errorf("Failed to load schemas of `%s` provider: %s", provider, err)
+		}
+	}
+	return ret, nil
+}
+
+func decodeSchemas(ret map[string]*configschema.Block, schemas map[string]*jsonSchema) error {
+	for name, schema := range schemas {
+		if schema.Block == nil {
+			continue
+		}
+		block, err := schema.Block.decode()
+		if err != nil {
+			return fmt.Errorf("`%s`: %s", name, err)
+		}
+		ret[name] = block
+	}
+	return nil
+}
+
+func (b *jsonSchemaBlock) decode() (*configschema.Block, error) {
+	ret := &configschema.Block{
+		Attributes: map[string]*configschema.Attribute{},
+		BlockTypes: map[string]*configschema.NestedBlock{},
+	}
+	for name, attr := range b.Attributes {
+		ty, err := ctyjson.UnmarshalType(attr.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type of `%s`: %s", name, err)
+		}
+		ret.Attributes[name] = &configschema.Attribute{
+			Type:      ty,
+			Required:  attr.Required,
+			Optional:  attr.Optional,
+			Computed:  attr.Computed,
+			Sensitive: attr.Sensitive,
+		}
+	}
+	for name, blockType := range b.BlockTypes {
+		nesting, ok := nestingModes[blockType.NestingMode]
+		if !ok {
+			return nil, fmt.Errorf("invalid nesting mode of `%s`: %s", name, blockType.NestingMode)
+		}
+		nested := &configschema.Block{}
+		if blockType.Block != nil {
+			var err error
+			nested, err = blockType.Block.decode()
+			if err != nil {
+				return nil, err
+			}
+		}
+		ret.BlockTypes[name] = &configschema.NestedBlock{
+			Block:    *nested,
+			Nesting:  nesting,
+			MinItems: blockType.MinItems,
+			MaxItems: blockType.MaxItems,
+		}
+	}
+	return ret, nil
+}
+
+// ResourceSchema returns the schema of the resource or the data source
+// It returns nil if `provider_schemas` is not set or the type is not found in the schemas.
+func (r *Runner) ResourceSchema(resource *configs.Resource) *configschema.Block {
+	return r.ResourceTypeSchemas(resource.Mode)[resource.Type]
+}
+
+// ResourceTypeSchemas returns schemas of resources or data sources indexed by types
+// It returns nil if `provider_schemas` is not set.
+func (r *Runner) ResourceTypeSchemas(mode addrs.ResourceMode) map[string]*configschema.Block {
+	if r.schemas == nil {
+		return nil
+	}
+	if mode == addrs.DataResourceMode {
+		return r.schemas.DataSources
+	}
+	return r.schemas.Resources
+}
+
+// SchemaBodyWalker receives a body of a resource or a nested block decoded with its schema
+// Diagnostics report arguments and blocks which are not declared in the schema.
+type SchemaBodyWalker func(resource *configs.Resource, content *hcl.BodyContent, schema *configschema.Block, diags hcl.Diagnostics) error
+
+// WalkResourceSchemaBodies walks bodies of resources and data sources in the module with their schemas
+// Nested blocks, including contents of dynamic blocks, are walked after their parents.
+// Resources whose types are not found in `provider_schemas` are skipped.
+func (r *Runner) WalkResourceSchemaBodies(walker SchemaBodyWalker) error {
+	if r.schemas == nil {
+		return nil
+	}
+
+	resources := []*configs.Resource{}
+	for _, resource := range r.TFConfig.Module.ManagedResources {
+		resources = append(resources, resource)
+	}
+	for _, resource := range r.TFConfig.Module.DataResources {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Addr().String() < resources[j].Addr().String()
+	})
+
+	for _, resource := range resources {
+		schema := r.ResourceSchema(resource)
+		if schema == nil {
+			log.Printf("[DEBUG] Schema of `%s` is not found. Skipped", resource.Type)
+			continue
+		}
+		if err := WalkSchemaBody(resource, schema, walker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkSchemaBody walks the body of the resource with the passed schema in the same way as WalkResourceSchemaBodies
+// It is useful to decode the body with a schema of another type, such as the type suggested for misspelled one.
+func WalkSchemaBody(resource *configs.Resource, schema *configschema.Block, walker SchemaBodyWalker) error {
+	return walkSchemaBody(resource, resource.Config, schema, walker)
+}
+
+// dynamicBlockSchema is the schema of a body of a dynamic block
+var dynamicBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "for_each", Required: true},
+		{Name: "iterator"},
+		{Name: "labels"},
+	},
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "content"},
+	},
+}
+
+func walkSchemaBody(resource *configs.Resource, body hcl.Body, schema *configschema.Block, walker SchemaBodyWalker) error {
+	// All attributes are optional so that only unknown arguments are reported.
+	// Required arguments may be passed by dynamic blocks or callers of modules.
+	bodySchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "dynamic", LabelNames: []string{"type"}}},
+	}
+	for name := range schema.Attributes {
+		bodySchema.Attributes = append(bodySchema.Attributes, hcl.AttributeSchema{Name: name})
+	}
+	for name := range schema.BlockTypes {
+		bodySchema.Blocks = append(bodySchema.Blocks, hcl.BlockHeaderSchema{Type: name})
+	}
+
+	content, diags := body.Content(bodySchema)
+	// Bodies of nested blocks keyed by block types. Contents of dynamic blocks are treated as the generated blocks
+	type nestedBody struct {
+		blockType string
+		body      hcl.Body
+	}
+	nested := []nestedBody{}
+	for _, block := range content.Blocks {
+		if block.Type != "dynamic" {
+			nested = append(nested, nestedBody{blockType: block.Type, body: block.Body})
+			continue
+		}
+
+		if _, exists := schema.BlockTypes[block.Labels[0]]; !exists {
+			diags = diags.Append(&hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsupported block type",
+				Detail:   fmt.Sprintf("Blocks of type %q are not expected here.", block.Labels[0]),
+				Subject:  &block.LabelRanges[0],
+			})
+			continue
+		}
+		dynamicContent, _ := block.Body.Content(dynamicBlockSchema)
+		for _, contentBlock := range dynamicContent.Blocks {
+			nested = append(nested, nestedBody{blockType: block.Labels[0], body: contentBlock.Body})
+		}
+	}
+
+	if err := walker(resource, content, schema, diags); err != nil {
+		return err
+	}
+
+	for _, n := range nested {
+		if err := walkSchemaBody(resource, n.body, &schema.BlockTypes[n.blockType].Block, walker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
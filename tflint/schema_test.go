@@ -0,0 +1,55 @@
+package tflint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func Test_LoadProviderSchemas(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := LoadProviderSchemas(filepath.Join(currentDir, "test-fixtures", "provider_schemas", "schemas.json"))
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	instance, ok := schemas.Resources["aws_instance"]
+	if !ok {
+		t.Fatal("`aws_instance` schema is not found")
+	}
+	if ami := instance.Attributes["ami"]; !ami.Required || !ami.Type.Equals(cty.String) {
+		t.Fatalf("Unexpected `ami` schema: %#v", ami)
+	}
+	if tags := instance.Attributes["tags"]; !tags.Type.Equals(cty.Map(cty.String)) {
+		t.Fatalf("Unexpected `tags` type: %s", tags.Type.FriendlyName())
+	}
+	device, ok := instance.BlockTypes["ebs_block_device"]
+	if !ok {
+		t.Fatal("`ebs_block_device` block schema is not found")
+	}
+	if device.Nesting != configschema.NestingSet || !device.Attributes["volume_size"].Type.Equals(cty.Number) {
+		t.Fatalf("Unexpected `ebs_block_device` schema: %#v", device)
+	}
+	if _, ok := schemas.DataSources["aws_ami"]; !ok {
+		t.Fatal("`aws_ami` schema is not found")
+	}
+}
+
+func Test_LoadProviderSchemas_invalid(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = LoadProviderSchemas(filepath.Join(currentDir, "test-fixtures", "config", "config.hcl"))
+	if err == nil {
+		t.Fatal("Expected an error, but no error occurred")
+	}
+}
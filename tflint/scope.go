@@ -0,0 +1,117 @@
+package tflint
+
+import (
+	"log"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+// scopeMatchers caches matchers of path patterns of rule configs, keyed by the joined patterns
+// Patterns are matched for every issue, so they are compiled only once per process.
+var scopeMatchers sync.Map
+
+// scopeMatcher returns the compiled matcher of the path patterns
+func scopeMatcher(patterns []string) *IgnoreMatcher {
+	key := strings.Join(patterns, "\n")
+	if matcher, ok := scopeMatchers.Load(key); ok {
+		return matcher.(*IgnoreMatcher)
+	}
+	matcher, _ := scopeMatchers.LoadOrStore(key, NewIgnoreMatcher(patterns))
+	return matcher.(*IgnoreMatcher)
+}
+
+// InRuleScope returns whether the rule can report issues in the runner according to the path patterns of the rule config
+// Path patterns are matched against file names of issues. An issue found in a child module is reported at the argument
+// of the module call in the root module, so its file name is always a file of the root module, not a file of the child module.
+// Therefore, the rule is skipped only if no files of the root module match the patterns, even in child modules.
+func (r *Runner) InRuleScope(ruleName string) bool {
+	rule, exists := r.config.Rules[ruleName]
+	if !exists || len(rule.Only) == 0 {
+		return true
+	}
+
+	matcher := scopeMatcher(rule.Only)
+	rootDir := filepath.Clean(r.TFConfig.Root.Module.SourceDir)
+	for filename := range r.Sources {
+		if filepath.Dir(filename) == rootDir && matcher.Match(filename, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// inRuleScope returns whether the issue matches all the scopes of the rule config
+// Scopes of resource addresses and tags are not matched by issues outside of resources.
+func (r *Runner) inRuleScope(issue *Issue) bool {
+	rule, exists := r.config.Rules[issue.Rule.Name()]
+	if !exists {
+		return true
+	}
+
+	if len(rule.Only) > 0 && !scopeMatcher(rule.Only).Match(issue.Range.Filename, false) {
+		return false
+	}
+
+	if len(rule.OnlyResources) > 0 {
+		matched := false
+		for _, pattern := range rule.OnlyResources {
+			// Patterns are validated when loading the config
+			if ok, _ := path.Match(pattern, issue.Address); ok && issue.Address != "" {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(rule.OnlyTags) > 0 {
+		// Issues in child modules are found at the last caller
+		location := issue.Range
+		if len(issue.Callers) > 0 {
+			location = issue.Callers[len(issue.Callers)-1]
+		}
+		tags, ok := r.resourceTags(location)
+		if !ok {
+			return false
+		}
+		for key, value := range rule.OnlyTags {
+			if tags[key] != value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// resourceTags returns the evaluated `tags` of the resource containing the range
+// It returns false if the range is not in any resources or the tags cannot be evaluated.
+func (r *Runner) resourceTags(rng hcl.Range) (map[string]string, bool) {
+	resource := r.resourceAt(rng)
+	if resource == nil {
+		return nil, false
+	}
+	content, _, diags := resource.Config.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "tags"}},
+	})
+	if diags.HasErrors() {
+		return nil, false
+	}
+	attr, exists := content.Attributes["tags"]
+	if !exists {
+		return map[string]string{}, true
+	}
+
+	tags := map[string]string{}
+	if err := r.EvaluateExpr(attr.Expr, &tags); err != nil {
+		log.Printf("[DEBUG] Failed to evaluate tags of `%s` for rule scopes: %s", resource.Addr(), r.redact(err.Error()))
+		return nil, false
+	}
+	return tags, true
+}
@@ -0,0 +1,179 @@
+package tflint
+
+import (
+	"reflect"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func Test_EmitIssue_ruleScope(t *testing.T) {
+	files := map[string]string{
+		"main.tf": `
+resource "aws_instance" "web" {
+  tags = {
+    env = "dev"
+  }
+}`,
+		"prod.tf": `
+variable "env" {
+  default = "prod"
+}
+
+resource "aws_instance" "prod_web" {
+  tags = {
+    env = var.env
+  }
+}
+
+resource "aws_instance" "prod_db" {}`,
+	}
+
+	cases := []struct {
+		Name     string
+		Rule     *RuleConfig
+		InScope  bool
+		Expected []string
+	}{
+		{
+			Name:     "no scopes",
+			Rule:     &RuleConfig{Name: "test_rule", Enabled: true},
+			InScope:  true,
+			Expected: []string{"aws_instance.web", "aws_instance.prod_web", "aws_instance.prod_db", ""},
+		},
+		{
+			Name:     "paths",
+			Rule:     &RuleConfig{Name: "test_rule", Enabled: true, Only: []string{"prod.tf"}},
+			InScope:  true,
+			Expected: []string{"aws_instance.prod_web", "aws_instance.prod_db", ""},
+		},
+		{
+			Name:     "no matching paths",
+			Rule:     &RuleConfig{Name: "test_rule", Enabled: true, Only: []string{"envs/prod/**"}},
+			InScope:  false,
+			Expected: []string{},
+		},
+		{
+			Name:     "resources",
+			Rule:     &RuleConfig{Name: "test_rule", Enabled: true, OnlyResources: []string{"aws_instance.prod_*"}},
+			InScope:  true,
+			Expected: []string{"aws_instance.prod_web", "aws_instance.prod_db"},
+		},
+		{
+			Name:     "tags",
+			Rule:     &RuleConfig{Name: "test_rule", Enabled: true, OnlyTags: map[string]string{"env": "prod"}},
+			InScope:  true,
+			Expected: []string{"aws_instance.prod_web"},
+		},
+	}
+
+	for _, tc := range cases {
+		config := EmptyConfig()
+		config.Rules["test_rule"] = tc.Rule
+		runner := TestRunnerWithConfig(t, files, config)
+
+		if runner.InRuleScope("test_rule") != tc.InScope {
+			t.Fatalf("Failed `%s` test: expected InRuleScope to be %t", tc.Name, tc.InScope)
+		}
+
+		locations := []hcl.Range{}
+		for _, resource := range runner.TFConfig.Module.ManagedResources {
+			locations = append(locations, resource.DeclRange)
+		}
+		locations = append(locations, runner.TFConfig.Module.Variables["env"].DeclRange)
+		for _, location := range locations {
+			runner.EmitIssue(&testRule{}, "test", location)
+		}
+
+		got := map[string]bool{}
+		for _, issue := range runner.Issues {
+			got[issue.Address] = true
+		}
+		if len(got) != len(tc.Expected) {
+			t.Fatalf("Failed `%s` test: expected=%#v, got=%#v", tc.Name, tc.Expected, got)
+		}
+		for _, address := range tc.Expected {
+			if !got[address] {
+				t.Fatalf("Failed `%s` test: expected=%#v, got=%#v", tc.Name, tc.Expected, got)
+			}
+		}
+	}
+}
+
+func Test_EmitIssue_ruleScope_childModule(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Only     []string
+		InScope  bool
+		Expected []string
+	}{
+		{
+			Name:    "files of the root module",
+			Only:    []string{"main.tf"},
+			InScope: true,
+			// `red` refers to `foo` and `bar`, which are passed in the root module
+			Expected: []string{"main.tf", "main.tf"},
+		},
+		{
+			Name:     "files of the child module",
+			Only:     []string{"module/**"},
+			InScope:  false,
+			Expected: []string{},
+		},
+	}
+
+	withinFixtureDir(t, "nested_module_vars", func() {
+		for _, tc := range cases {
+			config := moduleConfig()
+			config.Rules["test_rule"] = &RuleConfig{Name: "test_rule", Enabled: true, Only: tc.Only}
+			loader, err := NewLoader(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := loader.LoadConfig(".")
+			if err != nil {
+				t.Fatal(err)
+			}
+			runner, err := NewRunner(config, map[string]Annotations{}, cfg, map[string]*terraform.InputValue{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			runner.Sources = loader.Sources()
+			runners, err := NewModuleRunners(runner)
+			if err != nil {
+				t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+			}
+			child := runners[0]
+
+			if child.InRuleScope("test_rule") != tc.InScope {
+				t.Fatalf("Failed `%s` test: expected InRuleScope to be %t", tc.Name, tc.InScope)
+			}
+
+			call := child.TFConfig.Module.ModuleCalls["module2"]
+			attrs, diags := call.Config.JustAttributes()
+			if diags.HasErrors() {
+				t.Fatal(diags)
+			}
+			expr := attrs["red"].Expr
+			err = child.WithExpressionContext(expr, func() error {
+				child.EmitIssue(&testRule{}, "test", expr.Range())
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := []string{}
+			for _, issue := range child.Issues {
+				got = append(got, issue.Range.Filename)
+			}
+			if len(got) == 0 && len(tc.Expected) == 0 {
+				continue
+			}
+			if !reflect.DeepEqual(got, tc.Expected) {
+				t.Fatalf("Failed `%s` test: expected=%#v, got=%#v", tc.Name, tc.Expected, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package tflint
+
+import (
+	"errors"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// redactedValue is a placeholder for sensitive values
+const redactedValue = "(sensitive)"
+
+// minSensitiveValueLength is the minimum length of values to be redacted
+const minSensitiveValueLength = 4
+
+var sensitiveVariableSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "variable", LabelNames: []string{"name"}},
+	},
+}
+
+var sensitiveAttributeSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "sensitive"},
+	},
+}
+
+// RedactVariables returns variables in the form of `key=value` with values replaced by "(sensitive)"
+// Since it is not known which variables are sensitive before loading configurations,
+// all values are redacted. It is used for logging variables passed by CLI options and the config file.
+func RedactVariables(variables []string) []string {
+	ret := make([]string, len(variables))
+	for i, variable := range variables {
+		ret[i] = strings.SplitN(variable, "=", 2)[0] + "=" + redactedValue
+	}
+	return ret
+}
+
+// SensitiveVariables returns sorted names of variables marked as sensitive
+// A variable is sensitive if it is declared with `sensitive = true` (Terraform v0.14+) or its name matches `sensitive_pattern`.
+func (r *Runner) SensitiveVariables() ([]string, error) {
+	names := map[string]bool{}
+
+	if r.config.SensitivePattern != "" {
+		pattern, err := regexp.Compile(r.config.SensitivePattern)
+		if err != nil {
+			return nil, err
+		}
+		for name := range r.TFConfig.Module.Variables {
+			if pattern.MatchString(name) {
+				names[name] = true
+			}
+		}
+	}
+
+	files, err := r.moduleFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		content, _, diags := file.Body.PartialContent(sensitiveVariableSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(sensitiveAttributeSchema)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			attr, exists := attrs.Attributes["sensitive"]
+			if !exists {
+				continue
+			}
+
+			val, diags := attr.Expr.Value(nil)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+			if val.Type() == cty.Bool && val.IsKnown() && !val.IsNull() && val.True() {
+				names[block.Labels[0]] = true
+			}
+		}
+	}
+
+	ret := []string{}
+	for name := range names {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// redact replaces values of sensitive variables in the passed message with "(sensitive)"
+// Sensitive values are collected once on the first call. Trivial values such as "1" and "true" are not redacted,
+// because replacing them would break unrelated parts of messages, and they are not secrets in practice.
+func (r *Runner) redact(message string) string {
+	r.sensitiveOnce.Do(func() {
+		names, err := r.SensitiveVariables()
+		if err != nil {
+			log.Printf("[WARN] Failed to detect sensitive variables: %s", err)
+		}
+		for _, name := range names {
+			for _, value := range primitiveStrings(r.ctx.Evaluator.VariableValues[""][name]) {
+				if !trivialValue(value) {
+					r.sensitiveValues = append(r.sensitiveValues, value)
+				}
+			}
+		}
+		// Replace longer values first so that a value containing another value is redacted as a whole
+		sort.Slice(r.sensitiveValues, func(i, j int) bool {
+			return len(r.sensitiveValues[i]) > len(r.sensitiveValues[j])
+		})
+	})
+
+	for _, value := range r.sensitiveValues {
+		message = strings.Replace(message, value, redactedValue, -1)
+	}
+	return message
+}
+
+// Redact replaces values of sensitive variables in the passed message with "(sensitive)"
+// Issue messages and evidence are redacted by the runner, but rules should pass evaluated values through it before logging them.
+func (r *Runner) Redact(message string) string {
+	return r.redact(message)
+}
+
+// redactError returns an error whose message is redacted
+// It is used for causes of evaluation errors, which may contain values of variables.
+func (r *Runner) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if message := r.redact(err.Error()); message != err.Error() {
+		return errors.New(message)
+	}
+	return err
+}
+
+// trivialValue returns whether the value is too short or too common to be redacted
+func trivialValue(value string) bool {
+	if len(value) < minSensitiveValueLength {
+		return true
+	}
+	switch strings.ToLower(value) {
+	case "true", "false", "null":
+		return true
+	}
+	return false
+}
+
+// primitiveStrings returns string representations of all known primitive values in the passed value
+func primitiveStrings(val cty.Value) []string {
+	ret := []string{}
+	if val == cty.NilVal {
+		return ret
+	}
+
+	cty.Walk(val, func(path cty.Path, v cty.Value) (bool, error) {
+		if !v.IsKnown() || v.IsNull() {
+			return false, nil
+		}
+		switch v.Type() {
+		case cty.String:
+			if s := v.AsString(); s != "" {
+				ret = append(ret, s)
+			}
+		case cty.Number:
+			ret = append(ret, v.AsBigFloat().Text('f', -1))
+		}
+		return true, nil
+	})
+	return ret
+}
+
+// ignoreSensitiveArgumentDiagnostics removes errors of `sensitive` arguments in variable blocks from the passed diagnostics
+// The argument is introduced in Terraform v0.14 and is read by SensitiveVariables.
+func ignoreSensitiveArgumentDiagnostics(diags hcl.Diagnostics) hcl.Diagnostics {
+	var ret hcl.Diagnostics
+	for _, diag := range diags {
+		// HCL native syntax
+		if diag.Summary == "Unsupported argument" && strings.HasPrefix(diag.Detail, `An argument named "sensitive" is not expected here.`) {
+			continue
+		}
+		// JSON syntax
+		if diag.Summary == "Extraneous JSON object property" && strings.HasPrefix(diag.Detail, `No argument or block type is named "sensitive".`) {
+			continue
+		}
+		ret = append(ret, diag)
+	}
+	return ret
+}
@@ -0,0 +1,124 @@
+package tflint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func Test_SensitiveVariables(t *testing.T) {
+	config := EmptyConfig()
+	config.SensitivePattern = "token"
+
+	runner := TestRunnerWithConfig(t, map[string]string{
+		"main.tf": `
+variable "password" {
+  default   = "p@ssw0rd"
+  sensitive = true
+}
+
+variable "api_token" {
+  default = "abcdef"
+}
+
+variable "instance_type" {
+  default = "t2.micro"
+}`,
+	}, config)
+
+	names, err := runner.SensitiveVariables()
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	expected := []string{"api_token", "password"}
+	if !cmp.Equal(expected, names) {
+		t.Fatalf("Failed test: diff=%s", cmp.Diff(expected, names))
+	}
+
+	runner.EmitIssue(&testRule{}, `"p@ssw0rd" and "abcdef" are invalid for "t2.micro"`, hcl.Range{Filename: "main.tf"})
+	if msg := runner.Issues[0].Message; msg != `"(sensitive)" and "(sensitive)" are invalid for "t2.micro"` {
+		t.Fatalf("Failed test: unexpected message: %s", msg)
+	}
+}
+
+func Test_RedactVariables(t *testing.T) {
+	ret := RedactVariables([]string{"foo=bar", "baz=['a=b']"})
+	expected := []string{"foo=(sensitive)", "baz=(sensitive)"}
+	if !cmp.Equal(expected, ret) {
+		t.Fatalf("Failed test: diff=%s", cmp.Diff(expected, ret))
+	}
+}
+
+func Test_redact(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "password" {
+  default   = "p@ssw0rd"
+  sensitive = true
+}
+
+variable "enabled" {
+  default   = "true"
+  sensitive = true
+}
+
+variable "pin" {
+  default   = 1
+  sensitive = true
+}`,
+	})
+	// Sensitive values must be detected even before sources are set to the runner
+	runner.Sources = nil
+
+	cases := []struct {
+		Name     string
+		Message  string
+		Expected string
+	}{
+		{
+			Name:     "sensitive value",
+			Message:  `"p@ssw0rd" is invalid`,
+			Expected: `"(sensitive)" is invalid`,
+		},
+		{
+			Name:     "trivial values",
+			Message:  `count = 1 and enabled = true`,
+			Expected: `count = 1 and enabled = true`,
+		},
+	}
+
+	for _, tc := range cases {
+		if ret := runner.redact(tc.Message); ret != tc.Expected {
+			t.Fatalf("Failed `%s` test: expected=%s, got=%s", tc.Name, tc.Expected, ret)
+		}
+	}
+}
+
+func Test_EvalExpr_redactError(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "password" {
+  default   = "p@ssw0rd"
+  sensitive = true
+}
+
+resource "null_resource" "foo" {
+  triggers = {
+    ip = cidrhost(var.password, 1)
+  }
+}`,
+	})
+
+	expr := runner.TFConfig.Module.ManagedResources["null_resource.foo"].Config.(*hclsyntax.Body).Attributes["triggers"].Expr
+	_, err := runner.EvalExpr(expr, nil, cty.DynamicPseudoType)
+	if err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+	if strings.Contains(err.Error(), "p@ssw0rd") || !strings.Contains(err.Error(), "(sensitive)") {
+		t.Fatalf("The error is not redacted: %s", err)
+	}
+}
@@ -0,0 +1,364 @@
+package tflint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/spf13/afero"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// loadTFState reads the local state file of the current workspace of the configuration in the directory from the passed filesystem
+// If the state file does not exist, it returns nil without an error. The workspace is detected if it is empty.
+func loadTFState(fs afero.Afero, dir string, workspace string) (*states.State, error) {
+	path := getTFStatePath(fs, dir, workspace)
+	f, err := fs.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("[INFO] State file is not found: %s", path)
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	log.Printf("[INFO] Load state: %s", path)
+	file, err := statefile.Read(f)
+	if err != nil {
+		if err == statefile.ErrNoState {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return file.State, nil
+}
+
+// HasState returns whether the state is loaded
+// The state is loaded only in deep check mode.
+func (r *Runner) HasState() bool {
+	return r.state != nil
+}
+
+// IsResourceInState returns whether the passed resource has already been recorded in the state
+// If the state is not loaded, it always returns false
+func (r *Runner) IsResourceInState(resource *configs.Resource) bool {
+	if r.state == nil {
+		return false
+	}
+
+	for _, module := range r.state.Modules {
+		if !isSameModulePath(module.Addr, r.TFConfig.Path) {
+			continue
+		}
+		if module.Resource(resource.Addr()) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// isSameModulePath compares module paths ignoring instance keys
+func isSameModulePath(instance addrs.ModuleInstance, path addrs.Module) bool {
+	if len(instance) != len(path) {
+		return false
+	}
+	for i, step := range instance {
+		if step.Name != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// State is a query API over the loaded state for the module of the runner
+type State struct {
+	state *states.State
+	path  addrs.Module
+}
+
+// StateObject is the current object of a resource instance recorded in the state
+type StateObject struct {
+	// Address is the address of the resource instance, such as `aws_instance.web[0]`
+	Address string
+	src     *states.ResourceInstanceObjectSrc
+	value   cty.Value
+}
+
+// State returns the query API over the loaded state
+// It returns nil if the state is not loaded. Note that the state is loaded only in deep check mode.
+func (r *Runner) State() *State {
+	if r.state == nil {
+		return nil
+	}
+	return &State{state: r.state, path: r.TFConfig.Path}
+}
+
+// Lookup returns the object of the resource instance in the state, such as `aws_instance.web` or `aws_instance.web[0]`
+// The address is relative to the module of the runner. If the module is called multiple times,
+// the first instance of the module is used. It returns nil if the resource instance is not recorded.
+func (s *State) Lookup(address string) *StateObject {
+	addr, diags := addrs.ParseAbsResourceInstanceStr(address)
+	if diags.HasErrors() || !addr.Module.IsRoot() {
+		log.Printf("[WARN] Invalid address to lookup the state: %s", address)
+		return nil
+	}
+
+	for _, module := range s.state.Modules {
+		if !isSameModulePath(module.Addr, s.path) {
+			continue
+		}
+		instance := module.ResourceInstance(addr.Resource)
+		if instance == nil || instance.Current == nil {
+			continue
+		}
+		return &StateObject{Address: address, src: instance.Current}
+	}
+	return nil
+}
+
+// StateResource is a managed resource recorded in the state, regardless of instance keys
+type StateResource struct {
+	// Module is the path of the module relative to the module of the runner
+	Module   addrs.Module
+	Resource addrs.Resource
+}
+
+// String returns the address relative to the module of the runner, such as `module.vpc.aws_vpc.main`
+func (r StateResource) String() string {
+	parts := []string{}
+	for _, name := range r.Module {
+		parts = append(parts, "module."+name)
+	}
+	return strings.Join(append(parts, r.Resource.String()), ".")
+}
+
+// Resources returns managed resources recorded in the module of the runner and its descendants
+// Resources of module instances called multiple times are returned once. The result is sorted by addresses.
+func (s *State) Resources() []StateResource {
+	seen := map[string]bool{}
+	ret := []StateResource{}
+	for _, module := range s.state.Modules {
+		if len(module.Addr) < len(s.path) || !isSameModulePath(module.Addr[:len(s.path)], s.path) {
+			continue
+		}
+		path := addrs.Module{}
+		for _, step := range module.Addr[len(s.path):] {
+			path = append(path, step.Name)
+		}
+
+		for _, resource := range module.Resources {
+			if resource.Addr.Mode != addrs.ManagedResourceMode {
+				continue
+			}
+			r := StateResource{Module: path, Resource: resource.Addr}
+			if !seen[r.String()] {
+				seen[r.String()] = true
+				ret = append(ret, r)
+			}
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].String() < ret[j].String()
+	})
+	return ret
+}
+
+// Attr returns the value of the attribute recorded in the state
+// Since provider schemas are not available, the type of the value is inferred from the JSON representation.
+// For example, numbers are always `cty.Number` and blocks are objects or lists of objects.
+func (o *StateObject) Attr(name string) (cty.Value, error) {
+	if err := o.decode(); err != nil {
+		return cty.NilVal, err
+	}
+
+	if !o.value.Type().IsObjectType() || !o.value.Type().HasAttribute(name) {
+		return cty.NilVal, fmt.Errorf("`%s` is not recorded in `%s`", name, o.Address)
+	}
+	return o.value.GetAttr(name), nil
+}
+
+func (o *StateObject) decode() error {
+	if o.value != cty.NilVal {
+		return nil
+	}
+	if o.src.AttrsJSON == nil {
+		return fmt.Errorf("Attributes of `%s` are recorded in the legacy format", o.Address)
+	}
+
+	ty, err := ctyjson.ImpliedType(o.src.AttrsJSON)
+	if err != nil {
+		return err
+	}
+	o.value, err = ctyjson.Unmarshal(o.src.AttrsJSON, ty)
+	return err
+}
+
+// LoadState returns the query API over the state of the root module in the workspace of the config
+// The state is read from the backend configured in the module, or the local state file. It returns nil without an error
+// if the state does not exist. The workspace is detected if it is not set in the config.
+func LoadState(ctx context.Context, fs afero.Afero, module *configs.Module, c *Config) (*State, error) {
+	state, err := loadBackendState(ctx, fs, module, c)
+	if err != nil || state == nil {
+		return nil, err
+	}
+	return &State{state: state, path: addrs.RootModule}, nil
+}
+
+// GenerateConfig returns an HCL skeleton of the resource block for the object
+// If the schema of the resource type is passed, attributes are selected by the schema: purely computed attributes
+// such as `arn` are omitted, and nested blocks are written as declared in the schema. Otherwise, attributes are
+// selected from the recorded values, so computed attributes cannot be distinguished from arguments.
+// In both cases, `id`, null values and empty collections are omitted.
+func (o *StateObject) GenerateConfig(schema *configschema.Block) ([]byte, error) {
+	addr, diags := addrs.ParseAbsResourceInstanceStr(o.Address)
+	if diags.HasErrors() {
+		return nil, diags.Err()
+	}
+	if err := o.decode(); err != nil {
+		return nil, err
+	}
+
+	file := hclwrite.NewEmptyFile()
+	resource := addr.Resource.Resource
+	labels := []string{resource.Type, resource.Name}
+	blockType := "resource"
+	if resource.Mode == addrs.DataResourceMode {
+		blockType = "data"
+	}
+	body := file.Body().AppendNewBlock(blockType, labels).Body()
+	if schema != nil {
+		writeStateObjectWithSchema(body, o.value, schema, true)
+	} else {
+		writeStateObject(body, o.value, true)
+	}
+	return file.Bytes(), nil
+}
+
+func writeStateObject(body *hclwrite.Body, value cty.Value, root bool) {
+	if !value.Type().IsObjectType() {
+		return
+	}
+
+	names := []string{}
+	for name := range value.Type().AttributeTypes() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	blocks := []string{}
+	for _, name := range names {
+		if root && name == "id" {
+			continue
+		}
+		attr := value.GetAttr(name)
+		if isOmittedStateValue(attr) {
+			continue
+		}
+		if isBlockValue(attr) {
+			blocks = append(blocks, name)
+			continue
+		}
+		body.SetAttributeValue(name, attr)
+	}
+
+	for _, name := range blocks {
+		for it := value.GetAttr(name).ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			body.AppendNewline()
+			writeStateObject(body.AppendNewBlock(name, nil).Body(), element, false)
+		}
+	}
+}
+
+func writeStateObjectWithSchema(body *hclwrite.Body, value cty.Value, schema *configschema.Block, root bool) {
+	if !value.Type().IsObjectType() {
+		return
+	}
+
+	names := []string{}
+	for name := range schema.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attr := schema.Attributes[name]
+		if root && name == "id" {
+			continue
+		}
+		if attr.Computed && !attr.Optional && !attr.Required {
+			continue
+		}
+		if !value.Type().HasAttribute(name) || isOmittedStateValue(value.GetAttr(name)) {
+			continue
+		}
+		body.SetAttributeValue(name, value.GetAttr(name))
+	}
+
+	names = []string{}
+	for name := range schema.BlockTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !value.Type().HasAttribute(name) || isOmittedStateValue(value.GetAttr(name)) {
+			continue
+		}
+		blockType := schema.BlockTypes[name]
+		nested := value.GetAttr(name)
+
+		switch blockType.Nesting {
+		case configschema.NestingSingle, configschema.NestingGroup:
+			body.AppendNewline()
+			writeStateObjectWithSchema(body.AppendNewBlock(name, nil).Body(), nested, &blockType.Block, false)
+		case configschema.NestingMap:
+			for it := nested.ElementIterator(); it.Next(); {
+				key, element := it.Element()
+				body.AppendNewline()
+				writeStateObjectWithSchema(body.AppendNewBlock(name, []string{key.AsString()}).Body(), element, &blockType.Block, false)
+			}
+		default:
+			for it := nested.ElementIterator(); it.Next(); {
+				_, element := it.Element()
+				body.AppendNewline()
+				writeStateObjectWithSchema(body.AppendNewBlock(name, nil).Body(), element, &blockType.Block, false)
+			}
+		}
+	}
+}
+
+// isOmittedStateValue returns whether the recorded value is not written to the generated config
+func isOmittedStateValue(value cty.Value) bool {
+	if value.IsNull() || !value.IsKnown() {
+		return true
+	}
+	return value.CanIterateElements() && value.LengthInt() == 0
+}
+
+// isBlockValue returns whether the value looks like nested blocks, that is, a list of objects
+func isBlockValue(value cty.Value) bool {
+	ty := value.Type()
+	if !ty.IsTupleType() && !ty.IsListType() {
+		return false
+	}
+	for it := value.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		if !element.Type().IsObjectType() {
+			return false
+		}
+	}
+	return true
+}
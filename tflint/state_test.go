@@ -0,0 +1,386 @@
+package tflint
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/spf13/afero"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func Test_IsResourceInState(t *testing.T) {
+	content := `
+resource "aws_s3_bucket" "managed" {
+  bucket = "managed"
+}
+
+resource "aws_s3_bucket" "new" {
+  bucket = "new"
+}`
+	runner := TestRunner(t, map[string]string{"main.tf": content})
+	runner.state = states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_s3_bucket",
+				Name: "managed",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"bucket":"managed"}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+	})
+
+	expected := map[string]bool{"managed": true, "new": false}
+	for _, resource := range runner.LookupResourcesByType("aws_s3_bucket") {
+		if got := runner.IsResourceInState(resource); got != expected[resource.Name] {
+			t.Fatalf("`%s`: expected %t, but got %t", resource.Name, expected[resource.Name], got)
+		}
+	}
+}
+
+func Test_LookupNewResourcesByType(t *testing.T) {
+	content := `
+resource "aws_s3_bucket" "managed" {
+  bucket = "managed"
+}
+
+resource "aws_s3_bucket" "new" {
+  bucket = "new"
+}`
+	managed := states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_s3_bucket",
+				Name: "managed",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"bucket":"managed"}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+	})
+
+	cases := []struct {
+		Name     string
+		State    *states.State
+		Expected []string
+	}{
+		{
+			Name:     "no state",
+			State:    nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "empty state",
+			State:    states.NewState(),
+			Expected: []string{"managed", "new"},
+		},
+		{
+			Name:     "managed resource",
+			State:    managed,
+			Expected: []string{"new"},
+		},
+	}
+
+	for _, tc := range cases {
+		runner := TestRunnerWithState(t, map[string]string{"main.tf": content}, EmptyConfig(), tc.State)
+
+		got := []string{}
+		for _, resource := range runner.LookupNewResourcesByType("aws_s3_bucket") {
+			got = append(got, resource.Name)
+		}
+		sort.Strings(got)
+		if !cmp.Equal(tc.Expected, got) {
+			t.Fatalf("Failed `%s` test: diff=%s", tc.Name, cmp.Diff(tc.Expected, got))
+		}
+	}
+}
+
+func Test_State_Lookup(t *testing.T) {
+	runner := TestRunner(t, map[string]string{"main.tf": `
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+}`})
+	if runner.State() != nil {
+		t.Fatal("Expected nil state, but got a state")
+	}
+
+	runner.state = states.BuildState(func(s *states.SyncState) {
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "web",
+			}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"instance_type":"t2.large","cpu_core_count":2}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+		s.SetResourceInstanceCurrent(
+			addrs.Resource{
+				Mode: addrs.ManagedResourceMode,
+				Type: "aws_instance",
+				Name: "app",
+			}.Instance(addrs.IntKey(0)).Absolute(addrs.RootModuleInstance),
+			&states.ResourceInstanceObjectSrc{
+				Status:    states.ObjectReady,
+				AttrsJSON: []byte(`{"instance_type":"t2.micro"}`),
+			},
+			addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+		)
+	})
+	state := runner.State()
+
+	cases := []struct {
+		Name     string
+		Address  string
+		Attr     string
+		Expected cty.Value
+		Error    string
+	}{
+		{
+			Name:     "string",
+			Address:  "aws_instance.web",
+			Attr:     "instance_type",
+			Expected: cty.StringVal("t2.large"),
+		},
+		{
+			Name:     "number",
+			Address:  "aws_instance.web",
+			Attr:     "cpu_core_count",
+			Expected: cty.NumberIntVal(2),
+		},
+		{
+			Name:     "instance key",
+			Address:  "aws_instance.app[0]",
+			Attr:     "instance_type",
+			Expected: cty.StringVal("t2.micro"),
+		},
+		{
+			Name:    "attribute not found",
+			Address: "aws_instance.web",
+			Attr:    "ami",
+			Error:   "`ami` is not recorded in `aws_instance.web`",
+		},
+	}
+
+	for _, tc := range cases {
+		object := state.Lookup(tc.Address)
+		if object == nil {
+			t.Fatalf("Failed `%s` test: `%s` is not found", tc.Name, tc.Address)
+		}
+
+		ret, err := object.Attr(tc.Attr)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Fatalf("Failed `%s` test: expected error is `%s`, but got %v", tc.Name, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Failed `%s` test: unexpected error occurred: %s", tc.Name, err)
+		}
+		if !ret.RawEquals(tc.Expected) {
+			t.Fatalf("Failed `%s` test: expected=%#v, got=%#v", tc.Name, tc.Expected, ret)
+		}
+	}
+
+	for _, address := range []string{"aws_instance.db", "aws_instance.app", "module.foo.aws_instance.web", "invalid"} {
+		if object := state.Lookup(address); object != nil {
+			t.Fatalf("Expected `%s` is not found, but got %#v", address, object)
+		}
+	}
+}
+
+func Test_State_Resources(t *testing.T) {
+	state := &State{
+		state: states.BuildState(func(s *states.SyncState) {
+			for _, addr := range []addrs.AbsResourceInstance{
+				addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_instance", Name: "web"}.Instance(addrs.IntKey(0)).Absolute(addrs.RootModuleInstance),
+				addrs.Resource{Mode: addrs.DataResourceMode, Type: "aws_ami", Name: "ubuntu"}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance),
+				addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_vpc", Name: "main"}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance.Child("network", addrs.IntKey(0))),
+				addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_vpc", Name: "main"}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance.Child("network", addrs.IntKey(1))),
+				addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_subnet", Name: "public"}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance.Child("network", addrs.IntKey(0)).Child("subnets", addrs.NoKey)),
+			} {
+				s.SetResourceInstanceCurrent(
+					addr,
+					&states.ResourceInstanceObjectSrc{Status: states.ObjectReady, AttrsJSON: []byte(`{}`)},
+					addrs.NewDefaultProviderConfig("aws").Absolute(addrs.RootModuleInstance),
+				)
+			}
+		}),
+		path: addrs.RootModule,
+	}
+
+	got := []string{}
+	for _, resource := range state.Resources() {
+		got = append(got, resource.String())
+	}
+	expected := []string{"aws_instance.web", "module.network.aws_vpc.main", "module.network.module.subnets.aws_subnet.public"}
+	if !cmp.Equal(expected, got) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got))
+	}
+
+	state.path = addrs.Module{"network"}
+	got = []string{}
+	for _, resource := range state.Resources() {
+		got = append(got, resource.String())
+	}
+	expected = []string{"aws_vpc.main", "module.subnets.aws_subnet.public"}
+	if !cmp.Equal(expected, got) {
+		t.Fatalf("Failed: diff=%s", cmp.Diff(expected, got))
+	}
+}
+
+func Test_StateObject_GenerateConfig(t *testing.T) {
+	object := &StateObject{
+		Address: "aws_security_group.legacy",
+		src: &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{
+  "id": "sg-12345678",
+  "name": "legacy",
+  "revoke_rules_on_delete": false,
+  "timeouts": null,
+  "tags": {"Name": "legacy"},
+  "egress": [],
+  "ingress": [{"from_port": 443, "to_port": 443, "cidr_blocks": ["0.0.0.0/0"]}]
+}`),
+		},
+	}
+
+	ret, err := object.GenerateConfig(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := `resource "aws_security_group" "legacy" {
+  name                   = "legacy"
+  revoke_rules_on_delete = false
+  tags                   = { Name = "legacy" }
+
+  ingress {
+    cidr_blocks = ["0.0.0.0/0"]
+    from_port   = 443
+    to_port     = 443
+  }
+}
+`
+	if string(ret) != expected {
+		t.Fatalf("Failed test: expected=%s, got=%s", expected, string(ret))
+	}
+}
+
+func Test_StateObject_GenerateConfig_withSchema(t *testing.T) {
+	object := &StateObject{
+		Address: "aws_instance.web",
+		src: &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{
+  "id": "i-12345678",
+  "ami": "ami-12345678",
+  "arn": "arn:aws:ec2:us-east-1:123456789012:instance/i-12345678",
+  "instance_type": "t2.micro",
+  "private_ip": "10.0.0.1",
+  "tags": {"Name": "web"},
+  "ebs_block_device": [{"device_name": "/dev/sdb", "volume_size": 8, "volume_id": "vol-12345678"}]
+}`),
+		},
+	}
+	schemas, err := LoadProviderSchemas(filepath.Join("test-fixtures", "provider_schemas", "schemas.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret, err := object.GenerateConfig(schemas.Resources["aws_instance"])
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	// `arn` is purely computed, and `private_ip` and `volume_id` are not in the schema
+	expected := `resource "aws_instance" "web" {
+  ami           = "ami-12345678"
+  instance_type = "t2.micro"
+  tags          = { Name = "web" }
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_size = 8
+  }
+}
+`
+	if string(ret) != expected {
+		t.Fatalf("Failed test: expected=%s, got=%s", expected, string(ret))
+	}
+}
+
+func Test_loadTFState(t *testing.T) {
+	fs := afero.Afero{Fs: afero.NewMemMapFs()}
+	if err := fs.WriteFile(filepath.Join(".terraform", "environment"), []byte("staging"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	state := `{
+  "version": 4,
+  "terraform_version": "0.12.24",
+  "serial": 1,
+  "lineage": "00000000-0000-0000-0000-000000000000",
+  "outputs": {},
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "managed",
+      "provider": "provider.aws",
+      "instances": [
+        {
+          "schema_version": 0,
+          "attributes": {"bucket": "managed"}
+        }
+      ]
+    }
+  ]
+}`
+	if err := fs.WriteFile(filepath.Join("terraform.tfstate.d", "staging", "terraform.tfstate"), []byte(state), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	ret, err := loadTFState(fs, ".", "")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	resource := addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_s3_bucket", Name: "managed"}.Absolute(addrs.RootModuleInstance)
+	if ret == nil || ret.Resource(resource) == nil {
+		t.Fatalf("Expected `aws_s3_bucket.managed` is loaded from the staging workspace, but got %#v", ret)
+	}
+
+	if err := fs.WriteFile("terraform.tfstate", []byte(strings.Replace(state, `"name": "managed"`, `"name": "default"`, 1)), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = loadTFState(fs, ".", "default")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	resource = addrs.Resource{Mode: addrs.ManagedResourceMode, Type: "aws_s3_bucket", Name: "default"}.Absolute(addrs.RootModuleInstance)
+	if ret == nil || ret.Resource(resource) == nil {
+		t.Fatalf("Expected `aws_s3_bucket.default` is loaded from the overridden default workspace, but got %#v", ret)
+	}
+
+	ret, err = loadTFState(afero.Afero{Fs: afero.NewMemMapFs()}, ".", "")
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if ret != nil {
+		t.Fatalf("Expected no state, but got %#v", ret)
+	}
+}
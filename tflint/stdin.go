@@ -0,0 +1,20 @@
+package tflint
+
+import (
+	"log"
+
+	"github.com/spf13/afero"
+)
+
+// NewStdinFs returns a filesystem which has the passed source as the file on top of the base filesystem
+// The file does not have to exist, and the source takes precedence over the file on disk, so editors can inspect unsaved buffers.
+// Other files in the directory are read from the base filesystem. Nothing is written to the base filesystem.
+func NewStdinFs(base afero.Fs, filename string, src []byte) (afero.Fs, error) {
+	log.Printf("[INFO] Read `%s` from stdin", filename)
+
+	layer := afero.NewMemMapFs()
+	if err := afero.WriteFile(layer, filename, src, 0644); err != nil {
+		return nil, err
+	}
+	return afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), layer), nil
+}
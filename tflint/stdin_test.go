@@ -0,0 +1,63 @@
+package tflint
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func Test_NewStdinFs(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Filename string
+	}{
+		{
+			Name:     "existing file",
+			Filename: "main.tf",
+		},
+		{
+			Name:     "new file",
+			Filename: "new.tf",
+		},
+		{
+			Name:     "new directory",
+			Filename: filepath.Join("new", "main.tf"),
+		},
+	}
+
+	for _, tc := range cases {
+		base := afero.NewMemMapFs()
+		afero.WriteFile(base, "main.tf", []byte(`resource "aws_instance" "disk" {}`), 0644)
+		afero.WriteFile(base, "variables.tf", []byte(`variable "foo" {}`), 0644)
+
+		fs, err := NewStdinFs(base, tc.Filename, []byte(`resource "aws_instance" "stdin" {}`))
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		loader, err := NewLoader(EmptyConfig(), WithFS(fs))
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		config, err := loader.LoadConfig(filepath.Dir(tc.Filename))
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+		if _, exists := config.Module.ManagedResources["aws_instance.stdin"]; !exists {
+			t.Fatalf("Failed `%s` test: the source from stdin is not loaded: %#v", tc.Name, config.Module.ManagedResources)
+		}
+		if _, exists := config.Module.Variables["foo"]; filepath.Dir(tc.Filename) == "." && !exists {
+			t.Fatalf("Failed `%s` test: other files on disk are not loaded: %#v", tc.Name, config.Module.Variables)
+		}
+		if src := loader.Sources()[tc.Filename]; string(src) != `resource "aws_instance" "stdin" {}` {
+			t.Fatalf("Failed `%s` test: unexpected source: %s", tc.Name, src)
+		}
+
+		// Files on disk are never overwritten
+		src, _ := afero.ReadFile(base, "main.tf")
+		if string(src) != `resource "aws_instance" "disk" {}` {
+			t.Fatalf("Failed `%s` test: the file on disk is overwritten: %s", tc.Name, src)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package tflint
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// ResolveSymlink returns the info of the file which the symlink points to
+// Infos returned by ReadDir and Walk describe symlinks themselves, so linked directories look like files.
+// It returns the passed info as it is if it is not a symlink, and nil if the link is broken.
+func ResolveSymlink(fs afero.Afero, path string, info os.FileInfo) os.FileInfo {
+	if info.Mode()&os.ModeSymlink == 0 {
+		return info
+	}
+	resolved, err := fs.Stat(path)
+	if err != nil {
+		log.Printf("[WARN] Ignore the broken symlink %s: %s", path, err)
+		return nil
+	}
+	return resolved
+}
+
+// WalkFollowingSymlinks is afero.Walk which also walks directories linked by symlinks
+// Linked directories are walked with paths under the link, as Terraform reads them. Directories already walked
+// are skipped by their real paths, so symlinks to parent directories do not loop forever and a directory linked
+// from several places is walked only once.
+func WalkFollowingSymlinks(fs afero.Afero, root string, walkFn filepath.WalkFunc) error {
+	info, err := fs.Stat(root)
+	if err != nil {
+		return walkFn(root, nil, err)
+	}
+	err = walkFollowingSymlinks(fs, root, info, walkFn, map[string]bool{})
+	if err == filepath.SkipDir {
+		return nil
+	}
+	return err
+}
+
+func walkFollowingSymlinks(fs afero.Afero, path string, info os.FileInfo, walkFn filepath.WalkFunc, visited map[string]bool) error {
+	if !info.IsDir() {
+		return walkFn(path, info, nil)
+	}
+
+	// Real paths are only available on the OS filesystem. Other filesystems have no symlinks
+	if real, err := filepath.EvalSymlinks(path); err == nil {
+		if abs, err := filepath.Abs(real); err == nil {
+			if visited[abs] {
+				log.Printf("[DEBUG] %s is already walked as %s. Skipped", path, abs)
+				return nil
+			}
+			visited[abs] = true
+		}
+	}
+
+	if err := walkFn(path, info, nil); err != nil {
+		return err
+	}
+
+	infos, err := fs.ReadDir(path)
+	if err != nil {
+		return walkFn(path, info, err)
+	}
+	for _, child := range infos {
+		childPath := filepath.Join(path, child.Name())
+		resolved := ResolveSymlink(fs, childPath, child)
+		if resolved == nil {
+			continue
+		}
+
+		err := walkFollowingSymlinks(fs, childPath, resolved, walkFn, visited)
+		if err == filepath.SkipDir {
+			if !resolved.IsDir() {
+				// Skip the remaining files in the directory, the same as filepath.Walk
+				return nil
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
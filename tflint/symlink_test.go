@@ -0,0 +1,71 @@
+package tflint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+func Test_FindConfigDirs_symlinks(t *testing.T) {
+	root, err := ioutil.TempDir("", "symlinks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	outside, err := ioutil.TempDir("", "symlinks-outside")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+
+	for _, dir := range []string{"shared", "stacks/web/modules", "stacks/db"} {
+		if err := os.MkdirAll(filepath.Join(root, filepath.FromSlash(dir)), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{"shared/main.tf", "stacks/web/main.tf"} {
+		if err := ioutil.WriteFile(filepath.Join(root, filepath.FromSlash(file)), []byte{}, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(outside, "main.tf"), []byte{}, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	links := map[string]string{
+		// A file linked into the stack
+		"stacks/db/main.tf": filepath.Join(root, "shared", "main.tf"),
+		// A module shared by stacks, which is already found as `shared`
+		"stacks/web/modules/shared": filepath.Join(root, "shared"),
+		// A directory outside of the root
+		"stacks/web/modules/outside": outside,
+		// A loop
+		"stacks/web/modules/loop": filepath.Join(root, "stacks"),
+		// A broken link
+		"stacks/web/modules/broken": filepath.Join(root, "not_found"),
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, filepath.Join(root, filepath.FromSlash(link))); err != nil {
+			t.Skipf("Symlinks are not supported: %s", err)
+		}
+	}
+
+	dirs, err := FindConfigDirs(afero.Afero{Fs: afero.NewOsFs()}, root)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+
+	expected := []string{
+		filepath.Join(root, "shared"),
+		filepath.Join(root, "stacks", "db"),
+		filepath.Join(root, "stacks", "web"),
+		filepath.Join(root, "stacks", "web", "modules", "outside"),
+	}
+	if !cmp.Equal(expected, dirs) {
+		t.Fatalf("Unexpected directories: diff=%s", cmp.Diff(expected, dirs))
+	}
+}
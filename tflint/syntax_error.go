@@ -0,0 +1,95 @@
+package tflint
+
+import (
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+)
+
+// syntaxErrorRule is a pseudo rule of issues converted from syntax errors
+type syntaxErrorRule struct{}
+
+// Name returns the rule name
+func (r *syntaxErrorRule) Name() string {
+	return "terraform_syntax_error"
+}
+
+// Severity returns the rule severity
+func (r *syntaxErrorRule) Severity() string {
+	return ERROR
+}
+
+// Link returns the rule reference link
+func (r *syntaxErrorRule) Link() string {
+	return ""
+}
+
+// skipBrokenFiles parses files in the directory and hides files with syntax errors from the parser
+// Errors are recorded instead, so the other files are loaded and inspected as usual. Files are only parsed
+// here and in the parser once each, because files once hidden are no longer listed.
+func (l *Loader) skipBrokenFiles(dir string) {
+	primary, override, diags := l.parser.ConfigDirFiles(dir)
+	if diags.HasErrors() {
+		// The parser reports the same error when loading the directory
+		return
+	}
+
+	for _, file := range append(primary, override...) {
+		src, err := l.fs.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		var diags hcl.Diagnostics
+		if strings.HasSuffix(file, ".json") {
+			_, diags = hcljson.Parse(src, file)
+		} else {
+			_, diags = hclsyntax.ParseConfig(src, file, hcl.Pos{Byte: 0, Line: 1, Column: 1})
+		}
+		if !diags.HasErrors() {
+			continue
+		}
+
+		l.logger.Printf("[WARN] Skip `%s` due to syntax errors: %s", file, diags)
+		l.brokenFiles.addPath(file)
+		l.brokenSources[file] = src
+		for _, diag := range diags {
+			if diag.Severity == hcl.DiagError {
+				l.syntaxErrors = append(l.syntaxErrors, diag)
+			}
+		}
+	}
+}
+
+// SyntaxErrors returns syntax errors of files skipped in loading
+// It is always empty unless `report_syntax_errors` is enabled.
+func (l *Loader) SyntaxErrors() hcl.Diagnostics {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.syntaxErrors
+}
+
+// EmitSyntaxErrors converts syntax errors into issues
+// The first error of each file is reported, because subsequent errors are often caused by the first one.
+func (r *Runner) EmitSyntaxErrors(diags hcl.Diagnostics) {
+	reported := map[string]bool{}
+	for _, diag := range diags {
+		if diag.Subject == nil || reported[diag.Subject.Filename] {
+			continue
+		}
+		reported[diag.Subject.Filename] = true
+
+		message := diag.Summary
+		if diag.Detail != "" {
+			message += "; " + diag.Detail
+		}
+		r.emitIssue(&Issue{
+			Rule:    &syntaxErrorRule{},
+			Message: message,
+			Range:   *diag.Subject,
+		})
+	}
+}
@@ -3,7 +3,6 @@ package tflint
 import (
 	"bytes"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -11,10 +10,11 @@ import (
 
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/terraform"
-	"github.com/zclconf/go-cty/cty"
+	"github.com/spf13/afero"
 )
 
 var defaultValuesFile = "terraform.tfvars"
+var defaultValuesJSONFile = "terraform.tfvars.json"
 
 // ParseTFVariables parses the passed Terraform variable CLI arguments, and returns terraform.InputValues
 func ParseTFVariables(vars []string, declVars map[string]*configs.Variable) (terraform.InputValues, error) {
@@ -49,6 +49,39 @@ func ParseTFVariables(vars []string, declVars map[string]*configs.Variable) (ter
 	return variables, nil
 }
 
+// ArrangeTFVariables returns values of variables in order of priority, as with Terraform
+// The passed values must be loaded by LoadValuesFiles with Varfiles of the config, that is,
+// auto-loaded values files followed by a value for each of Varfiles. Variables of the config are
+// placed between values files in the order of the command line.
+func ArrangeTFVariables(values []terraform.InputValues, c *Config, declVars map[string]*configs.Variable) ([]terraform.InputValues, error) {
+	autoLoaded := len(values) - len(c.Varfiles)
+	if autoLoaded < 0 {
+		autoLoaded = 0
+	}
+
+	ret := append([]terraform.InputValues{}, values[:autoLoaded]...)
+	next := 0
+	for i, file := range values[autoLoaded:] {
+		for ; next < len(c.Variables) && c.variablePosition(next) <= i; next++ {
+			vars, err := ParseTFVariables(c.Variables[next:next+1], declVars)
+			if err != nil {
+				return ret, err
+			}
+			ret = append(ret, vars)
+		}
+		ret = append(ret, file)
+	}
+	if next < len(c.Variables) {
+		vars, err := ParseTFVariables(c.Variables[next:], declVars)
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, vars)
+	}
+
+	return ret, nil
+}
+
 func getTFDataDir() string {
 	dir := os.Getenv("TF_DATA_DIR")
 	if dir != "" {
@@ -60,6 +93,16 @@ func getTFDataDir() string {
 	return dir
 }
 
+// getTFDataDirIn returns the data directory of the configuration in the passed directory
+// An absolute TF_DATA_DIR is used as it is.
+func getTFDataDirIn(dir string) string {
+	dataDir := getTFDataDir()
+	if filepath.IsAbs(dataDir) {
+		return dataDir
+	}
+	return filepath.Join(dir, dataDir)
+}
+
 func getTFModuleDir() string {
 	return filepath.Join(getTFDataDir(), "modules")
 }
@@ -68,13 +111,30 @@ func getTFModuleManifestPath() string {
 	return filepath.Join(getTFModuleDir(), "modules.json")
 }
 
-func getTFWorkspace() string {
+// getTFStatePath returns the path of the local state file for the current workspace of the configuration in the directory
+// See https://www.terraform.io/docs/backends/types/local.html
+func getTFStatePath(fs afero.Afero, dir string, override string) string {
+	workspace := getTFWorkspace(fs, dir, override)
+	if workspace == "default" {
+		return filepath.Join(dir, "terraform.tfstate")
+	}
+	return filepath.Join(dir, "terraform.tfstate.d", workspace, "terraform.tfstate")
+}
+
+// getTFWorkspace returns the current workspace of the configuration in the directory
+// The passed workspace, which is set by `--workspace`, takes precedence over TF_WORKSPACE and the environment file.
+// The environment file is read from the data directory in the configuration directory, as Terraform is run there.
+func getTFWorkspace(fs afero.Afero, dir string, override string) string {
+	if override != "" {
+		log.Printf("[INFO] Workspace is overridden: %s", override)
+		return override
+	}
 	if envVar := os.Getenv("TF_WORKSPACE"); envVar != "" {
 		log.Printf("[INFO] TF_WORKSPACE environment variable found: %s", envVar)
 		return envVar
 	}
 
-	envData, _ := ioutil.ReadFile(filepath.Join(getTFDataDir(), "environment"))
+	envData, _ := fs.ReadFile(filepath.Join(getTFDataDirIn(dir), "environment"))
 	current := string(bytes.TrimSpace(envData))
 	if current != "" {
 		log.Printf("[INFO] environment file found: %s", current)
@@ -85,7 +145,10 @@ func getTFWorkspace() string {
 	return current
 }
 
-func getTFEnvVariables() terraform.InputValues {
+// getTFEnvVariables returns values of TF_VAR_* environment variables
+// As with Terraform, values of variables declared with complex types, such as lists and maps, are parsed as HCL expressions.
+// Values which cannot be parsed are ignored with warnings because environment variables are often shared by multiple configurations.
+func getTFEnvVariables(declVars map[string]*configs.Variable) terraform.InputValues {
 	envVariables := make(terraform.InputValues)
 	for _, e := range os.Environ() {
 		idx := strings.Index(e, "=")
@@ -96,8 +159,18 @@ func getTFEnvVariables() terraform.InputValues {
 			log.Printf("[INFO] TF_VAR_* environment variable found: key=%s", envKey)
 			varName := strings.Replace(envKey, "TF_VAR_", "", 1)
 
+			mode := configs.VariableParseLiteral
+			if declVar, declared := declVars[varName]; declared {
+				mode = declVar.ParsingMode
+			}
+			val, diags := mode.Parse(varName, envVal)
+			if diags.HasErrors() {
+				log.Printf("[WARN] Ignore `%s` environment variable: %s", envKey, diags)
+				continue
+			}
+
 			envVariables[varName] = &terraform.InputValue{
-				Value:      cty.StringVal(envVal),
+				Value:      val,
 				SourceType: terraform.ValueFromEnvVar,
 			}
 		}
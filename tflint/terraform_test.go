@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform/configs"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/spf13/afero"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -125,6 +126,86 @@ func Test_ParseTFVariables_errors(t *testing.T) {
 	}
 }
 
+func Test_ArrangeTFVariables(t *testing.T) {
+	fileVal := func(val string) terraform.InputValues {
+		return terraform.InputValues{
+			"foo": &terraform.InputValue{Value: cty.StringVal(val), SourceType: terraform.ValueFromNamedFile},
+		}
+	}
+	values := []terraform.InputValues{
+		{"foo": &terraform.InputValue{Value: cty.StringVal("auto"), SourceType: terraform.ValueFromAutoFile}},
+		fileVal("file1"),
+		fileVal("file2"),
+	}
+
+	cases := []struct {
+		Name     string
+		Config   *Config
+		Expected string
+	}{
+		{
+			Name: "variables after values files",
+			Config: &Config{
+				Varfiles:  []string{"file1.tfvars", "file2.tfvars"},
+				Variables: []string{"foo=cli"},
+			},
+			Expected: "cli",
+		},
+		{
+			Name: "variable before values files",
+			Config: &Config{
+				Varfiles:          []string{"file1.tfvars", "file2.tfvars"},
+				Variables:         []string{"foo=cli"},
+				VariablePositions: []int{0},
+			},
+			Expected: "file2",
+		},
+		{
+			Name: "variable between values files",
+			Config: &Config{
+				Varfiles:          []string{"file1.tfvars", "file2.tfvars"},
+				Variables:         []string{"foo=cli1", "foo=cli2"},
+				VariablePositions: []int{0, 1},
+			},
+			Expected: "file2",
+		},
+		{
+			Name: "last variable",
+			Config: &Config{
+				Varfiles:          []string{"file1.tfvars", "file2.tfvars"},
+				Variables:         []string{"foo=cli1", "foo=cli2"},
+				VariablePositions: []int{1, 2},
+			},
+			Expected: "cli2",
+		},
+		{
+			Name: "no values files",
+			Config: &Config{
+				Varfiles:  []string{},
+				Variables: []string{"foo=cli"},
+			},
+			Expected: "cli",
+		},
+	}
+
+	for _, tc := range cases {
+		loaded := values
+		if len(tc.Config.Varfiles) == 0 {
+			loaded = values[:1]
+		}
+
+		ret, err := ArrangeTFVariables(loaded, tc.Config, map[string]*configs.Variable{})
+		if err != nil {
+			t.Fatalf("Failed `%s` test: Unexpected error occurred: %s", tc.Name, err)
+		}
+
+		val := terraform.InputValues{}.Override(ret...)["foo"].Value
+		if !val.RawEquals(cty.StringVal(tc.Expected)) {
+			t.Fatalf("Failed `%s` test: Expected `%s`, but got `%#v`", tc.Name, tc.Expected, val)
+		}
+	}
+}
+
 func Test_getTFDataDir(t *testing.T) {
 	cases := []struct {
 		Name     string
@@ -252,12 +333,20 @@ func Test_getTFWorkspace(t *testing.T) {
 		Name     string
 		Dir      string
 		EnvVar   map[string]string
+		Override string
 		Expected string
 	}{
 		{
 			Name:     "default",
 			Expected: "default",
 		},
+		{
+			Name:     "override",
+			Dir:      filepath.Join(currentDir, "test-fixtures", "with_environment_file"),
+			EnvVar:   map[string]string{"TF_WORKSPACE": "dev"},
+			Override: "qa",
+			Expected: "qa",
+		},
 		{
 			Name:     "TF_WORKSPACE",
 			EnvVar:   map[string]string{"TF_WORKSPACE": "dev"},
@@ -291,7 +380,7 @@ func Test_getTFWorkspace(t *testing.T) {
 			}
 		}
 
-		ret := getTFWorkspace()
+		ret := getTFWorkspace(afero.Afero{Fs: afero.NewOsFs()}, ".", tc.Override)
 		if ret != tc.Expected {
 			t.Fatalf("Failed `%s` test: expected value is %s, but get %s", tc.Name, tc.Expected, ret)
 		}
@@ -316,6 +405,7 @@ func Test_getTFEnvVariables(t *testing.T) {
 	cases := []struct {
 		Name     string
 		EnvVar   map[string]string
+		DeclVars map[string]*configs.Variable
 		Expected terraform.InputValues
 	}{
 		{
@@ -335,6 +425,23 @@ func Test_getTFEnvVariables(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "complex types",
+			EnvVar: map[string]string{
+				"TF_VAR_zones":   `["us-east-1a", "us-east-1b"]`,
+				"TF_VAR_invalid": `["us-east-1a"`,
+			},
+			DeclVars: map[string]*configs.Variable{
+				"zones":   {Name: "zones", ParsingMode: configs.VariableParseHCL},
+				"invalid": {Name: "invalid", ParsingMode: configs.VariableParseHCL},
+			},
+			Expected: terraform.InputValues{
+				"zones": &terraform.InputValue{
+					Value:      cty.TupleVal([]cty.Value{cty.StringVal("us-east-1a"), cty.StringVal("us-east-1b")}),
+					SourceType: terraform.ValueFromEnvVar,
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -345,7 +452,7 @@ func Test_getTFEnvVariables(t *testing.T) {
 			}
 		}
 
-		ret := getTFEnvVariables()
+		ret := getTFEnvVariables(tc.DeclVars)
 		if !reflect.DeepEqual(tc.Expected, ret) {
 			t.Fatalf("Failed `%s` test:\n Expected: %#v\n Actual: %#v", tc.Name, tc.Expected, ret)
 		}
@@ -0,0 +1,7 @@
+resource "aws_instance" "web" {
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "db" {
+  instance_type = "t2.micro"
+}
@@ -1,5 +1,7 @@
 config {
   module = true
+  module_download = true
+  quick = true
   deep_check = true
   force = true
 
@@ -9,7 +11,10 @@ config {
     region                  = "us-east-1"
     profile                 = "production"
     shared_credentials_file = "~/.aws/myapp"
+    ca_bundle               = "~/.aws/ca.pem"
+    partition               = "aws"
   }
+  cache_aws_credentials = true
 
   ignore_module = {
     "github.com/terraform-linters/example-module" = true
@@ -18,6 +23,37 @@ config {
   varfile = ["example1.tfvars", "example2.tfvars"]
 
   variables = ["foo=bar", "bar=['foo']"]
+
+  plugin_signature_policy = "required"
+
+  sensitive_pattern = "password|token"
+
+  timeout = "5m"
+
+  template = "tflint.tmpl"
+
+  theme = "custom"
+
+  max_issues_per_file   = 20
+  max_issues_per_module = 50
+
+  issue_hook = ["python3", "triage.py"]
+
+  memory_limit = 2048
+
+  report_syntax_errors = true
+
+  escalate_after = 30
+
+  provider_schemas = "schemas.json"
+
+  workspace = "staging"
+
+  cache_dir = ".tflint.cache"
+
+  data_files = {
+    regions = "test-fixtures/config/regions.json"
+  }
 }
 
 rule "aws_instance_invalid_type" {
@@ -25,7 +61,11 @@ rule "aws_instance_invalid_type" {
 }
 
 rule "aws_instance_previous_type" {
-  enabled = false
+  enabled        = false
+  timeout        = "30s"
+  only           = ["envs/prod/**"]
+  only_resources = ["aws_instance.prod_*"]
+  only_tags      = { env = "prod" }
 }
 
 plugin "foo" {
@@ -34,4 +74,18 @@ plugin "foo" {
 
 plugin "bar" {
   enabled = false
+  version = "0.1.0"
+  source  = "github.com/foo/tflint-ruleset-bar"
+}
+
+theme "custom" {
+  error   = ["hi-red", "bold"]
+  warning = ["yellow"]
+}
+
+account "production" {
+  profile     = "production"
+  role_arn    = "arn:aws:iam::123456789012:role/tflint"
+  region      = "us-west-2"
+  directories = ["environments/production"]
 }
@@ -0,0 +1,5 @@
+config {
+  data_files = {
+    amis = "amis.json"
+  }
+}
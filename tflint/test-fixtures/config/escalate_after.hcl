@@ -0,0 +1,3 @@
+config {
+  escalate_after = -5
+}
@@ -0,0 +1,3 @@
+config {
+  max_issues_per_file = -1
+}
@@ -0,0 +1,3 @@
+config {
+  plugin_signature_policy = "none"
+}
@@ -0,0 +1,4 @@
+rule "aws_instance_previous_type" {
+  enabled        = true
+  only_resources = ["aws_instance.[prod"]
+}
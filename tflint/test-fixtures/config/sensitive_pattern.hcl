@@ -0,0 +1,3 @@
+config {
+  sensitive_pattern = "password("
+}
@@ -0,0 +1,3 @@
+config {
+  timeout = "10"
+}
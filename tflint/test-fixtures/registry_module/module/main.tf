@@ -0,0 +1,5 @@
+variable "cidr_block" {}
+
+resource "aws_vpc" "main" {
+  cidr_block = var.cidr_block
+}
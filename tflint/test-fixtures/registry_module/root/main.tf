@@ -0,0 +1,10 @@
+module "vpc" {
+  source  = "example.com/org/vpc/aws"
+  version = "~> 1.0"
+
+  cidr_block = "10.0.0.0/16"
+}
+
+module "s3" {
+  source = "s3::https://s3-eu-west-1.amazonaws.com/examplecorp-terraform-modules/vpc.zip"
+}
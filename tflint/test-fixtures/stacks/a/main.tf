@@ -0,0 +1,8 @@
+variable "instance_type" {}
+
+resource "aws_instance" "web" {
+  instance_type = var.instance_type
+  tags = {
+    Workspace = terraform.workspace
+  }
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/terraform/states"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/spf13/afero"
+	"github.com/terraform-linters/tflint/client"
 )
 
 // TestRunner returns a runner for testing.
@@ -27,7 +29,7 @@ func TestRunnerWithConfig(t *testing.T, files map[string]string, config *Config)
 		}
 	}
 
-	loader, err := NewLoader(fs, config)
+	loader, err := NewLoader(config, WithFS(fs))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -37,20 +39,37 @@ func TestRunnerWithConfig(t *testing.T, files map[string]string, config *Config)
 		t.Fatal(err)
 	}
 
-	runner, err := NewRunner(config, map[string]Annotations{}, cfg, map[string]*terraform.InputValue{})
+	runner, err := NewRunnerWithFS(fs, config, map[string]Annotations{}, cfg, map[string]*terraform.InputValue{})
 	if err != nil {
 		t.Fatal(err)
 	}
+	runner.Sources = loader.Sources()
 
 	return runner
 }
 
+// TestRunnerWithState returns a runner with passed config and state for testing.
+// The state is loaded only in deep check mode, but this runner does not create AWS clients.
+func TestRunnerWithState(t *testing.T, files map[string]string, config *Config, state *states.State) *Runner {
+	runner := TestRunnerWithConfig(t, files, config)
+	runner.state = state
+	return runner
+}
+
+// TestRunnerWithAwsClients returns a runner with passed state and clients for alias providers, keyed by aliases, for testing.
+// Mocks for the default provider are set to AwsClient as usual.
+func TestRunnerWithAwsClients(t *testing.T, files map[string]string, state *states.State, clients map[string]*client.AwsClient) *Runner {
+	runner := TestRunnerWithState(t, files, EmptyConfig(), state)
+	runner.awsClients = clients
+	return runner
+}
+
 // AssertIssues is an assertion helper for comparing issues
 func AssertIssues(t *testing.T, expected Issues, actual Issues) {
 	opts := []cmp.Option{
 		// Byte field will be ignored because it's not important in tests such as positions
 		cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
-		cmpopts.IgnoreFields(Issue{}, "Rule"),
+		cmpopts.IgnoreFields(Issue{}, "Rule", "Address", "Evidence"),
 	}
 	if !cmp.Equal(expected, actual, opts...) {
 		t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(expected, actual, opts...))
@@ -61,7 +80,7 @@ func AssertIssues(t *testing.T, expected Issues, actual Issues) {
 func AssertIssuesWithoutRange(t *testing.T, expected Issues, actual Issues) {
 	opts := []cmp.Option{
 		cmpopts.IgnoreFields(Issue{}, "Range"),
-		cmpopts.IgnoreFields(Issue{}, "Rule"),
+		cmpopts.IgnoreFields(Issue{}, "Rule", "Address", "Evidence"),
 	}
 	if !cmp.Equal(expected, actual, opts...) {
 		t.Fatalf("Expected issues are not matched:\n %s\n", cmp.Diff(expected, actual, opts...))
@@ -27,7 +27,7 @@ func testRunnerWithInputVariables(t *testing.T, files map[string]string, variabl
 		}
 	}
 
-	loader, err := NewLoader(fs, config)
+	loader, err := NewLoader(config, WithFS(fs))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -80,7 +80,7 @@ func withinFixtureDir(t *testing.T, dir string, test func()) {
 }
 
 func testRunnerWithOsFs(t *testing.T, config *Config) *Runner {
-	loader, err := NewLoader(afero.Afero{Fs: afero.NewOsFs()}, config)
+	loader, err := NewLoader(config)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -108,7 +108,7 @@ func testRunnerWithAnnotations(t *testing.T, files map[string]string, annotation
 		}
 	}
 
-	loader, err := NewLoader(fs, config)
+	loader, err := NewLoader(config, WithFS(fs))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,57 @@
+package tflint
+
+import (
+	"log"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// VariableDomain returns values the variable can take according to its validations
+// Only validations in the form of `contains([...], var.name)` with a constant list are recognized.
+// It returns nil if the domain is not known. Rules can use it to check all possible values of variables without defaults.
+func (r *Runner) VariableDomain(name string) []cty.Value {
+	variable, exists := r.TFConfig.Module.Variables[name]
+	if !exists {
+		return nil
+	}
+
+	for _, validation := range variable.Validations {
+		call, ok := validation.Condition.(*hclsyntax.FunctionCallExpr)
+		if !ok || call.Name != "contains" || len(call.Args) != 2 {
+			continue
+		}
+		traversal, ok := call.Args[1].(*hclsyntax.ScopeTraversalExpr)
+		if !ok || len(traversal.Traversal) != 2 || traversal.Traversal.RootName() != "var" {
+			continue
+		}
+
+		list, diags := call.Args[0].Value(nil)
+		if diags.HasErrors() || !list.IsWhollyKnown() || list.IsNull() || !list.CanIterateElements() {
+			continue
+		}
+		ret := []cty.Value{}
+		for it := list.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			ret = append(ret, v)
+		}
+		return ret
+	}
+	return nil
+}
+
+// ignoreVariableValidationDiagnostics ignores errors of validation blocks without the experiment opt-in
+// Custom variable validation is an experiment in the bundled Terraform, but it is generally available in Terraform v0.13+.
+// Validations are still decoded, so rules can inspect them.
+func ignoreVariableValidationDiagnostics(diags hcl.Diagnostics) hcl.Diagnostics {
+	var ret hcl.Diagnostics
+	for _, diag := range diags {
+		if diag.Summary == "Custom variable validation is experimental" {
+			log.Printf("[DEBUG] Ignore an error of the variable validation: %s", diag.Error())
+			continue
+		}
+		ret = append(ret, diag)
+	}
+	return ret
+}
@@ -0,0 +1,58 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func Test_VariableDomain(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+variable "region" {
+  validation {
+    condition     = contains(["us-east-1", "us-west-2"], var.region)
+    error_message = "The region must be supported."
+  }
+}
+
+variable "name" {
+  validation {
+    condition     = length(var.name) > 0
+    error_message = "The name must not be empty."
+  }
+}
+
+variable "instance_type" {}`,
+	})
+
+	cases := []struct {
+		Name     string
+		Expected []cty.Value
+	}{
+		{
+			Name:     "region",
+			Expected: []cty.Value{cty.StringVal("us-east-1"), cty.StringVal("us-west-2")},
+		},
+		{
+			Name:     "name",
+			Expected: nil,
+		},
+		{
+			Name:     "instance_type",
+			Expected: nil,
+		},
+		{
+			Name:     "undeclared",
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		ret := runner.VariableDomain(tc.Name)
+		if !cmp.Equal(tc.Expected, ret, cmp.Comparer(func(x, y cty.Value) bool { return x.RawEquals(y) })) {
+			t.Fatalf("Failed `%s` test: expected=%#v, got=%#v", tc.Name, tc.Expected, ret)
+		}
+	}
+}
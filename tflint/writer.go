@@ -0,0 +1,91 @@
+package tflint
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// FileWriter edits a file in HCL native syntax with hclwrite
+// Untouched parts of the file keep their exact bytes, including comments and alignment,
+// because the edits are converted into a Fix that replaces only the changed bytes.
+type FileWriter struct {
+	filename string
+	src      []byte
+	file     *hclwrite.File
+}
+
+// NewFileWriter returns a writer of the passed source
+func NewFileWriter(filename string, src []byte) (*FileWriter, error) {
+	if !strings.HasSuffix(filename, ".tf") {
+		return nil, fmt.Errorf("`%s` is not a file in HCL native syntax", filename)
+	}
+
+	file, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return &FileWriter{filename: filename, src: src, file: file}, nil
+}
+
+// FileWriter returns a writer of the passed file in loaded sources
+func (r *Runner) FileWriter(filename string) (*FileWriter, error) {
+	src, exists := r.Sources[filename]
+	if !exists {
+		return nil, fmt.Errorf("`%s` is not found in loaded sources", filename)
+	}
+	return NewFileWriter(filename, src)
+}
+
+// Body returns the root body of the file to edit
+func (w *FileWriter) Body() *hclwrite.Body {
+	return w.file.Body()
+}
+
+// Fix returns a fix which applies the edits to the original source
+// The range of the fix covers only the bytes between the first and last changes. It returns nil if nothing is changed.
+func (w *FileWriter) Fix() *Fix {
+	edited := w.file.Bytes()
+	if bytes.Equal(w.src, edited) {
+		return nil
+	}
+
+	prefix := 0
+	for prefix < len(w.src) && prefix < len(edited) && w.src[prefix] == edited[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(w.src)-prefix && suffix < len(edited)-prefix && w.src[len(w.src)-1-suffix] == edited[len(edited)-1-suffix] {
+		suffix++
+	}
+
+	return &Fix{
+		Range: hcl.Range{
+			Filename: w.filename,
+			Start:    posOf(w.src, prefix),
+			End:      posOf(w.src, len(w.src)-suffix),
+		},
+		Text: string(edited[prefix : len(edited)-suffix]),
+	}
+}
+
+// posOf returns the position of the byte offset in the source
+// Columns are counted in characters, so continuation bytes of multi-byte characters are skipped.
+func posOf(src []byte, offset int) hcl.Pos {
+	pos := hcl.InitialPos
+	for _, b := range src[:offset] {
+		switch {
+		case b == '\n':
+			pos.Line++
+			pos.Column = 1
+		case utf8.RuneStart(b):
+			pos.Column++
+		}
+	}
+	pos.Byte = offset
+	return pos
+}
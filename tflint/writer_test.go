@@ -0,0 +1,67 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func Test_FileWriter(t *testing.T) {
+	src := []byte(`# Web servers
+resource "aws_instance" "web" {
+  ami           = "ami-12345678" # pinned
+  instance_type = "t2.micro"
+  tags = {
+    Name = "web",
+  }
+}
+`)
+
+	writer, err := NewFileWriter("main.tf", src)
+	if err != nil {
+		t.Fatalf("Unexpected error occurred: %s", err)
+	}
+	if fix := writer.Fix(); fix != nil {
+		t.Fatalf("Expected no fix before editing, but got %#v", fix)
+	}
+
+	writer.Body().FirstMatchingBlock("resource", []string{"aws_instance", "web"}).Body().SetAttributeValue("instance_type", cty.StringVal("t3.micro"))
+
+	expected := &Fix{
+		Range: hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 4, Column: 21, Byte: 108},
+			End:      hcl.Pos{Line: 4, Column: 22, Byte: 109},
+		},
+		Text: "3",
+	}
+	fix := writer.Fix()
+	if !cmp.Equal(expected, fix) {
+		t.Fatalf("Failed test: diff: %s", cmp.Diff(expected, fix))
+	}
+
+	patched, _ := ApplyFixes(map[string][]byte{"main.tf": src}, Issues{{Rule: &testRule{}, Fix: fix}})
+	expectedSrc := `# Web servers
+resource "aws_instance" "web" {
+  ami           = "ami-12345678" # pinned
+  instance_type = "t3.micro"
+  tags = {
+    Name = "web",
+  }
+}
+`
+	if string(patched["main.tf"]) != expectedSrc {
+		t.Fatalf("Failed test: diff: %s", cmp.Diff(expectedSrc, string(patched["main.tf"])))
+	}
+}
+
+func Test_NewFileWriter_json(t *testing.T) {
+	_, err := NewFileWriter("main.tf.json", []byte("{}"))
+
+	expected := "`main.tf.json` is not a file in HCL native syntax"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Failed test: expected error is `%s`, but got `%v`", expected, err)
+	}
+}
@@ -8,8 +8,9 @@ import (
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/terraform-providers/terraform-provider-aws/aws"
+	"github.com/terraform-linters/tflint/rules/scaffold"
 	"github.com/terraform-linters/tflint/tools/utils"
+	"github.com/terraform-providers/terraform-provider-aws/aws"
 )
 
 type definition struct {
@@ -63,7 +64,7 @@ func main() {
 		for _, rule := range def.Rules {
 			meta := &ruleMeta{
 				RuleName:      rule.Name,
-				RuleNameCC:    utils.ToCamel(rule.Name),
+				RuleNameCC:    scaffold.ToCamel(rule.Name),
 				ResourceType:  rule.Resource,
 				AttributeName: rule.Attribute,
 				DataType:      dataType(rule.Resource, rule.Attribute),
@@ -1,6 +1,9 @@
 package main
 
-import "github.com/terraform-linters/tflint/tools/utils"
+import (
+	"github.com/terraform-linters/tflint/rules/scaffold"
+	"github.com/terraform-linters/tflint/tools/utils"
+)
 
 type providerMeta struct {
 	RuleNameCCList []string
@@ -10,7 +13,7 @@ func generateProviderFile(ruleNames []string) {
 	meta := &providerMeta{}
 
 	for _, ruleName := range ruleNames {
-		meta.RuleNameCCList = append(meta.RuleNameCCList, utils.ToCamel(ruleName))
+		meta.RuleNameCCList = append(meta.RuleNameCCList, scaffold.ToCamel(ruleName))
 	}
 
 	utils.GenerateFile("../rules/provider_model.go", "../rules/provider_model.go.tmpl", meta)
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-linters/tflint/rules/scaffold"
 	"github.com/terraform-linters/tflint/tools/utils"
 )
 
@@ -28,7 +29,7 @@ func generateRuleFile(resource, attribute string, model map[string]interface{},
 
 	meta := &ruleMeta{
 		RuleName:      ruleName,
-		RuleNameCC:    utils.ToCamel(ruleName),
+		RuleNameCC:    scaffold.ToCamel(ruleName),
 		ResourceType:  resource,
 		AttributeName: attribute,
 		Sensitive:     schema.Sensitive,
@@ -49,7 +50,7 @@ func generateRuleTestFile(resource, attribute string, model map[string]interface
 
 	meta := &ruleMeta{
 		RuleName:      ruleName,
-		RuleNameCC:    utils.ToCamel(ruleName),
+		RuleNameCC:    scaffold.ToCamel(ruleName),
 		ResourceType:  resource,
 		AttributeName: attribute,
 		Max:           fetchNumber(model, "max"),
@@ -109,6 +110,8 @@ func replacePattern(pattern string) string {
 	}
 	reg := regexp.MustCompile(`\\u([0-9A-F]{4})`)
 	replaced := reg.ReplaceAllString(pattern, `\x{$1}`)
+	// Some patterns of the API models only accept ARNs of the standard partition
+	replaced = strings.Replace(replaced, "arn:aws:", "arn:aws(-cn|-us-gov)?:", -1)
 	if !strings.HasPrefix(replaced, "^") && !strings.HasSuffix(replaced, "$") {
 		return fmt.Sprintf("^%s$", replaced)
 	}
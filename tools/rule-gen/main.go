@@ -6,14 +6,11 @@ import (
 	"os"
 	"strings"
 
-	"github.com/terraform-linters/tflint/tools/utils"
+	"github.com/terraform-linters/tflint/rules/scaffold"
 )
 
-type metadata struct {
-	RuleName   string
-	RuleNameCC string
-}
-
+// rule-gen is an interactive frontend of `tflint scaffold rule`
+// Both share the generator and the templates in the rules directory.
 func main() {
 	buf := bufio.NewReader(os.Stdin)
 	fmt.Print("Rule name? (e.g. aws_instance_invalid_type): ")
@@ -23,8 +20,11 @@ func main() {
 	}
 	ruleName = strings.Trim(ruleName, "\n")
 
-	meta := &metadata{RuleNameCC: utils.ToCamel(ruleName), RuleName: ruleName}
-
-	utils.GenerateFileWithLogs(fmt.Sprintf("../rules/awsrules/%s.go", ruleName), "../rules/rule.go.tmpl", meta)
-	utils.GenerateFileWithLogs(fmt.Sprintf("../rules/awsrules/%s_test.go", ruleName), "../rules/rule_test.go.tmpl", meta)
+	rule, err := scaffold.NewRule(ruleName)
+	if err != nil {
+		panic(err)
+	}
+	if err := scaffold.Generate("..", rule, os.Stdout); err != nil {
+		panic(err)
+	}
 }
@@ -3,30 +3,9 @@ package utils
 import (
 	"fmt"
 	"os"
-	"strings"
 	"text/template"
-
-	"github.com/serenize/snaker"
 )
 
-// ToCamel converts a string to CamelCase
-func ToCamel(str string) string {
-	exceptions := map[string]string{
-		"ami":         "AMI",
-		"db":          "DB",
-		"alb":         "ALB",
-		"elb":         "ELB",
-		"elasticache": "ElastiCache",
-		"iam":         "IAM",
-	}
-	for pattern, conv := range exceptions {
-		str = strings.Replace(str, "_"+pattern+"_", "_"+conv+"_", -1)
-		str = strings.Replace(str, pattern+"_", conv+"_", -1)
-		str = strings.Replace(str, "_"+pattern, "_"+conv, -1)
-	}
-	return snaker.SnakeToCamel(str)
-}
-
 // GenerateFile generates a new file from the passed template and metadata
 func GenerateFile(fileName string, tmplName string, meta interface{}) {
 	file, err := os.Create(fileName)